@@ -0,0 +1,246 @@
+//go:build !verifyonly
+
+package spec
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+
+	"github.com/bloxapp/dkg-spec/crypto"
+	"github.com/bloxapp/dkg-spec/crypto/bls"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+// OperatorFault attributes a verification failure to the operator that returned the faulty result
+type OperatorFault struct {
+	OperatorID uint64
+	Err        error
+}
+
+// FaultyOperatorsError is returned when one or more operators returned invalid (or no) results
+type FaultyOperatorsError struct {
+	Faults []*OperatorFault
+}
+
+func (e *FaultyOperatorsError) Error() string {
+	return fmt.Sprintf("faulty operators: %v", e.OperatorIDs())
+}
+
+// OperatorIDs returns the (ordered) IDs of all faulty operators
+func (e *FaultyOperatorsError) OperatorIDs() []uint64 {
+	ret := make([]uint64, 0, len(e.Faults))
+	for _, fault := range e.Faults {
+		ret = append(ret, fault.OperatorID)
+	}
+	return ret
+}
+
+// Aggregate is called on the initiator side to combine operator results into the final deposit data of amount gwei and
+// owner/nonce signature.
+// Every result is verified individually (request ID, partial signatures against its SharePubKey and ceremony proof) before any
+// reconstruction takes place, failures are attributed to the operators which returned them via FaultyOperatorsError.
+func Aggregate(
+	operators []*Operator,
+	withdrawalCredentials []byte,
+	validatorPK []byte,
+	fork [4]byte,
+	amount uint64,
+	ownerAddress [20]byte,
+	nonce uint64,
+	requestID [24]byte,
+	results []*Result,
+) (*bls.PublicKey, *phase0.DepositData, *bls.Sign, error) {
+	faults := VerifyResultsIndividually(
+		operators,
+		withdrawalCredentials,
+		validatorPK,
+		fork,
+		amount,
+		ownerAddress,
+		nonce,
+		requestID,
+		results,
+	)
+	if len(faults) > 0 {
+		return nil, nil, nil, &FaultyOperatorsError{Faults: faults}
+	}
+
+	return reconstructFromVerifiedResults(withdrawalCredentials, validatorPK, fork, amount, ownerAddress, nonce, results)
+}
+
+// PartialAggregation is the outcome of AggregatePartial
+type PartialAggregation struct {
+	ValidatorPK         *bls.PublicKey
+	DepositData         *phase0.DepositData
+	OwnerNonceSignature *bls.Sign
+	// Faults of the operators whose results were left out, ordered by operator ID. Empty if every operator returned a valid result.
+	Faults []*OperatorFault
+}
+
+// AggregatePartial is Aggregate for initiators accepting a partially successful ceremony: it succeeds as long as at least
+// threshold t operators returned valid results, reconstructing from those and reporting the faulty operators.
+// If fewer than t results are valid it returns FaultyOperatorsError, as Aggregate does.
+func AggregatePartial(
+	operators []*Operator,
+	t uint64,
+	withdrawalCredentials []byte,
+	validatorPK []byte,
+	fork [4]byte,
+	amount uint64,
+	ownerAddress [20]byte,
+	nonce uint64,
+	requestID [24]byte,
+	results []*Result,
+) (*PartialAggregation, error) {
+	if !ValidThresholdSet(t, operators) {
+		return nil, codedError(CodeInvalidThreshold, "threshold set is invalid")
+	}
+	faults := VerifyResultsIndividually(
+		operators,
+		withdrawalCredentials,
+		validatorPK,
+		fork,
+		amount,
+		ownerAddress,
+		nonce,
+		requestID,
+		results,
+	)
+	faulty := make(map[uint64]bool, len(faults))
+	for _, fault := range faults {
+		faulty[fault.OperatorID] = true
+	}
+	valid := make([]*Result, 0, len(results))
+	for _, result := range results {
+		if !faulty[result.OperatorID] {
+			valid = append(valid, result)
+		}
+	}
+	if uint64(len(valid)) < t {
+		return nil, &FaultyOperatorsError{Faults: faults}
+	}
+	if err := VerifyShareInterpolation(validatorPK, t, valid); err != nil {
+		return nil, err
+	}
+
+	pk, depositData, ownerNonceSig, err := reconstructFromVerifiedResults(withdrawalCredentials, validatorPK, fork, amount, ownerAddress, nonce, valid)
+	if err != nil {
+		return nil, err
+	}
+	return &PartialAggregation{
+		ValidatorPK:         pk,
+		DepositData:         depositData,
+		OwnerNonceSignature: ownerNonceSig,
+		Faults:              faults,
+	}, nil
+}
+
+// VerifyResultsIndividually verifies each result on its own and returns a fault for every operator which returned an invalid,
+// duplicate or no result at all. Faults are ordered by operator ID.
+func VerifyResultsIndividually(
+	operators []*Operator,
+	withdrawalCredentials []byte,
+	validatorPK []byte,
+	fork [4]byte,
+	amount uint64,
+	ownerAddress [20]byte,
+	nonce uint64,
+	requestID [24]byte,
+	results []*Result,
+) []*OperatorFault {
+	faults := make(map[uint64]error)
+	seen := make(map[uint64]bool)
+	for _, result := range results {
+		if seen[result.OperatorID] {
+			faults[result.OperatorID] = fmt.Errorf("duplicate result")
+			continue
+		}
+		seen[result.OperatorID] = true
+
+		if err := ValidateResult(
+			operators,
+			ownerAddress,
+			requestID,
+			withdrawalCredentials,
+			validatorPK,
+			fork,
+			amount,
+			nonce,
+			result,
+		); err != nil {
+			faults[result.OperatorID] = err
+		}
+	}
+	for _, operator := range operators {
+		if !seen[operator.ID] {
+			faults[operator.ID] = fmt.Errorf("missing result")
+		}
+	}
+
+	ret := make([]*OperatorFault, 0, len(faults))
+	for id, err := range faults {
+		ret = append(ret, &OperatorFault{OperatorID: id, Err: err})
+	}
+	sort.Slice(ret, func(i, j int) bool {
+		return ret[i].OperatorID < ret[j].OperatorID
+	})
+	return ret
+}
+
+// reconstructFromVerifiedResults reconstructs and verifies master signatures from results that were already verified individually
+func reconstructFromVerifiedResults(
+	withdrawalCredentials []byte,
+	validatorPK []byte,
+	fork [4]byte,
+	amount uint64,
+	ownerAddress [20]byte,
+	nonce uint64,
+	results []*Result,
+) (*bls.PublicKey, *phase0.DepositData, *bls.Sign, error) {
+	ids := make([]uint64, 0, len(results))
+	sharePubKeys := make([]*bls.PublicKey, 0, len(results))
+	sigsPartialDeposit := make([]*bls.Sign, 0, len(results))
+	sigsPartialOwnerNonce := make([]*bls.Sign, 0, len(results))
+	for _, result := range results {
+		pub, deposit, ownerNonce, err := GetPartialSigsFromResult(result)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		ids = append(ids, result.OperatorID)
+		sharePubKeys = append(sharePubKeys, pub)
+		sigsPartialDeposit = append(sigsPartialDeposit, deposit)
+		sigsPartialOwnerNonce = append(sigsPartialOwnerNonce, ownerNonce)
+	}
+
+	validatorRecoveredPK, err := crypto.RecoverValidatorPublicKey(ids, sharePubKeys)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("failed to recover validator public key from results")
+	}
+	if !bytes.Equal(validatorPK, validatorRecoveredPK.Serialize()) {
+		return nil, nil, nil, fmt.Errorf("invalid recovered validator pubkey")
+	}
+
+	masterDepositSig, masterOwnerNonceSig, err := ReconstructMasterSignatures(ids, sigsPartialDeposit, sigsPartialOwnerNonce)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	depositCredentials, err := crypto.DepositWithdrawalCredentials(fork, withdrawalCredentials)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	depositData := &phase0.DepositData{
+		PublicKey:             phase0.BLSPubKey(validatorRecoveredPK.Serialize()),
+		Amount:                phase0.Gwei(amount),
+		WithdrawalCredentials: depositCredentials,
+		Signature:             phase0.BLSSignature(masterDepositSig.Serialize()),
+	}
+	if err := crypto.VerifyDepositDataForFork(fork, depositData); err != nil {
+		return nil, nil, nil, fmt.Errorf("failed to verify master deposit signature: %v", err)
+	}
+	if !masterOwnerNonceSig.VerifyByte(validatorRecoveredPK, PartialNonceRoot(ownerAddress, nonce)) {
+		return nil, nil, nil, fmt.Errorf("failed to verify master owner/nonce signature")
+	}
+	return validatorRecoveredPK, depositData, masterOwnerNonceSig, nil
+}
@@ -0,0 +1,158 @@
+package api
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// AdmissionPolicy decides what happens to a ceremony request over the concurrency limit
+type AdmissionPolicy int
+
+const (
+	// AdmissionReject rejects requests over the limit immediately
+	AdmissionReject AdmissionPolicy = iota
+	// AdmissionQueue waits up to QueueTimeout for a slot before rejecting
+	AdmissionQueue
+)
+
+type AdmissionConfig struct {
+	// MaxPerOwner is the max number of in-flight ceremonies per owner, 0 for unlimited
+	MaxPerOwner int
+	// MaxPerInitiator is the max number of in-flight ceremonies per initiator, 0 for unlimited
+	MaxPerInitiator int
+	Policy          AdmissionPolicy
+	// QueueTimeout bounds the time a queued request waits for a slot, 0 waits until the request is cancelled
+	QueueTimeout time.Duration
+	// InitiatorKey identifies the initiator of r, defaults to the remote IP
+	InitiatorKey func(r *http.Request) string
+}
+
+// AdmissionController is a middleware limiting the number of simultaneous ceremonies per owner and initiator
+type AdmissionController struct {
+	config     AdmissionConfig
+	owners     *semaphores
+	initiators *semaphores
+}
+
+func NewAdmissionController(config AdmissionConfig) *AdmissionController {
+	if config.InitiatorKey == nil {
+		config.InitiatorKey = RemoteIP
+	}
+	return &AdmissionController{
+		config:     config,
+		owners:     newSemaphores(config.MaxPerOwner),
+		initiators: newSemaphores(config.MaxPerInitiator),
+	}
+}
+
+// Wrap returns next guarded by the admission controller
+func (a *AdmissionController) Wrap(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		owner, found, err := requestOwner(r)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, err)
+			return
+		}
+		if !found {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		ctx := r.Context()
+		if a.config.Policy == AdmissionQueue && a.config.QueueTimeout > 0 {
+			var cancel context.CancelFunc
+			ctx, cancel = context.WithTimeout(ctx, a.config.QueueTimeout)
+			defer cancel()
+		}
+		queue := a.config.Policy == AdmissionQueue
+
+		releaseInitiator, err := a.initiators.acquire(ctx, a.config.InitiatorKey(r), queue)
+		if err != nil {
+			writeError(w, http.StatusTooManyRequests, fmt.Errorf("too many concurrent ceremonies for initiator"))
+			return
+		}
+		defer releaseInitiator()
+		releaseOwner, err := a.owners.acquire(ctx, hex.EncodeToString(owner[:]), queue)
+		if err != nil {
+			writeError(w, http.StatusTooManyRequests, fmt.Errorf("too many concurrent ceremonies for owner"))
+			return
+		}
+		defer releaseOwner()
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+type semaphore struct {
+	slots chan struct{}
+	// refs counts holders and waiters, the semaphore is dropped when it reaches 0
+	refs int
+}
+
+// semaphores is a set of counting semaphores keyed by owner or initiator
+type semaphores struct {
+	max  int
+	mtx  sync.Mutex
+	sems map[string]*semaphore
+}
+
+func newSemaphores(max int) *semaphores {
+	return &semaphores{
+		max:  max,
+		sems: make(map[string]*semaphore),
+	}
+}
+
+// acquire takes a slot for key, waiting for one until ctx is done if queue is true.
+// The returned func releases the slot.
+func (s *semaphores) acquire(ctx context.Context, key string, queue bool) (func(), error) {
+	if s.max <= 0 {
+		return func() {}, nil
+	}
+
+	s.mtx.Lock()
+	sem, found := s.sems[key]
+	if !found {
+		sem = &semaphore{slots: make(chan struct{}, s.max)}
+		s.sems[key] = sem
+	}
+	sem.refs++
+	s.mtx.Unlock()
+
+	acquired := false
+	if queue {
+		select {
+		case sem.slots <- struct{}{}:
+			acquired = true
+		case <-ctx.Done():
+		}
+	} else {
+		select {
+		case sem.slots <- struct{}{}:
+			acquired = true
+		default:
+		}
+	}
+
+	if !acquired {
+		s.unref(key, sem)
+		return nil, fmt.Errorf("no slot available")
+	}
+	return func() {
+		<-sem.slots
+		s.unref(key, sem)
+	}, nil
+}
+
+func (s *semaphores) unref(key string, sem *semaphore) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	sem.refs--
+	if sem.refs == 0 {
+		delete(s.sems, key)
+	}
+}
@@ -0,0 +1,104 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	spec "github.com/bloxapp/dkg-spec"
+	"github.com/bloxapp/dkg-spec/testing/fixtures"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSemaphores(t *testing.T) {
+	s := newSemaphores(1)
+	release, err := s.acquire(context.Background(), "a", false)
+	require.NoError(t, err)
+	_, err = s.acquire(context.Background(), "a", false)
+	require.Error(t, err)
+	releaseB, err := s.acquire(context.Background(), "b", false)
+	require.NoError(t, err)
+	releaseB()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	_, err = s.acquire(ctx, "a", true)
+	require.Error(t, err)
+
+	acquired := make(chan struct{})
+	go func() {
+		release, err := s.acquire(context.Background(), "a", true)
+		require.NoError(t, err)
+		release()
+		close(acquired)
+	}()
+	release()
+	<-acquired
+
+	require.Empty(t, s.sems)
+}
+
+func TestAdmissionController(t *testing.T) {
+	entered := make(chan struct{})
+	unblock := make(chan struct{})
+	blocking := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		entered <- struct{}{}
+		<-unblock
+	})
+	body, err := json.Marshal(&InitRequest{SignedInit: &spec.SignedInit{Init: &spec.Init{Owner: fixtures.TestOwnerAddress}}})
+	require.NoError(t, err)
+	post := func(h http.Handler) *httptest.ResponseRecorder {
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, PathInit, bytes.NewReader(body)))
+		return rec
+	}
+
+	t.Run("reject", func(t *testing.T) {
+		h := NewAdmissionController(AdmissionConfig{MaxPerOwner: 1}).Wrap(blocking)
+		done := make(chan *httptest.ResponseRecorder)
+		go func() { done <- post(h) }()
+		<-entered
+
+		rec := post(h)
+		require.EqualValues(t, http.StatusTooManyRequests, rec.Code)
+		require.JSONEq(t, `{"error":"too many concurrent ceremonies for owner"}`, rec.Body.String())
+
+		unblock <- struct{}{}
+		require.EqualValues(t, http.StatusOK, (<-done).Code)
+	})
+
+	t.Run("queue", func(t *testing.T) {
+		h := NewAdmissionController(AdmissionConfig{MaxPerOwner: 1, Policy: AdmissionQueue}).Wrap(blocking)
+		first := make(chan *httptest.ResponseRecorder)
+		go func() { first <- post(h) }()
+		<-entered
+
+		second := make(chan *httptest.ResponseRecorder)
+		go func() { second <- post(h) }()
+		unblock <- struct{}{}
+		require.EqualValues(t, http.StatusOK, (<-first).Code)
+
+		<-entered
+		unblock <- struct{}{}
+		require.EqualValues(t, http.StatusOK, (<-second).Code)
+	})
+
+	t.Run("queue timeout", func(t *testing.T) {
+		h := NewAdmissionController(AdmissionConfig{MaxPerInitiator: 1, Policy: AdmissionQueue, QueueTimeout: 10 * time.Millisecond}).Wrap(blocking)
+		done := make(chan *httptest.ResponseRecorder)
+		go func() { done <- post(h) }()
+		<-entered
+
+		rec := post(h)
+		require.EqualValues(t, http.StatusTooManyRequests, rec.Code)
+		require.JSONEq(t, `{"error":"too many concurrent ceremonies for initiator"}`, rec.Body.String())
+
+		unblock <- struct{}{}
+		require.EqualValues(t, http.StatusOK, (<-done).Code)
+	})
+}
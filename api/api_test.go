@@ -0,0 +1,137 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	spec "github.com/bloxapp/dkg-spec"
+	"github.com/bloxapp/dkg-spec/testing/fixtures"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestEmbeddedOpenAPI(t *testing.T) {
+	generated, err := GenerateOpenAPI()
+	require.NoError(t, err)
+	require.EqualValues(t, string(generated), string(OpenAPIJSON), "run go generate ./api")
+}
+
+func TestRequestIDJSON(t *testing.T) {
+	id := RequestID(fixtures.TestRequestID)
+	byts, err := json.Marshal(id)
+	require.NoError(t, err)
+
+	decoded := RequestID{}
+	require.NoError(t, json.Unmarshal(byts, &decoded))
+	require.EqualValues(t, id, decoded)
+
+	require.EqualError(t, json.Unmarshal([]byte(`"0102"`), &decoded), "invalid request ID length")
+}
+
+func TestErrorResponse(t *testing.T) {
+	t.Run("batch error", func(t *testing.T) {
+		requestID := RequestID(fixtures.TestRequestID)
+		err := fmt.Errorf("bulk resign: %w", &BatchError{
+			Index: 2,
+			Field: "validator_pubkey",
+			Err:   spec.ValidateInitMessage(&spec.Init{Operators: fixtures.GenerateOperators(4)}),
+		})
+		resp := NewErrorResponse(&requestID, err)
+		require.Equal(t, "bulk resign: message 2: validator_pubkey: threshold set is invalid", resp.Error)
+		require.Equal(t, spec.CodeInvalidThreshold, resp.Code)
+		require.Equal(t, "validator_pubkey", resp.Field)
+		require.Equal(t, 2, *resp.Index)
+
+		byts, err := json.Marshal(resp)
+		require.NoError(t, err)
+		decoded := &ErrorResponse{}
+		require.NoError(t, json.Unmarshal(byts, decoded))
+		require.EqualValues(t, resp, decoded)
+	})
+
+	t.Run("minimal", func(t *testing.T) {
+		byts, err := json.Marshal(NewErrorResponse(nil, fmt.Errorf("failed")))
+		require.NoError(t, err)
+		require.Equal(t, `{"error":"failed"}`, string(byts))
+	})
+}
+
+func TestClient(t *testing.T) {
+	result := fixtures.Results4Operators()[0]
+	mux := http.NewServeMux()
+	mux.HandleFunc(PathInit, func(w http.ResponseWriter, r *http.Request) {
+		req := &InitRequest{}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(req))
+		require.EqualValues(t, fixtures.TestRequestID, req.RequestID)
+		require.Len(t, req.SignedInit.Init.Operators, 4)
+		require.NoError(t, json.NewEncoder(w).Encode(result))
+	})
+	mux.HandleFunc(PathResign, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		require.NoError(t, json.NewEncoder(w).Encode(&ErrorResponse{Error: "invalid owner", Code: spec.CodeProofOwnerMismatch}))
+	})
+	mux.HandleFunc(PathHealth, func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, json.NewEncoder(w).Encode(&HealthResponse{Status: HealthStatusOK}))
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+	client := NewClient(server.URL+"/", nil)
+
+	t.Run("init", func(t *testing.T) {
+		ret, err := client.Init(context.Background(), &InitRequest{
+			RequestID: fixtures.TestRequestID,
+			SignedInit: &spec.SignedInit{
+				Init: &spec.Init{
+					Operators:             fixtures.GenerateOperators(4),
+					T:                     3,
+					WithdrawalCredentials: fixtures.TestWithdrawalCred,
+					Fork:                  fixtures.TestFork,
+					Owner:                 fixtures.TestOwnerAddress,
+				},
+			},
+		})
+		require.NoError(t, err)
+		require.EqualValues(t, result, ret)
+	})
+
+	t.Run("error response", func(t *testing.T) {
+		_, err := client.Resign(context.Background(), &ResignRequest{})
+		require.EqualError(t, err, "operator returned status 400: invalid owner")
+		require.Equal(t, spec.CodeProofOwnerMismatch, spec.ErrorCodeOf(err))
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		_, err := client.Reshare(context.Background(), &ReshareRequest{})
+		require.EqualError(t, err, "operator returned status 404")
+	})
+
+	t.Run("health", func(t *testing.T) {
+		ret, err := client.Health(context.Background())
+		require.NoError(t, err)
+		require.EqualValues(t, HealthStatusOK, ret.Status)
+	})
+}
+
+func TestServerTiming(t *testing.T) {
+	timings := []spec.PhaseTiming{
+		{Phase: spec.PhaseValidation, Duration: 1500 * time.Microsecond},
+		{Phase: spec.PhaseSigning, Duration: 20 * time.Millisecond},
+	}
+	header := FormatServerTiming(timings)
+	require.Equal(t, "validation;dur=1.5, signing;dur=20", header)
+	parsed, err := ParseServerTiming(header)
+	require.NoError(t, err)
+	require.EqualValues(t, timings, parsed)
+
+	parsed, err = ParseServerTiming(`cache;desc="hit", db;dur=2.5`)
+	require.NoError(t, err)
+	require.EqualValues(t, []spec.PhaseTiming{{Phase: "db", Duration: 2500 * time.Microsecond}}, parsed)
+
+	_, err = ParseServerTiming("db;dur=abc")
+	require.Error(t, err)
+}
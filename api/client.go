@@ -0,0 +1,110 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	spec "github.com/bloxapp/dkg-spec"
+)
+
+// Transport delivers ceremony requests to a single operator and returns its result.
+// Client implements it over HTTP, in-process implementations are used for simulations.
+type Transport interface {
+	Init(ctx context.Context, req *InitRequest) (*spec.Result, error)
+	Reshare(ctx context.Context, req *ReshareRequest) (*spec.Result, error)
+	Resign(ctx context.Context, req *ResignRequest) (*spec.Result, error)
+}
+
+var _ Transport = (*Client)(nil)
+
+// Client is a typed client for an operator's HTTP endpoints (see openapi.json)
+type Client struct {
+	baseURL    string
+	httpClient *http.Client
+}
+
+// NewClient returns a client for the operator served at baseURL, http.DefaultClient is used if httpClient is nil
+func NewClient(baseURL string, httpClient *http.Client) *Client {
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+	return &Client{
+		baseURL:    strings.TrimRight(baseURL, "/"),
+		httpClient: httpClient,
+	}
+}
+
+// Init sends an init request, returns the operator's result
+func (c *Client) Init(ctx context.Context, req *InitRequest) (*spec.Result, error) {
+	ret := &spec.Result{}
+	if err := c.do(ctx, http.MethodPost, PathInit, req, ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// Reshare sends a reshare request, returns the operator's result
+func (c *Client) Reshare(ctx context.Context, req *ReshareRequest) (*spec.Result, error) {
+	ret := &spec.Result{}
+	if err := c.do(ctx, http.MethodPost, PathReshare, req, ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// Resign sends a resign request, returns the operator's result
+func (c *Client) Resign(ctx context.Context, req *ResignRequest) (*spec.Result, error) {
+	ret := &spec.Result{}
+	if err := c.do(ctx, http.MethodPost, PathResign, req, ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// Health returns the operator's health status
+func (c *Client) Health(ctx context.Context) (*HealthResponse, error) {
+	ret := &HealthResponse{}
+	if err := c.do(ctx, http.MethodGet, PathHealth, nil, ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+func (c *Client) do(ctx context.Context, method string, path string, body interface{}, ret interface{}) error {
+	var reqBody *bytes.Reader
+	if body != nil {
+		byts, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+		reqBody = bytes.NewReader(byts)
+	} else {
+		reqBody = bytes.NewReader(nil)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, c.baseURL+path, reqBody)
+	if err != nil {
+		return err
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		errResp := &ErrorResponse{}
+		if err := json.NewDecoder(resp.Body).Decode(errResp); err != nil || errResp.Error == "" {
+			return fmt.Errorf("operator returned status %d", resp.StatusCode)
+		}
+		return &ResponseError{StatusCode: resp.StatusCode, Response: errResp}
+	}
+	return json.NewDecoder(resp.Body).Decode(ret)
+}
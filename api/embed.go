@@ -0,0 +1,10 @@
+package api
+
+import (
+	_ "embed"
+)
+
+// OpenAPIJSON is the embedded (generated) OpenAPI document of the operator HTTP endpoints
+//
+//go:embed openapi.json
+var OpenAPIJSON []byte
@@ -0,0 +1,18 @@
+package main
+
+import (
+	"os"
+
+	"github.com/bloxapp/dkg-spec/api"
+)
+
+// writes openapi.json, run from the api package directory (see go:generate)
+func main() {
+	byts, err := api.GenerateOpenAPI()
+	if err != nil {
+		panic(err)
+	}
+	if err := os.WriteFile("openapi.json", byts, 0o644); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,443 @@
+package api
+
+import (
+	"bytes"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+
+	spec "github.com/bloxapp/dkg-spec"
+	"github.com/bloxapp/dkg-spec/crypto"
+	"github.com/bloxapp/dkg-spec/eip1271"
+	"github.com/bloxapp/dkg-spec/wal"
+)
+
+// ReshareDealsProvider returns the old operators' deals (see spec.OperatorReshareDeal) of the reshare ceremony requestID
+// addressed to the handler's operator, exchanged between operators outside of this API
+type ReshareDealsProvider func(requestID RequestID, reshare *spec.Reshare) ([]*spec.ReshareDeal, error)
+
+// ceremony states logged to the WAL after a request's start, see requestKey
+const (
+	stateFailed  = "failed"
+	stateAborted = "aborted"
+)
+
+var errCeremonyAborted = &spec.ValidationError{
+	Code: spec.CodeCeremonyAborted,
+	Err:  errors.New("ceremony aborted, interrupted by an operator restart"),
+}
+
+// Handler serves an operator's HTTP endpoints (see openapi.json)
+type Handler struct {
+	operator *spec.Operator
+	sk       *rsa.PrivateKey
+	client   eip1271.ETHClient
+	shares   spec.ShareProvider
+	wal      *wal.WAL
+	deposits spec.DepositChecker
+	chain    spec.ValidatorChecker
+	mode     *spec.ValidationMode
+	timings  *spec.TimingRecorder
+	deals    ReshareDealsProvider
+	policy   spec.PolicyFunc
+	inflight requestLocks
+	mux      *http.ServeMux
+}
+
+// NewHandler returns a handler serving operator's endpoints.
+// A minimal operator server is http.ListenAndServe(addr, NewHandler(operator, sk, client, shares))
+func NewHandler(
+	operator *spec.Operator,
+	sk *rsa.PrivateKey,
+	client eip1271.ETHClient,
+	shares spec.ShareProvider,
+) *Handler {
+	h := &Handler{
+		operator: operator,
+		sk:       sk,
+		client:   client,
+		shares:   shares,
+		mux:      http.NewServeMux(),
+	}
+	h.mux.HandleFunc(PathInit, h.handleInit)
+	h.mux.HandleFunc(PathReshare, h.handleReshare)
+	h.mux.HandleFunc(PathResign, h.handleResign)
+	h.mux.HandleFunc(PathHealth, h.handleHealth)
+	return h
+}
+
+// NewHandlerFromKeystore returns a handler serving operator's endpoints with the RSA key of the keystore file at path (see
+// crypto.LoadRSAPrivateKey), returns error if it isn't operator's key
+func NewHandlerFromKeystore(
+	operator *spec.Operator,
+	path string,
+	password []byte,
+	client eip1271.ETHClient,
+	shares spec.ShareProvider,
+) (*Handler, error) {
+	sk, err := crypto.LoadRSAPrivateKey(path, password)
+	if err != nil {
+		return nil, err
+	}
+	pk, err := crypto.ParseRSAPublicKey(operator.PubKey)
+	if err != nil {
+		return nil, err
+	}
+	if !pk.Equal(&sk.PublicKey) {
+		return nil, fmt.Errorf("keystore isn't operator %d's key", operator.ID)
+	}
+	return NewHandler(operator, sk, client, shares), nil
+}
+
+// SetWAL makes the handler log every ceremony result before sending it.
+// A request whose result is already logged is answered with the logged result instead of running the ceremony again, a
+// request ID is bound to the ceremony and payload it was first logged with (see requestKey).
+// Ceremonies interrupted by a restart are resumed or aborted when their request is retried, see resume.
+func (h *Handler) SetWAL(w *wal.WAL) {
+	h.wal = w
+}
+
+// SetDepositChecker makes init requests fail if the validator already has a deposit with different withdrawal credentials
+func (h *Handler) SetDepositChecker(checker spec.DepositChecker) {
+	h.deposits = checker
+}
+
+// SetValidatorChecker makes reshare and re-sign requests fail unless the validator is registered on the beacon chain
+// with the requested withdrawal credentials
+func (h *Handler) SetValidatorChecker(checker spec.ValidatorChecker) {
+	h.chain = checker
+}
+
+// SetValidationMode makes requests decode under mode (see spec.DecodeJSON), e.g. spec.ValidationStrict to reject anything
+// but canonically encoded requests
+func (h *Handler) SetValidationMode(mode spec.ValidationMode) {
+	h.mode = &mode
+}
+
+// SetReshareDealsProvider enables reshare requests, which fail unless the old operators' deals are provided
+func (h *Handler) SetReshareDealsProvider(deals ReshareDealsProvider) {
+	h.deals = deals
+}
+
+// SetTimingRecorder makes ceremony responses report their phase timings taken from recorder in a Server-Timing header
+// (see ParseServerTiming), recorder must be installed with spec.SetTimingHook(recorder.Hook)
+func (h *Handler) SetTimingRecorder(recorder *spec.TimingRecorder) {
+	h.timings = recorder
+}
+
+// SetPolicy makes the handler reject ceremonies policy rejects, before any ceremony work.
+// Init policies get the verified initiator in their context (see spec.InitiatorFromContext).
+func (h *Handler) SetPolicy(policy spec.PolicyFunc) {
+	h.policy = policy
+}
+
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	h.mux.ServeHTTP(w, r)
+}
+
+func (h *Handler) handleInit(w http.ResponseWriter, r *http.Request) {
+	req := &InitRequest{}
+	if !h.decodeRequest(w, r, req) {
+		return
+	}
+	if req.SignedInit == nil || req.SignedInit.Init == nil {
+		writeRequestError(w, http.StatusBadRequest, req.RequestID, fmt.Errorf("missing signed init"))
+		return
+	}
+
+	// the initiator is only known once its signature is verified, policies read it from the context
+	if err := spec.VerifySignedInit(req.SignedInit); err != nil {
+		writeRequestError(w, errorStatus(err), req.RequestID, err)
+		return
+	}
+	r = r.WithContext(spec.ContextWithInitiator(r.Context(), req.SignedInit.InitiatorPubKey))
+	if !h.applyPolicy(w, r, req.RequestID, req.SignedInit.Init) {
+		return
+	}
+
+	h.run(w, req.RequestID, "init", req, func() (*spec.Result, error) {
+		return spec.OperatorSignedInit(req.SignedInit, req.RequestID, h.operator.ID, h.sk, h.deposits)
+	})
+}
+
+func (h *Handler) handleReshare(w http.ResponseWriter, r *http.Request) {
+	req := &ReshareRequest{}
+	if !h.decodeRequest(w, r, req) {
+		return
+	}
+	if req.SignedReshare == nil || (req.Proof != nil && req.Proof.Proof == nil) {
+		writeRequestError(w, http.StatusBadRequest, req.RequestID, fmt.Errorf("missing signed reshare or proof"))
+		return
+	}
+	// operators joining the cluster hold no proof of the validator's ceremony
+	if req.Proof == nil && spec.GetOperator(req.SignedReshare.Reshare.OldOperators, h.operator.ID) != nil {
+		writeRequestError(w, http.StatusBadRequest, req.RequestID, fmt.Errorf("missing proof"))
+		return
+	}
+
+	if !h.applyPolicy(w, r, req.RequestID, &req.SignedReshare.Reshare) {
+		return
+	}
+
+	h.run(w, req.RequestID, "reshare", req, func() (*spec.Result, error) {
+		if h.deals == nil {
+			return nil, fmt.Errorf("reshare deals provider not set")
+		}
+		deals, err := h.deals(req.RequestID, &req.SignedReshare.Reshare)
+		if err != nil {
+			return nil, err
+		}
+		return spec.OperatorReshare(req.SignedReshare, h.operator, req.Proof, req.Params, deals, req.RequestID, h.sk, h.client, h.chain)
+	})
+}
+
+func (h *Handler) handleResign(w http.ResponseWriter, r *http.Request) {
+	req := &ResignRequest{}
+	if !h.decodeRequest(w, r, req) {
+		return
+	}
+	if req.SignedResign == nil || req.Proof == nil || req.Proof.Proof == nil {
+		writeRequestError(w, http.StatusBadRequest, req.RequestID, fmt.Errorf("missing signed resign or proof"))
+		return
+	}
+
+	if !h.applyPolicy(w, r, req.RequestID, &req.SignedResign.Resign) {
+		return
+	}
+
+	h.run(w, req.RequestID, "resign", req, func() (*spec.Result, error) {
+		if _, err := h.shares.SharePubKey(req.SignedResign.Resign.ValidatorPubKey); err != nil {
+			return nil, err
+		}
+		return spec.OperatorResign(req.SignedResign, h.operator, req.Proof, req.Params, req.RequestID, h.shares, h.sk, h.client, h.chain)
+	})
+}
+
+func (h *Handler) handleHealth(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeError(w, http.StatusMethodNotAllowed, fmt.Errorf("method %s not allowed", r.Method))
+		return
+	}
+	writeJSON(w, http.StatusOK, &HealthResponse{Status: HealthStatusOK})
+}
+
+// decodeRequest decodes a POST request body into req, writes an error response and returns false on failure
+func (h *Handler) decodeRequest(w http.ResponseWriter, r *http.Request, req interface{}) bool {
+	if r.Method != http.MethodPost {
+		writeError(w, http.StatusMethodNotAllowed, fmt.Errorf("method %s not allowed", r.Method))
+		return false
+	}
+	body := http.MaxBytesReader(w, r.Body, maxRequestBody)
+	var err error
+	if h.mode == nil {
+		err = json.NewDecoder(body).Decode(req)
+	} else {
+		var byts []byte
+		if byts, err = io.ReadAll(body); err == nil {
+			err = spec.DecodeJSON(byts, req, *h.mode)
+		}
+	}
+	if err != nil {
+		status := http.StatusBadRequest
+		var maxBytesErr *http.MaxBytesError
+		if errors.As(err, &maxBytesErr) {
+			status = http.StatusRequestEntityTooLarge
+		}
+		writeError(w, status, fmt.Errorf("invalid request: %w", err))
+		return false
+	}
+	return true
+}
+
+// applyPolicy writes an error response and returns false if the handler's policy rejects message
+func (h *Handler) applyPolicy(w http.ResponseWriter, r *http.Request, requestID RequestID, message spec.CeremonyMessage) bool {
+	if err := spec.ApplyPolicy(r.Context(), h.policy, message); err != nil {
+		writeRequestError(w, http.StatusForbidden, requestID, err)
+		return false
+	}
+	return true
+}
+
+// run runs a ceremony and writes its result, logging state transitions and the result to the WAL if set
+func (h *Handler) run(
+	w http.ResponseWriter,
+	requestID RequestID,
+	ceremony string,
+	req interface{},
+	ceremonyF func() (*spec.Result, error),
+) {
+	if h.wal == nil {
+		result, err := ceremonyF()
+		h.writeTimings(w, requestID)
+		if err != nil {
+			writeRequestError(w, errorStatus(err), requestID, err)
+			return
+		}
+		writeJSON(w, http.StatusOK, result)
+		return
+	}
+
+	// concurrent requests of the same ID wait for the first one, then are answered from the WAL
+	unlock := h.inflight.lock(requestID)
+	defer unlock()
+	key, err := requestKey(ceremony, req)
+	if err != nil {
+		writeRequestError(w, http.StatusInternalServerError, requestID, err)
+		return
+	}
+	if states := h.wal.States(requestID); len(states) > 0 && !bytes.Equal(states[0], key) {
+		writeRequestError(w, http.StatusConflict, requestID, fmt.Errorf("request ID already used by another request"))
+		return
+	}
+	if byts, found := h.wal.Outbound(requestID); found {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write(byts)
+		return
+	}
+	if states := h.wal.States(requestID); len(states) > 0 {
+		if err := h.resume(requestID, ceremony, states[len(states)-1]); err != nil {
+			writeRequestError(w, errorStatus(err), requestID, err)
+			return
+		}
+	}
+	if err := h.logState(requestID, string(key)); err != nil {
+		writeRequestError(w, http.StatusInternalServerError, requestID, err)
+		return
+	}
+	result, err := ceremonyF()
+	h.writeTimings(w, requestID)
+	if err != nil {
+		if logErr := h.logState(requestID, stateFailed); logErr != nil {
+			err = logErr
+		}
+		writeRequestError(w, errorStatus(err), requestID, err)
+		return
+	}
+	byts, err := json.Marshal(result)
+	if err != nil {
+		writeRequestError(w, http.StatusInternalServerError, requestID, err)
+		return
+	}
+	// the result is durably logged before it's sent so it's never lost nor produced twice
+	if err := h.wal.Append(&wal.Record{RequestID: requestID, Type: wal.RecordOutbound, Data: byts}); err != nil {
+		writeRequestError(w, http.StatusInternalServerError, requestID, err)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(byts)
+}
+
+// resume returns nil if the ceremony of requestID, whose last logged state is state, can run.
+// A ceremony whose start is its last state was interrupted before its result or failure was logged, i.e. the operator
+// stopped mid-ceremony. Re-signing is deterministic so an interrupted resign continues, an interrupted init or reshare may
+// have dealt shares already so it's aborted for good: its request ID fails with CodeCeremonyAborted, and the initiator
+// starts over with a new request ID.
+func (h *Handler) resume(requestID RequestID, ceremony string, state []byte) error {
+	switch string(state) {
+	case stateAborted:
+		return errCeremonyAborted
+	case stateFailed:
+		return nil
+	}
+	if ceremony == "resign" {
+		return nil
+	}
+	if err := h.logState(requestID, stateAborted); err != nil {
+		return err
+	}
+	return errCeremonyAborted
+}
+
+// requestKey returns the ceremony and payload hash a request ID is bound to, logged as the request's first state
+func requestKey(ceremony string, req interface{}) ([]byte, error) {
+	byts, err := json.Marshal(req)
+	if err != nil {
+		return nil, err
+	}
+	return []byte(fmt.Sprintf("%s %x", ceremony, sha256.Sum256(byts))), nil
+}
+
+// requestLocks serializes requests of the same ID
+type requestLocks struct {
+	mtx   sync.Mutex
+	locks map[RequestID]*requestLock
+}
+
+type requestLock struct {
+	sync.Mutex
+	waiting int
+}
+
+// lock blocks until no other request of requestID runs, returns the function releasing the lock
+func (l *requestLocks) lock(requestID RequestID) func() {
+	l.mtx.Lock()
+	if l.locks == nil {
+		l.locks = make(map[RequestID]*requestLock)
+	}
+	lock, found := l.locks[requestID]
+	if !found {
+		lock = &requestLock{}
+		l.locks[requestID] = lock
+	}
+	lock.waiting++
+	l.mtx.Unlock()
+
+	lock.Lock()
+	return func() {
+		lock.Unlock()
+		l.mtx.Lock()
+		defer l.mtx.Unlock()
+		if lock.waiting--; lock.waiting == 0 {
+			delete(l.locks, requestID)
+		}
+	}
+}
+
+// errorStatus returns the response status of a ceremony failure: a coded failure (see spec.ErrorCode) is the request's, any
+// other failure is the operator's
+func errorStatus(err error) int {
+	switch code := spec.ErrorCodeOf(err); {
+	case code == spec.CodeUnknown, code == spec.CodeChainLookupFailed:
+		return http.StatusInternalServerError
+	case code == spec.CodeCeremonyAborted:
+		return http.StatusConflict
+	case code >= spec.CodeInitiatorNotAllowed:
+		return http.StatusForbidden
+	default:
+		return http.StatusBadRequest
+	}
+}
+
+func (h *Handler) writeTimings(w http.ResponseWriter, requestID RequestID) {
+	if h.timings == nil {
+		return
+	}
+	if timings := h.timings.Take(requestID); len(timings) > 0 {
+		w.Header().Set(ServerTimingHeader, FormatServerTiming(timings))
+	}
+}
+
+func (h *Handler) logState(requestID RequestID, state string) error {
+	return h.wal.Append(&wal.Record{RequestID: requestID, Type: wal.RecordState, Data: []byte(state)})
+}
+
+func writeError(w http.ResponseWriter, status int, err error) {
+	writeJSON(w, status, NewErrorResponse(nil, err))
+}
+
+func writeRequestError(w http.ResponseWriter, status int, requestID RequestID, err error) {
+	writeJSON(w, status, NewErrorResponse(&requestID, err))
+}
+
+func writeJSON(w http.ResponseWriter, status int, body interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(body)
+}
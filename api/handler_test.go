@@ -0,0 +1,552 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"crypto/rsa"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+
+	spec "github.com/bloxapp/dkg-spec"
+	"github.com/bloxapp/dkg-spec/crypto"
+	"github.com/bloxapp/dkg-spec/crypto/bls"
+	"github.com/bloxapp/dkg-spec/eip1271"
+	"github.com/bloxapp/dkg-spec/testing/fixtures"
+	"github.com/bloxapp/dkg-spec/testing/stubs"
+	"github.com/bloxapp/dkg-spec/wal"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+func contractOwnerClient() *stubs.Client {
+	return &stubs.Client{
+		CallContractF: func(call ethereum.CallMsg) ([]byte, error) {
+			ret := make([]byte, 32)
+			copy(ret[:4], eip1271.MagicValue[:])
+			return ret, nil
+		},
+		CodeAtMap: map[common.Address]bool{
+			fixtures.TestOwnerAddress: true,
+		},
+	}
+}
+
+type validatorCheckerF func(validatorPK []byte) (*spec.ChainValidator, error)
+
+func (f validatorCheckerF) Validator(validatorPK []byte) (*spec.ChainValidator, error) {
+	return f(validatorPK)
+}
+
+func TestHandler(t *testing.T) {
+	operators := fixtures.GenerateOperators(4)
+	shares := spec.ShareLookup(func(validatorPK []byte) (*bls.SecretKey, error) {
+		if !bytes.Equal(validatorPK, fixtures.ShareSK(fixtures.TestValidator4Operators).GetPublicKey().Serialize()) {
+			return nil, fmt.Errorf("unknown validator")
+		}
+		return fixtures.ShareSK(fixtures.TestValidator4OperatorsShare1), nil
+	})
+	server := httptest.NewServer(NewHandler(
+		operators[0],
+		fixtures.OperatorSK(fixtures.TestOperator1SK),
+		contractOwnerClient(),
+		shares,
+	))
+	defer server.Close()
+	client := NewClient(server.URL, nil)
+
+	resign := spec.Resign{
+		ValidatorPubKey:       fixtures.TestOperator1Proof4Operators.Proof.ValidatorPubKey,
+		Fork:                  fixtures.TestFork,
+		WithdrawalCredentials: fixtures.TestWithdrawalCred[:32],
+		Owner:                 fixtures.TestOwnerAddress,
+		Nonce:                 1,
+		Amount:                spec.DepositAmount,
+	}
+
+	t.Run("resign", func(t *testing.T) {
+		result, err := client.Resign(context.Background(), &ResignRequest{
+			RequestID:    fixtures.TestRequestID,
+			SignedResign: &spec.SignedResign{Resign: resign, Signature: make([]byte, 65)},
+			Proof:        &fixtures.TestOperator1Proof4Operators,
+			Params:       fixtures.TestParams(4),
+		})
+		require.NoError(t, err)
+		require.NoError(t, spec.ValidateResult(
+			operators,
+			resign.Owner,
+			fixtures.TestRequestID,
+			resign.WithdrawalCredentials,
+			resign.ValidatorPubKey,
+			resign.Fork,
+			spec.DepositAmount,
+			resign.Nonce,
+			result,
+		))
+	})
+
+	t.Run("resign timings", func(t *testing.T) {
+		recorder := spec.NewTimingRecorder()
+		spec.SetTimingHook(recorder.Hook)
+		defer spec.SetTimingHook(nil)
+		h := NewHandler(operators[0], fixtures.OperatorSK(fixtures.TestOperator1SK), contractOwnerClient(), shares)
+		h.SetTimingRecorder(recorder)
+		h.SetValidatorChecker(validatorCheckerF(func([]byte) (*spec.ChainValidator, error) {
+			return &spec.ChainValidator{Status: spec.ValidatorActiveOngoing, WithdrawalCredentials: crypto.ETH1WithdrawalCredentials(resign.WithdrawalCredentials)}, nil
+		}))
+		server := httptest.NewServer(h)
+		defer server.Close()
+		body, err := json.Marshal(&ResignRequest{
+			RequestID:    fixtures.TestRequestID,
+			SignedResign: &spec.SignedResign{Resign: resign, Signature: make([]byte, 65)},
+			Proof:        &fixtures.TestOperator1Proof4Operators,
+			Params:       fixtures.TestParams(4),
+		})
+		require.NoError(t, err)
+		resp, err := http.Post(server.URL+PathResign, "application/json", bytes.NewReader(body))
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+
+		timings, err := ParseServerTiming(resp.Header.Get(ServerTimingHeader))
+		require.NoError(t, err)
+		phases := make([]spec.Phase, len(timings))
+		for i, timing := range timings {
+			phases[i] = timing.Phase
+		}
+		require.EqualValues(t, []spec.Phase{
+			spec.PhaseOwnerSignature,
+			spec.PhaseValidation,
+			spec.PhaseChainCheck,
+			spec.PhaseSigning,
+		}, phases)
+		require.Empty(t, recorder.Take(fixtures.TestRequestID))
+	})
+
+	t.Run("resign validator not on chain", func(t *testing.T) {
+		h := NewHandler(operators[0], fixtures.OperatorSK(fixtures.TestOperator1SK), contractOwnerClient(), shares)
+		h.SetValidatorChecker(validatorCheckerF(func([]byte) (*spec.ChainValidator, error) {
+			return nil, nil
+		}))
+		server := httptest.NewServer(h)
+		defer server.Close()
+		_, err := NewClient(server.URL, nil).Resign(context.Background(), &ResignRequest{
+			SignedResign: &spec.SignedResign{Resign: resign, Signature: make([]byte, 65)},
+			Proof:        &fixtures.TestOperator1Proof4Operators,
+			Params:       fixtures.TestParams(4),
+		})
+		require.EqualError(t, err, "operator returned status 400: validator not found on chain")
+		require.Equal(t, spec.CodeValidatorNotFound, spec.ErrorCodeOf(err))
+	})
+
+	t.Run("resign with validator index", func(t *testing.T) {
+		h := NewHandler(operators[0], fixtures.OperatorSK(fixtures.TestOperator1SK), contractOwnerClient(), shares)
+		h.SetValidatorChecker(validatorCheckerF(func([]byte) (*spec.ChainValidator, error) {
+			return &spec.ChainValidator{Index: 42, Status: spec.ValidatorActiveOngoing, WithdrawalCredentials: crypto.ETH1WithdrawalCredentials(resign.WithdrawalCredentials)}, nil
+		}))
+		server := httptest.NewServer(h)
+		defer server.Close()
+		result, err := NewClient(server.URL, nil).Resign(context.Background(), &ResignRequest{
+			SignedResign: &spec.SignedResign{Resign: resign, Signature: make([]byte, 65)},
+			Proof:        &fixtures.TestOperator1Proof4Operators,
+			Params:       fixtures.TestParams(4),
+		})
+		require.NoError(t, err)
+		require.EqualValues(t, 42, result.ValidatorIndex)
+	})
+
+	t.Run("resign rejected by policy", func(t *testing.T) {
+		h := NewHandler(operators[0], fixtures.OperatorSK(fixtures.TestOperator1SK), contractOwnerClient(), shares)
+		h.SetPolicy(func(ctx context.Context, message spec.CeremonyMessage) error {
+			return fmt.Errorf("owner %x not KYC'd", message.GetOwner())
+		})
+		server := httptest.NewServer(h)
+		defer server.Close()
+		_, err := NewClient(server.URL, nil).Resign(context.Background(), &ResignRequest{
+			SignedResign: &spec.SignedResign{Resign: resign, Signature: make([]byte, 65)},
+			Proof:        &fixtures.TestOperator1Proof4Operators,
+			Params:       fixtures.TestParams(4),
+		})
+		require.EqualError(t, err, fmt.Sprintf("operator returned status 403: owner %x not KYC'd", resign.Owner))
+		require.Equal(t, spec.CodePolicyRejected, spec.ErrorCodeOf(err))
+	})
+
+	t.Run("init initiator policy", func(t *testing.T) {
+		initiator := fixtures.OperatorSK(fixtures.TestOperator1SK)
+		other := fixtures.OperatorSK(fixtures.TestOperator2SK)
+		initiatorID, err := spec.InitiatorID(fixtures.EncodedOperatorPK(fixtures.TestOperator1SK))
+		require.NoError(t, err)
+		otherID, err := spec.InitiatorID(fixtures.EncodedOperatorPK(fixtures.TestOperator2SK))
+		require.NoError(t, err)
+		policyClient := func(policy *spec.InitiatorPolicy) *Client {
+			h := NewHandler(operators[0], fixtures.OperatorSK(fixtures.TestOperator1SK), contractOwnerClient(), shares)
+			h.SetPolicy(policy.PolicyFunc())
+			server := httptest.NewServer(h)
+			t.Cleanup(server.Close)
+			return NewClient(server.URL, nil)
+		}
+		// the init is invalid, so an initiator passing the policy gets the init's validation error
+		initReq := func(sk *rsa.PrivateKey) *InitRequest {
+			signed, err := spec.SignInit(&spec.Init{Operators: operators, T: 2, Owner: fixtures.TestOwnerAddress}, [24]byte{}, sk)
+			require.NoError(t, err)
+			return &InitRequest{SignedInit: signed}
+		}
+
+		allowing := policyClient(&spec.InitiatorPolicy{AllowedInitiators: []common.Hash{initiatorID}})
+		_, err = allowing.Init(context.Background(), initReq(initiator))
+		require.Equal(t, spec.CodeInvalidThreshold, spec.ErrorCodeOf(err))
+		_, err = allowing.Init(context.Background(), initReq(other))
+		require.EqualError(t, err, fmt.Sprintf("operator returned status 403: initiator %x not allowed", otherID))
+		require.Equal(t, spec.CodeInitiatorNotAllowed, spec.ErrorCodeOf(err))
+
+		denying := policyClient(&spec.InitiatorPolicy{DeniedInitiators: []common.Hash{initiatorID}})
+		_, err = denying.Init(context.Background(), initReq(initiator))
+		require.EqualError(t, err, fmt.Sprintf("operator returned status 403: initiator %x denied", initiatorID))
+		_, err = denying.Init(context.Background(), initReq(other))
+		require.Equal(t, spec.CodeInvalidThreshold, spec.ErrorCodeOf(err))
+	})
+
+	t.Run("resign unknown share", func(t *testing.T) {
+		unknown := resign
+		unknown.ValidatorPubKey = make([]byte, 48)
+		_, err := client.Resign(context.Background(), &ResignRequest{
+			SignedResign: &spec.SignedResign{Resign: unknown, Signature: make([]byte, 65)},
+			Proof:        &fixtures.TestOperator1Proof4Operators,
+			Params:       fixtures.TestParams(4),
+		})
+		require.EqualError(t, err, "operator returned status 500: unknown validator")
+	})
+
+	t.Run("resign invalid proof", func(t *testing.T) {
+		_, err := client.Resign(context.Background(), &ResignRequest{
+			SignedResign: &spec.SignedResign{Resign: resign, Signature: make([]byte, 65)},
+			Proof:        &fixtures.TestOperator2Proof4Operators,
+			Params:       fixtures.TestParams(4),
+		})
+		require.EqualError(t, err, "operator returned status 400: crypto/rsa: verification error")
+		require.Equal(t, spec.CodeInvalidProofSignature, spec.ErrorCodeOf(err))
+		respErr := &ResponseError{}
+		require.ErrorAs(t, err, &respErr)
+		require.EqualValues(t, RequestID{}, *respErr.Response.RequestID)
+	})
+
+	t.Run("reshare missing proof", func(t *testing.T) {
+		_, err := client.Reshare(context.Background(), &ReshareRequest{})
+		require.EqualError(t, err, "operator returned status 400: missing signed reshare or proof")
+
+		// operator 1 holds a share of the validator, so it must send its proof
+		_, err = client.Reshare(context.Background(), &ReshareRequest{
+			SignedReshare: &spec.SignedReshare{Reshare: fixtures.TestReshare4Operators, Signature: make([]byte, 65)},
+		})
+		require.EqualError(t, err, "operator returned status 400: missing proof")
+	})
+
+	t.Run("reshare joining operator", func(t *testing.T) {
+		reshare := fixtures.TestReshare4Operators
+		reshare.WithdrawalCredentials = fixtures.TestOwnerAddress[:]
+		joining := reshare.NewOperators[3]
+		require.Nil(t, spec.GetOperator(reshare.OldOperators, joining.ID))
+		oldShares := []string{
+			fixtures.TestValidator4OperatorsShare1,
+			fixtures.TestValidator4OperatorsShare2,
+			fixtures.TestValidator4OperatorsShare3,
+		}
+		h := NewHandler(joining, fixtures.OperatorSK(fixtures.TestOperator5SK), contractOwnerClient(), shares)
+		h.SetReshareDealsProvider(func(requestID RequestID, reshare *spec.Reshare) ([]*spec.ReshareDeal, error) {
+			ret := make([]*spec.ReshareDeal, 0, len(oldShares))
+			for i, share := range oldShares {
+				dealt, err := spec.DealReshare(reshare, requestID, uint64(i+1), fixtures.ShareSK(share))
+				if err != nil {
+					return nil, err
+				}
+				for _, deal := range dealt {
+					if deal.RecipientID == joining.ID {
+						ret = append(ret, deal)
+					}
+				}
+			}
+			return ret, nil
+		})
+		server := httptest.NewServer(h)
+		defer server.Close()
+
+		result, err := NewClient(server.URL, nil).Reshare(context.Background(), &ReshareRequest{
+			RequestID:     fixtures.TestRequestID,
+			SignedReshare: &spec.SignedReshare{Reshare: reshare, Signature: make([]byte, 65)},
+		})
+		require.NoError(t, err)
+		require.EqualValues(t, joining.ID, result.OperatorID)
+		require.NoError(t, spec.VerifyProofCommitments(result.SignedProof.Proof, joining.ID))
+	})
+
+	t.Run("strict validation", func(t *testing.T) {
+		h := NewHandler(operators[0], fixtures.OperatorSK(fixtures.TestOperator1SK), contractOwnerClient(), shares)
+		h.SetValidationMode(spec.ValidationStrict)
+		server := httptest.NewServer(h)
+		defer server.Close()
+		body := `{"request_id":"` + strings.Repeat("00", 24) + `","signed_init":{"Init":{"T":3}},"extra":true}`
+		resp, err := http.Post(server.URL+PathInit, "application/json", strings.NewReader(body))
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		require.Equal(t, http.StatusBadRequest, resp.StatusCode)
+		errResp := &ErrorResponse{}
+		require.NoError(t, json.NewDecoder(resp.Body).Decode(errResp))
+		require.Equal(t, "invalid request: unknown field extra", errResp.Error)
+		require.Equal(t, spec.CodeUnknownField, errResp.Code)
+	})
+
+	t.Run("invalid init", func(t *testing.T) {
+		signed, err := spec.SignInit(&spec.Init{Operators: operators, T: 2}, [24]byte{}, fixtures.OperatorSK(fixtures.TestOperator1SK))
+		require.NoError(t, err)
+		_, err = client.Init(context.Background(), &InitRequest{SignedInit: signed})
+		require.EqualError(t, err, "operator returned status 400: threshold set is invalid")
+		require.Equal(t, spec.CodeInvalidThreshold, spec.ErrorCodeOf(err))
+	})
+
+	t.Run("init missing signed init", func(t *testing.T) {
+		_, err := client.Init(context.Background(), &InitRequest{})
+		require.EqualError(t, err, "operator returned status 400: missing signed init")
+	})
+
+	t.Run("init signed for another request", func(t *testing.T) {
+		signed, err := spec.SignInit(&spec.Init{Operators: operators, T: 3}, fixtures.TestRequestID, fixtures.OperatorSK(fixtures.TestOperator1SK))
+		require.NoError(t, err)
+		_, err = client.Init(context.Background(), &InitRequest{SignedInit: signed})
+		require.Equal(t, spec.CodeRequestIDMismatch, spec.ErrorCodeOf(err))
+	})
+
+	t.Run("init invalid initiator signature", func(t *testing.T) {
+		signed, err := spec.SignInit(&spec.Init{Operators: operators, T: 2}, [24]byte{}, fixtures.OperatorSK(fixtures.TestOperator1SK))
+		require.NoError(t, err)
+		signed.Init.T = 3
+		_, err = client.Init(context.Background(), &InitRequest{SignedInit: signed})
+		require.Equal(t, spec.CodeInvalidInitiatorSignature, spec.ErrorCodeOf(err))
+	})
+
+	t.Run("reshare deals provider not set", func(t *testing.T) {
+		_, err := client.Reshare(context.Background(), &ReshareRequest{
+			SignedReshare: &spec.SignedReshare{Reshare: fixtures.TestReshare4Operators, Signature: make([]byte, 65)},
+			Proof:         &fixtures.TestOperator1Proof4Operators,
+		})
+		require.EqualError(t, err, "operator returned status 500: reshare deals provider not set")
+	})
+
+	t.Run("invalid body", func(t *testing.T) {
+		resp, err := http.Post(server.URL+PathInit, "application/json", strings.NewReader("{"))
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		require.EqualValues(t, http.StatusBadRequest, resp.StatusCode)
+	})
+
+	t.Run("body too large", func(t *testing.T) {
+		body := `{"request_id":"` + strings.Repeat("00", 24) + `","signed_init":{"Init":{"Fork":"` + strings.Repeat("0", maxRequestBody) + `"}}}`
+		resp, err := http.Post(server.URL+PathInit, "application/json", strings.NewReader(body))
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		require.EqualValues(t, http.StatusRequestEntityTooLarge, resp.StatusCode)
+	})
+
+	t.Run("method not allowed", func(t *testing.T) {
+		resp, err := http.Get(server.URL + PathInit)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		require.EqualValues(t, http.StatusMethodNotAllowed, resp.StatusCode)
+	})
+
+	t.Run("health", func(t *testing.T) {
+		ret, err := client.Health(context.Background())
+		require.NoError(t, err)
+		require.EqualValues(t, HealthStatusOK, ret.Status)
+	})
+}
+
+func TestHandlerWAL(t *testing.T) {
+	operators := fixtures.GenerateOperators(4)
+	path := filepath.Join(t.TempDir(), "operator.wal")
+	newServer := func(w *wal.WAL) *httptest.Server {
+		h := NewHandler(
+			operators[0],
+			fixtures.OperatorSK(fixtures.TestOperator1SK),
+			contractOwnerClient(),
+			spec.ShareLookup(func(validatorPK []byte) (*bls.SecretKey, error) {
+				return fixtures.ShareSK(fixtures.TestValidator4OperatorsShare1), nil
+			}),
+		)
+		h.SetWAL(w)
+		return httptest.NewServer(h)
+	}
+	req := &ResignRequest{
+		RequestID: fixtures.TestRequestID,
+		SignedResign: &spec.SignedResign{
+			Resign: spec.Resign{
+				ValidatorPubKey:       fixtures.TestOperator1Proof4Operators.Proof.ValidatorPubKey,
+				Fork:                  fixtures.TestFork,
+				WithdrawalCredentials: fixtures.TestWithdrawalCred[:32],
+				Owner:                 fixtures.TestOwnerAddress,
+				Nonce:                 1,
+				Amount:                spec.DepositAmount,
+			},
+			Signature: make([]byte, 65),
+		},
+		Proof:  &fixtures.TestOperator1Proof4Operators,
+		Params: fixtures.TestParams(4),
+	}
+
+	w, err := wal.Open(path)
+	require.NoError(t, err)
+	server := newServer(w)
+	result, err := NewClient(server.URL, nil).Resign(context.Background(), req)
+	require.NoError(t, err)
+	server.Close()
+	require.NoError(t, w.Close())
+
+	// after a restart the logged result is sent again, not a newly signed one
+	w, err = wal.Open(path)
+	require.NoError(t, err)
+	defer w.Close()
+	state, found := w.State(fixtures.TestRequestID)
+	require.True(t, found)
+	require.True(t, strings.HasPrefix(string(state), "resign "))
+
+	server = newServer(w)
+	defer server.Close()
+	replayed, err := NewClient(server.URL, nil).Resign(context.Background(), req)
+	require.NoError(t, err)
+	require.EqualValues(t, result, replayed)
+	require.Len(t, w.Records(), 2)
+
+	t.Run("request ID of another payload", func(t *testing.T) {
+		other := *req
+		otherResign := *req.SignedResign
+		otherResign.Resign.Nonce = 2
+		other.SignedResign = &otherResign
+		_, err := NewClient(server.URL, nil).Resign(context.Background(), &other)
+		require.EqualError(t, err, "operator returned status 409: request ID already used by another request")
+		require.Len(t, w.Records(), 2)
+	})
+
+	t.Run("concurrent requests", func(t *testing.T) {
+		concurrent := *req
+		concurrent.RequestID = RequestID{0xcc}
+		results := make([]*spec.Result, 8)
+		errs := make([]error, len(results))
+		var wg sync.WaitGroup
+		for i := range results {
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				results[i], errs[i] = NewClient(server.URL, nil).Resign(context.Background(), &concurrent)
+			}(i)
+		}
+		wg.Wait()
+		for i := range results {
+			require.NoError(t, errs[i])
+			require.EqualValues(t, results[0], results[i])
+		}
+		// the ceremony ran once
+		require.Len(t, w.States(concurrent.RequestID), 1)
+		require.Len(t, w.Records(), 4)
+	})
+}
+
+func TestHandlerResume(t *testing.T) {
+	operators := fixtures.GenerateOperators(4)
+	w, err := wal.Open(filepath.Join(t.TempDir(), "operator.wal"))
+	require.NoError(t, err)
+	defer w.Close()
+	h := NewHandler(
+		operators[0],
+		fixtures.OperatorSK(fixtures.TestOperator1SK),
+		contractOwnerClient(),
+		spec.ShareLookup(func(validatorPK []byte) (*bls.SecretKey, error) {
+			return fixtures.ShareSK(fixtures.TestValidator4OperatorsShare1), nil
+		}),
+	)
+	h.SetWAL(w)
+	server := httptest.NewServer(h)
+	defer server.Close()
+	client := NewClient(server.URL, nil)
+
+	// interrupted logs the start of req's ceremony, as an operator stopping mid-ceremony does
+	interrupted := func(t *testing.T, requestID RequestID, ceremony string, req interface{}) {
+		key, err := requestKey(ceremony, req)
+		require.NoError(t, err)
+		require.NoError(t, w.Append(&wal.Record{RequestID: requestID, Type: wal.RecordState, Data: key}))
+	}
+
+	t.Run("interrupted init is aborted", func(t *testing.T) {
+		signed, err := spec.SignInit(&spec.Init{
+			Operators:             operators,
+			T:                     3,
+			WithdrawalCredentials: fixtures.TestWithdrawalCred[:32],
+			Fork:                  fixtures.TestFork,
+			Owner:                 fixtures.TestOwnerAddress,
+		}, RequestID{0xa1}, fixtures.OperatorSK(fixtures.TestOperator1SK))
+		require.NoError(t, err)
+		req := &InitRequest{RequestID: RequestID{0xa1}, SignedInit: signed}
+		interrupted(t, req.RequestID, "init", req)
+
+		for i := 0; i < 2; i++ {
+			_, err := client.Init(context.Background(), req)
+			require.EqualError(t, err, "operator returned status 409: ceremony aborted, interrupted by an operator restart")
+			require.EqualValues(t, spec.CodeCeremonyAborted, spec.ErrorCodeOf(err))
+		}
+		state, _ := w.State(req.RequestID)
+		require.EqualValues(t, "aborted", state)
+		require.Len(t, w.States(req.RequestID), 2)
+	})
+
+	t.Run("interrupted resign continues", func(t *testing.T) {
+		req := &ResignRequest{
+			RequestID: RequestID{0xa2},
+			SignedResign: &spec.SignedResign{
+				Resign: spec.Resign{
+					ValidatorPubKey:       fixtures.TestOperator1Proof4Operators.Proof.ValidatorPubKey,
+					Fork:                  fixtures.TestFork,
+					WithdrawalCredentials: fixtures.TestWithdrawalCred[:32],
+					Owner:                 fixtures.TestOwnerAddress,
+					Nonce:                 1,
+					Amount:                spec.DepositAmount,
+				},
+				Signature: make([]byte, 65),
+			},
+			Proof:  &fixtures.TestOperator1Proof4Operators,
+			Params: fixtures.TestParams(4),
+		}
+		interrupted(t, req.RequestID, "resign", req)
+
+		_, err := client.Resign(context.Background(), req)
+		require.NoError(t, err)
+		_, found := w.Outbound(req.RequestID)
+		require.True(t, found)
+	})
+}
+
+func TestNewHandlerFromKeystore(t *testing.T) {
+	operators := fixtures.GenerateOperators(4)
+	path := filepath.Join(t.TempDir(), "operator.pem")
+	password := []byte("password")
+	require.NoError(t, crypto.SaveRSAPrivateKey(path, fixtures.OperatorSK(fixtures.TestOperator1SK), password))
+
+	t.Run("operator's keystore", func(t *testing.T) {
+		_, err := NewHandlerFromKeystore(operators[0], path, password, contractOwnerClient(), nil)
+		require.NoError(t, err)
+	})
+
+	t.Run("another operator's keystore", func(t *testing.T) {
+		_, err := NewHandlerFromKeystore(operators[1], path, password, contractOwnerClient(), nil)
+		require.EqualError(t, err, "keystore isn't operator 2's key")
+	})
+
+	t.Run("wrong password", func(t *testing.T) {
+		_, err := NewHandlerFromKeystore(operators[0], path, []byte("other password"), contractOwnerClient(), nil)
+		require.EqualError(t, err, "failed to decrypt private key, wrong password?")
+	})
+}
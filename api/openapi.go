@@ -0,0 +1,191 @@
+package api
+
+import (
+	"encoding/json"
+	"strings"
+
+	"github.com/bloxapp/dkg-spec/schema"
+)
+
+const (
+	openAPIVersion = "3.1.0"
+	apiVersion     = "1.0.0"
+	componentsRef  = "#/components/schemas/"
+)
+
+// OpenAPI is the subset of an OpenAPI 3.1 document used to describe the operator endpoints
+type OpenAPI struct {
+	OpenAPI    string               `json:"openapi"`
+	Info       *OpenAPIInfo         `json:"info"`
+	Paths      map[string]*PathItem `json:"paths"`
+	Components *Components          `json:"components"`
+}
+
+type OpenAPIInfo struct {
+	Title   string `json:"title"`
+	Version string `json:"version"`
+}
+
+type PathItem struct {
+	Get  *Operation `json:"get,omitempty"`
+	Post *Operation `json:"post,omitempty"`
+}
+
+type Operation struct {
+	OperationID string               `json:"operationId"`
+	Summary     string               `json:"summary"`
+	RequestBody *RequestBody         `json:"requestBody,omitempty"`
+	Responses   map[string]*Response `json:"responses"`
+}
+
+type RequestBody struct {
+	Required bool                  `json:"required"`
+	Content  map[string]*MediaType `json:"content"`
+}
+
+type Response struct {
+	Description string                `json:"description"`
+	Content     map[string]*MediaType `json:"content,omitempty"`
+}
+
+type MediaType struct {
+	Schema *schema.Schema `json:"schema"`
+}
+
+type Components struct {
+	Schemas map[string]*schema.Schema `json:"schemas"`
+}
+
+func jsonContent(name string) map[string]*MediaType {
+	return map[string]*MediaType{
+		"application/json": {Schema: &schema.Schema{Ref: componentsRef + name}},
+	}
+}
+
+func ceremonyOperation(id string, summary string, request string) *Operation {
+	return &Operation{
+		OperationID: id,
+		Summary:     summary,
+		RequestBody: &RequestBody{
+			Required: true,
+			Content:  jsonContent(request),
+		},
+		Responses: map[string]*Response{
+			"200": {Description: "Operator result", Content: jsonContent("Result")},
+			"400": {Description: "Invalid request, or rejected by the ceremony's validation", Content: jsonContent("ErrorResponse")},
+			"403": {Description: "Rejected by the operator's policy", Content: jsonContent("ErrorResponse")},
+			"409": {Description: "Request ID already used by another request, or its ceremony was aborted", Content: jsonContent("ErrorResponse")},
+			"413": {Description: "Request body too large", Content: jsonContent("ErrorResponse")},
+			"500": {Description: "Ceremony failed", Content: jsonContent("ErrorResponse")},
+		},
+	}
+}
+
+// toComponent returns a copy of s with $defs references rewritten to components references
+func toComponent(s *schema.Schema) *schema.Schema {
+	if s == nil {
+		return nil
+	}
+	ret := *s
+	ret.Ref = strings.Replace(ret.Ref, "#/$defs/", componentsRef, 1)
+	ret.Items = toComponent(s.Items)
+	ret.Defs = nil
+	if s.Properties != nil {
+		ret.Properties = make(map[string]*schema.Schema, len(s.Properties))
+		for name, property := range s.Properties {
+			ret.Properties[name] = toComponent(property)
+		}
+	}
+	return &ret
+}
+
+func object(description string, properties map[string]*schema.Schema, required ...string) *schema.Schema {
+	additional := false
+	return &schema.Schema{
+		Type:                 "object",
+		Description:          description,
+		Properties:           properties,
+		Required:             required,
+		AdditionalProperties: &additional,
+	}
+}
+
+func requestIDSchema() *schema.Schema {
+	length := 48
+	return &schema.Schema{
+		Type:        "string",
+		Description: "hex encoded ceremony request ID",
+		Pattern:     "^([0-9a-fA-F]{2})*$",
+		MinLength:   &length,
+		MaxLength:   &length,
+	}
+}
+
+func ref(name string) *schema.Schema {
+	return &schema.Schema{Ref: componentsRef + name}
+}
+
+// NewOpenAPI returns the OpenAPI document describing the operator HTTP endpoints
+func NewOpenAPI() *OpenAPI {
+	schemas := make(map[string]*schema.Schema)
+	for name, def := range schema.Definitions() {
+		schemas[name] = toComponent(def)
+	}
+	schemas["InitRequest"] = object("Init request", map[string]*schema.Schema{
+		"request_id":  requestIDSchema(),
+		"signed_init": ref("SignedInit"),
+	}, "request_id", "signed_init")
+	schemas["ReshareRequest"] = object("Reshare request", map[string]*schema.Schema{
+		"request_id":     requestIDSchema(),
+		"signed_reshare": ref("SignedReshare"),
+		"proof":          ref("SignedProof"),
+		"params":         ref("CeremonyParams"),
+	}, "request_id", "signed_reshare")
+	schemas["ResignRequest"] = object("Resign request", map[string]*schema.Schema{
+		"request_id":    requestIDSchema(),
+		"signed_resign": ref("SignedResign"),
+		"proof":         ref("SignedProof"),
+		"params":        ref("CeremonyParams"),
+	}, "request_id", "signed_resign", "proof")
+	schemas["HealthResponse"] = object("Health status", map[string]*schema.Schema{
+		"status": {Type: "string"},
+	}, "status")
+	minIndex := 0
+	schemas["ErrorResponse"] = object("Error", map[string]*schema.Schema{
+		"error":      {Type: "string"},
+		"code":       {Type: "string", Description: "validation failure code, e.g. invalid_threshold"},
+		"field":      {Type: "string", Description: "offending field"},
+		"index":      {Type: "integer", Description: "offending message's position within a batch request", Minimum: &minIndex},
+		"request_id": requestIDSchema(),
+	}, "error")
+
+	return &OpenAPI{
+		OpenAPI: openAPIVersion,
+		Info: &OpenAPIInfo{
+			Title:   "DKG operator API",
+			Version: apiVersion,
+		},
+		Paths: map[string]*PathItem{
+			PathInit:    {Post: ceremonyOperation("init", "Start a new DKG ceremony", "InitRequest")},
+			PathReshare: {Post: ceremonyOperation("reshare", "Reshare a validator to a new set of operators", "ReshareRequest")},
+			PathResign:  {Post: ceremonyOperation("resign", "Re-sign a validator's deposit data and owner nonce", "ResignRequest")},
+			PathHealth: {Get: &Operation{
+				OperationID: "health",
+				Summary:     "Operator health",
+				Responses: map[string]*Response{
+					"200": {Description: "Operator is healthy", Content: jsonContent("HealthResponse")},
+				},
+			}},
+		},
+		Components: &Components{Schemas: schemas},
+	}
+}
+
+// GenerateOpenAPI returns the encoded OpenAPI document
+func GenerateOpenAPI() ([]byte, error) {
+	byts, err := json.MarshalIndent(NewOpenAPI(), "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append(byts, '\n'), nil
+}
@@ -0,0 +1,193 @@
+package api
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// maxRequestBody bounds the request body read by handlers and by middlewares inspecting the request
+const maxRequestBody = 1 << 20
+
+// RateLimit allows Burst requests at once, refilled at Rate requests per second. A zero Rate disables the limit.
+type RateLimit struct {
+	Rate  float64
+	Burst int
+}
+
+type RateLimiterConfig struct {
+	// PerInitiator limits requests by initiator (see InitiatorKey)
+	PerInitiator RateLimit
+	// PerOwner limits ceremony requests by the owner address in the request
+	PerOwner RateLimit
+	// InitiatorKey identifies the initiator of r, defaults to the remote IP
+	InitiatorKey func(r *http.Request) string
+}
+
+// RateLimiter is a middleware rejecting ceremony requests exceeding the configured rates with 429
+type RateLimiter struct {
+	config     RateLimiterConfig
+	initiators *buckets
+	owners     *buckets
+}
+
+func NewRateLimiter(config RateLimiterConfig) *RateLimiter {
+	if config.InitiatorKey == nil {
+		config.InitiatorKey = RemoteIP
+	}
+	return &RateLimiter{
+		config:     config,
+		initiators: newBuckets(config.PerInitiator),
+		owners:     newBuckets(config.PerOwner),
+	}
+}
+
+// Wrap returns next guarded by the rate limiter
+func (l *RateLimiter) Wrap(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		now := time.Now()
+		if !l.initiators.allow(l.config.InitiatorKey(r), now) {
+			writeError(w, http.StatusTooManyRequests, fmt.Errorf("initiator rate limit exceeded"))
+			return
+		}
+		if l.config.PerOwner.Rate > 0 {
+			owner, found, err := requestOwner(r)
+			if err != nil {
+				writeError(w, http.StatusBadRequest, err)
+				return
+			}
+			if found && !l.owners.allow(hex.EncodeToString(owner[:]), now) {
+				writeError(w, http.StatusTooManyRequests, fmt.Errorf("owner rate limit exceeded"))
+				return
+			}
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// RemoteIP returns the IP r was received from
+func RemoteIP(r *http.Request) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return host
+}
+
+// requestOwner returns the owner address of a ceremony request, found is false for other requests.
+// r.Body is restored so it can be read again by the wrapped handler.
+func requestOwner(r *http.Request) (owner [20]byte, found bool, err error) {
+	if r.Method != http.MethodPost {
+		return owner, false, nil
+	}
+	var req interface{}
+	switch r.URL.Path {
+	case PathInit:
+		req = &InitRequest{}
+	case PathReshare:
+		req = &ReshareRequest{}
+	case PathResign:
+		req = &ResignRequest{}
+	default:
+		return owner, false, nil
+	}
+
+	body, err := io.ReadAll(io.LimitReader(r.Body, maxRequestBody+1))
+	if err != nil {
+		return owner, false, err
+	}
+	if len(body) > maxRequestBody {
+		return owner, false, fmt.Errorf("request body too large")
+	}
+	r.Body = io.NopCloser(bytes.NewReader(body))
+	if err := json.Unmarshal(body, req); err != nil {
+		// let the handler report the invalid request
+		return owner, false, nil
+	}
+
+	switch req := req.(type) {
+	case *InitRequest:
+		if req.SignedInit != nil && req.SignedInit.Init != nil {
+			return req.SignedInit.Init.Owner, true, nil
+		}
+	case *ReshareRequest:
+		if req.SignedReshare != nil {
+			return req.SignedReshare.Reshare.Owner, true, nil
+		}
+	case *ResignRequest:
+		if req.SignedResign != nil {
+			return req.SignedResign.Resign.Owner, true, nil
+		}
+	}
+	return owner, false, nil
+}
+
+// maxIdleBuckets is the number of tracked keys above which full (idle) buckets are dropped
+const maxIdleBuckets = 10000
+
+type bucket struct {
+	tokens float64
+	last   time.Time
+}
+
+// buckets is a set of token buckets keyed by initiator or owner
+type buckets struct {
+	limit   RateLimit
+	mtx     sync.Mutex
+	buckets map[string]*bucket
+}
+
+func newBuckets(limit RateLimit) *buckets {
+	return &buckets{
+		limit:   limit,
+		buckets: make(map[string]*bucket),
+	}
+}
+
+func (b *buckets) allow(key string, now time.Time) bool {
+	if b.limit.Rate <= 0 {
+		return true
+	}
+	b.mtx.Lock()
+	defer b.mtx.Unlock()
+
+	if len(b.buckets) > maxIdleBuckets {
+		b.prune(now)
+	}
+	bk, found := b.buckets[key]
+	if !found {
+		bk = &bucket{tokens: float64(b.limit.Burst), last: now}
+		b.buckets[key] = bk
+	}
+	b.refill(bk, now)
+	if bk.tokens < 1 {
+		return false
+	}
+	bk.tokens--
+	return true
+}
+
+func (b *buckets) refill(bk *bucket, now time.Time) {
+	if elapsed := now.Sub(bk.last).Seconds(); elapsed > 0 {
+		bk.tokens += elapsed * b.limit.Rate
+		if bk.tokens > float64(b.limit.Burst) {
+			bk.tokens = float64(b.limit.Burst)
+		}
+	}
+	bk.last = now
+}
+
+func (b *buckets) prune(now time.Time) {
+	for key, bk := range b.buckets {
+		b.refill(bk, now)
+		if bk.tokens >= float64(b.limit.Burst) {
+			delete(b.buckets, key)
+		}
+	}
+}
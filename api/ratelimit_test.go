@@ -0,0 +1,76 @@
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	spec "github.com/bloxapp/dkg-spec"
+	"github.com/bloxapp/dkg-spec/testing/fixtures"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuckets(t *testing.T) {
+	b := newBuckets(RateLimit{Rate: 1, Burst: 2})
+	now := time.Unix(1700000000, 0)
+	require.True(t, b.allow("a", now))
+	require.True(t, b.allow("a", now))
+	require.False(t, b.allow("a", now))
+	require.True(t, b.allow("b", now))
+
+	require.False(t, b.allow("a", now.Add(500*time.Millisecond)))
+	require.True(t, b.allow("a", now.Add(time.Second)))
+
+	unlimited := newBuckets(RateLimit{})
+	for i := 0; i < 10; i++ {
+		require.True(t, unlimited.allow("a", now))
+	}
+}
+
+func TestRateLimiter(t *testing.T) {
+	echo := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// the body must still be readable by the wrapped handler
+		_, err := io.Copy(w, r.Body)
+		require.NoError(t, err)
+	})
+	initRequest := func(owner [20]byte) []byte {
+		byts, err := json.Marshal(&InitRequest{SignedInit: &spec.SignedInit{Init: &spec.Init{Operators: fixtures.GenerateOperators(4), Owner: owner}}})
+		require.NoError(t, err)
+		return byts
+	}
+	post := func(h http.Handler, body []byte) *httptest.ResponseRecorder {
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, PathInit, bytes.NewReader(body)))
+		return rec
+	}
+
+	t.Run("per initiator", func(t *testing.T) {
+		h := NewRateLimiter(RateLimiterConfig{PerInitiator: RateLimit{Rate: 0.001, Burst: 2}}).Wrap(echo)
+		body := initRequest(fixtures.TestOwnerAddress)
+		require.EqualValues(t, http.StatusOK, post(h, body).Code)
+		require.EqualValues(t, http.StatusOK, post(h, body).Code)
+		rec := post(h, body)
+		require.EqualValues(t, http.StatusTooManyRequests, rec.Code)
+		require.JSONEq(t, `{"error":"initiator rate limit exceeded"}`, rec.Body.String())
+	})
+
+	t.Run("per owner", func(t *testing.T) {
+		h := NewRateLimiter(RateLimiterConfig{PerOwner: RateLimit{Rate: 0.001, Burst: 1}}).Wrap(echo)
+		body := initRequest(fixtures.TestOwnerAddress)
+		rec := post(h, body)
+		require.EqualValues(t, http.StatusOK, rec.Code)
+		require.EqualValues(t, body, rec.Body.Bytes())
+		require.EqualValues(t, http.StatusTooManyRequests, post(h, body).Code)
+		require.EqualValues(t, http.StatusOK, post(h, initRequest([20]byte{1})).Code)
+
+		// health requests carry no owner
+		rec = httptest.NewRecorder()
+		h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, PathHealth, nil))
+		require.EqualValues(t, http.StatusOK, rec.Code)
+	})
+}
@@ -0,0 +1,48 @@
+package api
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	spec "github.com/bloxapp/dkg-spec"
+)
+
+// ServerTimingHeader reports ceremony phase timings to the initiator (https://www.w3.org/TR/server-timing/)
+const ServerTimingHeader = "Server-Timing"
+
+// FormatServerTiming encodes timings as a Server-Timing header value, durations in milliseconds
+func FormatServerTiming(timings []spec.PhaseTiming) string {
+	metrics := make([]string, len(timings))
+	for i, timing := range timings {
+		metrics[i] = fmt.Sprintf("%s;dur=%s", timing.Phase, strconv.FormatFloat(float64(timing.Duration)/float64(time.Millisecond), 'f', -1, 64))
+	}
+	return strings.Join(metrics, ", ")
+}
+
+// ParseServerTiming decodes a Server-Timing header value, metrics without a duration are skipped
+func ParseServerTiming(header string) ([]spec.PhaseTiming, error) {
+	ret := make([]spec.PhaseTiming, 0)
+	if strings.TrimSpace(header) == "" {
+		return ret, nil
+	}
+	for _, metric := range strings.Split(header, ",") {
+		params := strings.Split(strings.TrimSpace(metric), ";")
+		for _, param := range params[1:] {
+			key, value, found := strings.Cut(strings.TrimSpace(param), "=")
+			if !found || key != "dur" {
+				continue
+			}
+			ms, err := strconv.ParseFloat(value, 64)
+			if err != nil {
+				return nil, fmt.Errorf("invalid duration of %s: %v", params[0], err)
+			}
+			ret = append(ret, spec.PhaseTiming{
+				Phase:    spec.Phase(params[0]),
+				Duration: time.Duration(ms * float64(time.Millisecond)),
+			})
+		}
+	}
+	return ret, nil
+}
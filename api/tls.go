@@ -0,0 +1,118 @@
+package api
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"fmt"
+	"math/big"
+	"time"
+
+	spec "github.com/bloxapp/dkg-spec"
+	"github.com/bloxapp/dkg-spec/crypto"
+)
+
+// CertificateValidity is the validity period of certificates created by NewOperatorCertificate
+const CertificateValidity = 365 * 24 * time.Hour
+
+// NewOperatorCertificate returns a self-signed TLS certificate for operatorID's RSA identity key.
+// Peers authenticate it by its public key (see VerifyOperatorCertificate), not by a certificate chain.
+func NewOperatorCertificate(sk *rsa.PrivateKey, operatorID uint64) (tls.Certificate, error) {
+	serial, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
+	if err != nil {
+		return tls.Certificate{}, err
+	}
+	now := time.Now()
+	template := &x509.Certificate{
+		SerialNumber: serial,
+		Subject:      pkix.Name{CommonName: fmt.Sprintf("operator-%d", operatorID)},
+		NotBefore:    now.Add(-time.Hour),
+		NotAfter:     now.Add(CertificateValidity),
+		KeyUsage:     x509.KeyUsageDigitalSignature | x509.KeyUsageKeyEncipherment,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &sk.PublicKey, sk)
+	if err != nil {
+		return tls.Certificate{}, err
+	}
+	leaf, err := x509.ParseCertificate(der)
+	if err != nil {
+		return tls.Certificate{}, err
+	}
+	return tls.Certificate{
+		Certificate: [][]byte{der},
+		PrivateKey:  sk,
+		Leaf:        leaf,
+	}, nil
+}
+
+// VerifyOperatorCertificate returns the operator whose registered public key matches cert's, or error if none does
+func VerifyOperatorCertificate(cert *x509.Certificate, operators []*spec.Operator) (*spec.Operator, error) {
+	certPK, ok := cert.PublicKey.(*rsa.PublicKey)
+	if !ok {
+		return nil, fmt.Errorf("certificate key is not RSA")
+	}
+	now := time.Now()
+	if now.Before(cert.NotBefore) || now.After(cert.NotAfter) {
+		return nil, fmt.Errorf("certificate expired or not yet valid")
+	}
+	if err := cert.CheckSignature(cert.SignatureAlgorithm, cert.RawTBSCertificate, cert.Signature); err != nil {
+		return nil, fmt.Errorf("invalid certificate signature: %v", err)
+	}
+	for _, operator := range operators {
+		pk, err := crypto.ParseRSAPublicKey(operator.PubKey)
+		if err != nil {
+			return nil, err
+		}
+		if pk.Equal(certPK) {
+			return operator, nil
+		}
+	}
+	return nil, fmt.Errorf("certificate does not match any operator")
+}
+
+// verifyPeerOperator returns a tls.Config.VerifyPeerCertificate func accepting only operators' certificates
+func verifyPeerOperator(operators []*spec.Operator) func([][]byte, [][]*x509.Certificate) error {
+	return func(rawCerts [][]byte, _ [][]*x509.Certificate) error {
+		if len(rawCerts) == 0 {
+			return fmt.Errorf("missing peer certificate")
+		}
+		cert, err := x509.ParseCertificate(rawCerts[0])
+		if err != nil {
+			return err
+		}
+		_, err = VerifyOperatorCertificate(cert, operators)
+		return err
+	}
+}
+
+// ServerTLSConfig returns the TLS config of an operator server presenting cert.
+// If peers is not empty only clients presenting one of peers' certificates are accepted.
+func ServerTLSConfig(cert tls.Certificate, peers []*spec.Operator) *tls.Config {
+	ret := &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		MinVersion:   tls.VersionTLS12,
+	}
+	if len(peers) > 0 {
+		ret.ClientAuth = tls.RequireAnyClientCert
+		ret.VerifyPeerCertificate = verifyPeerOperator(peers)
+	}
+	return ret
+}
+
+// ClientTLSConfig returns the TLS config of a client accepting only servers presenting one of operators' certificates.
+// cert is presented to the server if not nil.
+func ClientTLSConfig(operators []*spec.Operator, cert *tls.Certificate) *tls.Config {
+	ret := &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		// the chain is not verified, operators are authenticated by their registered public key instead
+		InsecureSkipVerify:    true,
+		VerifyPeerCertificate: verifyPeerOperator(operators),
+	}
+	if cert != nil {
+		ret.Certificates = []tls.Certificate{*cert}
+	}
+	return ret
+}
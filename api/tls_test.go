@@ -0,0 +1,63 @@
+package api
+
+import (
+	"context"
+	"crypto/tls"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	spec "github.com/bloxapp/dkg-spec"
+	"github.com/bloxapp/dkg-spec/testing/fixtures"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestMutualTLS(t *testing.T) {
+	operators := fixtures.GenerateOperators(4)
+	serverCert, err := NewOperatorCertificate(fixtures.OperatorSK(fixtures.TestOperator1SK), 1)
+	require.NoError(t, err)
+	clientCert, err := NewOperatorCertificate(fixtures.OperatorSK(fixtures.TestOperator2SK), 2)
+	require.NoError(t, err)
+
+	t.Run("verify certificate", func(t *testing.T) {
+		operator, err := VerifyOperatorCertificate(serverCert.Leaf, operators)
+		require.NoError(t, err)
+		require.EqualValues(t, 1, operator.ID)
+
+		_, err = VerifyOperatorCertificate(serverCert.Leaf, operators[1:])
+		require.EqualError(t, err, "certificate does not match any operator")
+	})
+
+	server := httptest.NewUnstartedServer(NewHandler(operators[0], nil, nil, nil))
+	server.TLS = ServerTLSConfig(serverCert, operators[1:2])
+	server.StartTLS()
+	defer server.Close()
+
+	newClient := func(operators []*spec.Operator, cert *tls.Certificate) *Client {
+		return NewClient(server.URL, &http.Client{
+			Transport: &http.Transport{TLSClientConfig: ClientTLSConfig(operators, cert)},
+		})
+	}
+
+	t.Run("authenticated", func(t *testing.T) {
+		ret, err := newClient(operators[:1], &clientCert).Health(context.Background())
+		require.NoError(t, err)
+		require.EqualValues(t, HealthStatusOK, ret.Status)
+	})
+
+	t.Run("unknown server", func(t *testing.T) {
+		_, err := newClient(operators[1:], &clientCert).Health(context.Background())
+		require.ErrorContains(t, err, "certificate does not match any operator")
+	})
+
+	t.Run("missing client certificate", func(t *testing.T) {
+		_, err := newClient(operators[:1], nil).Health(context.Background())
+		require.Error(t, err)
+	})
+
+	t.Run("unknown client", func(t *testing.T) {
+		_, err := newClient(operators[:1], &serverCert).Health(context.Background())
+		require.Error(t, err)
+	})
+}
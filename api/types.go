@@ -0,0 +1,140 @@
+package api
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	spec "github.com/bloxapp/dkg-spec"
+)
+
+const (
+	PathInit    = "/init"
+	PathReshare = "/reshare"
+	PathResign  = "/resign"
+	PathHealth  = "/health"
+
+	HealthStatusOK = "ok"
+)
+
+// RequestID is a ceremony request ID, JSON encoded as a hex string
+type RequestID [24]byte
+
+func (id RequestID) MarshalJSON() ([]byte, error) {
+	return json.Marshal(hex.EncodeToString(id[:]))
+}
+
+func (id *RequestID) UnmarshalJSON(data []byte) error {
+	var str string
+	if err := json.Unmarshal(data, &str); err != nil {
+		return err
+	}
+	byts, err := hex.DecodeString(str)
+	if err != nil {
+		return err
+	}
+	if len(byts) != len(id) {
+		return fmt.Errorf("invalid request ID length")
+	}
+	copy(id[:], byts)
+	return nil
+}
+
+// InitRequest is sent by the initiator to each operator to start a new DKG ceremony
+type InitRequest struct {
+	RequestID RequestID `json:"request_id"`
+	// SignedInit is the init signed by the initiator for RequestID, see spec.SignInit
+	SignedInit *spec.SignedInit `json:"signed_init"`
+}
+
+// ReshareRequest is sent by the initiator to each operator to reshare a validator
+type ReshareRequest struct {
+	RequestID     RequestID           `json:"request_id"`
+	SignedReshare *spec.SignedReshare `json:"signed_reshare"`
+	// Proof is the receiving operator's proof from the ceremony which created the validator, unset for operators joining
+	// the cluster
+	Proof *spec.SignedProof `json:"proof,omitempty"`
+	// Params are the parameters of the ceremony Proof was issued in (see spec.Proof.ParamsHash), unset if Proof is or doesn't
+	// commit to them
+	Params *spec.CeremonyParams `json:"params,omitempty"`
+}
+
+// ResignRequest is sent by the initiator to each operator to re-sign a validator's deposit data and owner nonce
+type ResignRequest struct {
+	RequestID    RequestID          `json:"request_id"`
+	SignedResign *spec.SignedResign `json:"signed_resign"`
+	// Proof is the receiving operator's proof from the ceremony which created the validator
+	Proof *spec.SignedProof `json:"proof"`
+	// Params are the parameters of the ceremony Proof was issued in (see spec.Proof.ParamsHash), unset if Proof doesn't
+	// commit to them
+	Params *spec.CeremonyParams `json:"params,omitempty"`
+}
+
+// HealthResponse is returned by the health endpoint
+type HealthResponse struct {
+	Status string `json:"status"`
+}
+
+// ErrorResponse is returned by every endpoint on failure
+type ErrorResponse struct {
+	// Error is the failure's message
+	Error string `json:"error"`
+	// Code identifies validation failures, omitted for other failures
+	Code spec.ErrorCode `json:"code,omitempty"`
+	// Field is the offending field, if known
+	Field string `json:"field,omitempty"`
+	// Index is the offending message's position within a batch request
+	Index *int `json:"index,omitempty"`
+	// RequestID of the failed request, omitted if the request couldn't be decoded
+	RequestID *RequestID `json:"request_id,omitempty"`
+}
+
+// BatchError attributes a failure to the message at Index of a batch request, and optionally to one of its fields
+type BatchError struct {
+	Index int
+	Field string
+	Err   error
+}
+
+func (e *BatchError) Error() string {
+	if e.Field != "" {
+		return fmt.Sprintf("message %d: %s: %v", e.Index, e.Field, e.Err)
+	}
+	return fmt.Sprintf("message %d: %v", e.Index, e.Err)
+}
+
+func (e *BatchError) Unwrap() error {
+	return e.Err
+}
+
+// NewErrorResponse returns the response reporting err, with its code and offending message if err wraps a BatchError
+func NewErrorResponse(requestID *RequestID, err error) *ErrorResponse {
+	ret := &ErrorResponse{
+		Error:     err.Error(),
+		Code:      spec.ErrorCodeOf(err),
+		RequestID: requestID,
+	}
+	var batchErr *BatchError
+	if errors.As(err, &batchErr) {
+		index := batchErr.Index
+		ret.Index = &index
+		ret.Field = batchErr.Field
+	}
+	return ret
+}
+
+// ResponseError is returned by Client when an operator responds with an error
+type ResponseError struct {
+	StatusCode int
+	Response   *ErrorResponse
+}
+
+func (e *ResponseError) Error() string {
+	return fmt.Sprintf("operator returned status %d: %s", e.StatusCode, e.Response.Error)
+}
+
+// Unwrap returns the operator's failure with its code, so spec.ErrorCodeOf works on client errors
+func (e *ResponseError) Unwrap() error {
+	return &spec.ValidationError{Code: e.Response.Code, Err: errors.New(e.Response.Error)}
+}
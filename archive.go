@@ -0,0 +1,364 @@
+package spec
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+
+	ssz "github.com/ferranbt/fastssz"
+)
+
+/*
+A ceremony archive is a gzip compressed tar stream holding:
+	manifest.json			lists every entry with its size and sha256 digest
+	<entries...>			as listed in the manifest, in order
+	manifest.sha256			hex encoded sha256 of manifest.json
+
+Ceremony artifacts are stored under a directory named by the hex encoded request ID:
+	<request id>/proofs.json		see proofs_file.go
+	<request id>/results/<operator id>.ssz	SSZ encoded Result
+	<request id>/transcript			opaque ceremony transcript
+	<request id>/init.ssz			SSZ encoded Init, of the ceremony creating the validator
+	<request id>/reshare.ssz		SSZ encoded SignedReshare, of a reshare ceremony
+	<request id>/lineage.ssz		SSZ encoded Lineage, of a reshare ceremony
+*/
+
+const (
+	ArchiveVersion          = uint64(1)
+	archiveManifestName     = "manifest.json"
+	archiveManifestHashName = "manifest.sha256"
+)
+
+// ArchiveManifestEntry describes a single archive entry
+type ArchiveManifestEntry struct {
+	Name   string `json:"name"`
+	Size   int    `json:"size"`
+	SHA256 string `json:"sha256"`
+}
+
+// ArchiveManifest lists all entries of an archive
+type ArchiveManifest struct {
+	Version uint64                  `json:"version"`
+	Entries []*ArchiveManifestEntry `json:"entries"`
+}
+
+// CeremonyArtifacts are the artifacts of a single ceremony stored in an archive
+type CeremonyArtifacts struct {
+	RequestID  [24]byte
+	Proofs     CeremonyProofs
+	Results    []*Result
+	Transcript []byte
+	// Init requesting the ceremony, set for the ceremony creating a validator (see ValidatorHistory)
+	Init *Init
+	// SignedReshare authorizing the ceremony and the Lineage it emitted, set for reshare ceremonies
+	SignedReshare *SignedReshare
+	Lineage       *Lineage
+}
+
+// Archive bundles the artifacts of many ceremonies
+type Archive struct {
+	names   []string
+	entries map[string][]byte
+}
+
+// NewArchive returns an empty archive
+func NewArchive() *Archive {
+	return &Archive{
+		entries: make(map[string][]byte),
+	}
+}
+
+// Add adds a new entry to the archive
+func (a *Archive) Add(name string, data []byte) error {
+	if name == "" || name == archiveManifestName || name == archiveManifestHashName {
+		return fmt.Errorf("invalid entry name")
+	}
+	if _, found := a.entries[name]; found {
+		return fmt.Errorf("duplicate entry %s", name)
+	}
+	a.names = append(a.names, name)
+	a.entries[name] = data
+	return nil
+}
+
+// Get returns an entry by name
+func (a *Archive) Get(name string) ([]byte, bool) {
+	ret, found := a.entries[name]
+	return ret, found
+}
+
+// Names returns all entry names in the order they were added
+func (a *Archive) Names() []string {
+	return append([]string{}, a.names...)
+}
+
+// Manifest returns the manifest describing the current archive entries
+func (a *Archive) Manifest() *ArchiveManifest {
+	ret := &ArchiveManifest{
+		Version: ArchiveVersion,
+		Entries: make([]*ArchiveManifestEntry, 0, len(a.names)),
+	}
+	for _, name := range a.names {
+		digest := sha256.Sum256(a.entries[name])
+		ret.Entries = append(ret.Entries, &ArchiveManifestEntry{
+			Name:   name,
+			Size:   len(a.entries[name]),
+			SHA256: hex.EncodeToString(digest[:]),
+		})
+	}
+	return ret
+}
+
+// AddCeremony adds all artifacts of a ceremony to the archive
+func (a *Archive) AddCeremony(artifacts *CeremonyArtifacts) error {
+	dir := hex.EncodeToString(artifacts.RequestID[:])
+
+	if artifacts.Proofs != nil {
+		buf := &bytes.Buffer{}
+		if err := WriteProofs(buf, []CeremonyProofs{artifacts.Proofs}); err != nil {
+			return err
+		}
+		if err := a.Add(dir+"/proofs.json", buf.Bytes()); err != nil {
+			return err
+		}
+	}
+	for _, result := range artifacts.Results {
+		byts, err := result.MarshalSSZ()
+		if err != nil {
+			return err
+		}
+		if err := a.Add(fmt.Sprintf("%s/results/%d.ssz", dir, result.OperatorID), byts); err != nil {
+			return err
+		}
+	}
+	if artifacts.Transcript != nil {
+		if err := a.Add(dir+"/transcript", artifacts.Transcript); err != nil {
+			return err
+		}
+	}
+	if artifacts.Init != nil {
+		if err := a.addSSZ(dir+"/init.ssz", artifacts.Init); err != nil {
+			return err
+		}
+	}
+	if artifacts.SignedReshare != nil {
+		if err := a.addSSZ(dir+"/reshare.ssz", artifacts.SignedReshare); err != nil {
+			return err
+		}
+	}
+	if artifacts.Lineage != nil {
+		if err := a.addSSZ(dir+"/lineage.ssz", artifacts.Lineage); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// addSSZ adds msg SSZ encoded as entry name
+func (a *Archive) addSSZ(name string, msg ssz.Marshaler) error {
+	byts, err := msg.MarshalSSZ()
+	if err != nil {
+		return err
+	}
+	return a.Add(name, byts)
+}
+
+// RequestIDs returns the (ordered) request IDs of all ceremonies in the archive
+func (a *Archive) RequestIDs() ([][24]byte, error) {
+	seen := make(map[string]bool)
+	var ret [][24]byte
+	for _, name := range a.names {
+		dir, _, found := strings.Cut(name, "/")
+		if !found || seen[dir] {
+			continue
+		}
+		seen[dir] = true
+
+		byts, err := hex.DecodeString(dir)
+		if err != nil || len(byts) != 24 {
+			return nil, fmt.Errorf("invalid ceremony directory %s", dir)
+		}
+		var id [24]byte
+		copy(id[:], byts)
+		ret = append(ret, id)
+	}
+	sort.Slice(ret, func(i, j int) bool {
+		return bytes.Compare(ret[i][:], ret[j][:]) < 0
+	})
+	return ret, nil
+}
+
+// Ceremony returns the artifacts stored for requestID
+func (a *Archive) Ceremony(requestID [24]byte) (*CeremonyArtifacts, error) {
+	dir := hex.EncodeToString(requestID[:]) + "/"
+	ret := &CeremonyArtifacts{RequestID: requestID}
+	found := false
+	for _, name := range a.names {
+		if !strings.HasPrefix(name, dir) {
+			continue
+		}
+		found = true
+		data := a.entries[name]
+
+		switch rel := strings.TrimPrefix(name, dir); {
+		case rel == "proofs.json":
+			proofs, err := ReadProofs(bytes.NewReader(data))
+			if err != nil {
+				return nil, err
+			}
+			if len(proofs) != 1 {
+				return nil, fmt.Errorf("expected a single ceremony in %s", name)
+			}
+			ret.Proofs = proofs[0]
+		case rel == "transcript":
+			ret.Transcript = data
+		case rel == "init.ssz":
+			ret.Init = &Init{}
+			if err := ret.Init.UnmarshalSSZ(data); err != nil {
+				return nil, err
+			}
+		case rel == "reshare.ssz":
+			ret.SignedReshare = &SignedReshare{}
+			if err := ret.SignedReshare.UnmarshalSSZ(data); err != nil {
+				return nil, err
+			}
+		case rel == "lineage.ssz":
+			ret.Lineage = &Lineage{}
+			if err := ret.Lineage.UnmarshalSSZ(data); err != nil {
+				return nil, err
+			}
+		case strings.HasPrefix(rel, "results/") && strings.HasSuffix(rel, ".ssz"):
+			result := &Result{}
+			if err := result.UnmarshalSSZ(data); err != nil {
+				return nil, err
+			}
+			id, err := strconv.ParseUint(strings.TrimSuffix(strings.TrimPrefix(rel, "results/"), ".ssz"), 10, 64)
+			if err != nil || id != result.OperatorID {
+				return nil, fmt.Errorf("result entry %s does not match operator ID", name)
+			}
+			ret.Results = append(ret.Results, result)
+		default:
+			return nil, fmt.Errorf("unknown ceremony entry %s", name)
+		}
+	}
+	if !found {
+		return nil, fmt.Errorf("ceremony not found")
+	}
+	return ret, nil
+}
+
+// WriteArchive writes a compressed archive, including its manifest and manifest hash
+func WriteArchive(w io.Writer, a *Archive) error {
+	manifest, err := json.Marshal(a.Manifest())
+	if err != nil {
+		return err
+	}
+	manifestHash := sha256.Sum256(manifest)
+
+	gz := gzip.NewWriter(w)
+	tw := tar.NewWriter(gz)
+	writeEntry := func(name string, data []byte) error {
+		if err := tw.WriteHeader(&tar.Header{
+			Typeflag: tar.TypeReg,
+			Name:     name,
+			Mode:     0o600,
+			Size:     int64(len(data)),
+			Format:   tar.FormatPAX,
+		}); err != nil {
+			return err
+		}
+		_, err := tw.Write(data)
+		return err
+	}
+
+	if err := writeEntry(archiveManifestName, manifest); err != nil {
+		return err
+	}
+	for _, name := range a.names {
+		if err := writeEntry(name, a.entries[name]); err != nil {
+			return err
+		}
+	}
+	if err := writeEntry(archiveManifestHashName, []byte(hex.EncodeToString(manifestHash[:]))); err != nil {
+		return err
+	}
+
+	if err := tw.Close(); err != nil {
+		return err
+	}
+	return gz.Close()
+}
+
+// ReadArchive reads a compressed archive, returns error if the manifest or any entry fails integrity verification
+func ReadArchive(r io.Reader) (*Archive, error) {
+	gz, err := gzip.NewReader(r)
+	if err != nil {
+		return nil, err
+	}
+	defer gz.Close()
+
+	files := make(map[string][]byte)
+	var names []string
+	tr := tar.NewReader(gz)
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		if _, found := files[header.Name]; found {
+			return nil, fmt.Errorf("duplicate entry %s", header.Name)
+		}
+		data, err := io.ReadAll(tr)
+		if err != nil {
+			return nil, err
+		}
+		files[header.Name] = data
+		names = append(names, header.Name)
+	}
+
+	manifestBytes, found := files[archiveManifestName]
+	if !found {
+		return nil, fmt.Errorf("missing manifest")
+	}
+	manifestHash := sha256.Sum256(manifestBytes)
+	if string(files[archiveManifestHashName]) != hex.EncodeToString(manifestHash[:]) {
+		return nil, fmt.Errorf("invalid manifest hash")
+	}
+	manifest := &ArchiveManifest{}
+	if err := json.Unmarshal(manifestBytes, manifest); err != nil {
+		return nil, err
+	}
+	if manifest.Version != ArchiveVersion {
+		return nil, fmt.Errorf("unsupported archive version %d", manifest.Version)
+	}
+	if len(manifest.Entries)+2 != len(names) {
+		return nil, fmt.Errorf("manifest entries count mismatch")
+	}
+
+	ret := NewArchive()
+	for _, entry := range manifest.Entries {
+		data, found := files[entry.Name]
+		if !found {
+			return nil, fmt.Errorf("missing entry %s", entry.Name)
+		}
+		digest := sha256.Sum256(data)
+		if len(data) != entry.Size || hex.EncodeToString(digest[:]) != entry.SHA256 {
+			return nil, fmt.Errorf("entry %s failed integrity check", entry.Name)
+		}
+		if err := ret.Add(entry.Name, data); err != nil {
+			return nil, err
+		}
+	}
+	return ret, nil
+}
@@ -0,0 +1,96 @@
+package spec
+
+// ValidateReshareBatch returns nil if messages are a consistent bulk reshare, checked before any per-message work:
+// all messages are of owner, reshare distinct validators, and move them between the same old and new operators
+func ValidateReshareBatch(owner [20]byte, messages []*SignedReshare) error {
+	if len(messages) == 0 {
+		return codedError(CodeBatchInconsistent, "empty batch")
+	}
+	validators := make(map[[48]byte]int, len(messages))
+	var first *Reshare
+	for i, msg := range messages {
+		if msg == nil {
+			return codedError(CodeBatchInconsistent, "message %d: empty message", i)
+		}
+		reshare := &msg.Reshare
+		if err := checkBatchMessage(i, owner, reshare.Owner, reshare.ValidatorPubKey, validators); err != nil {
+			return err
+		}
+		if !UniqueAndOrderedOperators(reshare.OldOperators) || !UniqueAndOrderedOperators(reshare.NewOperators) {
+			return codedError(CodeOperatorsNotOrdered, "message %d: operators not unique or not ordered", i)
+		}
+		if first == nil {
+			first = reshare
+			continue
+		}
+		if !EqualOperators(first.OldOperators, reshare.OldOperators) {
+			return codedError(CodeBatchInconsistent, "message %d: old operators differ from message 0", i)
+		}
+		if !EqualOperators(first.NewOperators, reshare.NewOperators) {
+			return codedError(CodeBatchInconsistent, "message %d: new operators differ from message 0", i)
+		}
+	}
+	return nil
+}
+
+// ValidateResignBatch returns nil if messages are a consistent bulk resign, checked before any per-message work:
+// all messages are of owner and resign distinct validators
+func ValidateResignBatch(owner [20]byte, messages []*SignedResign) error {
+	if len(messages) == 0 {
+		return codedError(CodeBatchInconsistent, "empty batch")
+	}
+	validators := make(map[[48]byte]int, len(messages))
+	for i, msg := range messages {
+		if msg == nil {
+			return codedError(CodeBatchInconsistent, "message %d: empty message", i)
+		}
+		if err := checkBatchMessage(i, owner, msg.Resign.Owner, msg.Resign.ValidatorPubKey, validators); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// checkBatchMessage checks the owner and validator of message i, adding the validator to validators (validator -> first message index)
+func checkBatchMessage(i int, expectedOwner, owner [20]byte, validatorPK []byte, validators map[[48]byte]int) error {
+	if owner != expectedOwner {
+		return codedError(CodeBatchInconsistent, "message %d: owner %x differs from the batch owner %x", i, owner, expectedOwner)
+	}
+	if len(validatorPK) != 48 {
+		return codedError(CodeInvalidValidatorPubKey, "message %d: invalid validator public key length", i)
+	}
+	if first, found := validators[[48]byte(validatorPK)]; found {
+		return codedError(CodeBatchInconsistent, "message %d: validator %x already in message %d", i, validatorPK, first)
+	}
+	validators[[48]byte(validatorPK)] = i
+	return nil
+}
+
+// ValidateInitBatchNonces returns nil if inits are a bulk ceremony of owner whose nonces are contiguous from nextNonce, the owner's
+// next unused nonce on chain (see NonceProvider), so that every validator registers. Messages may be in any order.
+func ValidateInitBatchNonces(owner [20]byte, nextNonce uint64, inits []*Init) error {
+	if len(inits) == 0 {
+		return codedError(CodeBatchInconsistent, "empty batch")
+	}
+	last := nextNonce + uint64(len(inits)) - 1
+	nonces := make(map[uint64]int, len(inits))
+	for i, init := range inits {
+		if init == nil {
+			return codedError(CodeBatchInconsistent, "message %d: empty message", i)
+		}
+		if init.Owner != owner {
+			return codedError(CodeBatchInconsistent, "message %d: owner %x differs from the batch owner %x", i, init.Owner, owner)
+		}
+		if init.Nonce < nextNonce {
+			return codedError(CodeNonceMismatch, "message %d: nonce %d already used, owner's next nonce is %d", i, init.Nonce, nextNonce)
+		}
+		if init.Nonce > last {
+			return codedError(CodeNonceMismatch, "message %d: nonce %d leaves a gap, expected nonces %d to %d", i, init.Nonce, nextNonce, last)
+		}
+		if first, found := nonces[init.Nonce]; found {
+			return codedError(CodeNonceMismatch, "message %d: nonce %d already in message %d", i, init.Nonce, first)
+		}
+		nonces[init.Nonce] = i
+	}
+	return nil
+}
@@ -0,0 +1,104 @@
+// Package beaconapi is a thin beacon node API client used to look up validators, it implements spec.ValidatorChecker and spec.DepositChecker
+package beaconapi
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	spec "github.com/bloxapp/dkg-spec"
+)
+
+// DefaultTimeout bounds a single beacon node request
+const DefaultTimeout = 10 * time.Second
+
+type validatorResponse struct {
+	Data struct {
+		Index     string `json:"index"`
+		Status    string `json:"status"`
+		Validator struct {
+			PubKey                string `json:"pubkey"`
+			WithdrawalCredentials string `json:"withdrawal_credentials"`
+		} `json:"validator"`
+	} `json:"data"`
+}
+
+// Client fetches validators from a beacon node's standard API
+type Client struct {
+	baseURL    string
+	httpClient *http.Client
+}
+
+// NewClient returns a client for the beacon node served at baseURL, a client with DefaultTimeout is used if httpClient is nil
+func NewClient(baseURL string, httpClient *http.Client) *Client {
+	if httpClient == nil {
+		httpClient = &http.Client{Timeout: DefaultTimeout}
+	}
+	return &Client{
+		baseURL:    strings.TrimRight(baseURL, "/"),
+		httpClient: httpClient,
+	}
+}
+
+// FetchValidator returns validatorPK's registration at the head state, nil if it's not registered
+func (c *Client) FetchValidator(ctx context.Context, validatorPK []byte) (*spec.ChainValidator, error) {
+	url := fmt.Sprintf("%s/eth/v1/beacon/states/head/validators/0x%s", c.baseURL, hex.EncodeToString(validatorPK))
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/json")
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+	case http.StatusNotFound:
+		return nil, nil
+	default:
+		return nil, fmt.Errorf("beacon node returned status %d", resp.StatusCode)
+	}
+
+	body := &validatorResponse{}
+	if err := json.NewDecoder(resp.Body).Decode(body); err != nil {
+		return nil, err
+	}
+	index, err := strconv.ParseUint(body.Data.Index, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid validator index: %v", err)
+	}
+	withdrawalCredentials, err := hex.DecodeString(strings.TrimPrefix(body.Data.Validator.WithdrawalCredentials, "0x"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid withdrawal credentials: %v", err)
+	}
+	return &spec.ChainValidator{
+		Index:                 index,
+		Status:                spec.ValidatorStatus(body.Data.Status),
+		WithdrawalCredentials: withdrawalCredentials,
+	}, nil
+}
+
+// Validator implements spec.ValidatorChecker
+func (c *Client) Validator(validatorPK []byte) (*spec.ChainValidator, error) {
+	return c.FetchValidator(context.Background(), validatorPK)
+}
+
+// ExistingWithdrawalCredentials implements spec.DepositChecker, only deposits already processed by the beacon chain are visible
+func (c *Client) ExistingWithdrawalCredentials(validatorPK []byte) ([][]byte, error) {
+	validator, err := c.FetchValidator(context.Background(), validatorPK)
+	if err != nil {
+		return nil, err
+	}
+	if validator == nil {
+		return nil, nil
+	}
+	return [][]byte{validator.WithdrawalCredentials}, nil
+}
@@ -0,0 +1,159 @@
+package spec
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+)
+
+// ValidatorResults are the operators' results of a single ceremony of a bulk ceremony, ordered by operator ID
+type ValidatorResults struct {
+	RequestID       [24]byte
+	ValidatorPubKey []byte
+	Results         []*Result
+}
+
+// Missing returns the IDs of operators without a result, in operators order
+func (vr *ValidatorResults) Missing(operators []*Operator) []uint64 {
+	var ret []uint64
+	for _, operator := range operators {
+		if vr.result(operator.ID) == nil {
+			ret = append(ret, operator.ID)
+		}
+	}
+	return ret
+}
+
+func (vr *ValidatorResults) result(operatorID uint64) *Result {
+	for _, result := range vr.Results {
+		if result.OperatorID == operatorID {
+			return result
+		}
+	}
+	return nil
+}
+
+// BulkResults collects the results of a bulk ceremony as operators return them, indexed by request ID and validator public key.
+// Ceremonies are kept in the order their first result was added, which is the batch order of BulkResultsRoot.
+type BulkResults struct {
+	validators  []*ValidatorResults
+	byRequestID map[[24]byte]int
+	byValidator map[[48]byte]int
+}
+
+// NewBulkResults returns an empty BulkResults
+func NewBulkResults() *BulkResults {
+	return &BulkResults{
+		byRequestID: map[[24]byte]int{},
+		byValidator: map[[48]byte]int{},
+	}
+}
+
+// Add adds an operator's result, keyed by its request ID and its proof's validator public key
+func (b *BulkResults) Add(result *Result) error {
+	if result == nil || result.SignedProof.Proof == nil {
+		return fmt.Errorf("empty result")
+	}
+	validatorPK := result.SignedProof.Proof.ValidatorPubKey
+	if len(validatorPK) != 48 {
+		return codedError(CodeInvalidValidatorPubKey, "invalid validator public key length")
+	}
+	i, found := b.byRequestID[result.RequestID]
+	if !found {
+		if _, found := b.byValidator[[48]byte(validatorPK)]; found {
+			return codedError(CodeRequestIDMismatch, "validator %x already has results of another request", validatorPK)
+		}
+		i = len(b.validators)
+		b.validators = append(b.validators, &ValidatorResults{
+			RequestID:       result.RequestID,
+			ValidatorPubKey: validatorPK,
+		})
+		b.byRequestID[result.RequestID] = i
+		b.byValidator[[48]byte(validatorPK)] = i
+	}
+
+	vr := b.validators[i]
+	if !bytes.Equal(vr.ValidatorPubKey, validatorPK) {
+		return codedError(CodeProofValidatorMismatch, "request %x results are for validator %x", result.RequestID, vr.ValidatorPubKey)
+	}
+	if vr.result(result.OperatorID) != nil {
+		return fmt.Errorf("duplicate result of operator %d for request %x", result.OperatorID, result.RequestID)
+	}
+	vr.Results = append(vr.Results, result)
+	sort.Slice(vr.Results, func(i, j int) bool {
+		return vr.Results[i].OperatorID < vr.Results[j].OperatorID
+	})
+	return nil
+}
+
+// Len returns the number of ceremonies
+func (b *BulkResults) Len() int {
+	return len(b.validators)
+}
+
+// ByRequestID returns the results of the ceremony with requestID, or nil
+func (b *BulkResults) ByRequestID(requestID [24]byte) *ValidatorResults {
+	if i, found := b.byRequestID[requestID]; found {
+		return b.validators[i]
+	}
+	return nil
+}
+
+// ByValidator returns the results of the ceremony creating validatorPK, or nil
+func (b *BulkResults) ByValidator(validatorPK []byte) *ValidatorResults {
+	if len(validatorPK) != 48 {
+		return nil
+	}
+	if i, found := b.byValidator[[48]byte(validatorPK)]; found {
+		return b.validators[i]
+	}
+	return nil
+}
+
+// Validators returns the results of every ceremony, in batch order
+func (b *BulkResults) Validators() []*ValidatorResults {
+	return append([]*ValidatorResults{}, b.validators...)
+}
+
+// Results returns the results of every ceremony in batch order, as expected by BulkResultsRoot
+func (b *BulkResults) Results() [][]*Result {
+	ret := make([][]*Result, len(b.validators))
+	for i, vr := range b.validators {
+		ret[i] = vr.Results
+	}
+	return ret
+}
+
+// Complete returns nil if every ceremony has a result of each of operators
+func (b *BulkResults) Complete(operators []*Operator) error {
+	for _, vr := range b.validators {
+		if missing := vr.Missing(operators); len(missing) > 0 {
+			return codedError(CodeResultsCountMismatch, "request %x missing results of operators %v", vr.RequestID, missing)
+		}
+	}
+	return nil
+}
+
+// MarshalJSON encodes the results as an array of each ceremony's results, in batch order
+func (b *BulkResults) MarshalJSON() ([]byte, error) {
+	return json.Marshal(b.Results())
+}
+
+// UnmarshalJSON decodes results encoded with MarshalJSON, adding them as Add does
+func (b *BulkResults) UnmarshalJSON(data []byte) error {
+	var bulk [][]*Result
+	if err := json.Unmarshal(data, &bulk); err != nil {
+		return err
+	}
+	ret := NewBulkResults()
+	for i, results := range bulk {
+		for _, result := range results {
+			if err := ret.Add(result); err != nil {
+				return fmt.Errorf("ceremony %d: %w", i, err)
+			}
+		}
+	}
+	*b = *ret
+	return nil
+}
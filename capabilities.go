@@ -0,0 +1,98 @@
+//go:build !verifyonly
+
+package spec
+
+import (
+	"encoding/hex"
+	"fmt"
+
+	"golang.org/x/exp/slices"
+)
+
+// Capabilities are what an operator serves, advertised so initiators can plan ceremonies the whole committee supports
+type Capabilities struct {
+	SpecVersion string `json:"spec_version"`
+	// MessageTypes are the ceremonies the operator runs
+	MessageTypes []MessageType `json:"message_types"`
+	// MaxBulkSize is the max number of messages of a bulk request, 0 if unbounded
+	MaxBulkSize uint64 `json:"max_bulk_size"`
+	// ShareEncryptions are the schemes the operator encrypts shares with
+	ShareEncryptions []ShareEncryption `json:"share_encryptions"`
+	// Forks are the 0x prefixed hex fork versions the operator signs deposit data for, identifying their networks
+	Forks []string `json:"forks"`
+}
+
+// CurrentCapabilities returns the capabilities of an operator running this spec version, accepting bulk requests
+// of up to maxBulkSize messages (0 if unbounded)
+func CurrentCapabilities(maxBulkSize uint64) *Capabilities {
+	compatibility := currentCompatibility()
+	forks := make([]string, 0, len(compatibility.Forks))
+	for _, fork := range compatibility.Forks {
+		forks = append(forks, formatFork(fork.Fork))
+	}
+	return &Capabilities{
+		SpecVersion:      compatibility.SpecVersion,
+		MessageTypes:     []MessageType{InitMessageType, ReshareMessageType, ResignMessageType},
+		MaxBulkSize:      maxBulkSize,
+		ShareEncryptions: []ShareEncryption{ShareEncryptionRSAPKCS1v15, ShareEncryptionRSAOAEPAESGCM},
+		Forks:            forks,
+	}
+}
+
+// IntersectCapabilities returns the capabilities every one of a committee's operators has, in the order of the first operator's.
+// Operators must run the same spec version.
+func IntersectCapabilities(capabilities ...*Capabilities) (*Capabilities, error) {
+	if len(capabilities) == 0 {
+		return nil, fmt.Errorf("no capabilities")
+	}
+	first := capabilities[0]
+	ret := &Capabilities{
+		SpecVersion:      first.SpecVersion,
+		MessageTypes:     append([]MessageType{}, first.MessageTypes...),
+		MaxBulkSize:      first.MaxBulkSize,
+		ShareEncryptions: append([]ShareEncryption{}, first.ShareEncryptions...),
+		Forks:            append([]string{}, first.Forks...),
+	}
+	for i, c := range capabilities[1:] {
+		if c.SpecVersion != ret.SpecVersion {
+			return nil, fmt.Errorf("operator %d runs spec %s, operator 0 runs %s", i+1, c.SpecVersion, ret.SpecVersion)
+		}
+		ret.MessageTypes = intersect(ret.MessageTypes, c.MessageTypes)
+		ret.ShareEncryptions = intersect(ret.ShareEncryptions, c.ShareEncryptions)
+		ret.Forks = intersect(ret.Forks, c.Forks)
+		if c.MaxBulkSize > 0 && (ret.MaxBulkSize == 0 || c.MaxBulkSize < ret.MaxBulkSize) {
+			ret.MaxBulkSize = c.MaxBulkSize
+		}
+	}
+	return ret, nil
+}
+
+// Supports returns nil if a ceremony of type t, for fork and of bulkSize messages is supported
+func (c *Capabilities) Supports(t MessageType, fork [4]byte, bulkSize int) error {
+	if !slices.Contains(c.MessageTypes, t) {
+		return fmt.Errorf("%s ceremonies not supported", t)
+	}
+	if !slices.Contains(c.Forks, formatFork(fork)) {
+		return fmt.Errorf("fork %x not supported", fork[:])
+	}
+	if c.MaxBulkSize > 0 && uint64(bulkSize) > c.MaxBulkSize {
+		return fmt.Errorf("bulk size %d above max %d", bulkSize, c.MaxBulkSize)
+	}
+	return nil
+}
+
+// formatFork returns fork as a lowercase 0x prefixed hex string, the only accepted format of Capabilities.Forks
+func formatFork(fork [4]byte) string {
+	return "0x" + hex.EncodeToString(fork[:])
+}
+
+// intersect returns the elements of a also in b, in a's order
+func intersect[T comparable](a, b []T) []T {
+	ret := make([]T, 0, len(a))
+	for _, v := range a {
+		if slices.Contains(b, v) {
+			ret = append(ret, v)
+		}
+	}
+	return ret
+}
@@ -0,0 +1,207 @@
+// Command capi builds the spec as a C shared library so non-Go implementations can reuse it as the reference verifier:
+//
+//	go build -buildmode=c-shared -o libdkgspec.so ./capi
+//
+// Messages are passed as JSON strings and byte arrays as hex strings (0x prefix optional). Every function returns a
+// JSON string {"result": ..., "error": ...} which must be released with DkgFree.
+package main
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	spec "github.com/bloxapp/dkg-spec"
+	"github.com/bloxapp/dkg-spec/crypto"
+	"github.com/bloxapp/dkg-spec/verify"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+type response struct {
+	Result interface{} `json:"result"`
+	Error  *string     `json:"error"`
+}
+
+func respond(result interface{}, err error) string {
+	ret := response{Result: result}
+	if err != nil {
+		msg := err.Error()
+		ret = response{Error: &msg}
+	}
+	byts, err := json.Marshal(ret)
+	if err != nil {
+		return fmt.Sprintf(`{"result":null,"error":%q}`, err.Error())
+	}
+	return string(byts)
+}
+
+func decodeHex(s string) ([]byte, error) {
+	return hex.DecodeString(strings.TrimPrefix(s, "0x"))
+}
+
+func decodeFixedHex(name string, s string, size int) ([]byte, error) {
+	ret, err := decodeHex(s)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s: %v", name, err)
+	}
+	if len(ret) != size {
+		return nil, fmt.Errorf("invalid %s length", name)
+	}
+	return ret, nil
+}
+
+func hashTreeRoot(messageType, messageJSON string) (interface{}, error) {
+	root, err := verify.HashTreeRoot(messageType, []byte(messageJSON))
+	if err != nil {
+		return nil, err
+	}
+	return hex.EncodeToString(root[:]), nil
+}
+
+func depositSigningRoot(fork, validatorPK, withdrawalCredentials string, amount uint64) (interface{}, error) {
+	forkBytes, err := decodeFixedHex("fork", fork, 4)
+	if err != nil {
+		return nil, err
+	}
+	pk, err := decodeFixedHex("validator public key", validatorPK, 48)
+	if err != nil {
+		return nil, err
+	}
+	wc, err := decodeHex(withdrawalCredentials)
+	if err != nil {
+		return nil, fmt.Errorf("invalid withdrawal credentials: %v", err)
+	}
+	root, err := crypto.DepositDataRootForFork([4]byte(forkBytes), pk, wc, phase0.Gwei(amount))
+	if err != nil {
+		return nil, err
+	}
+	return hex.EncodeToString(root[:]), nil
+}
+
+func nonceSigningRoot(owner string, nonce uint64) (interface{}, error) {
+	ownerBytes, err := decodeFixedHex("owner", owner, 20)
+	if err != nil {
+		return nil, err
+	}
+	return hex.EncodeToString(spec.PartialNonceRoot([20]byte(ownerBytes), nonce)), nil
+}
+
+func validateInitMessage(initJSON string) (interface{}, error) {
+	init := &spec.Init{}
+	if err := json.Unmarshal([]byte(initJSON), init); err != nil {
+		return nil, err
+	}
+	return true, spec.ValidateInitMessage(init)
+}
+
+func decodeOperatorAndProof(operatorJSON, proofJSON string) (*spec.Operator, *spec.SignedProof, error) {
+	operator := &spec.Operator{}
+	if err := json.Unmarshal([]byte(operatorJSON), operator); err != nil {
+		return nil, nil, err
+	}
+	proof := &spec.SignedProof{}
+	if err := json.Unmarshal([]byte(proofJSON), proof); err != nil {
+		return nil, nil, err
+	}
+	return operator, proof, nil
+}
+
+func validateReshareMessage(reshareJSON, operatorJSON, proofJSON, paramsJSON string) (interface{}, error) {
+	reshare := &spec.Reshare{}
+	if err := json.Unmarshal([]byte(reshareJSON), reshare); err != nil {
+		return nil, err
+	}
+	operator, proof, err := decodeOperatorAndProof(operatorJSON, proofJSON)
+	if err != nil {
+		return nil, err
+	}
+	params, err := decodeParams(paramsJSON)
+	if err != nil {
+		return nil, err
+	}
+	return true, spec.ValidateReshareMessage(reshare, operator, proof, params)
+}
+
+func validateResignMessage(resignJSON, operatorJSON, proofJSON, paramsJSON string) (interface{}, error) {
+	resign := &spec.Resign{}
+	if err := json.Unmarshal([]byte(resignJSON), resign); err != nil {
+		return nil, err
+	}
+	operator, proof, err := decodeOperatorAndProof(operatorJSON, proofJSON)
+	if err != nil {
+		return nil, err
+	}
+	params, err := decodeParams(paramsJSON)
+	if err != nil {
+		return nil, err
+	}
+	return true, spec.ValidateResignMessage(resign, operator, proof, params)
+}
+
+// decodeParams decodes ceremony params, nil if paramsJSON is empty
+func decodeParams(paramsJSON string) (*spec.CeremonyParams, error) {
+	if paramsJSON == "" {
+		return nil, nil
+	}
+	params := &spec.CeremonyParams{}
+	if err := json.Unmarshal([]byte(paramsJSON), params); err != nil {
+		return nil, err
+	}
+	return params, nil
+}
+
+func validateResult(
+	operatorsJSON string,
+	owner string,
+	requestID string,
+	withdrawalCredentials string,
+	validatorPK string,
+	fork string,
+	amount uint64,
+	nonce uint64,
+	resultJSON string,
+) (interface{}, error) {
+	operators := []*spec.Operator{}
+	if err := json.Unmarshal([]byte(operatorsJSON), &operators); err != nil {
+		return nil, err
+	}
+	result := &spec.Result{}
+	if err := json.Unmarshal([]byte(resultJSON), result); err != nil {
+		return nil, err
+	}
+	ownerBytes, err := decodeFixedHex("owner", owner, 20)
+	if err != nil {
+		return nil, err
+	}
+	requestIDBytes, err := decodeFixedHex("request ID", requestID, 24)
+	if err != nil {
+		return nil, err
+	}
+	wc, err := decodeHex(withdrawalCredentials)
+	if err != nil {
+		return nil, fmt.Errorf("invalid withdrawal credentials: %v", err)
+	}
+	pk, err := decodeFixedHex("validator public key", validatorPK, 48)
+	if err != nil {
+		return nil, err
+	}
+	forkBytes, err := decodeFixedHex("fork", fork, 4)
+	if err != nil {
+		return nil, err
+	}
+	return true, spec.ValidateResult(
+		operators,
+		[20]byte(ownerBytes),
+		[24]byte(requestIDBytes),
+		wc,
+		pk,
+		[4]byte(forkBytes),
+		amount,
+		nonce,
+		result,
+	)
+}
+
+func main() {}
@@ -0,0 +1,86 @@
+package main
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	spec "github.com/bloxapp/dkg-spec"
+	"github.com/bloxapp/dkg-spec/testing/fixtures"
+
+	"github.com/stretchr/testify/require"
+)
+
+func mustJSON(t *testing.T, v interface{}) string {
+	byts, err := json.Marshal(v)
+	require.NoError(t, err)
+	return string(byts)
+}
+
+func TestRespond(t *testing.T) {
+	require.EqualValues(t, `{"result":true,"error":null}`, respond(true, nil))
+	require.EqualValues(t, `{"result":null,"error":"invalid"}`, respond(true, fmt.Errorf("invalid")))
+}
+
+func TestSigningRoots(t *testing.T) {
+	t.Run("nonce", func(t *testing.T) {
+		root, err := nonceSigningRoot("0x"+hex.EncodeToString(fixtures.TestOwnerAddress[:]), fixtures.TestNonce)
+		require.NoError(t, err)
+		require.EqualValues(t, hex.EncodeToString(spec.PartialNonceRoot(fixtures.TestOwnerAddress, fixtures.TestNonce)), root)
+	})
+
+	t.Run("deposit", func(t *testing.T) {
+		validatorPK := fixtures.ShareSK(fixtures.TestValidator4Operators).GetPublicKey().Serialize()
+		root, err := depositSigningRoot("00000000", hex.EncodeToString(validatorPK), hex.EncodeToString(fixtures.TestWithdrawalCred), 32000000000)
+		require.NoError(t, err)
+		require.Len(t, root, 64)
+	})
+
+	t.Run("invalid fork", func(t *testing.T) {
+		_, err := depositSigningRoot("0000", "", "", 32000000000)
+		require.EqualError(t, err, "invalid fork length")
+	})
+}
+
+func TestValidateMessages(t *testing.T) {
+	t.Run("reshare", func(t *testing.T) {
+		_, err := validateReshareMessage(
+			mustJSON(t, &fixtures.TestReshare4Operators),
+			mustJSON(t, fixtures.GenerateOperators(4)[0]),
+			mustJSON(t, &fixtures.TestOperator1Proof4Operators),
+			mustJSON(t, fixtures.TestParams(4)),
+		)
+		require.NoError(t, err)
+	})
+
+	t.Run("reshare wrong operator", func(t *testing.T) {
+		_, err := validateReshareMessage(
+			mustJSON(t, &fixtures.TestReshare4Operators),
+			mustJSON(t, fixtures.GenerateOperators(4)[1]),
+			mustJSON(t, &fixtures.TestOperator1Proof4Operators),
+			mustJSON(t, fixtures.TestParams(4)),
+		)
+		require.Error(t, err)
+	})
+
+	t.Run("result", func(t *testing.T) {
+		_, err := validateResult(
+			mustJSON(t, fixtures.GenerateOperators(4)),
+			hex.EncodeToString(fixtures.TestOwnerAddress[:]),
+			hex.EncodeToString(fixtures.TestRequestID[:]),
+			hex.EncodeToString(fixtures.TestWithdrawalCred),
+			hex.EncodeToString(fixtures.ShareSK(fixtures.TestValidator4Operators).GetPublicKey().Serialize()),
+			hex.EncodeToString(fixtures.TestFork[:]),
+			spec.DepositAmount,
+			fixtures.TestNonce,
+			mustJSON(t, fixtures.Results4Operators()[0]),
+		)
+		require.NoError(t, err)
+	})
+
+	t.Run("init invalid json", func(t *testing.T) {
+		_, err := validateInitMessage("{")
+		require.Error(t, err)
+	})
+}
@@ -0,0 +1,86 @@
+package main
+
+/*
+#include <stdlib.h>
+*/
+import "C"
+
+import (
+	"unsafe"
+
+	"github.com/bloxapp/dkg-spec/verify"
+)
+
+func ret(result interface{}, err error) *C.char {
+	return C.CString(respond(result, err))
+}
+
+//export DkgFree
+func DkgFree(ptr *C.char) {
+	C.free(unsafe.Pointer(ptr))
+}
+
+//export DkgVerifyCeremonyProof
+func DkgVerifyCeremonyProof(operatorPubKey, signedProofJSON *C.char) *C.char {
+	return ret(true, verify.VerifyCeremonyProof([]byte(C.GoString(operatorPubKey)), []byte(C.GoString(signedProofJSON))))
+}
+
+//export DkgValidateCeremonyProofs
+func DkgValidateCeremonyProofs(operatorsJSON, proofsJSON *C.char) *C.char {
+	return ret(true, verify.ValidateCeremonyProofs([]byte(C.GoString(operatorsJSON)), []byte(C.GoString(proofsJSON))))
+}
+
+//export DkgHashTreeRoot
+func DkgHashTreeRoot(messageType, messageJSON *C.char) *C.char {
+	return ret(hashTreeRoot(C.GoString(messageType), C.GoString(messageJSON)))
+}
+
+//export DkgDepositSigningRoot
+func DkgDepositSigningRoot(fork, validatorPK, withdrawalCredentials *C.char, amount C.ulonglong) *C.char {
+	return ret(depositSigningRoot(C.GoString(fork), C.GoString(validatorPK), C.GoString(withdrawalCredentials), uint64(amount)))
+}
+
+//export DkgNonceSigningRoot
+func DkgNonceSigningRoot(owner *C.char, nonce C.ulonglong) *C.char {
+	return ret(nonceSigningRoot(C.GoString(owner), uint64(nonce)))
+}
+
+//export DkgValidateInitMessage
+func DkgValidateInitMessage(initJSON *C.char) *C.char {
+	return ret(validateInitMessage(C.GoString(initJSON)))
+}
+
+//export DkgValidateReshareMessage
+func DkgValidateReshareMessage(reshareJSON, operatorJSON, proofJSON, paramsJSON *C.char) *C.char {
+	return ret(validateReshareMessage(C.GoString(reshareJSON), C.GoString(operatorJSON), C.GoString(proofJSON), C.GoString(paramsJSON)))
+}
+
+//export DkgValidateResignMessage
+func DkgValidateResignMessage(resignJSON, operatorJSON, proofJSON, paramsJSON *C.char) *C.char {
+	return ret(validateResignMessage(C.GoString(resignJSON), C.GoString(operatorJSON), C.GoString(proofJSON), C.GoString(paramsJSON)))
+}
+
+//export DkgValidateResult
+func DkgValidateResult(
+	operatorsJSON *C.char,
+	owner *C.char,
+	requestID *C.char,
+	withdrawalCredentials *C.char,
+	validatorPK *C.char,
+	fork *C.char,
+	amount C.ulonglong,
+	nonce C.ulonglong,
+	resultJSON *C.char,
+) *C.char {
+	return ret(validateResult(
+		C.GoString(operatorsJSON),
+		C.GoString(owner),
+		C.GoString(requestID),
+		C.GoString(withdrawalCredentials),
+		C.GoString(validatorPK),
+		C.GoString(fork),
+		uint64(amount),
+		uint64(nonce),
+		C.GoString(resultJSON),
+	))
+}
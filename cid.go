@@ -0,0 +1,47 @@
+package spec
+
+import (
+	"crypto/sha256"
+	"encoding/base32"
+	"strings"
+
+	ssz "github.com/ferranbt/fastssz"
+)
+
+const (
+	cidVersion1        = 0x01
+	cidCodecRaw        = 0x55
+	multihashSHA256    = 0x12
+	multihashSHA256Len = 0x20
+	multibaseBase32    = "b"
+)
+
+var cidBase32 = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+// CID returns the CIDv1 (raw codec, sha2-256 multihash, base32 multibase) of data.
+// It matches `ipfs add --cid-version=1 --raw-leaves` for data fitting a single block (256KiB with the default chunker).
+func CID(data []byte) string {
+	digest := sha256.Sum256(data)
+	byts := append([]byte{cidVersion1, cidCodecRaw, multihashSHA256, multihashSHA256Len}, digest[:]...)
+	return multibaseBase32 + strings.ToLower(cidBase32.EncodeToString(byts))
+}
+
+// SSZCID returns the CID of an object's canonical SSZ encoding
+func SSZCID(obj ssz.Marshaler) (string, error) {
+	var ret string
+	err := withSSZ(obj, func(byts []byte) error {
+		ret = CID(byts)
+		return nil
+	})
+	return ret, err
+}
+
+// ProofsCID returns the CID of the proofs.json encoding of proofs
+func ProofsCID(proofs []CeremonyProofs) (string, error) {
+	buf := getJSONBuffer()
+	defer putJSONBuffer(buf)
+	if err := WriteProofs(buf, proofs); err != nil {
+		return "", err
+	}
+	return CID(buf.Bytes()), nil
+}
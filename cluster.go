@@ -0,0 +1,96 @@
+package spec
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/bloxapp/dkg-spec/ssvnetwork"
+)
+
+// ClusterMismatch describes a difference between stored ceremony proofs and the validator's on-chain registration
+type ClusterMismatch struct {
+	// Field is one of "registration", "owner", "operators", "validator", "shares", "share_pub" or "encrypted_share"
+	Field   string
+	Message string
+}
+
+func (m *ClusterMismatch) String() string {
+	return fmt.Sprintf("%s: %s", m.Field, m.Message)
+}
+
+// VerifyProofsAgainstCluster reconciles a ceremony's proofs with the validator's current on-chain registration
+// (see ssvnetwork.CurrentRegistration), returning every mismatch found. An empty result means proofs and chain agree.
+func VerifyProofsAgainstCluster(
+	operators []*Operator,
+	proofs CeremonyProofs,
+	registration *ssvnetwork.ValidatorEvent,
+) []*ClusterMismatch {
+	if registration == nil || registration.Removed {
+		return []*ClusterMismatch{{Field: "registration", Message: "validator is not registered"}}
+	}
+
+	var ret []*ClusterMismatch
+	if !bytes.Equal(registration.PublicKey, proofs.ValidatorPubKey()) {
+		ret = append(ret, &ClusterMismatch{Field: "validator", Message: fmt.Sprintf("registered %x, proofs %x", registration.PublicKey, proofs.ValidatorPubKey())})
+	}
+	owner := proofs.Owner()
+	if !bytes.Equal(registration.Owner[:], owner[:]) {
+		ret = append(ret, &ClusterMismatch{Field: "owner", Message: fmt.Sprintf("registered %x, proofs %x", registration.Owner, owner)})
+	}
+
+	ids := make([]uint64, 0, len(operators))
+	for _, operator := range operators {
+		ids = append(ids, operator.ID)
+	}
+	if !equalIDs(registration.OperatorIDs, ids) {
+		ret = append(ret, &ClusterMismatch{Field: "operators", Message: fmt.Sprintf("registered %v, proofs %v", registration.OperatorIDs, ids)})
+		return ret
+	}
+	if len(proofs) != len(operators) {
+		ret = append(ret, &ClusterMismatch{Field: "operators", Message: "mismatch proofs count"})
+		return ret
+	}
+
+	for i, proof := range proofs {
+		if proof == nil || proof.Proof == nil {
+			ret = append(ret, &ClusterMismatch{Field: "validator", Message: fmt.Sprintf("operator %d proof is empty", ids[i])})
+			continue
+		}
+		if !bytes.Equal(proof.Proof.ValidatorPubKey, registration.PublicKey) {
+			ret = append(ret, &ClusterMismatch{Field: "validator", Message: fmt.Sprintf("operator %d proof is for another validator", ids[i])})
+		}
+		if !bytes.Equal(proof.Proof.Owner[:], registration.Owner[:]) {
+			ret = append(ret, &ClusterMismatch{Field: "owner", Message: fmt.Sprintf("operator %d proof is for another owner", ids[i])})
+		}
+	}
+
+	sharePubKeys, encryptedShares, err := ssvnetwork.ParseShares(registration.Shares, len(operators))
+	if err != nil {
+		// registered shares can't be the ceremony's shares
+		return append(ret, &ClusterMismatch{Field: "shares", Message: fmt.Sprintf("registered shares can't be parsed: %v", err)})
+	}
+	for i, proof := range proofs {
+		if proof == nil || proof.Proof == nil {
+			continue
+		}
+		if !bytes.Equal(proof.Proof.SharePubKey, sharePubKeys[i]) {
+			ret = append(ret, &ClusterMismatch{Field: "share_pub", Message: fmt.Sprintf("operator %d share pubkey differs from registered", ids[i])})
+		}
+		if !bytes.Equal(proof.Proof.EncryptedShare, encryptedShares[i]) {
+			ret = append(ret, &ClusterMismatch{Field: "encrypted_share", Message: fmt.Sprintf("operator %d encrypted share differs from registered", ids[i])})
+		}
+	}
+	return ret
+}
+
+func equalIDs(a, b []uint64) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
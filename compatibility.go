@@ -0,0 +1,93 @@
+//go:build !verifyonly
+
+package spec
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+
+	"github.com/bloxapp/dkg-spec/crypto"
+)
+
+// Compatibility is what a spec version supports
+type Compatibility struct {
+	SpecVersion     string
+	ProofVersions   []uint8
+	InitVersions    []uint8
+	ReshareVersions []uint8
+	ResignVersions  []uint8
+	// Forks ordered by fork version
+	Forks []*SupportedFork
+	// ClusterSizes ordered ascending
+	ClusterSizes []int
+}
+
+// SupportedFork is a fork supported by a spec version
+type SupportedFork struct {
+	Fork [4]byte
+	Name string
+	// Compounding is true if compounding (0x02) withdrawal credentials are accepted
+	Compounding bool
+}
+
+// CompatibilityMatrix returns what every known spec version supports, oldest first
+func CompatibilityMatrix() []*Compatibility {
+	return []*Compatibility{
+		currentCompatibility(),
+	}
+}
+
+// CompatibilityOf returns what specVersion supports
+func CompatibilityOf(specVersion string) (*Compatibility, error) {
+	for _, c := range CompatibilityMatrix() {
+		if c.SpecVersion == specVersion {
+			return c, nil
+		}
+	}
+	return nil, fmt.Errorf("unknown spec version %s", specVersion)
+}
+
+// Supports returns nil if a ceremony of fork with clusterSize operators is supported
+func (c *Compatibility) Supports(fork [4]byte, clusterSize int) error {
+	found := false
+	for _, f := range c.Forks {
+		if f.Fork == fork {
+			found = true
+			break
+		}
+	}
+	if !found {
+		return fmt.Errorf("fork %x not supported by spec %s", fork, c.SpecVersion)
+	}
+	for _, size := range c.ClusterSizes {
+		if size == clusterSize {
+			return nil
+		}
+	}
+	return fmt.Errorf("cluster size %d not supported by spec %s", clusterSize, c.SpecVersion)
+}
+
+// currentCompatibility includes forks registered with crypto.RegisterFork
+func currentCompatibility() *Compatibility {
+	forks := make([]*SupportedFork, 0)
+	for fork, behavior := range crypto.Forks() {
+		forks = append(forks, &SupportedFork{
+			Fork:        fork,
+			Name:        behavior.Name,
+			Compounding: behavior.Compounding,
+		})
+	}
+	sort.Slice(forks, func(i, j int) bool {
+		return bytes.Compare(forks[i].Fork[:], forks[j].Fork[:]) < 0
+	})
+	return &Compatibility{
+		SpecVersion:     SpecVersion,
+		ProofVersions:   []uint8{1, 2, 3, 4, ProofVersion},
+		InitVersions:    []uint8{1, InitVersion},
+		ReshareVersions: []uint8{1, ReshareVersion},
+		ResignVersions:  []uint8{1, ResignVersion},
+		Forks:           forks,
+		ClusterSizes:    append([]int{}, ClusterSizes...),
+	}
+}
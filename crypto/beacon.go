@@ -1,11 +1,13 @@
+//go:build !verifyonly
+
 package crypto
 
 import (
 	"fmt"
+
+	"github.com/bloxapp/dkg-spec/crypto/bls"
+
 	"github.com/attestantio/go-eth2-client/spec/phase0"
-	"github.com/bloxapp/eth2-key-manager/core"
-	eth1deposit "github.com/bloxapp/eth2-key-manager/eth1_deposit"
-	types "github.com/wealdtech/go-eth2-types/v2"
 )
 
 const (
@@ -13,21 +15,21 @@ const (
 	ETH1WithdrawalPrefixByte              = byte(1)
 )
 
+// DomainDeposit is the deposit signature domain type
+var DomainDeposit = phase0.DomainType{0x03, 0x00, 0x00, 0x00}
+
 // GetNetworkByFork translates the network fork bytes into name
 //
 //	TODO: once eth2_key_manager implements this we can get rid of it and support all networks ekm supports automatically
-func GetNetworkByFork(fork [4]byte) (core.Network, error) {
-
-	switch fork {
-	case [4]byte{0x00, 0x00, 0x10, 0x20}:
-		return core.PraterNetwork, nil
-	case [4]byte{0x01, 0x01, 0x70, 0x00}:
-		return core.HoleskyNetwork, nil
-	case [4]byte{0, 0, 0, 0}:
-		return core.MainNetwork, nil
-	default:
-		return core.MainNetwork, fmt.Errorf("unknown network")
+func GetNetworkByFork(fork [4]byte) (Network, error) {
+	behavior, err := GetForkBehavior(fork)
+	if err != nil {
+		return MainNetwork, err
 	}
+	if behavior.Network == "" {
+		return MainNetwork, fmt.Errorf("fork %x has no known network", fork)
+	}
+	return behavior.Network, nil
 }
 
 func ETH1WithdrawalCredentials(withdrawalAddr []byte) []byte {
@@ -38,24 +40,35 @@ func ETH1WithdrawalCredentials(withdrawalAddr []byte) []byte {
 	return withdrawalCredentials
 }
 
-func ComputeDepositMessageSigningRoot(network core.Network, message *phase0.DepositMessage) (phase0.Root, error) {
-	if !eth1deposit.IsSupportedDepositNetwork(network) {
+func ComputeDepositMessageSigningRoot(network Network, message *phase0.DepositMessage) (phase0.Root, error) {
+	if !isSupportedDepositNetwork(network) {
 		return phase0.Root{}, fmt.Errorf("network %s is not supported", network)
 	}
+	return computeDepositMessageSigningRoot(network.GenesisForkVersion(), message)
+}
 
+// ComputeDepositMessageSigningRootForFork computes the deposit message signing root with fork's (possibly overridden) genesis fork version
+func ComputeDepositMessageSigningRootForFork(fork [4]byte, message *phase0.DepositMessage) (phase0.Root, error) {
+	behavior, err := GetForkBehavior(fork)
+	if err != nil {
+		return phase0.Root{}, err
+	}
+	return computeDepositMessageSigningRoot(behavior.GenesisForkVersion, message)
+}
+
+func computeDepositMessageSigningRoot(genesisForkVersion phase0.Version, message *phase0.DepositMessage) (phase0.Root, error) {
 	// Compute DepositMessage root.
 	depositMsgRoot, err := message.HashTreeRoot()
 	if err != nil {
 		return phase0.Root{}, fmt.Errorf("failed to determine the root hash of deposit data: %s", err)
 	}
-	genesisForkVersion := network.GenesisForkVersion()
-	domain, err := types.ComputeDomain(types.DomainDeposit, genesisForkVersion[:], types.ZeroGenesisValidatorsRoot)
+	domain, err := ComputeDomain(DomainDeposit, genesisForkVersion, phase0.Root{})
 	if err != nil {
 		return phase0.Root{}, fmt.Errorf("failed to calculate domain: %s", err)
 	}
 	container := &phase0.SigningData{
 		ObjectRoot: depositMsgRoot,
-		Domain:     phase0.Domain(domain),
+		Domain:     domain,
 	}
 	signingRoot, err := container.HashTreeRoot()
 	if err != nil {
@@ -65,7 +78,7 @@ func ComputeDepositMessageSigningRoot(network core.Network, message *phase0.Depo
 }
 
 // VerifyDepositData reconstructs and checks BLS signatures for ETH2 deposit message
-func VerifyDepositData(network core.Network, depositData *phase0.DepositData) error {
+func VerifyDepositData(network Network, depositData *phase0.DepositData) error {
 	signingRoot, err := ComputeDepositMessageSigningRoot(network, &phase0.DepositMessage{
 		PublicKey:             depositData.PublicKey,
 		Amount:                depositData.Amount,
@@ -74,22 +87,54 @@ func VerifyDepositData(network core.Network, depositData *phase0.DepositData) er
 	if err != nil {
 		return fmt.Errorf("failed to compute signing root: %s", err)
 	}
+	return verifyDepositSignature(signingRoot, depositData)
+}
 
-	// Verify the signature.
+// VerifyDepositDataForFork reconstructs and checks BLS signatures for ETH2 deposit message on fork
+func VerifyDepositDataForFork(fork [4]byte, depositData *phase0.DepositData) error {
+	signingRoot, err := ComputeDepositMessageSigningRootForFork(fork, &phase0.DepositMessage{
+		PublicKey:             depositData.PublicKey,
+		Amount:                depositData.Amount,
+		WithdrawalCredentials: depositData.WithdrawalCredentials,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to compute signing root: %s", err)
+	}
+	return verifyDepositSignature(signingRoot, depositData)
+}
+
+// ComputeDomain returns the signature domain of domainType for forkVersion and genesisValidatorsRoot
+func ComputeDomain(domainType phase0.DomainType, forkVersion phase0.Version, genesisValidatorsRoot phase0.Root) (phase0.Domain, error) {
+	forkDataRoot, err := (&phase0.ForkData{
+		CurrentVersion:        forkVersion,
+		GenesisValidatorsRoot: genesisValidatorsRoot,
+	}).HashTreeRoot()
+	if err != nil {
+		return phase0.Domain{}, err
+	}
+	var ret phase0.Domain
+	copy(ret[:4], domainType[:])
+	copy(ret[4:], forkDataRoot[:28])
+	return ret, nil
+}
+
+func verifyDepositSignature(signingRoot phase0.Root, depositData *phase0.DepositData) error {
+	InitBLS()
+	// copies keep cgo from receiving pointers into depositData, which holds Go pointers
 	pkCopy := make([]byte, len(depositData.PublicKey))
 	copy(pkCopy, depositData.PublicKey[:])
-	pubkey, err := types.BLSPublicKeyFromBytes(pkCopy)
-	if err != nil {
+	pubkey := &bls.PublicKey{}
+	if err := pubkey.Deserialize(pkCopy); err != nil {
 		return fmt.Errorf("failed to parse public key: %s", err)
 	}
 
 	sigCpy := make([]byte, len(depositData.Signature))
 	copy(sigCpy, depositData.Signature[:])
-	sig, err := types.BLSSignatureFromBytes(sigCpy)
-	if err != nil {
+	sig := &bls.Sign{}
+	if err := sig.Deserialize(sigCpy); err != nil {
 		return fmt.Errorf("failed to parse signature: %s", err)
 	}
-	if !sig.Verify(signingRoot[:], pubkey) {
+	if !sig.VerifyByte(pubkey, signingRoot[:]) {
 		return fmt.Errorf("invalid signature")
 	}
 	return nil
@@ -101,12 +146,22 @@ func DepositDataRootForFork(
 	withdrawalCredentials []byte,
 	amount phase0.Gwei,
 ) (phase0.Root, error) {
-	network, err := GetNetworkByFork(fork)
+	maxAmount, err := MaxDepositAmount(fork, withdrawalCredentials)
+	if err != nil {
+		return phase0.Root{}, err
+	}
+	if amount > maxAmount {
+		return phase0.Root{}, fmt.Errorf("deposit amount exceeds max effective balance")
+	}
+	if len(validatorPK) != len(phase0.BLSPubKey{}) {
+		return phase0.Root{}, fmt.Errorf("invalid validator public key length")
+	}
+	depositCredentials, err := DepositWithdrawalCredentials(fork, withdrawalCredentials)
 	if err != nil {
 		return phase0.Root{}, err
 	}
-	return ComputeDepositMessageSigningRoot(network, &phase0.DepositMessage{
+	return ComputeDepositMessageSigningRootForFork(fork, &phase0.DepositMessage{
 		PublicKey:             phase0.BLSPubKey(validatorPK),
 		Amount:                amount,
-		WithdrawalCredentials: ETH1WithdrawalCredentials(withdrawalCredentials)})
+		WithdrawalCredentials: depositCredentials})
 }
@@ -1,12 +1,15 @@
+//go:build !verifyonly
+
 package crypto
 
 import (
+	"testing"
+
+	"github.com/bloxapp/dkg-spec/crypto/bls"
+
 	"github.com/attestantio/go-eth2-client/spec/phase0"
-	"github.com/bloxapp/eth2-key-manager/core"
 	"github.com/ethereum/go-ethereum/common"
-	"github.com/herumi/bls-eth-go-binary/bls"
 	"github.com/stretchr/testify/require"
-	"testing"
 )
 
 func TestETH1WithdrawalCredentials(t *testing.T) {
@@ -23,7 +26,7 @@ func TestETH1WithdrawalCredentials(t *testing.T) {
 
 func TestComputeDepositMessageSigningRoot(t *testing.T) {
 	t.Run("mainnet", func(t *testing.T) {
-		r, err := ComputeDepositMessageSigningRoot(core.MainNetwork, &phase0.DepositMessage{
+		r, err := ComputeDepositMessageSigningRoot(MainNetwork, &phase0.DepositMessage{
 			PublicKey:             phase0.BLSPubKey([]byte{1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1}),
 			WithdrawalCredentials: ETH1WithdrawalCredentials([]byte{1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 19, 20}),
 			Amount:                32000000000,
@@ -33,7 +36,7 @@ func TestComputeDepositMessageSigningRoot(t *testing.T) {
 	})
 
 	t.Run("holesky", func(t *testing.T) {
-		r, err := ComputeDepositMessageSigningRoot(core.HoleskyNetwork, &phase0.DepositMessage{
+		r, err := ComputeDepositMessageSigningRoot(HoleskyNetwork, &phase0.DepositMessage{
 			PublicKey:             phase0.BLSPubKey([]byte{1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1}),
 			WithdrawalCredentials: ETH1WithdrawalCredentials([]byte{1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 19, 20}),
 			Amount:                32000000000,
@@ -76,7 +79,7 @@ func TestVerifyDepositData(t *testing.T) {
 		pk := phase0.BLSPubKey{}
 		copy(pk[:], sk.GetPublicKey().Serialize())
 
-		r, err := ComputeDepositMessageSigningRoot(core.MainNetwork, &phase0.DepositMessage{
+		r, err := ComputeDepositMessageSigningRoot(MainNetwork, &phase0.DepositMessage{
 			PublicKey:             pk,
 			WithdrawalCredentials: []byte{1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 19, 20},
 			Amount:                32000000000,
@@ -93,7 +96,7 @@ func TestVerifyDepositData(t *testing.T) {
 			Signature:             sig,
 		}
 
-		require.NoError(t, VerifyDepositData(core.MainNetwork, depositData))
+		require.NoError(t, VerifyDepositData(MainNetwork, depositData))
 	})
 
 	t.Run("holesky", func(t *testing.T) {
@@ -104,7 +107,7 @@ func TestVerifyDepositData(t *testing.T) {
 		pk := phase0.BLSPubKey{}
 		copy(pk[:], sk.GetPublicKey().Serialize())
 
-		r, err := ComputeDepositMessageSigningRoot(core.HoleskyNetwork, &phase0.DepositMessage{
+		r, err := ComputeDepositMessageSigningRoot(HoleskyNetwork, &phase0.DepositMessage{
 			PublicKey:             pk,
 			WithdrawalCredentials: []byte{1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 19, 20},
 			Amount:                32000000000,
@@ -121,6 +124,6 @@ func TestVerifyDepositData(t *testing.T) {
 			Signature:             sig,
 		}
 
-		require.NoError(t, VerifyDepositData(core.HoleskyNetwork, depositData))
+		require.NoError(t, VerifyDepositData(HoleskyNetwork, depositData))
 	})
 }
@@ -0,0 +1,144 @@
+package bls
+
+import (
+	"encoding/hex"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSignVerify(t *testing.T) {
+	require.NoError(t, Init())
+
+	// same vector for every backend
+	sk := &SecretKey{}
+	require.NoError(t, sk.SetHexString("263dbd792f5b1be47ed85f8938c0f29586af0d3ac7b977f21c278fe1462040e3"))
+	pk := sk.GetPublicKey()
+	sig := sk.SignByte([]byte("dkg-spec"))
+	require.EqualValues(t, "a491d1b0ecd9bb917989f0e74f0dea0422eac4a873e5e2644f368dffb9a6e20fd6e10c1b77654d067c0618f6e5a7f79a", hex.EncodeToString(pk.Serialize()))
+	require.EqualValues(t, "974d974d3e58e38adfa6959a23d543e23b97d36757e38cf0b42a24a3ed0ec8693410b545de5d4769615b768cf485d8440738603561aeedd41a4d2cd38641a564f53620d03cc4e71885256e328fcb0341818f7038b238a75d9440432ca3911eba", hex.EncodeToString(sig.Serialize()))
+
+	t.Run("valid", func(t *testing.T) {
+		pk2 := &PublicKey{}
+		require.NoError(t, pk2.Deserialize(pk.Serialize()))
+		sig2 := &Sign{}
+		require.NoError(t, sig2.Deserialize(sig.Serialize()))
+		require.True(t, sig2.VerifyByte(pk2, []byte("dkg-spec")))
+	})
+
+	t.Run("wrong message", func(t *testing.T) {
+		require.False(t, sig.VerifyByte(pk, []byte("dkg-spec2")))
+	})
+
+	t.Run("invalid encoding", func(t *testing.T) {
+		require.Error(t, (&PublicKey{}).Deserialize(make([]byte, 47)))
+		require.Error(t, (&Sign{}).Deserialize(pk.Serialize()))
+	})
+}
+
+func TestRecover(t *testing.T) {
+	require.NoError(t, Init())
+
+	msk := make([]SecretKey, 3)
+	for i := range msk {
+		msk[i].SetByCSPRNG()
+	}
+	msg := []byte("dkg-spec")
+
+	ids := make([]ID, 0)
+	pks := make([]PublicKey, 0)
+	sigs := make([]Sign, 0)
+	for _, index := range []int{1, 3, 4} {
+		id := ID{}
+		require.NoError(t, id.SetDecString(fmt.Sprintf("%d", index)))
+		share := SecretKey{}
+		require.NoError(t, share.Set(msk, &id))
+		ids = append(ids, id)
+		pks = append(pks, *share.GetPublicKey())
+		sigs = append(sigs, *share.SignByte(msg))
+	}
+
+	pk := &PublicKey{}
+	require.NoError(t, pk.Recover(pks, ids))
+	require.EqualValues(t, msk[0].GetPublicKey().Serialize(), pk.Serialize())
+
+	sig := &Sign{}
+	require.NoError(t, sig.Recover(sigs, ids))
+	require.EqualValues(t, msk[0].SignByte(msg).Serialize(), sig.Serialize())
+	require.True(t, sig.VerifyByte(pk, msg))
+
+	shares := make([]SecretKey, len(ids))
+	for i := range ids {
+		require.NoError(t, shares[i].Set(msk, &ids[i]))
+	}
+	sk := &SecretKey{}
+	require.NoError(t, sk.Recover(shares, ids))
+	require.True(t, sk.IsEqual(&msk[0]))
+
+	mpk := make([]PublicKey, len(msk))
+	for i := range msk {
+		mpk[i] = *msk[i].GetPublicKey()
+	}
+	for i := range ids {
+		sharePK := &PublicKey{}
+		require.NoError(t, sharePK.Set(mpk, &ids[i]))
+		require.True(t, sharePK.IsEqual(&pks[i]))
+	}
+
+	sum := &SecretKey{}
+	require.NoError(t, sum.Deserialize(msk[0].Serialize()))
+	sum.Add(&msk[1])
+	sumPK := msk[0].GetPublicKey()
+	sumPK.Add(msk[1].GetPublicKey())
+	require.True(t, sum.GetPublicKey().IsEqual(sumPK))
+}
+
+func TestMultiVerify(t *testing.T) {
+	require.NoError(t, Init())
+
+	sigs := make([]Sign, 0)
+	pks := make([]PublicKey, 0)
+	msgs := make([][]byte, 0)
+	for i := 0; i < 6; i++ {
+		sk := SecretKey{}
+		sk.SetByCSPRNG()
+		// pairs of signatures over the same message
+		msg := make([]byte, 32)
+		msg[0] = byte(i / 2)
+		sigs = append(sigs, *sk.SignByte(msg))
+		pks = append(pks, *sk.GetPublicKey())
+		msgs = append(msgs, msg)
+	}
+
+	t.Run("valid", func(t *testing.T) {
+		require.True(t, MultiVerify(sigs, pks, msgs))
+	})
+
+	t.Run("other length messages", func(t *testing.T) {
+		sk := SecretKey{}
+		sk.SetByCSPRNG()
+		require.True(t, MultiVerify(
+			append(append([]Sign{}, sigs...), *sk.SignByte([]byte("dkg-spec"))),
+			append(append([]PublicKey{}, pks...), *sk.GetPublicKey()),
+			append(append([][]byte{}, msgs...), []byte("dkg-spec")),
+		))
+	})
+
+	t.Run("swapped signatures", func(t *testing.T) {
+		swapped := append([]Sign{}, sigs...)
+		swapped[0], swapped[1] = swapped[1], swapped[0]
+		require.False(t, MultiVerify(swapped, pks, msgs))
+	})
+
+	t.Run("wrong message", func(t *testing.T) {
+		wrong := append([][]byte{}, msgs...)
+		wrong[3] = make([]byte, 32)
+		require.False(t, MultiVerify(sigs, pks, wrong))
+	})
+
+	t.Run("inconsistent lengths", func(t *testing.T) {
+		require.False(t, MultiVerify(sigs, pks[1:], msgs))
+		require.False(t, MultiVerify(nil, nil, nil))
+	})
+}
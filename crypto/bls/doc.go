@@ -0,0 +1,7 @@
+// Package bls is the BLS12-381 backend used across the spec, exposing herumi's bls-eth-go-binary API.
+//
+// herumi (cgo) is used by default, building with the purebls tag switches to a pure Go implementation
+// (gnark-crypto) for environments where cgo is forbidden, at the cost of slower signing and verification:
+//
+//	CGO_ENABLED=0 go build -tags purebls ./...
+package bls
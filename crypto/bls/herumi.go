@@ -0,0 +1,47 @@
+//go:build !purebls
+
+package bls
+
+import (
+	herumi "github.com/herumi/bls-eth-go-binary/bls"
+)
+
+type (
+	SecretKey = herumi.SecretKey
+	PublicKey = herumi.PublicKey
+	Sign      = herumi.Sign
+	ID        = herumi.ID
+)
+
+// Init initializes herumi for BLS12-381 in ETH (draft 07) mode
+func Init() error {
+	if err := herumi.Init(herumi.BLS12_381); err != nil {
+		return err
+	}
+	return herumi.SetETHmode(herumi.EthModeDraft07)
+}
+
+// MultiVerify returns true if every sigs[i] is pubs[i]'s signature over msgs[i], checked at once with a random linear
+// combination of the signatures. herumi only batches 32 byte messages, others are verified one by one.
+func MultiVerify(sigs []Sign, pubs []PublicKey, msgs [][]byte) bool {
+	n := len(sigs)
+	if n == 0 || len(pubs) != n || len(msgs) != n {
+		return false
+	}
+	concatenated := make([]byte, 0, 32*n)
+	for i, msg := range msgs {
+		if pubs[i].IsZero() {
+			return false
+		}
+		if len(msg) != 32 {
+			for i := range sigs {
+				if !sigs[i].VerifyByte(&pubs[i], msgs[i]) {
+					return false
+				}
+			}
+			return true
+		}
+		concatenated = append(concatenated, msg...)
+	}
+	return herumi.MultiVerify(sigs, pubs, concatenated)
+}
@@ -0,0 +1,377 @@
+//go:build purebls
+
+package bls
+
+import (
+	"crypto/rand"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"math/big"
+
+	bls12381 "github.com/consensys/gnark-crypto/ecc/bls12-381"
+	"github.com/consensys/gnark-crypto/ecc/bls12-381/fr"
+)
+
+// dst is the ETH proof of possession ciphersuite, the one used by herumi's EthModeDraft07
+var dst = []byte("BLS_SIG_BLS12381G2_XMD:SHA-256_SSWU_RO_POP_")
+
+// Init is a no-op, kept for parity with the herumi backend
+func Init() error {
+	return nil
+}
+
+func setScalar(z *fr.Element, v *big.Int) error {
+	if v.Sign() < 0 || v.Cmp(fr.Modulus()) >= 0 {
+		return fmt.Errorf("scalar out of range")
+	}
+	z.SetBigInt(v)
+	return nil
+}
+
+func hashToG2(msg []byte) bls12381.G2Affine {
+	// can only fail for a DST longer than 255 bytes
+	ret, _ := bls12381.HashToG2(msg, dst)
+	return ret
+}
+
+// lagrangeCoefficients returns the lagrange basis polynomials of ids evaluated at 0
+func lagrangeCoefficients(ids []ID) ([]fr.Element, error) {
+	if len(ids) == 0 {
+		return nil, fmt.Errorf("no ids")
+	}
+	ret := make([]fr.Element, len(ids))
+	for i := range ids {
+		num, den := fr.One(), fr.One()
+		for j := range ids {
+			if i == j {
+				continue
+			}
+			var diff fr.Element
+			diff.Sub(&ids[j].v, &ids[i].v)
+			if diff.IsZero() {
+				return nil, fmt.Errorf("duplicate ids")
+			}
+			num.Mul(&num, &ids[j].v)
+			den.Mul(&den, &diff)
+		}
+		ret[i].Div(&num, &den)
+	}
+	return ret, nil
+}
+
+// ID is a share's (non zero) evaluation point
+type ID struct {
+	v fr.Element
+}
+
+func (id *ID) SetDecString(s string) error {
+	v, ok := new(big.Int).SetString(s, 10)
+	if !ok {
+		return fmt.Errorf("invalid id %s", s)
+	}
+	return setScalar(&id.v, v)
+}
+
+type SecretKey struct {
+	v fr.Element
+}
+
+func (sk *SecretKey) bigInt() *big.Int {
+	return sk.v.BigInt(new(big.Int))
+}
+
+func (sk *SecretKey) SetByCSPRNG() {
+	// crypto/rand failures are unrecoverable, as with herumi
+	if _, err := sk.v.SetRandom(); err != nil {
+		panic(err)
+	}
+}
+
+func (sk *SecretKey) SetHexString(s string) error {
+	v, ok := new(big.Int).SetString(s, 16)
+	if !ok {
+		return fmt.Errorf("invalid hex string")
+	}
+	return setScalar(&sk.v, v)
+}
+
+func (sk *SecretKey) GetHexString() string {
+	return sk.v.Text(16)
+}
+
+// Serialize returns the 32 bytes big endian encoding
+func (sk *SecretKey) Serialize() []byte {
+	ret := sk.v.Bytes()
+	return ret[:]
+}
+
+func (sk *SecretKey) Deserialize(buf []byte) error {
+	if len(buf) != fr.Bytes {
+		return fmt.Errorf("invalid secret key length")
+	}
+	return sk.v.SetBytesCanonical(buf)
+}
+
+func (sk *SecretKey) SerializeToHexStr() string {
+	return hex.EncodeToString(sk.Serialize())
+}
+
+func (sk *SecretKey) DeserializeHexStr(s string) error {
+	buf, err := hex.DecodeString(s)
+	if err != nil {
+		return err
+	}
+	return sk.Deserialize(buf)
+}
+
+func (sk *SecretKey) IsEqual(rhs *SecretKey) bool {
+	return sk.v.Equal(&rhs.v)
+}
+
+// Set sets sk to the evaluation of the msk polynomial (msk[0] being the master key) at id
+func (sk *SecretKey) Set(msk []SecretKey, id *ID) error {
+	if len(msk) == 0 {
+		return fmt.Errorf("empty master secret key")
+	}
+	ret := msk[len(msk)-1].v
+	for i := len(msk) - 2; i >= 0; i-- {
+		ret.Mul(&ret, &id.v)
+		ret.Add(&ret, &msk[i].v)
+	}
+	sk.v = ret
+	return nil
+}
+
+func (sk *SecretKey) Add(rhs *SecretKey) {
+	sk.v.Add(&sk.v, &rhs.v)
+}
+
+// Recover sets sk to the master secret key interpolated from shares and their ids
+func (sk *SecretKey) Recover(shares []SecretKey, ids []ID) error {
+	if len(shares) != len(ids) {
+		return fmt.Errorf("inconsistent ids len")
+	}
+	coefficients, err := lagrangeCoefficients(ids)
+	if err != nil {
+		return err
+	}
+	var acc fr.Element
+	for i := range shares {
+		var term fr.Element
+		term.Mul(&shares[i].v, &coefficients[i])
+		acc.Add(&acc, &term)
+	}
+	sk.v = acc
+	return nil
+}
+
+func (sk *SecretKey) GetPublicKey() *PublicKey {
+	ret := &PublicKey{}
+	ret.p.ScalarMultiplicationBase(sk.bigInt())
+	return ret
+}
+
+func (sk *SecretKey) SignByte(msg []byte) *Sign {
+	h := hashToG2(msg)
+	ret := &Sign{}
+	ret.p.ScalarMultiplication(&h, sk.bigInt())
+	return ret
+}
+
+type PublicKey struct {
+	p bls12381.G1Affine
+}
+
+// Serialize returns the 48 bytes compressed encoding
+func (pk *PublicKey) Serialize() []byte {
+	ret := pk.p.Bytes()
+	return ret[:]
+}
+
+func (pk *PublicKey) Deserialize(buf []byte) error {
+	if len(buf) != bls12381.SizeOfG1AffineCompressed {
+		return fmt.Errorf("invalid public key length")
+	}
+	_, err := pk.p.SetBytes(buf)
+	return err
+}
+
+func (pk *PublicKey) SerializeToHexStr() string {
+	return hex.EncodeToString(pk.Serialize())
+}
+
+func (pk *PublicKey) DeserializeHexStr(s string) error {
+	buf, err := hex.DecodeString(s)
+	if err != nil {
+		return err
+	}
+	return pk.Deserialize(buf)
+}
+
+func (pk *PublicKey) IsEqual(rhs *PublicKey) bool {
+	return pk.p.Equal(&rhs.p)
+}
+
+func (pk *PublicKey) Add(rhs *PublicKey) {
+	pk.p.Add(&pk.p, &rhs.p)
+}
+
+// Set sets pk to the evaluation of the mpk polynomial (mpk[0] being the master public key) at id
+func (pk *PublicKey) Set(mpk []PublicKey, id *ID) error {
+	if len(mpk) == 0 {
+		return fmt.Errorf("empty master public key")
+	}
+	idInt := id.v.BigInt(new(big.Int))
+	var acc bls12381.G1Jac
+	acc.FromAffine(&mpk[len(mpk)-1].p)
+	for i := len(mpk) - 2; i >= 0; i-- {
+		acc.ScalarMultiplication(&acc, idInt)
+		acc.AddMixed(&mpk[i].p)
+	}
+	pk.p.FromJacobian(&acc)
+	return nil
+}
+
+// Recover sets pk to the master public key interpolated from share public keys and their ids
+func (pk *PublicKey) Recover(pks []PublicKey, ids []ID) error {
+	if len(pks) != len(ids) {
+		return fmt.Errorf("inconsistent ids len")
+	}
+	coefficients, err := lagrangeCoefficients(ids)
+	if err != nil {
+		return err
+	}
+	var acc bls12381.G1Jac
+	for i := range pks {
+		var term bls12381.G1Affine
+		term.ScalarMultiplication(&pks[i].p, coefficients[i].BigInt(new(big.Int)))
+		acc.AddMixed(&term)
+	}
+	pk.p.FromJacobian(&acc)
+	return nil
+}
+
+type Sign struct {
+	p bls12381.G2Affine
+}
+
+// Serialize returns the 96 bytes compressed encoding
+func (sig *Sign) Serialize() []byte {
+	ret := sig.p.Bytes()
+	return ret[:]
+}
+
+func (sig *Sign) Deserialize(buf []byte) error {
+	if len(buf) != bls12381.SizeOfG2AffineCompressed {
+		return fmt.Errorf("invalid signature length")
+	}
+	_, err := sig.p.SetBytes(buf)
+	return err
+}
+
+func (sig *Sign) SerializeToHexStr() string {
+	return hex.EncodeToString(sig.Serialize())
+}
+
+func (sig *Sign) DeserializeHexStr(s string) error {
+	buf, err := hex.DecodeString(s)
+	if err != nil {
+		return err
+	}
+	return sig.Deserialize(buf)
+}
+
+func (sig *Sign) IsEqual(rhs *Sign) bool {
+	return sig.p.Equal(&rhs.p)
+}
+
+func (sig *Sign) Add(rhs *Sign) {
+	sig.p.Add(&sig.p, &rhs.p)
+}
+
+// VerifyByte returns true if sig is pk's signature over msg
+func (sig *Sign) VerifyByte(pk *PublicKey, msg []byte) bool {
+	if pk == nil || pk.p.IsInfinity() {
+		return false
+	}
+	_, _, g1, _ := bls12381.Generators()
+	var negG1 bls12381.G1Affine
+	negG1.Neg(&g1)
+	ok, err := bls12381.PairingCheck(
+		[]bls12381.G1Affine{negG1, pk.p},
+		[]bls12381.G2Affine{sig.p, hashToG2(msg)},
+	)
+	return err == nil && ok
+}
+
+// Recover sets sig to the master signature interpolated from partial signatures and their ids
+func (sig *Sign) Recover(sigs []Sign, ids []ID) error {
+	if len(sigs) != len(ids) {
+		return fmt.Errorf("inconsistent ids len")
+	}
+	coefficients, err := lagrangeCoefficients(ids)
+	if err != nil {
+		return err
+	}
+	var acc bls12381.G2Jac
+	for i := range sigs {
+		var term bls12381.G2Affine
+		term.ScalarMultiplication(&sigs[i].p, coefficients[i].BigInt(new(big.Int)))
+		acc.AddMixed(&term)
+	}
+	sig.p.FromJacobian(&acc)
+	return nil
+}
+
+// MultiVerify returns true if every sigs[i] is pubs[i]'s signature over msgs[i], checked at once with a random linear
+// combination: e(G1, Σ r_i·sigs[i]) = Π_m e(Σ_{msgs[i] = m} r_i·pubs[i], H(m)) for random 64 bit r_i. Signatures over
+// the same message share a pairing, so partial signatures of one root cost two pairings however many there are.
+func MultiVerify(sigs []Sign, pubs []PublicKey, msgs [][]byte) bool {
+	n := len(sigs)
+	if n == 0 || len(pubs) != n || len(msgs) != n {
+		return false
+	}
+	randoms := make([]byte, 8*n)
+	if _, err := rand.Read(randoms); err != nil {
+		return false
+	}
+
+	var aggSig bls12381.G2Jac
+	pubsByMsg := make(map[string]*bls12381.G1Jac)
+	var order []string
+	for i := range sigs {
+		if pubs[i].p.IsInfinity() {
+			return false
+		}
+		// odd, hence non zero
+		r := new(big.Int).SetUint64(binary.LittleEndian.Uint64(randoms[8*i:]) | 1)
+		var sig bls12381.G2Affine
+		sig.ScalarMultiplication(&sigs[i].p, r)
+		aggSig.AddMixed(&sig)
+
+		var pk bls12381.G1Affine
+		pk.ScalarMultiplication(&pubs[i].p, r)
+		acc, found := pubsByMsg[string(msgs[i])]
+		if !found {
+			acc = &bls12381.G1Jac{}
+			pubsByMsg[string(msgs[i])] = acc
+			order = append(order, string(msgs[i]))
+		}
+		acc.AddMixed(&pk)
+	}
+
+	_, _, g1, _ := bls12381.Generators()
+	p := make([]bls12381.G1Affine, 1, len(order)+1)
+	q := make([]bls12381.G2Affine, 1, len(order)+1)
+	p[0].Neg(&g1)
+	q[0].FromJacobian(&aggSig)
+	for _, msg := range order {
+		var pk bls12381.G1Affine
+		pk.FromJacobian(pubsByMsg[msg])
+		p = append(p, pk)
+		q = append(q, hashToG2([]byte(msg)))
+	}
+	ok, err := bls12381.PairingCheck(p, q)
+	return err == nil && ok
+}
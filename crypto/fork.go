@@ -0,0 +1,139 @@
+//go:build !verifyonly
+
+package crypto
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+const (
+	// MaxEffectiveBalanceElectraInGwei is the max effective balance of validators with compounding withdrawal credentials (EIP-7251)
+	MaxEffectiveBalanceElectraInGwei phase0.Gwei = 2048000000000
+	CompoundingWithdrawalPrefixByte              = byte(2)
+)
+
+// ForkBehavior is the deposit behavior of a fork version
+type ForkBehavior struct {
+	Name string
+	// Network the fork belongs to, empty for unknown networks (e.g. shadow forks)
+	Network Network
+	// GenesisForkVersion of the network, deposits are always signed with the genesis fork version domain
+	GenesisForkVersion phase0.Version
+	// GenesisValidatorsRoot of the network
+	GenesisValidatorsRoot phase0.Root
+	// Compounding is true if compounding (0x02) withdrawal credentials are accepted
+	Compounding bool
+	// MaxEffectiveBalance is the max deposit amount for compounding withdrawal credentials
+	MaxEffectiveBalance phase0.Gwei
+}
+
+func networkForkBehavior(name string, network Network, electra bool) *ForkBehavior {
+	ret := &ForkBehavior{
+		Name:                  name,
+		Network:               network,
+		GenesisForkVersion:    network.GenesisForkVersion(),
+		GenesisValidatorsRoot: network.GenesisValidatorsRoot(),
+		MaxEffectiveBalance:   MaxEffectiveBalanceInGwei,
+	}
+	if electra {
+		ret.Compounding = true
+		ret.MaxEffectiveBalance = MaxEffectiveBalanceElectraInGwei
+	}
+	return ret
+}
+
+func defaultForkBehaviors() map[[4]byte]*ForkBehavior {
+	return map[[4]byte]*ForkBehavior{
+		{0x00, 0x00, 0x00, 0x00}: networkForkBehavior("mainnet phase0", MainNetwork, false),
+		{0x05, 0x00, 0x00, 0x00}: networkForkBehavior("mainnet electra", MainNetwork, true),
+		{0x00, 0x00, 0x10, 0x20}: networkForkBehavior("prater phase0", PraterNetwork, false),
+		{0x01, 0x01, 0x70, 0x00}: networkForkBehavior("holesky phase0", HoleskyNetwork, false),
+		{0x06, 0x01, 0x70, 0x00}: networkForkBehavior("holesky electra", HoleskyNetwork, true),
+	}
+}
+
+var (
+	forksMtx      sync.RWMutex
+	forkBehaviors = defaultForkBehaviors()
+)
+
+// GetForkBehavior returns the deposit behavior of fork
+func GetForkBehavior(fork [4]byte) (*ForkBehavior, error) {
+	forksMtx.RLock()
+	defer forksMtx.RUnlock()
+	behavior, found := forkBehaviors[fork]
+	if !found {
+		return nil, fmt.Errorf("unknown network")
+	}
+	ret := *behavior
+	return &ret, nil
+}
+
+// Forks returns a copy of the fork behaviors table
+func Forks() map[[4]byte]ForkBehavior {
+	forksMtx.RLock()
+	defer forksMtx.RUnlock()
+	ret := make(map[[4]byte]ForkBehavior, len(forkBehaviors))
+	for fork, behavior := range forkBehaviors {
+		ret[fork] = *behavior
+	}
+	return ret
+}
+
+// RegisterFork adds or overrides fork's behavior, e.g. for shadow forks and long-lived testnets
+func RegisterFork(fork [4]byte, behavior ForkBehavior) error {
+	if behavior.MaxEffectiveBalance < MaxEffectiveBalanceInGwei {
+		return fmt.Errorf("max effective balance below %d gwei", MaxEffectiveBalanceInGwei)
+	}
+	forksMtx.Lock()
+	defer forksMtx.Unlock()
+	forkBehaviors[fork] = &behavior
+	return nil
+}
+
+// ResetForks restores the built in fork behaviors table
+func ResetForks() {
+	forksMtx.Lock()
+	defer forksMtx.Unlock()
+	forkBehaviors = defaultForkBehaviors()
+}
+
+func CompoundingWithdrawalCredentials(withdrawalAddr []byte) []byte {
+	ret := ETH1WithdrawalCredentials(withdrawalAddr)
+	ret[0] = CompoundingWithdrawalPrefixByte
+	return ret
+}
+
+// DepositWithdrawalCredentials returns the withdrawal credentials deposited for withdrawalCredentials on fork.
+// Compounding credentials are kept as is on forks accepting them, anything else is treated as an ETH1 withdrawal address.
+func DepositWithdrawalCredentials(fork [4]byte, withdrawalCredentials []byte) ([]byte, error) {
+	behavior, err := GetForkBehavior(fork)
+	if err != nil {
+		return nil, err
+	}
+	if isCompounding(behavior, withdrawalCredentials) {
+		return withdrawalCredentials, nil
+	}
+	return ETH1WithdrawalCredentials(withdrawalCredentials), nil
+}
+
+// MaxDepositAmount returns the max deposit amount for withdrawalCredentials on fork
+func MaxDepositAmount(fork [4]byte, withdrawalCredentials []byte) (phase0.Gwei, error) {
+	behavior, err := GetForkBehavior(fork)
+	if err != nil {
+		return 0, err
+	}
+	if isCompounding(behavior, withdrawalCredentials) {
+		return behavior.MaxEffectiveBalance, nil
+	}
+	return MaxEffectiveBalanceInGwei, nil
+}
+
+func isCompounding(behavior *ForkBehavior, withdrawalCredentials []byte) bool {
+	return behavior.Compounding &&
+		len(withdrawalCredentials) == 32 &&
+		withdrawalCredentials[0] == CompoundingWithdrawalPrefixByte
+}
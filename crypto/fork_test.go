@@ -0,0 +1,155 @@
+//go:build !verifyonly
+
+package crypto
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDepositDomain(t *testing.T) {
+	// DOMAIN_DEPOSIT is computed with the genesis fork version for every fork, including Electra
+	for _, fork := range [][4]byte{{0, 0, 0, 0}, {0x05, 0, 0, 0}} {
+		network, err := GetNetworkByFork(fork)
+		require.NoError(t, err)
+		genesisForkVersion := network.GenesisForkVersion()
+		domain, err := ComputeDomain(DomainDeposit, genesisForkVersion, phase0.Root{})
+		require.NoError(t, err)
+		require.EqualValues(t, "03000000f5a5fd42d16a20302798ef6ed309979b43003d2320d9f0e8ea9831a9", hex.EncodeToString(domain[:]))
+	}
+}
+
+func TestForkBehavior(t *testing.T) {
+	t.Run("electra maps to its network", func(t *testing.T) {
+		network, err := GetNetworkByFork([4]byte{0x05, 0x00, 0x00, 0x00})
+		require.NoError(t, err)
+		require.EqualValues(t, MainNetwork, network)
+		network, err = GetNetworkByFork([4]byte{0x06, 0x01, 0x70, 0x00})
+		require.NoError(t, err)
+		require.EqualValues(t, HoleskyNetwork, network)
+		// holesky deneb isn't electra
+		_, err = GetForkBehavior([4]byte{0x05, 0x01, 0x70, 0x00})
+		require.EqualError(t, err, "unknown network")
+	})
+
+	t.Run("unknown", func(t *testing.T) {
+		_, err := GetForkBehavior([4]byte{9, 9, 9, 9})
+		require.EqualError(t, err, "unknown network")
+	})
+
+	address := []byte{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 19, 20}
+	compounding := CompoundingWithdrawalCredentials(address)
+	require.EqualValues(t, []byte{2, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 19, 20}, compounding)
+
+	t.Run("compounding credentials", func(t *testing.T) {
+		credentials, err := DepositWithdrawalCredentials([4]byte{0x05, 0, 0, 0}, compounding)
+		require.NoError(t, err)
+		require.EqualValues(t, compounding, credentials)
+
+		// pre-Electra the credentials are treated as an ETH1 withdrawal address
+		credentials, err = DepositWithdrawalCredentials([4]byte{0, 0, 0, 0}, compounding)
+		require.NoError(t, err)
+		require.EqualValues(t, ETH1WithdrawalCredentials(compounding), credentials)
+
+		credentials, err = DepositWithdrawalCredentials([4]byte{0x05, 0, 0, 0}, address)
+		require.NoError(t, err)
+		require.EqualValues(t, ETH1WithdrawalCredentials(address), credentials)
+	})
+
+	t.Run("max deposit amount", func(t *testing.T) {
+		amount, err := MaxDepositAmount([4]byte{0x05, 0, 0, 0}, compounding)
+		require.NoError(t, err)
+		require.EqualValues(t, MaxEffectiveBalanceElectraInGwei, amount)
+		amount, err = MaxDepositAmount([4]byte{0x05, 0, 0, 0}, address)
+		require.NoError(t, err)
+		require.EqualValues(t, MaxEffectiveBalanceInGwei, amount)
+		amount, err = MaxDepositAmount([4]byte{0, 0, 0, 0}, compounding)
+		require.NoError(t, err)
+		require.EqualValues(t, MaxEffectiveBalanceInGwei, amount)
+	})
+}
+
+func TestDepositDataRootForElectra(t *testing.T) {
+	pk := []byte{1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1}
+	address := []byte{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 19, 20}
+
+	t.Run("same root as phase0 for ETH1 credentials", func(t *testing.T) {
+		phase0Root, err := DepositDataRootForFork([4]byte{0, 0, 0, 0}, pk, address, MaxEffectiveBalanceInGwei)
+		require.NoError(t, err)
+		electraRoot, err := DepositDataRootForFork([4]byte{0x05, 0, 0, 0}, pk, address, MaxEffectiveBalanceInGwei)
+		require.NoError(t, err)
+		require.EqualValues(t, phase0Root, electraRoot)
+	})
+
+	t.Run("compounding", func(t *testing.T) {
+		compounding := CompoundingWithdrawalCredentials(address)
+		root, err := DepositDataRootForFork([4]byte{0x05, 0, 0, 0}, pk, compounding, MaxEffectiveBalanceElectraInGwei)
+		require.NoError(t, err)
+		expected, err := ComputeDepositMessageSigningRoot(MainNetwork, &phase0.DepositMessage{
+			PublicKey:             phase0.BLSPubKey(pk),
+			WithdrawalCredentials: compounding,
+			Amount:                MaxEffectiveBalanceElectraInGwei,
+		})
+		require.NoError(t, err)
+		require.EqualValues(t, expected, root)
+	})
+
+	t.Run("amount above max effective balance", func(t *testing.T) {
+		_, err := DepositDataRootForFork([4]byte{0x05, 0, 0, 0}, pk, address, MaxEffectiveBalanceInGwei+1)
+		require.EqualError(t, err, "deposit amount exceeds max effective balance")
+		_, err = DepositDataRootForFork([4]byte{0, 0, 0, 0}, pk, CompoundingWithdrawalCredentials(address), MaxEffectiveBalanceElectraInGwei)
+		require.EqualError(t, err, "deposit amount exceeds max effective balance")
+	})
+}
+
+func TestRegisterFork(t *testing.T) {
+	defer ResetForks()
+	shadowFork := [4]byte{0x10, 0x00, 0x00, 0x38}
+	pk := []byte{1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1}
+	address := []byte{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 19, 20}
+
+	_, err := DepositDataRootForFork(shadowFork, pk, address, MaxEffectiveBalanceInGwei)
+	require.EqualError(t, err, "unknown network")
+
+	require.EqualError(t, RegisterFork(shadowFork, ForkBehavior{Name: "shadow"}), "max effective balance below 32000000000 gwei")
+	require.NoError(t, RegisterFork(shadowFork, ForkBehavior{
+		Name:                  "shadow",
+		GenesisForkVersion:    phase0.Version{0x10, 0x00, 0x00, 0x38},
+		GenesisValidatorsRoot: phase0.Root{1},
+		MaxEffectiveBalance:   MaxEffectiveBalanceInGwei,
+	}))
+	require.Contains(t, Forks(), shadowFork)
+
+	root, err := DepositDataRootForFork(shadowFork, pk, address, MaxEffectiveBalanceInGwei)
+	require.NoError(t, err)
+	expected, err := computeDepositMessageSigningRoot(phase0.Version{0x10, 0x00, 0x00, 0x38}, &phase0.DepositMessage{
+		PublicKey:             phase0.BLSPubKey(pk),
+		WithdrawalCredentials: ETH1WithdrawalCredentials(address),
+		Amount:                MaxEffectiveBalanceInGwei,
+	})
+	require.NoError(t, err)
+	require.EqualValues(t, expected, root)
+
+	_, err = GetNetworkByFork(shadowFork)
+	require.EqualError(t, err, "fork 10000038 has no known network")
+
+	// overriding a built in fork
+	require.NoError(t, RegisterFork([4]byte{0, 0, 0, 0}, ForkBehavior{
+		Name:                "mainnet capped",
+		Network:             MainNetwork,
+		GenesisForkVersion:  MainNetwork.GenesisForkVersion(),
+		MaxEffectiveBalance: MaxEffectiveBalanceInGwei,
+	}))
+	behavior, err := GetForkBehavior([4]byte{0, 0, 0, 0})
+	require.NoError(t, err)
+	require.EqualValues(t, "mainnet capped", behavior.Name)
+
+	ResetForks()
+	require.NotContains(t, Forks(), shadowFork)
+	behavior, err = GetForkBehavior([4]byte{0, 0, 0, 0})
+	require.NoError(t, err)
+	require.EqualValues(t, "mainnet phase0", behavior.Name)
+}
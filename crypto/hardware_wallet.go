@@ -0,0 +1,105 @@
+package crypto
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/accounts"
+)
+
+// Hardware wallets (Ledger, Trezor) don't sign raw hashes, owners sign a message's signing root as an EIP-191 personal message instead.
+// VerifySignedMessageByOwner accepts both raw and personal message EOA signatures.
+
+const (
+	// LedgerMaxChunkSize is the max data length of a Ledger Ethereum app APDU
+	LedgerMaxChunkSize = 150
+
+	ledgerCLA                 = 0xe0
+	ledgerInsSignPersonalMsg  = 0x08
+	ledgerP1FirstChunk        = 0x00
+	ledgerP1SubsequentChunk   = 0x80
+	ledgerMaxDerivationLength = 10
+)
+
+// OwnerMessage is a message the owner signs over its signing root, e.g. a reshare or resign under its domain
+type OwnerMessage interface {
+	SigningRoot() ([32]byte, error)
+}
+
+// OwnerSigningPayload returns the message a hardware wallet signs as a personal message for msg, i.e. its signing root
+// operators verify the owner's signature over. Each message of a bulk request is signed separately.
+func OwnerSigningPayload(msg OwnerMessage) ([]byte, error) {
+	root, err := msg.SigningRoot()
+	if err != nil {
+		return nil, err
+	}
+	return root[:], nil
+}
+
+// LedgerSignPersonalMessageAPDUs returns the APDUs signing message as a personal message with the key at path (e.g. parsed with accounts.ParseDerivationPath)
+// on the Ledger Ethereum app, in order. The first chunk carries the path and message length.
+func LedgerSignPersonalMessageAPDUs(path accounts.DerivationPath, message []byte) ([][]byte, error) {
+	if len(path) == 0 || len(path) > ledgerMaxDerivationLength {
+		return nil, fmt.Errorf("invalid derivation path length %d", len(path))
+	}
+	header := make([]byte, 0, 1+4*len(path)+4)
+	header = append(header, byte(len(path)))
+	for _, component := range path {
+		header = binary.BigEndian.AppendUint32(header, component)
+	}
+	header = binary.BigEndian.AppendUint32(header, uint32(len(message)))
+
+	var ret [][]byte
+	p1 := byte(ledgerP1FirstChunk)
+	for first := true; first || len(message) > 0; first = false {
+		data := []byte{}
+		if first {
+			data = append(data, header...)
+		}
+		n := LedgerMaxChunkSize - len(data)
+		if n > len(message) {
+			n = len(message)
+		}
+		data = append(data, message[:n]...)
+		message = message[n:]
+
+		apdu := []byte{ledgerCLA, ledgerInsSignPersonalMsg, p1, 0x00, byte(len(data))}
+		ret = append(ret, append(apdu, data...))
+		p1 = ledgerP1SubsequentChunk
+	}
+	return ret, nil
+}
+
+// ParseLedgerSignature returns the signature in a Ledger Ethereum app response (v, r and s) in the [R || S || V] format VerifySignedMessageByOwner expects
+func ParseLedgerSignature(response []byte) ([]byte, error) {
+	if len(response) < 65 {
+		return nil, fmt.Errorf("ledger response too short")
+	}
+	sig := make([]byte, 0, 65)
+	sig = append(sig, response[1:65]...)
+	sig = append(sig, response[0])
+	return NormalizeOwnerSignature(sig)
+}
+
+// NormalizeOwnerSignature returns a [R || S || V] signature with V as 0 or 1, as VerifySignedMessageByOwner expects.
+// Wallets (e.g. Trezor) return V as 27 or 28.
+func NormalizeOwnerSignature(sig []byte) ([]byte, error) {
+	if len(sig) != 65 {
+		return nil, fmt.Errorf("invalid signature length %d", len(sig))
+	}
+	ret := make([]byte, 65)
+	copy(ret, sig)
+	switch ret[64] {
+	case 0, 1:
+	case 27, 28:
+		ret[64] -= 27
+	default:
+		return nil, fmt.Errorf("invalid signature recovery id %d", ret[64])
+	}
+	return ret, nil
+}
+
+// personalMessageHash returns the EIP-191 personal message hash of hash, as signed by hardware wallets
+func personalMessageHash(hash [32]byte) []byte {
+	return accounts.TextHash(hash[:])
+}
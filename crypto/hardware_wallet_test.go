@@ -0,0 +1,58 @@
+package crypto
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHardwareWalletSigning(t *testing.T) {
+	t.Run("ledger APDUs", func(t *testing.T) {
+		path, err := accounts.ParseDerivationPath("m/44'/60'/0'/0/0")
+		require.NoError(t, err)
+
+		apdus, err := LedgerSignPersonalMessageAPDUs(path, make([]byte, 32))
+		require.NoError(t, err)
+		require.Len(t, apdus, 1)
+		require.EqualValues(t, []byte{0xe0, 0x08, 0x00, 0x00, 1 + 5*4 + 4 + 32, 5, 0x80, 0, 0, 44}, apdus[0][:10])
+		require.EqualValues(t, []byte{0, 0, 0, 32}, apdus[0][26:30])
+
+		message := make([]byte, 300)
+		for i := range message {
+			message[i] = byte(i)
+		}
+		apdus, err = LedgerSignPersonalMessageAPDUs(path, message)
+		require.NoError(t, err)
+		require.Len(t, apdus, 3)
+		var reassembled []byte
+		for i, apdu := range apdus {
+			require.LessOrEqual(t, len(apdu)-5, LedgerMaxChunkSize)
+			require.EqualValues(t, len(apdu)-5, apdu[4])
+			if i == 0 {
+				require.EqualValues(t, 0x00, apdu[2])
+				reassembled = append(reassembled, apdu[5+1+5*4+4:]...)
+			} else {
+				require.EqualValues(t, 0x80, apdu[2])
+				reassembled = append(reassembled, apdu[5:]...)
+			}
+		}
+		require.EqualValues(t, message, reassembled)
+
+		_, err = LedgerSignPersonalMessageAPDUs(nil, message)
+		require.EqualError(t, err, "invalid derivation path length 0")
+	})
+
+	t.Run("normalize", func(t *testing.T) {
+		_, err := NormalizeOwnerSignature(make([]byte, 64))
+		require.EqualError(t, err, "invalid signature length 64")
+		sig := make([]byte, 65)
+		sig[64] = 29
+		_, err = NormalizeOwnerSignature(sig)
+		require.EqualError(t, err, "invalid signature recovery id 29")
+		sig[64] = 1
+		normalized, err := NormalizeOwnerSignature(sig)
+		require.NoError(t, err)
+		require.EqualValues(t, sig, normalized)
+	})
+}
@@ -0,0 +1,68 @@
+package crypto
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"fmt"
+)
+
+// HybridKeyLen is the AES-256 key length of hybrid encryption
+const HybridKeyLen = 32
+
+// EncryptHybrid encrypts msg with a random AES-GCM key, wrapped with RSA-OAEP (SHA-256) to pub.
+// label is both the OAEP label and the GCM additional data, decryption fails with any other label.
+// The ciphertext is the wrapped key followed by the GCM nonce and sealed msg.
+func EncryptHybrid(pub *rsa.PublicKey, msg []byte, label []byte) ([]byte, error) {
+	key := make([]byte, HybridKeyLen)
+	if _, err := rand.Read(key); err != nil {
+		return nil, err
+	}
+	wrappedKey, err := rsa.EncryptOAEP(sha256.New(), rand.Reader, pub, key, label)
+	if err != nil {
+		return nil, err
+	}
+	gcm, err := newGCM(key)
+	if err != nil {
+		return nil, err
+	}
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+	ret := append(wrappedKey, nonce...)
+	return gcm.Seal(ret, nonce, msg, label), nil
+}
+
+// DecryptHybrid decrypts a ciphertext of EncryptHybrid with sk and label
+func DecryptHybrid(sk *rsa.PrivateKey, ciphertext []byte, label []byte) ([]byte, error) {
+	if len(ciphertext) < sk.Size() {
+		return nil, fmt.Errorf("hybrid ciphertext too short")
+	}
+	key, err := rsa.DecryptOAEP(sha256.New(), rand.Reader, sk, ciphertext[:sk.Size()], label)
+	if err != nil {
+		return nil, err
+	}
+	gcm, err := newGCM(key)
+	if err != nil {
+		return nil, err
+	}
+	sealed := ciphertext[sk.Size():]
+	if len(sealed) < gcm.NonceSize() {
+		return nil, fmt.Errorf("hybrid ciphertext too short")
+	}
+	return gcm.Open(nil, sealed[:gcm.NonceSize()], sealed[gcm.NonceSize():], label)
+}
+
+func newGCM(key []byte) (cipher.AEAD, error) {
+	if len(key) != HybridKeyLen {
+		return nil, fmt.Errorf("invalid hybrid key length")
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
@@ -0,0 +1,52 @@
+package crypto
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestHybrid(t *testing.T) {
+	sk, _, err := GenerateRSAKeys()
+	require.NoError(t, err)
+	msg := []byte("share")
+	label := []byte("label")
+
+	ciphertext, err := EncryptHybrid(&sk.PublicKey, msg, label)
+	require.NoError(t, err)
+	require.Len(t, ciphertext, sk.Size()+12+len(msg)+16)
+
+	t.Run("decrypt", func(t *testing.T) {
+		decrypted, err := DecryptHybrid(sk, ciphertext, label)
+		require.NoError(t, err)
+		require.EqualValues(t, msg, decrypted)
+	})
+
+	t.Run("wrong key", func(t *testing.T) {
+		other, _, err := GenerateRSAKeys()
+		require.NoError(t, err)
+		_, err = DecryptHybrid(other, ciphertext, label)
+		require.Error(t, err)
+	})
+
+	t.Run("wrong label", func(t *testing.T) {
+		_, err := DecryptHybrid(sk, ciphertext, []byte("other"))
+		require.Error(t, err)
+		_, err = DecryptHybrid(sk, ciphertext, nil)
+		require.Error(t, err)
+	})
+
+	t.Run("tampered", func(t *testing.T) {
+		tampered := append([]byte{}, ciphertext...)
+		tampered[len(tampered)-1] ^= 1
+		_, err := DecryptHybrid(sk, tampered, label)
+		require.Error(t, err)
+	})
+
+	t.Run("truncated", func(t *testing.T) {
+		_, err := DecryptHybrid(sk, ciphertext[:sk.Size()-1], label)
+		require.EqualError(t, err, "hybrid ciphertext too short")
+		_, err = DecryptHybrid(sk, ciphertext[:sk.Size()+1], label)
+		require.EqualError(t, err, "hybrid ciphertext too short")
+	})
+}
@@ -0,0 +1,267 @@
+package crypto
+
+import (
+	"crypto/rand"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+
+	"golang.org/x/crypto/argon2"
+	"golang.org/x/crypto/scrypt"
+)
+
+// KDFFunction is a passphrase key derivation function
+type KDFFunction uint8
+
+const (
+	KDFScrypt   KDFFunction = 1
+	KDFArgon2id KDFFunction = 2
+)
+
+const (
+	// KDFSaltLen is the length of the random salt passphrase keys are derived with
+	KDFSaltLen = 32
+	// KDFKeyLen is the length of derived keys, an AES-256 key (see Seal)
+	KDFKeyLen = 32
+
+	// StandardScryptN, StandardScryptR and StandardScryptP are the scrypt parameters of Ethereum keystores (EIP-2335)
+	StandardScryptN = 1 << 18
+	StandardScryptR = 8
+	StandardScryptP = 1
+	// StandardArgon2idTime, StandardArgon2idMemory (KiB) and StandardArgon2idThreads are the second recommended option of RFC 9106
+	StandardArgon2idTime    = 3
+	StandardArgon2idMemory  = 64 * 1024
+	StandardArgon2idThreads = 4
+
+	// maxKDFMemory bounds the memory of deriving a key with untrusted parameters: 128 * N * r bytes for scrypt, Memory KiB for
+	// argon2id
+	maxKDFMemory = 1 << 30
+	// maxArgon2idTime bounds the passes of argon2id with untrusted parameters
+	maxArgon2idTime = 64
+)
+
+var kdfFunctionNames = map[KDFFunction]string{
+	KDFScrypt:   "scrypt",
+	KDFArgon2id: "argon2id",
+}
+
+func (f KDFFunction) String() string {
+	if name, found := kdfFunctionNames[f]; found {
+		return name
+	}
+	return fmt.Sprintf("kdf(%d)", uint8(f))
+}
+
+// KDFParams are the parameters every passphrase key is derived with (state and keystore encryption alike), stored next to
+// the encrypted data so it's decrypted with the same key. Only the function's own parameters are set.
+type KDFParams struct {
+	Function KDFFunction
+	Salt     []byte
+	// scrypt parameters
+	N, R, P uint32
+	// argon2id parameters, Memory in KiB
+	Time, Memory uint32
+	Threads      uint8
+}
+
+// NewKDFParams returns function's standard parameters with a random salt
+func NewKDFParams(function KDFFunction) (*KDFParams, error) {
+	ret := &KDFParams{
+		Function: function,
+		Salt:     make([]byte, KDFSaltLen),
+	}
+	switch function {
+	case KDFScrypt:
+		ret.N, ret.R, ret.P = StandardScryptN, StandardScryptR, StandardScryptP
+	case KDFArgon2id:
+		ret.Time, ret.Memory, ret.Threads = StandardArgon2idTime, StandardArgon2idMemory, StandardArgon2idThreads
+	default:
+		return nil, fmt.Errorf("unknown kdf function %v", function)
+	}
+	if _, err := rand.Read(ret.Salt); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// Validate returns nil if the parameters are of a known function and within bounds, so deriving a key with parameters read
+// from untrusted data can't exhaust memory
+func (p *KDFParams) Validate() error {
+	if len(p.Salt) != KDFSaltLen {
+		return fmt.Errorf("invalid salt length")
+	}
+	switch p.Function {
+	case KDFScrypt:
+		if !validScryptParams(uint64(p.N), uint64(p.R), uint64(p.P)) || p.Time != 0 || p.Memory != 0 || p.Threads != 0 {
+			return fmt.Errorf("invalid scrypt parameters")
+		}
+	case KDFArgon2id:
+		if p.Time == 0 || p.Time > maxArgon2idTime || p.Threads == 0 ||
+			p.Memory < 8*uint32(p.Threads) || 1024*uint64(p.Memory) > maxKDFMemory {
+			return fmt.Errorf("invalid argon2id parameters")
+		}
+		if p.N != 0 || p.R != 0 || p.P != 0 {
+			return fmt.Errorf("invalid argon2id parameters")
+		}
+	default:
+		return fmt.Errorf("unknown kdf function %v", p.Function)
+	}
+	return nil
+}
+
+// validScryptParams returns true if scrypt's n, r and p are valid and within bounds
+func validScryptParams(n, r, p uint64) bool {
+	return n > 1 && n&(n-1) == 0 && r > 0 && p > 0 && n <= maxKDFMemory/128 && r <= maxKDFMemory/128 &&
+		128*n*r <= maxKDFMemory && r*p < 1<<30
+}
+
+// DeriveKey returns the KDFKeyLen bytes key derived from passphrase
+func (p *KDFParams) DeriveKey(passphrase []byte) ([]byte, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+	if p.Function == KDFArgon2id {
+		return argon2.IDKey(passphrase, p.Salt, p.Time, p.Memory, p.Threads, KDFKeyLen), nil
+	}
+	return scrypt.Key(passphrase, p.Salt, int(p.N), int(p.R), int(p.P), KDFKeyLen)
+}
+
+/*
+KDF parameters are binary encoded as the function byte and the salt, followed by the function's parameters (big-endian):
+
+	scrypt:		0x01 | salt (32) | N (4) | r (4) | p (4)
+	argon2id:	0x02 | salt (32) | time (4) | memory (4) | threads (1)
+
+and JSON encoded as the "kdf" module of EIP-2335 keystores, e.g.
+
+	{"function": "scrypt", "params": {"dklen": 32, "n": 262144, "r": 8, "p": 1, "salt": "<hex>"}}
+	{"function": "argon2id", "params": {"dklen": 32, "time": 3, "memory": 65536, "threads": 4, "salt": "<hex>"}}
+*/
+
+// MarshalBinary returns the binary encoding of the parameters
+func (p *KDFParams) MarshalBinary() ([]byte, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+	ret := append([]byte{byte(p.Function)}, p.Salt...)
+	if p.Function == KDFArgon2id {
+		ret = binary.BigEndian.AppendUint32(ret, p.Time)
+		ret = binary.BigEndian.AppendUint32(ret, p.Memory)
+		return append(ret, p.Threads), nil
+	}
+	ret = binary.BigEndian.AppendUint32(ret, p.N)
+	ret = binary.BigEndian.AppendUint32(ret, p.R)
+	return binary.BigEndian.AppendUint32(ret, p.P), nil
+}
+
+// UnmarshalBinary decodes the binary encoding of parameters, returns error if they aren't valid
+func (p *KDFParams) UnmarshalBinary(data []byte) error {
+	if len(data) < 1+KDFSaltLen {
+		return fmt.Errorf("kdf parameters too short")
+	}
+	ret := KDFParams{
+		Function: KDFFunction(data[0]),
+		Salt:     append([]byte{}, data[1:1+KDFSaltLen]...),
+	}
+	params := data[1+KDFSaltLen:]
+	switch ret.Function {
+	case KDFScrypt:
+		if len(params) != 12 {
+			return fmt.Errorf("invalid scrypt parameters length")
+		}
+		ret.N = binary.BigEndian.Uint32(params[0:4])
+		ret.R = binary.BigEndian.Uint32(params[4:8])
+		ret.P = binary.BigEndian.Uint32(params[8:12])
+	case KDFArgon2id:
+		if len(params) != 9 {
+			return fmt.Errorf("invalid argon2id parameters length")
+		}
+		ret.Time = binary.BigEndian.Uint32(params[0:4])
+		ret.Memory = binary.BigEndian.Uint32(params[4:8])
+		ret.Threads = params[8]
+	}
+	if err := ret.Validate(); err != nil {
+		return err
+	}
+	*p = ret
+	return nil
+}
+
+type kdfParamsJSON struct {
+	DKLen   int     `json:"dklen"`
+	N       *uint32 `json:"n,omitempty"`
+	R       *uint32 `json:"r,omitempty"`
+	P       *uint32 `json:"p,omitempty"`
+	Time    *uint32 `json:"time,omitempty"`
+	Memory  *uint32 `json:"memory,omitempty"`
+	Threads *uint8  `json:"threads,omitempty"`
+	Salt    string  `json:"salt"`
+}
+
+type kdfJSON struct {
+	Function string        `json:"function"`
+	Params   kdfParamsJSON `json:"params"`
+}
+
+// MarshalJSON returns the JSON encoding of the parameters
+func (p *KDFParams) MarshalJSON() ([]byte, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+	ret := kdfJSON{
+		Function: p.Function.String(),
+		Params:   kdfParamsJSON{DKLen: KDFKeyLen, Salt: hex.EncodeToString(p.Salt)},
+	}
+	if p.Function == KDFArgon2id {
+		ret.Params.Time, ret.Params.Memory, ret.Params.Threads = &p.Time, &p.Memory, &p.Threads
+	} else {
+		ret.Params.N, ret.Params.R, ret.Params.P = &p.N, &p.R, &p.P
+	}
+	return json.Marshal(ret)
+}
+
+// UnmarshalJSON decodes the JSON encoding of parameters, returns error if they aren't valid
+func (p *KDFParams) UnmarshalJSON(data []byte) error {
+	var v kdfJSON
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	if v.Params.DKLen != KDFKeyLen {
+		return fmt.Errorf("invalid derived key length %d", v.Params.DKLen)
+	}
+	salt, err := hex.DecodeString(v.Params.Salt)
+	if err != nil {
+		return fmt.Errorf("invalid salt: %v", err)
+	}
+	ret := KDFParams{Salt: salt}
+	switch v.Function {
+	case KDFScrypt.String():
+		ret.Function = KDFScrypt
+		ret.N, ret.R, ret.P = deref(v.Params.N), deref(v.Params.R), deref(v.Params.P)
+		if v.Params.Time != nil || v.Params.Memory != nil || v.Params.Threads != nil {
+			return fmt.Errorf("invalid scrypt parameters")
+		}
+	case KDFArgon2id.String():
+		ret.Function = KDFArgon2id
+		ret.Time, ret.Memory, ret.Threads = deref(v.Params.Time), deref(v.Params.Memory), deref(v.Params.Threads)
+		if v.Params.N != nil || v.Params.R != nil || v.Params.P != nil {
+			return fmt.Errorf("invalid argon2id parameters")
+		}
+	default:
+		return fmt.Errorf("unknown kdf function %q", v.Function)
+	}
+	if err := ret.Validate(); err != nil {
+		return err
+	}
+	*p = ret
+	return nil
+}
+
+func deref[T any](v *T) T {
+	var ret T
+	if v != nil {
+		ret = *v
+	}
+	return ret
+}
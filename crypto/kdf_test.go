@@ -0,0 +1,104 @@
+package crypto
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestKDF(t *testing.T) {
+	scryptParams, err := NewKDFParams(KDFScrypt)
+	require.NoError(t, err)
+	scryptParams.N = 1 << 10
+	argon2idParams, err := NewKDFParams(KDFArgon2id)
+	require.NoError(t, err)
+	argon2idParams.Memory = 1024
+
+	for _, params := range []*KDFParams{scryptParams, argon2idParams} {
+		params := params
+		t.Run(params.Function.String(), func(t *testing.T) {
+			key, err := params.DeriveKey([]byte("passphrase"))
+			require.NoError(t, err)
+			require.Len(t, key, KDFKeyLen)
+
+			again, err := params.DeriveKey([]byte("passphrase"))
+			require.NoError(t, err)
+			require.EqualValues(t, key, again)
+			other, err := params.DeriveKey([]byte("other"))
+			require.NoError(t, err)
+			require.NotEqualValues(t, key, other)
+
+			byts, err := params.MarshalBinary()
+			require.NoError(t, err)
+			decoded := &KDFParams{}
+			require.NoError(t, decoded.UnmarshalBinary(byts))
+			require.EqualValues(t, params, decoded)
+
+			byts, err = json.Marshal(params)
+			require.NoError(t, err)
+			decoded = &KDFParams{}
+			require.NoError(t, json.Unmarshal(byts, decoded))
+			require.EqualValues(t, params, decoded)
+		})
+	}
+
+	t.Run("standard parameters", func(t *testing.T) {
+		require.EqualValues(t, StandardScryptN, 1<<18)
+		params, err := NewKDFParams(KDFArgon2id)
+		require.NoError(t, err)
+		require.NoError(t, params.Validate())
+		_, err = NewKDFParams(KDFFunction(3))
+		require.EqualError(t, err, "unknown kdf function kdf(3)")
+	})
+
+	t.Run("eip-2335 json", func(t *testing.T) {
+		params := &KDFParams{}
+		require.NoError(t, json.Unmarshal([]byte(`{
+			"function": "scrypt",
+			"params": {"dklen": 32, "n": 262144, "p": 1, "r": 8, "salt": "d4e56740f876aef8c010b86a40d5f56745a118d0906a34e69aec8c0db1cb8fa3"}
+		}`), params))
+		require.EqualValues(t, KDFScrypt, params.Function)
+		require.EqualValues(t, StandardScryptN, params.N)
+		require.EqualValues(t, StandardScryptR, params.R)
+		require.EqualValues(t, StandardScryptP, params.P)
+	})
+
+	t.Run("invalid parameters", func(t *testing.T) {
+		invalid := *scryptParams
+		invalid.N = 1<<10 + 1
+		_, err := invalid.DeriveKey([]byte("passphrase"))
+		require.EqualError(t, err, "invalid scrypt parameters")
+
+		invalid = *scryptParams
+		invalid.N = 1 << 30
+		_, err = invalid.MarshalBinary()
+		require.EqualError(t, err, "invalid scrypt parameters")
+
+		invalid = *scryptParams
+		invalid.Time = 1
+		require.EqualError(t, invalid.Validate(), "invalid scrypt parameters")
+
+		invalid = *argon2idParams
+		invalid.Memory = 1 << 21
+		require.EqualError(t, invalid.Validate(), "invalid argon2id parameters")
+
+		invalid = *argon2idParams
+		invalid.Salt = invalid.Salt[:16]
+		require.EqualError(t, invalid.Validate(), "invalid salt length")
+	})
+
+	t.Run("invalid encoding", func(t *testing.T) {
+		byts, err := argon2idParams.MarshalBinary()
+		require.NoError(t, err)
+		require.EqualError(t, (&KDFParams{}).UnmarshalBinary(byts[:len(byts)-1]), "invalid argon2id parameters length")
+		require.EqualError(t, (&KDFParams{}).UnmarshalBinary(byts[:10]), "kdf parameters too short")
+		byts[0] = 3
+		require.EqualError(t, (&KDFParams{}).UnmarshalBinary(byts), "unknown kdf function kdf(3)")
+
+		err = json.Unmarshal([]byte(`{"function": "pbkdf2", "params": {"dklen": 32, "salt": ""}}`), &KDFParams{})
+		require.EqualError(t, err, `unknown kdf function "pbkdf2"`)
+		err = json.Unmarshal([]byte(`{"function": "scrypt", "params": {"dklen": 16, "salt": ""}}`), &KDFParams{})
+		require.EqualError(t, err, "invalid derived key length 16")
+	})
+}
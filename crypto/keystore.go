@@ -0,0 +1,245 @@
+package crypto
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"encoding/pem"
+	"fmt"
+	"hash"
+	"os"
+
+	"golang.org/x/crypto/pbkdf2"
+	"golang.org/x/crypto/scrypt"
+)
+
+/*
+Operator keystores are PEM "ENCRYPTED PRIVATE KEY" blocks: the PKCS#8 encoded RSA key encrypted with PBES2 (RFC 8018), as
+written by e.g. "openssl pkcs8 -topk8 -scrypt".
+EncryptRSAPrivateKey uses scrypt with the standard parameters (see NewKDFParams) and AES-256-CBC, DecryptRSAPrivateKey also
+accepts PBKDF2 (HMAC-SHA1 or HMAC-SHA256) and AES-128-CBC.
+*/
+
+// encryptedPrivateKeyPEMType is the PEM block type of operator keystores
+const encryptedPrivateKeyPEMType = "ENCRYPTED PRIVATE KEY"
+
+// maxPBKDF2Iterations bounds the iterations of PBKDF2 with untrusted parameters
+const maxPBKDF2Iterations = 10_000_000
+
+var (
+	oidPBES2          = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 5, 13}
+	oidPBKDF2         = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 5, 12}
+	oidScrypt         = asn1.ObjectIdentifier{1, 3, 6, 1, 4, 1, 11591, 4, 11}
+	oidHMACWithSHA1   = asn1.ObjectIdentifier{1, 2, 840, 113549, 2, 7}
+	oidHMACWithSHA256 = asn1.ObjectIdentifier{1, 2, 840, 113549, 2, 9}
+	oidAES128CBC      = asn1.ObjectIdentifier{2, 16, 840, 1, 101, 3, 4, 1, 2}
+	oidAES256CBC      = asn1.ObjectIdentifier{2, 16, 840, 1, 101, 3, 4, 1, 42}
+)
+
+type encryptedPrivateKeyInfo struct {
+	Algorithm     pkix.AlgorithmIdentifier
+	EncryptedData []byte
+}
+
+type pbes2Params struct {
+	KeyDerivationFunc pkix.AlgorithmIdentifier
+	EncryptionScheme  pkix.AlgorithmIdentifier
+}
+
+type scryptParams struct {
+	Salt      []byte
+	N         int
+	R         int
+	P         int
+	KeyLength int `asn1:"optional"`
+}
+
+type pbkdf2Params struct {
+	Salt       []byte
+	Iterations int
+	KeyLength  int                      `asn1:"optional"`
+	PRF        pkix.AlgorithmIdentifier `asn1:"optional"`
+}
+
+// EncryptRSAPrivateKey returns sk's PEM encoded keystore, encrypted with a key derived from password
+func EncryptRSAPrivateKey(sk *rsa.PrivateKey, password []byte) ([]byte, error) {
+	der, err := x509.MarshalPKCS8PrivateKey(sk)
+	if err != nil {
+		return nil, err
+	}
+	params, err := NewKDFParams(KDFScrypt)
+	if err != nil {
+		return nil, err
+	}
+	key, err := params.DeriveKey(password)
+	if err != nil {
+		return nil, err
+	}
+	iv := make([]byte, aes.BlockSize)
+	if _, err := rand.Read(iv); err != nil {
+		return nil, err
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	padding := aes.BlockSize - len(der)%aes.BlockSize
+	encrypted := append(der, bytes.Repeat([]byte{byte(padding)}, padding)...)
+	cipher.NewCBCEncrypter(block, iv).CryptBlocks(encrypted, encrypted)
+
+	kdf, err := asn1.Marshal(scryptParams{
+		Salt:      params.Salt,
+		N:         int(params.N),
+		R:         int(params.R),
+		P:         int(params.P),
+		KeyLength: KDFKeyLen,
+	})
+	if err != nil {
+		return nil, err
+	}
+	encryptionIV, err := asn1.Marshal(iv)
+	if err != nil {
+		return nil, err
+	}
+	pbes2, err := asn1.Marshal(pbes2Params{
+		KeyDerivationFunc: pkix.AlgorithmIdentifier{Algorithm: oidScrypt, Parameters: asn1.RawValue{FullBytes: kdf}},
+		EncryptionScheme:  pkix.AlgorithmIdentifier{Algorithm: oidAES256CBC, Parameters: asn1.RawValue{FullBytes: encryptionIV}},
+	})
+	if err != nil {
+		return nil, err
+	}
+	ret, err := asn1.Marshal(encryptedPrivateKeyInfo{
+		Algorithm:     pkix.AlgorithmIdentifier{Algorithm: oidPBES2, Parameters: asn1.RawValue{FullBytes: pbes2}},
+		EncryptedData: encrypted,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: encryptedPrivateKeyPEMType, Bytes: ret}), nil
+}
+
+// DecryptRSAPrivateKey returns the RSA key of a PEM encoded keystore encrypted with password
+func DecryptRSAPrivateKey(keystore []byte, password []byte) (*rsa.PrivateKey, error) {
+	block, _ := pem.Decode(keystore)
+	if block == nil {
+		return nil, fmt.Errorf("decode PEM block")
+	}
+	if block.Type != encryptedPrivateKeyPEMType {
+		return nil, fmt.Errorf("not an encrypted private key: %s", block.Type)
+	}
+	var info encryptedPrivateKeyInfo
+	if rest, err := asn1.Unmarshal(block.Bytes, &info); err != nil || len(rest) > 0 {
+		return nil, fmt.Errorf("invalid encrypted private key")
+	}
+	if !info.Algorithm.Algorithm.Equal(oidPBES2) {
+		return nil, fmt.Errorf("unsupported encryption algorithm %v", info.Algorithm.Algorithm)
+	}
+	var params pbes2Params
+	if _, err := asn1.Unmarshal(info.Algorithm.Parameters.FullBytes, &params); err != nil {
+		return nil, fmt.Errorf("invalid PBES2 parameters")
+	}
+
+	var keyLen int
+	switch scheme := params.EncryptionScheme.Algorithm; {
+	case scheme.Equal(oidAES128CBC):
+		keyLen = 16
+	case scheme.Equal(oidAES256CBC):
+		keyLen = 32
+	default:
+		return nil, fmt.Errorf("unsupported encryption scheme %v", scheme)
+	}
+	var iv []byte
+	if _, err := asn1.Unmarshal(params.EncryptionScheme.Parameters.FullBytes, &iv); err != nil || len(iv) != aes.BlockSize {
+		return nil, fmt.Errorf("invalid encryption IV")
+	}
+	key, err := deriveKeystoreKey(params.KeyDerivationFunc, password, keyLen)
+	if err != nil {
+		return nil, err
+	}
+
+	encrypted := info.EncryptedData
+	if len(encrypted) == 0 || len(encrypted)%aes.BlockSize != 0 {
+		return nil, fmt.Errorf("invalid encrypted private key length")
+	}
+	aesBlock, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	der := make([]byte, len(encrypted))
+	cipher.NewCBCDecrypter(aesBlock, iv).CryptBlocks(der, encrypted)
+	padding := int(der[len(der)-1])
+	if padding == 0 || padding > aes.BlockSize || !bytes.Equal(der[len(der)-padding:], bytes.Repeat([]byte{byte(padding)}, padding)) {
+		return nil, fmt.Errorf("failed to decrypt private key, wrong password?")
+	}
+	sk, err := x509.ParsePKCS8PrivateKey(der[:len(der)-padding])
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt private key, wrong password?")
+	}
+	ret, ok := sk.(*rsa.PrivateKey)
+	if !ok {
+		return nil, fmt.Errorf("not an RSA private key")
+	}
+	return ret, nil
+}
+
+// deriveKeystoreKey returns the keyLen bytes key derived from password with a keystore's key derivation function, returns error
+// if it's unsupported or its parameters aren't within bounds
+func deriveKeystoreKey(kdf pkix.AlgorithmIdentifier, password []byte, keyLen int) ([]byte, error) {
+	switch {
+	case kdf.Algorithm.Equal(oidScrypt):
+		var params scryptParams
+		if _, err := asn1.Unmarshal(kdf.Parameters.FullBytes, &params); err != nil {
+			return nil, fmt.Errorf("invalid scrypt parameters")
+		}
+		if params.N < 0 || params.R < 0 || params.P < 0 || !validScryptParams(uint64(params.N), uint64(params.R), uint64(params.P)) ||
+			(params.KeyLength != 0 && params.KeyLength != keyLen) {
+			return nil, fmt.Errorf("invalid scrypt parameters")
+		}
+		return scrypt.Key(password, params.Salt, params.N, params.R, params.P, keyLen)
+	case kdf.Algorithm.Equal(oidPBKDF2):
+		var params pbkdf2Params
+		if _, err := asn1.Unmarshal(kdf.Parameters.FullBytes, &params); err != nil {
+			return nil, fmt.Errorf("invalid PBKDF2 parameters")
+		}
+		if params.Iterations <= 0 || params.Iterations > maxPBKDF2Iterations || (params.KeyLength != 0 && params.KeyLength != keyLen) {
+			return nil, fmt.Errorf("invalid PBKDF2 parameters")
+		}
+		var prf func() hash.Hash
+		switch {
+		case params.PRF.Algorithm == nil || params.PRF.Algorithm.Equal(oidHMACWithSHA1):
+			prf = sha1.New
+		case params.PRF.Algorithm.Equal(oidHMACWithSHA256):
+			prf = sha256.New
+		default:
+			return nil, fmt.Errorf("unsupported PBKDF2 function %v", params.PRF.Algorithm)
+		}
+		return pbkdf2.Key(password, params.Salt, params.Iterations, keyLen, prf), nil
+	default:
+		return nil, fmt.Errorf("unsupported key derivation function %v", kdf.Algorithm)
+	}
+}
+
+// LoadRSAPrivateKey reads the RSA key of the keystore file at path, see DecryptRSAPrivateKey
+func LoadRSAPrivateKey(path string, password []byte) (*rsa.PrivateKey, error) {
+	keystore, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return DecryptRSAPrivateKey(keystore, password)
+}
+
+// SaveRSAPrivateKey writes sk to path as a keystore file encrypted with password, see EncryptRSAPrivateKey
+func SaveRSAPrivateKey(path string, sk *rsa.PrivateKey, password []byte) error {
+	keystore, err := EncryptRSAPrivateKey(sk, password)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, keystore, 0o600)
+}
@@ -0,0 +1,21 @@
+//go:build !verifyonly && !purebls
+
+package crypto
+
+import (
+	"github.com/bloxapp/eth2-key-manager/core"
+	eth1deposit "github.com/bloxapp/eth2-key-manager/eth1_deposit"
+)
+
+// Network is an eth2-key-manager network
+type Network = core.Network
+
+const (
+	MainNetwork    = core.MainNetwork
+	PraterNetwork  = core.PraterNetwork
+	HoleskyNetwork = core.HoleskyNetwork
+)
+
+func isSupportedDepositNetwork(network Network) bool {
+	return eth1deposit.IsSupportedDepositNetwork(network)
+}
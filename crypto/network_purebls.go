@@ -0,0 +1,55 @@
+//go:build !verifyonly && purebls
+
+package crypto
+
+import (
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+// Network mirrors eth2-key-manager's core.Network, which can't be built without cgo
+type Network string
+
+const (
+	MainNetwork    Network = "mainnet"
+	PraterNetwork  Network = "prater"
+	HoleskyNetwork Network = "holesky"
+)
+
+// GenesisForkVersion returns the genesis fork version of the network
+func (n Network) GenesisForkVersion() phase0.Version {
+	switch n {
+	case PraterNetwork:
+		return phase0.Version{0x00, 0x00, 0x10, 0x20}
+	case HoleskyNetwork:
+		return phase0.Version{0x01, 0x01, 0x70, 0x00}
+	default:
+		return phase0.Version{0x00, 0x00, 0x00, 0x00}
+	}
+}
+
+// GenesisValidatorsRoot returns the genesis validators root of the network
+func (n Network) GenesisValidatorsRoot() phase0.Root {
+	switch n {
+	case PraterNetwork:
+		return phase0.Root{
+			0x04, 0x3d, 0xb0, 0xd9, 0xa8, 0x38, 0x13, 0x55, 0x1e, 0xe2, 0xf3, 0x34, 0x50, 0xd2, 0x37, 0x97,
+			0x75, 0x7d, 0x43, 0x09, 0x11, 0xa9, 0x32, 0x05, 0x30, 0xad, 0x8a, 0x0e, 0xab, 0xc4, 0x3e, 0xfb,
+		}
+	case HoleskyNetwork:
+		return phase0.Root{
+			0x91, 0x43, 0xaa, 0x7c, 0x61, 0x5a, 0x7f, 0x71, 0x15, 0xe2, 0xb6, 0xaa, 0xc3, 0x19, 0xc0, 0x35,
+			0x29, 0xdf, 0x82, 0x42, 0xae, 0x70, 0x5f, 0xba, 0x9d, 0xf3, 0x9b, 0x79, 0xc5, 0x9f, 0xa8, 0xb1,
+		}
+	case MainNetwork:
+		return phase0.Root{
+			0x4b, 0x36, 0x3d, 0xb9, 0x4e, 0x28, 0x61, 0x20, 0xd7, 0x6e, 0xb9, 0x05, 0x34, 0x0f, 0xdd, 0x4e,
+			0x54, 0xbf, 0xe9, 0xf0, 0x6b, 0xf3, 0x3f, 0xf6, 0xcf, 0x5a, 0xd2, 0x7f, 0x51, 0x1b, 0xfe, 0x95,
+		}
+	default:
+		return phase0.Root{}
+	}
+}
+
+func isSupportedDepositNetwork(network Network) bool {
+	return network == PraterNetwork || network == HoleskyNetwork || network == MainNetwork
+}
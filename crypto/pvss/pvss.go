@@ -0,0 +1,608 @@
+// Package pvss implements publicly verifiable secret sharing of BLS12-381 secret keys.
+//
+// A dealer shares a secret with a random polynomial of degree t-1, publishing Feldman commitments to its coefficients and
+// each recipient's share encrypted to the recipient's PVSS public key (a G1 point). Shares are split into 16 bit chunks,
+// each ElGamal encrypted in the exponent so recipients can recover the share itself (a BLS secret key) rather than a group
+// element. A Chaum-Pedersen proof shows the chunks recombine to an encryption of the share committed to by the polynomial,
+// so anyone can verify a transcript without any secret.
+//
+// Chunks aren't range proven: a dealer encrypting a chunk out of range makes its recipient's decryption fail, which the
+// recipient reports publicly with ProveDecryptionFailure, blaming the dealer verifiably.
+package pvss
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+	"math/big"
+	"sync"
+
+	bls12381 "github.com/consensys/gnark-crypto/ecc/bls12-381"
+	"github.com/consensys/gnark-crypto/ecc/bls12-381/fr"
+)
+
+const (
+	// ChunkBits is the size of each encrypted chunk of a share
+	ChunkBits = 16
+	// Chunks is the number of chunks of a share
+	Chunks = (fr.Bytes * 8) / ChunkBits
+
+	pointLen = bls12381.SizeOfG1AffineCompressed
+)
+
+var proofDomain = []byte("DKG PVSS proof")
+
+// PrivateKey is a recipient's PVSS decryption key
+type PrivateKey struct {
+	s fr.Element
+}
+
+// GenerateKey returns a random PVSS key
+func GenerateKey() (*PrivateKey, error) {
+	ret := &PrivateKey{}
+	if _, err := ret.s.SetRandom(); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// PublicKey returns the key's compressed G1 public key, which dealers encrypt shares to
+func (sk *PrivateKey) PublicKey() []byte {
+	return pointBytes(scalarMulBase(&sk.s))
+}
+
+// Serialize returns the key as a 32 byte big-endian scalar
+func (sk *PrivateKey) Serialize() []byte {
+	b := sk.s.Bytes()
+	return b[:]
+}
+
+// Deserialize sets the key from a 32 byte big-endian scalar
+func (sk *PrivateKey) Deserialize(buf []byte) error {
+	return sk.s.SetBytesCanonical(buf)
+}
+
+// Recipient is a party receiving a share, identified by its share index
+type Recipient struct {
+	ID     uint64
+	PubKey []byte
+}
+
+// Proof is a non-interactive Chaum-Pedersen proof of equality of discrete logarithms
+type Proof struct {
+	Challenge []byte `json:"challenge"`
+	Response  []byte `json:"response"`
+}
+
+// EncryptedShare is a recipient's share, encrypted chunk by chunk
+type EncryptedShare struct {
+	ID uint64 `json:"id"`
+	// Randomness of each chunk's ElGamal encryption, r_k·G
+	Randomness [][]byte `json:"randomness"`
+	// Ciphertexts of each chunk, m_k·G + r_k·PK
+	Ciphertexts [][]byte `json:"ciphertexts"`
+	// Proof that the chunks encrypt the share committed to by the transcript's commitments
+	Proof *Proof `json:"proof"`
+}
+
+// Transcript is a dealer's public dealing
+type Transcript struct {
+	// Commitments to the polynomial's coefficients, Commitments[0] is the shared secret's public key
+	Commitments [][]byte `json:"commitments"`
+	// Shares in recipients order
+	Shares []*EncryptedShare `json:"shares"`
+}
+
+// Deal shares secret (a 32 byte big-endian scalar, e.g. a BLS secret key) among recipients with a threshold of t.
+// context binds the transcript to its ceremony and dealer, verification and decryption must use the same context.
+func Deal(context []byte, secret []byte, t int, recipients []*Recipient) (*Transcript, error) {
+	if t < 1 || t > len(recipients) {
+		return nil, fmt.Errorf("invalid threshold %d of %d recipients", t, len(recipients))
+	}
+	coefficients := make([]fr.Element, t)
+	if err := coefficients[0].SetBytesCanonical(secret); err != nil {
+		return nil, fmt.Errorf("invalid secret: %w", err)
+	}
+	for i := 1; i < t; i++ {
+		if _, err := coefficients[i].SetRandom(); err != nil {
+			return nil, err
+		}
+	}
+
+	ret := &Transcript{Commitments: make([][]byte, t)}
+	for i := range coefficients {
+		ret.Commitments[i] = pointBytes(scalarMulBase(&coefficients[i]))
+	}
+	for _, recipient := range recipients {
+		pk, err := parsePublicKey(recipient.PubKey)
+		if err != nil {
+			return nil, fmt.Errorf("recipient %d: invalid public key: %w", recipient.ID, err)
+		}
+		share := evaluate(coefficients, recipient.ID)
+		encrypted, err := encryptShare(context, recipient.ID, pk, &share)
+		if err != nil {
+			return nil, err
+		}
+		ret.Shares = append(ret.Shares, encrypted)
+	}
+	return ret, nil
+}
+
+// RandomSecret returns a random secret to Deal, e.g. an operator's contribution to a DKG
+func RandomSecret() ([]byte, error) {
+	var s fr.Element
+	if _, err := s.SetRandom(); err != nil {
+		return nil, err
+	}
+	b := s.Bytes()
+	return b[:], nil
+}
+
+// Verify returns nil if transcript deals a secret with a threshold of t to recipients, each share provably encrypted
+// to its recipient
+func Verify(context []byte, transcript *Transcript, t int, recipients []*Recipient) error {
+	if len(transcript.Commitments) != t {
+		return fmt.Errorf("%d commitments, expected %d", len(transcript.Commitments), t)
+	}
+	if len(transcript.Shares) != len(recipients) {
+		return fmt.Errorf("%d shares, expected %d", len(transcript.Shares), len(recipients))
+	}
+	commitments, err := parsePoints(transcript.Commitments)
+	if err != nil {
+		return fmt.Errorf("invalid commitment: %w", err)
+	}
+	for i, recipient := range recipients {
+		share := transcript.Shares[i]
+		if share.ID != recipient.ID {
+			return fmt.Errorf("share %d is for recipient %d, expected %d", i, share.ID, recipient.ID)
+		}
+		pk, err := parsePublicKey(recipient.PubKey)
+		if err != nil {
+			return fmt.Errorf("recipient %d: invalid public key: %w", recipient.ID, err)
+		}
+		if err := verifyShare(context, pk, share, evaluateCommitments(commitments, recipient.ID)); err != nil {
+			return fmt.Errorf("recipient %d: %w", recipient.ID, err)
+		}
+	}
+	return nil
+}
+
+// SharePublicKey returns the compressed public key of recipient id's share, as committed to by transcript
+func SharePublicKey(transcript *Transcript, id uint64) ([]byte, error) {
+	commitments, err := parsePoints(transcript.Commitments)
+	if err != nil {
+		return nil, err
+	}
+	v := evaluateCommitments(commitments, id)
+	return pointBytes(&v), nil
+}
+
+// AggregateCommitments returns the commitments to the sum of the polynomials dealt by transcripts, as in a DKG where
+// every party deals: Commitments[0] is the joint secret's public key
+func AggregateCommitments(transcripts []*Transcript) ([][]byte, error) {
+	if len(transcripts) == 0 {
+		return nil, fmt.Errorf("no transcripts")
+	}
+	sums := make([]bls12381.G1Jac, len(transcripts[0].Commitments))
+	for i, transcript := range transcripts {
+		if len(transcript.Commitments) != len(sums) {
+			return nil, fmt.Errorf("transcript %d: %d commitments, expected %d", i, len(transcript.Commitments), len(sums))
+		}
+		commitments, err := parsePoints(transcript.Commitments)
+		if err != nil {
+			return nil, fmt.Errorf("transcript %d: %w", i, err)
+		}
+		for j, commitment := range commitments {
+			var p bls12381.G1Jac
+			p.FromAffine(commitment)
+			sums[j].AddAssign(&p)
+		}
+	}
+	ret := make([][]byte, len(sums))
+	for j, affine := range bls12381.BatchJacobianToAffineG1(sums) {
+		ret[j] = pointBytes(&affine)
+	}
+	return ret, nil
+}
+
+// AddShares returns the sum of shares (32 byte big-endian scalars), a recipient's share of the joint secret in a DKG
+func AddShares(shares [][]byte) ([]byte, error) {
+	var ret fr.Element
+	for i, share := range shares {
+		var s fr.Element
+		if err := s.SetBytesCanonical(share); err != nil {
+			return nil, fmt.Errorf("share %d: %w", i, err)
+		}
+		ret.Add(&ret, &s)
+	}
+	b := ret.Bytes()
+	return b[:], nil
+}
+
+// Decrypt returns recipient id's share of a verified transcript as a 32 byte big-endian scalar
+func Decrypt(sk *PrivateKey, id uint64, transcript *Transcript) ([]byte, error) {
+	share, err := findShare(transcript, id)
+	if err != nil {
+		return nil, err
+	}
+	var ret fr.Element
+	shift := new(big.Int).Lsh(big.NewInt(1), ChunkBits)
+	var shiftElement fr.Element
+	shiftElement.SetBigInt(shift)
+	for k := len(share.Ciphertexts) - 1; k >= 0; k-- {
+		m, err := decryptChunk(sk, share, k)
+		if err != nil {
+			return nil, err
+		}
+		var chunk fr.Element
+		chunk.SetUint64(uint64(m))
+		ret.Mul(&ret, &shiftElement).Add(&ret, &chunk)
+	}
+
+	expected, err := SharePublicKey(transcript, id)
+	if err != nil {
+		return nil, err
+	}
+	if !bytes.Equal(pointBytes(scalarMulBase(&ret)), expected) {
+		return nil, fmt.Errorf("decrypted share doesn't match commitments")
+	}
+	b := ret.Bytes()
+	return b[:], nil
+}
+
+// DecryptionFailure proves a recipient's chunk doesn't decrypt to a value in range, blaming the dealer
+type DecryptionFailure struct {
+	ID    uint64 `json:"id"`
+	Chunk int    `json:"chunk"`
+	// SharedKey is sk·R_k, the chunk's ElGamal mask
+	SharedKey []byte `json:"shared_key"`
+	// Proof that SharedKey is sk·R_k for the recipient's public key
+	Proof *Proof `json:"proof"`
+}
+
+// ProveDecryptionFailure returns the proof that chunk of recipient id's share is out of range, for anyone to verify with
+// VerifyDecryptionFailure
+func ProveDecryptionFailure(context []byte, sk *PrivateKey, id uint64, transcript *Transcript, chunk int) (*DecryptionFailure, error) {
+	share, err := findShare(transcript, id)
+	if err != nil {
+		return nil, err
+	}
+	if chunk < 0 || chunk >= len(share.Randomness) {
+		return nil, fmt.Errorf("invalid chunk %d", chunk)
+	}
+	r, err := parsePoint(share.Randomness[chunk])
+	if err != nil {
+		return nil, err
+	}
+	sharedKey := scalarMul(r, &sk.s)
+	proof, err := proveDLEQ(context, id, &sk.s, g1(), scalarMulBase(&sk.s), r, sharedKey)
+	if err != nil {
+		return nil, err
+	}
+	return &DecryptionFailure{
+		ID:        id,
+		Chunk:     chunk,
+		SharedKey: pointBytes(sharedKey),
+		Proof:     proof,
+	}, nil
+}
+
+// VerifyDecryptionFailure returns nil if failure proves the dealer of transcript encrypted an out of range chunk to the
+// recipient with pubKey
+func VerifyDecryptionFailure(context []byte, pubKey []byte, transcript *Transcript, failure *DecryptionFailure) error {
+	share, err := findShare(transcript, failure.ID)
+	if err != nil {
+		return err
+	}
+	if failure.Chunk < 0 || failure.Chunk >= len(share.Randomness) || len(share.Randomness) != len(share.Ciphertexts) {
+		return fmt.Errorf("invalid chunk %d", failure.Chunk)
+	}
+	pk, err := parsePublicKey(pubKey)
+	if err != nil {
+		return err
+	}
+	r, err := parsePoint(share.Randomness[failure.Chunk])
+	if err != nil {
+		return err
+	}
+	c, err := parsePoint(share.Ciphertexts[failure.Chunk])
+	if err != nil {
+		return err
+	}
+	sharedKey, err := parsePoint(failure.SharedKey)
+	if err != nil {
+		return err
+	}
+	if err := verifyDLEQ(context, failure.ID, g1(), pk, r, sharedKey, failure.Proof); err != nil {
+		return err
+	}
+	var m bls12381.G1Affine
+	m.Sub(c, sharedKey)
+	if _, found := chunkTable()[m.Bytes()]; found {
+		return fmt.Errorf("chunk %d is in range", failure.Chunk)
+	}
+	return nil
+}
+
+func encryptShare(context []byte, id uint64, pk *bls12381.G1Affine, share *fr.Element) (*EncryptedShare, error) {
+	var shareInt big.Int
+	share.BigInt(&shareInt)
+	mask := new(big.Int).Lsh(big.NewInt(1), ChunkBits)
+	mask.Sub(mask, big.NewInt(1))
+	chunks := make([]fr.Element, Chunks)
+	for k := range chunks {
+		chunks[k].SetBigInt(new(big.Int).And(new(big.Int).Rsh(&shareInt, uint(k*ChunkBits)), mask))
+	}
+	return encryptChunks(context, id, pk, share, chunks)
+}
+
+// encryptChunks encrypts chunks of share, which must recombine to it
+func encryptChunks(context []byte, id uint64, pk *bls12381.G1Affine, share *fr.Element, chunks []fr.Element) (*EncryptedShare, error) {
+	ret := &EncryptedShare{
+		ID:          id,
+		Randomness:  make([][]byte, len(chunks)),
+		Ciphertexts: make([][]byte, len(chunks)),
+	}
+	// r is the combined randomness Σ 2^(16k)·r_k, the randomness of the recombined ciphertext
+	var r fr.Element
+	for k := range chunks {
+		var rk fr.Element
+		if _, err := rk.SetRandom(); err != nil {
+			return nil, err
+		}
+		var c, rpk bls12381.G1Jac
+		c.FromAffine(scalarMulBase(&chunks[k]))
+		rpk.FromAffine(scalarMul(pk, &rk))
+		c.AddAssign(&rpk)
+		var cAffine bls12381.G1Affine
+		cAffine.FromJacobian(&c)
+		ret.Randomness[k] = pointBytes(scalarMulBase(&rk))
+		ret.Ciphertexts[k] = pointBytes(&cAffine)
+
+		var weighted fr.Element
+		weighted.Mul(&rk, chunkWeight(k))
+		r.Add(&r, &weighted)
+	}
+
+	combinedR, combinedC, err := recombine(ret)
+	if err != nil {
+		return nil, err
+	}
+	var masked bls12381.G1Affine
+	masked.Sub(combinedC, scalarMulBase(share))
+	ret.Proof, err = proveDLEQ(context, id, &r, g1(), combinedR, pk, &masked)
+	if err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// verifyShare checks the recombined ciphertext C and randomness R satisfy C - v = r·PK with R = r·G
+func verifyShare(context []byte, pk *bls12381.G1Affine, share *EncryptedShare, v bls12381.G1Affine) error {
+	if len(share.Randomness) != Chunks || len(share.Ciphertexts) != Chunks {
+		return fmt.Errorf("expected %d chunks", Chunks)
+	}
+	if share.Proof == nil {
+		return fmt.Errorf("missing proof")
+	}
+	combinedR, combinedC, err := recombine(share)
+	if err != nil {
+		return err
+	}
+	var masked bls12381.G1Affine
+	masked.Sub(combinedC, &v)
+	return verifyDLEQ(context, share.ID, g1(), combinedR, pk, &masked, share.Proof)
+}
+
+// recombine returns Σ 2^(16k)·R_k and Σ 2^(16k)·C_k
+func recombine(share *EncryptedShare) (*bls12381.G1Affine, *bls12381.G1Affine, error) {
+	var r, c bls12381.G1Jac
+	for k := range share.Randomness {
+		rk, err := parsePoint(share.Randomness[k])
+		if err != nil {
+			return nil, nil, fmt.Errorf("invalid randomness %d: %w", k, err)
+		}
+		ck, err := parsePoint(share.Ciphertexts[k])
+		if err != nil {
+			return nil, nil, fmt.Errorf("invalid ciphertext %d: %w", k, err)
+		}
+		var p bls12381.G1Jac
+		p.FromAffine(scalarMul(rk, chunkWeight(k)))
+		r.AddAssign(&p)
+		p.FromAffine(scalarMul(ck, chunkWeight(k)))
+		c.AddAssign(&p)
+	}
+	var rAffine, cAffine bls12381.G1Affine
+	rAffine.FromJacobian(&r)
+	cAffine.FromJacobian(&c)
+	return &rAffine, &cAffine, nil
+}
+
+func decryptChunk(sk *PrivateKey, share *EncryptedShare, k int) (uint16, error) {
+	r, err := parsePoint(share.Randomness[k])
+	if err != nil {
+		return 0, err
+	}
+	c, err := parsePoint(share.Ciphertexts[k])
+	if err != nil {
+		return 0, err
+	}
+	var m bls12381.G1Affine
+	m.Sub(c, scalarMul(r, &sk.s))
+	ret, found := chunkTable()[m.Bytes()]
+	if !found {
+		return 0, fmt.Errorf("chunk %d out of range", k)
+	}
+	return ret, nil
+}
+
+// proveDLEQ proves log_g(a) = log_h(b) = x
+func proveDLEQ(context []byte, id uint64, x *fr.Element, g, a, h, b *bls12381.G1Affine) (*Proof, error) {
+	var w fr.Element
+	if _, err := w.SetRandom(); err != nil {
+		return nil, err
+	}
+	challenge := dleqChallenge(context, id, g, a, h, b, scalarMul(g, &w), scalarMul(h, &w))
+	var response fr.Element
+	response.Mul(&challenge, x)
+	response.Sub(&w, &response)
+	c, z := challenge.Bytes(), response.Bytes()
+	return &Proof{Challenge: c[:], Response: z[:]}, nil
+}
+
+func verifyDLEQ(context []byte, id uint64, g, a, h, b *bls12381.G1Affine, proof *Proof) error {
+	if proof == nil {
+		return fmt.Errorf("missing proof")
+	}
+	var challenge, response fr.Element
+	if err := challenge.SetBytesCanonical(proof.Challenge); err != nil {
+		return fmt.Errorf("invalid proof challenge: %w", err)
+	}
+	if err := response.SetBytesCanonical(proof.Response); err != nil {
+		return fmt.Errorf("invalid proof response: %w", err)
+	}
+	// w·g = z·g + c·a and w·h = z·h + c·b
+	var commitG, commitH bls12381.G1Affine
+	commitG.Add(scalarMul(g, &response), scalarMul(a, &challenge))
+	commitH.Add(scalarMul(h, &response), scalarMul(b, &challenge))
+	if expected := dleqChallenge(context, id, g, a, h, b, &commitG, &commitH); !expected.Equal(&challenge) {
+		return fmt.Errorf("invalid proof")
+	}
+	return nil
+}
+
+func dleqChallenge(context []byte, id uint64, points ...*bls12381.G1Affine) fr.Element {
+	h := sha256.New()
+	h.Write(proofDomain)
+	h.Write(binary.LittleEndian.AppendUint64(nil, uint64(len(context))))
+	h.Write(context)
+	h.Write(binary.LittleEndian.AppendUint64(nil, id))
+	for _, p := range points {
+		b := p.Bytes()
+		h.Write(b[:])
+	}
+	var ret fr.Element
+	ret.SetBytes(h.Sum(nil))
+	return ret
+}
+
+var (
+	chunkTableOnce sync.Once
+	chunkTableMap  map[[pointLen]byte]uint16
+)
+
+// chunkTable maps m·G to m for every chunk value m
+func chunkTable() map[[pointLen]byte]uint16 {
+	chunkTableOnce.Do(func() {
+		g := g1()
+		points := make([]bls12381.G1Jac, 1<<ChunkBits)
+		for m := 1; m < len(points); m++ {
+			points[m].Set(&points[m-1])
+			var gJac bls12381.G1Jac
+			gJac.FromAffine(g)
+			points[m].AddAssign(&gJac)
+		}
+		affine := bls12381.BatchJacobianToAffineG1(points)
+		chunkTableMap = make(map[[pointLen]byte]uint16, len(affine))
+		for m := range affine {
+			chunkTableMap[affine[m].Bytes()] = uint16(m)
+		}
+	})
+	return chunkTableMap
+}
+
+// chunkWeight returns 2^(16k)
+func chunkWeight(k int) *fr.Element {
+	var ret fr.Element
+	ret.SetBigInt(new(big.Int).Lsh(big.NewInt(1), uint(k*ChunkBits)))
+	return &ret
+}
+
+func evaluate(coefficients []fr.Element, id uint64) fr.Element {
+	var x, ret fr.Element
+	x.SetUint64(id)
+	for i := len(coefficients) - 1; i >= 0; i-- {
+		ret.Mul(&ret, &x).Add(&ret, &coefficients[i])
+	}
+	return ret
+}
+
+func evaluateCommitments(commitments []*bls12381.G1Affine, id uint64) bls12381.G1Affine {
+	var x, power fr.Element
+	x.SetUint64(id)
+	power.SetOne()
+	var ret bls12381.G1Jac
+	for _, commitment := range commitments {
+		var term bls12381.G1Jac
+		term.FromAffine(scalarMul(commitment, &power))
+		ret.AddAssign(&term)
+		power.Mul(&power, &x)
+	}
+	var affine bls12381.G1Affine
+	affine.FromJacobian(&ret)
+	return affine
+}
+
+func findShare(transcript *Transcript, id uint64) (*EncryptedShare, error) {
+	for _, share := range transcript.Shares {
+		if share.ID == id {
+			return share, nil
+		}
+	}
+	return nil, fmt.Errorf("no share for recipient %d", id)
+}
+
+func g1() *bls12381.G1Affine {
+	_, _, g, _ := bls12381.Generators()
+	return &g
+}
+
+func scalarMulBase(s *fr.Element) *bls12381.G1Affine {
+	return scalarMul(g1(), s)
+}
+
+func scalarMul(p *bls12381.G1Affine, s *fr.Element) *bls12381.G1Affine {
+	var si big.Int
+	s.BigInt(&si)
+	return new(bls12381.G1Affine).ScalarMultiplication(p, &si)
+}
+
+func pointBytes(p *bls12381.G1Affine) []byte {
+	b := p.Bytes()
+	return b[:]
+}
+
+func parsePoint(buf []byte) (*bls12381.G1Affine, error) {
+	if len(buf) != pointLen {
+		return nil, fmt.Errorf("invalid point length %d", len(buf))
+	}
+	ret := &bls12381.G1Affine{}
+	if _, err := ret.SetBytes(buf); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+func parsePublicKey(buf []byte) (*bls12381.G1Affine, error) {
+	ret, err := parsePoint(buf)
+	if err != nil {
+		return nil, err
+	}
+	if ret.IsInfinity() {
+		return nil, fmt.Errorf("identity public key")
+	}
+	return ret, nil
+}
+
+func parsePoints(bufs [][]byte) ([]*bls12381.G1Affine, error) {
+	ret := make([]*bls12381.G1Affine, len(bufs))
+	for i, buf := range bufs {
+		p, err := parsePoint(buf)
+		if err != nil {
+			return nil, err
+		}
+		ret[i] = p
+	}
+	return ret, nil
+}
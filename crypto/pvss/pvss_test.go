@@ -0,0 +1,134 @@
+package pvss
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/bloxapp/dkg-spec/crypto/bls"
+
+	"github.com/consensys/gnark-crypto/ecc/bls12-381/fr"
+	"github.com/stretchr/testify/require"
+)
+
+func recipients(t *testing.T, n int) ([]*Recipient, []*PrivateKey) {
+	ret := make([]*Recipient, n)
+	sks := make([]*PrivateKey, n)
+	for i := range ret {
+		sk, err := GenerateKey()
+		require.NoError(t, err)
+		sks[i] = sk
+		ret[i] = &Recipient{ID: uint64(i + 1), PubKey: sk.PublicKey()}
+	}
+	return ret, sks
+}
+
+func TestPVSS(t *testing.T) {
+	require.NoError(t, bls.Init())
+	context := []byte("ceremony")
+	secret := &bls.SecretKey{}
+	secret.SetByCSPRNG()
+	recipients, sks := recipients(t, 4)
+	transcript, err := Deal(context, secret.Serialize(), 3, recipients)
+	require.NoError(t, err)
+
+	t.Run("verify and decrypt", func(t *testing.T) {
+		require.NoError(t, Verify(context, transcript, 3, recipients))
+		require.EqualValues(t, secret.GetPublicKey().Serialize(), transcript.Commitments[0])
+
+		shares := make([]bls.SecretKey, 3)
+		ids := make([]bls.ID, 3)
+		for i := range shares {
+			byts, err := Decrypt(sks[i], recipients[i].ID, transcript)
+			require.NoError(t, err)
+			require.NoError(t, shares[i].Deserialize(byts))
+			sharePK, err := SharePublicKey(transcript, recipients[i].ID)
+			require.NoError(t, err)
+			require.EqualValues(t, sharePK, shares[i].GetPublicKey().Serialize())
+			require.NoError(t, ids[i].SetDecString(fmt.Sprintf("%d", recipients[i].ID)))
+		}
+		recovered := &bls.SecretKey{}
+		require.NoError(t, recovered.Recover(shares, ids))
+		require.True(t, recovered.IsEqual(secret))
+	})
+
+	t.Run("other context", func(t *testing.T) {
+		require.EqualError(t, Verify([]byte("other ceremony"), transcript, 3, recipients), "recipient 1: invalid proof")
+	})
+
+	t.Run("wrong threshold", func(t *testing.T) {
+		require.EqualError(t, Verify(context, transcript, 4, recipients), "3 commitments, expected 4")
+	})
+
+	t.Run("tampered chunk", func(t *testing.T) {
+		tampered := *transcript
+		tampered.Shares = append([]*EncryptedShare{}, transcript.Shares...)
+		share := *tampered.Shares[1]
+		share.Ciphertexts = append([][]byte{}, share.Ciphertexts...)
+		share.Ciphertexts[0], share.Ciphertexts[1] = share.Ciphertexts[1], share.Ciphertexts[0]
+		tampered.Shares[1] = &share
+		require.EqualError(t, Verify(context, &tampered, 3, recipients), "recipient 2: invalid proof")
+	})
+
+	t.Run("other recipient's key", func(t *testing.T) {
+		swapped := append([]*Recipient{}, recipients...)
+		swapped[0] = &Recipient{ID: 1, PubKey: recipients[1].PubKey}
+		require.EqualError(t, Verify(context, transcript, 3, swapped), "recipient 1: invalid proof")
+
+		_, err := Decrypt(sks[1], 1, transcript)
+		require.Error(t, err)
+	})
+
+	t.Run("out of range chunk", func(t *testing.T) {
+		// a valid transcript whose first chunk for recipient 1 is out of range: 2^16 + m_0, compensated by m_1 - 1
+		coefficients := make([]fr.Element, 3)
+		require.NoError(t, coefficients[0].SetBytesCanonical(secret.Serialize()))
+		_, err := coefficients[1].SetRandom()
+		require.NoError(t, err)
+		_, err = coefficients[2].SetRandom()
+		require.NoError(t, err)
+		malicious := &Transcript{}
+		for i := range coefficients {
+			malicious.Commitments = append(malicious.Commitments, pointBytes(scalarMulBase(&coefficients[i])))
+		}
+		for _, recipient := range recipients {
+			pk, err := parsePublicKey(recipient.PubKey)
+			require.NoError(t, err)
+			share := evaluate(coefficients, recipient.ID)
+			encrypted, err := encryptShare(context, recipient.ID, pk, &share)
+			require.NoError(t, err)
+			if recipient.ID == 1 {
+				b := share.Bytes()
+				chunks := make([]fr.Element, Chunks)
+				for k := range chunks {
+					chunks[k].SetUint64(uint64(b[len(b)-2*k-2])<<8 | uint64(b[len(b)-2*k-1]))
+				}
+				var one, overflow fr.Element
+				one.SetOne()
+				overflow.SetUint64(1 << ChunkBits)
+				chunks[0].Add(&chunks[0], &overflow)
+				chunks[1].Sub(&chunks[1], &one)
+				encrypted, err = encryptChunks(context, recipient.ID, pk, &share, chunks)
+				require.NoError(t, err)
+			}
+			malicious.Shares = append(malicious.Shares, encrypted)
+		}
+		require.NoError(t, Verify(context, malicious, 3, recipients))
+
+		_, err = Decrypt(sks[0], 1, malicious)
+		require.EqualError(t, err, "chunk 0 out of range")
+		failure, err := ProveDecryptionFailure(context, sks[0], 1, malicious, 0)
+		require.NoError(t, err)
+		require.NoError(t, VerifyDecryptionFailure(context, recipients[0].PubKey, malicious, failure))
+
+		// an honest chunk can't be blamed, nor can another recipient's key be used
+		failure, err = ProveDecryptionFailure(context, sks[0], 1, malicious, 2)
+		require.NoError(t, err)
+		require.EqualError(t, VerifyDecryptionFailure(context, recipients[0].PubKey, malicious, failure), "chunk 2 is in range")
+		require.EqualError(t, VerifyDecryptionFailure(context, recipients[1].PubKey, malicious, failure), "invalid proof")
+	})
+
+	t.Run("invalid threshold", func(t *testing.T) {
+		_, err := Deal(context, secret.Serialize(), 5, recipients)
+		require.EqualError(t, err, "invalid threshold 5 of 4 recipients")
+	})
+}
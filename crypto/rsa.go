@@ -1,6 +1,7 @@
 package crypto
 
 import (
+	"bytes"
 	"crypto"
 	"crypto/rand"
 	"crypto/rsa"
@@ -10,6 +11,7 @@ import (
 	"encoding/pem"
 	"errors"
 	"fmt"
+	"math/big"
 )
 
 // GenerateRSAKeys creates a random RSA key pair
@@ -38,9 +40,20 @@ func VerifyRSA(pk *rsa.PublicKey, msg, signature []byte) error {
 	return rsa.VerifyPSS(pk, crypto.SHA256, r[:], signature, nil)
 }
 
-// ParseRSAPublicKey parses encoded to base64 x509 RSA public key
+// MinRSAKeyBits is the minimal size of operator and initiator RSA keys
+const MinRSAKeyBits = 2048
+
+/*
+ParseRSAPublicKey parses an RSA public key in SSV's operator public key format: the base64 encoding of a PEM encoded PKIX
+public key (see EncodeRSAPublicKey), surrounding whitespace ignored. The key may also be ABI encoded as a string, as
+registered on-chain (e.g. the publicKey of SSV's OperatorAdded event).
+Returns error if the key isn't an RSA key of at least MinRSAKeyBits bits.
+*/
 func ParseRSAPublicKey(pk []byte) (*rsa.PublicKey, error) {
-	operatorKeyByte, err := base64.StdEncoding.DecodeString(string(pk))
+	if abiString, ok := decodeABIString(pk); ok {
+		pk = abiString
+	}
+	operatorKeyByte, err := base64.StdEncoding.DecodeString(string(bytes.TrimSpace(pk)))
 	if err != nil {
 		return nil, err
 	}
@@ -48,11 +61,24 @@ func ParseRSAPublicKey(pk []byte) (*rsa.PublicKey, error) {
 	if pemblock == nil {
 		return nil, errors.New("decode PEM block")
 	}
-	pbkey, err := x509.ParsePKIXPublicKey(pemblock.Bytes)
-	if err != nil {
-		return nil, err
+	return parseRSAPublicKeyPEM(pemblock)
+}
+
+// decodeABIString returns the string ABI encoded as the single value of data, false if data isn't an ABI encoded string
+func decodeABIString(data []byte) ([]byte, bool) {
+	if len(data) < 64 || (len(data)-64)%32 != 0 || new(big.Int).SetBytes(data[:32]).Cmp(big.NewInt(32)) != 0 {
+		return nil, false
+	}
+	length := new(big.Int).SetBytes(data[32:64])
+	padded := uint64(len(data) - 64)
+	if !length.IsUint64() || length.Uint64() > padded || padded-length.Uint64() >= 32 {
+		return nil, false
+	}
+	end := 64 + length.Uint64()
+	if !bytes.Equal(data[end:], make([]byte, len(data)-int(end))) {
+		return nil, false
 	}
-	return pbkey.(*rsa.PublicKey), nil
+	return data[64:end], true
 }
 
 func EncodeRSAPublicKey(pk *rsa.PublicKey) ([]byte, error) {
@@ -77,3 +103,8 @@ func EncodeRSAPublicKey(pk *rsa.PublicKey) ([]byte, error) {
 func Encrypt(pub *rsa.PublicKey, msg []byte) ([]byte, error) {
 	return rsa.EncryptPKCS1v15(rand.Reader, pub, msg)
 }
+
+// Decrypt with RSA private key a DKG share key encrypted by Encrypt
+func Decrypt(sk *rsa.PrivateKey, ciphertext []byte) ([]byte, error) {
+	return rsa.DecryptPKCS1v15(rand.Reader, sk, ciphertext)
+}
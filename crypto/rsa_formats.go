@@ -0,0 +1,130 @@
+package crypto
+
+import (
+	"bytes"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"math/big"
+)
+
+/*
+ParseAnyRSAPublicKey parses an RSA public key supplied in any of the formats external key management systems export:
+
+  - SSV's operator public key format, base64 or ABI encoded (see ParseRSAPublicKey)
+  - PEM, a "PUBLIC KEY" (PKIX) or "RSA PUBLIC KEY" (PKIX or PKCS#1) block
+  - raw DER, PKIX or PKCS#1
+  - JWK (RFC 7517), a JSON object with kty "RSA" and the public n and e members
+
+Returns error if the key isn't an RSA public key of at least MinRSAKeyBits bits.
+*/
+func ParseAnyRSAPublicKey(pk []byte) (*rsa.PublicKey, error) {
+	if len(pk) > 0 && pk[0] == 0x30 {
+		return parseRSAPublicKeyDER(pk)
+	}
+	trimmed := bytes.TrimSpace(pk)
+	switch {
+	case bytes.HasPrefix(trimmed, []byte("{")):
+		return parseRSAPublicKeyJWK(trimmed)
+	case bytes.HasPrefix(trimmed, []byte("-----BEGIN")):
+		block, _ := pem.Decode(trimmed)
+		if block == nil {
+			return nil, errors.New("decode PEM block")
+		}
+		return parseRSAPublicKeyPEM(block)
+	default:
+		return ParseRSAPublicKey(pk)
+	}
+}
+
+// NormalizeRSAPublicKey returns the canonical encoding (see EncodeRSAPublicKey) of an RSA public key in any format
+// ParseAnyRSAPublicKey accepts. Operator public keys are hashed in their canonical encoding, so the same key always yields
+// the same messages and proofs whatever format it was supplied in.
+func NormalizeRSAPublicKey(pk []byte) ([]byte, error) {
+	parsed, err := ParseAnyRSAPublicKey(pk)
+	if err != nil {
+		return nil, err
+	}
+	return EncodeRSAPublicKey(parsed)
+}
+
+// parseRSAPublicKeyPEM parses an RSA public key PEM block, SSV's "RSA PUBLIC KEY" blocks hold PKIX keys
+func parseRSAPublicKeyPEM(block *pem.Block) (*rsa.PublicKey, error) {
+	if block.Type != "RSA PUBLIC KEY" && block.Type != "PUBLIC KEY" {
+		return nil, fmt.Errorf("unexpected PEM block type %s", block.Type)
+	}
+	if block.Type == "PUBLIC KEY" {
+		key, err := x509.ParsePKIXPublicKey(block.Bytes)
+		if err != nil {
+			return nil, err
+		}
+		return checkRSAPublicKey(key)
+	}
+	return parseRSAPublicKeyDER(block.Bytes)
+}
+
+// parseRSAPublicKeyDER parses a PKIX or PKCS#1 DER encoded RSA public key
+func parseRSAPublicKeyDER(der []byte) (*rsa.PublicKey, error) {
+	key, err := x509.ParsePKIXPublicKey(der)
+	if err != nil {
+		pkcs1, pkcs1Err := x509.ParsePKCS1PublicKey(der)
+		if pkcs1Err != nil {
+			return nil, err
+		}
+		key = pkcs1
+	}
+	return checkRSAPublicKey(key)
+}
+
+type rsaPublicJWK struct {
+	Kty string `json:"kty"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+	D   string `json:"d"`
+}
+
+// parseRSAPublicKeyJWK parses an RSA public JWK, JWKs holding a private key are rejected
+func parseRSAPublicKeyJWK(data []byte) (*rsa.PublicKey, error) {
+	var jwk rsaPublicJWK
+	if err := json.Unmarshal(data, &jwk); err != nil {
+		return nil, fmt.Errorf("invalid JWK: %v", err)
+	}
+	if jwk.Kty != "RSA" {
+		return nil, fmt.Errorf("unexpected JWK key type %q", jwk.Kty)
+	}
+	if jwk.D != "" {
+		return nil, errors.New("JWK holds a private key")
+	}
+	n, err := base64.RawURLEncoding.DecodeString(jwk.N)
+	if err != nil || len(n) == 0 || n[0] == 0 {
+		return nil, errors.New("invalid JWK modulus")
+	}
+	e, err := base64.RawURLEncoding.DecodeString(jwk.E)
+	if err != nil || len(e) == 0 || len(e) > 4 || e[0] == 0 {
+		return nil, errors.New("invalid JWK exponent")
+	}
+	ret := &rsa.PublicKey{
+		N: new(big.Int).SetBytes(n),
+		E: int(new(big.Int).SetBytes(e).Int64()),
+	}
+	if ret.E < 3 || ret.E%2 == 0 {
+		return nil, errors.New("invalid JWK exponent")
+	}
+	return checkRSAPublicKey(ret)
+}
+
+// checkRSAPublicKey returns key if it's an RSA public key of at least MinRSAKeyBits bits
+func checkRSAPublicKey(key interface{}) (*rsa.PublicKey, error) {
+	ret, ok := key.(*rsa.PublicKey)
+	if !ok {
+		return nil, errors.New("not an RSA public key")
+	}
+	if ret.N.BitLen() < MinRSAKeyBits {
+		return nil, fmt.Errorf("RSA key of %d bits, at least %d expected", ret.N.BitLen(), MinRSAKeyBits)
+	}
+	return ret, nil
+}
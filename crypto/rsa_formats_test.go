@@ -0,0 +1,71 @@
+package crypto
+
+import (
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseAnyRSAPublicKey(t *testing.T) {
+	sk, pk, err := GenerateRSAKeys()
+	require.NoError(t, err)
+	canonical, err := EncodeRSAPublicKey(pk)
+	require.NoError(t, err)
+	pkix, err := x509.MarshalPKIXPublicKey(pk)
+	require.NoError(t, err)
+	pkcs1 := x509.MarshalPKCS1PublicKey(pk)
+	jwk := fmt.Sprintf(`{"kty": "RSA", "n": %q, "e": %q}`,
+		base64.RawURLEncoding.EncodeToString(pk.N.Bytes()),
+		base64.RawURLEncoding.EncodeToString(big.NewInt(int64(pk.E)).Bytes()),
+	)
+
+	for name, encoded := range map[string][]byte{
+		"ssv":            canonical,
+		"pem pkix":       pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: pkix}),
+		"pem pkcs1":      pem.EncodeToMemory(&pem.Block{Type: "RSA PUBLIC KEY", Bytes: pkcs1}),
+		"der pkix":       pkix,
+		"der pkcs1":      pkcs1,
+		"jwk":            []byte(jwk),
+		"jwk whitespace": []byte("\n" + jwk + "\n"),
+	} {
+		encoded := encoded
+		t.Run(name, func(t *testing.T) {
+			parsed, err := ParseAnyRSAPublicKey(encoded)
+			require.NoError(t, err)
+			require.True(t, pk.Equal(parsed))
+			normalized, err := NormalizeRSAPublicKey(encoded)
+			require.NoError(t, err)
+			require.EqualValues(t, canonical, normalized)
+		})
+	}
+
+	t.Run("private JWK", func(t *testing.T) {
+		private := fmt.Sprintf(`{"kty": "RSA", "n": "AQAB", "e": "AQAB", "d": %q}`, base64.RawURLEncoding.EncodeToString(sk.D.Bytes()))
+		_, err := ParseAnyRSAPublicKey([]byte(private))
+		require.EqualError(t, err, "JWK holds a private key")
+	})
+
+	t.Run("invalid JWK", func(t *testing.T) {
+		_, err := ParseAnyRSAPublicKey([]byte(`{"kty": "EC"}`))
+		require.EqualError(t, err, `unexpected JWK key type "EC"`)
+		_, err = ParseAnyRSAPublicKey([]byte(`{"kty": "RSA", "n": "AQAB", "e": "AAE"}`))
+		require.EqualError(t, err, "invalid JWK exponent")
+		_, err = ParseAnyRSAPublicKey([]byte(`{"kty": "RSA", "n": "AQAB", "e": "AQAB"}`))
+		require.EqualError(t, err, "RSA key of 17 bits, at least 2048 expected")
+	})
+
+	t.Run("invalid PEM", func(t *testing.T) {
+		_, err := ParseAnyRSAPublicKey(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: pkix}))
+		require.EqualError(t, err, "unexpected PEM block type CERTIFICATE")
+	})
+
+	t.Run("invalid DER", func(t *testing.T) {
+		_, err := ParseAnyRSAPublicKey(pkix[:len(pkix)-1])
+		require.Error(t, err)
+	})
+}
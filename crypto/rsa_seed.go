@@ -0,0 +1,117 @@
+package crypto
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rsa"
+	"crypto/sha256"
+	"fmt"
+	"io"
+	"math/big"
+
+	"github.com/tyler-smith/go-bip39"
+	"golang.org/x/crypto/hkdf"
+)
+
+const (
+	// MinRSASeedLen is the minimal length of the seed an operator's RSA key is derived from
+	MinRSASeedLen = 32
+	// rsaKeyBits is the size of operator RSA keys, see GenerateRSAKeys
+	rsaKeyBits = 2048
+	// rsaPublicExponent is the public exponent of operator RSA keys
+	rsaPublicExponent = 65537
+)
+
+// rsaSeedInfo is the HKDF info operator RSA keys are derived with
+var rsaSeedInfo = []byte("DKG operator RSA key")
+
+/*
+GenerateRSAKeysFromSeed derives an operator's RSA key pair deterministically from seed, so an operator recovers its identity
+(and the shares encrypted to it) from a backup of the seed:
+
+ 1. a 32 bytes key is derived from seed with HKDF-SHA256 (no salt, info "DKG operator RSA key")
+ 2. the key seeds an AES-256-CTR stream (zero IV)
+ 3. prime candidates are read from the stream, 128 bytes each (big-endian), with their two most significant bits and least
+    significant bit set; the first candidate c that is prime (see big.Int ProbablyPrime(20)) with c-1 coprime to e is p,
+    the next such candidate other than p is q
+ 4. the key is the 2048 bits key of p and q with public exponent e = 65537 and d = e^-1 mod (p-1)(q-1)
+*/
+func GenerateRSAKeysFromSeed(seed []byte) (*rsa.PrivateKey, *rsa.PublicKey, error) {
+	if len(seed) < MinRSASeedLen {
+		return nil, nil, fmt.Errorf("seed shorter than %d bytes", MinRSASeedLen)
+	}
+	key := make([]byte, 32)
+	if _, err := io.ReadFull(hkdf.New(sha256.New, seed, nil, rsaSeedInfo), key); err != nil {
+		return nil, nil, err
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, nil, err
+	}
+	stream := cipher.NewCTR(block, make([]byte, aes.BlockSize))
+
+	e := big.NewInt(rsaPublicExponent)
+	p := seededPrime(stream, e, nil)
+	q := seededPrime(stream, e, p)
+
+	one := big.NewInt(1)
+	phi := new(big.Int).Mul(new(big.Int).Sub(p, one), new(big.Int).Sub(q, one))
+	sk := &rsa.PrivateKey{
+		PublicKey: rsa.PublicKey{
+			N: new(big.Int).Mul(p, q),
+			E: rsaPublicExponent,
+		},
+		D:      new(big.Int).ModInverse(e, phi),
+		Primes: []*big.Int{p, q},
+	}
+	if err := sk.Validate(); err != nil {
+		return nil, nil, err
+	}
+	sk.Precompute()
+	return sk, &sk.PublicKey, nil
+}
+
+// seededPrime returns the next prime p of rsaKeyBits/2 bits read from stream such that p-1 is coprime to e and p isn't other
+func seededPrime(stream cipher.Stream, e, other *big.Int) *big.Int {
+	buf := make([]byte, rsaKeyBits/16)
+	one := big.NewInt(1)
+	for {
+		for i := range buf {
+			buf[i] = 0
+		}
+		stream.XORKeyStream(buf, buf)
+		buf[0] |= 0xc0
+		buf[len(buf)-1] |= 1
+		ret := new(big.Int).SetBytes(buf)
+		if other != nil && ret.Cmp(other) == 0 {
+			continue
+		}
+		if !ret.ProbablyPrime(20) {
+			continue
+		}
+		if new(big.Int).GCD(nil, nil, e, new(big.Int).Sub(ret, one)).Cmp(one) != 0 {
+			continue
+		}
+		return ret
+	}
+}
+
+// NewRSAMnemonic returns a random 24 words BIP-39 mnemonic to back up an operator's RSA key with, see
+// GenerateRSAKeysFromMnemonic
+func NewRSAMnemonic() (string, error) {
+	entropy, err := bip39.NewEntropy(256)
+	if err != nil {
+		return "", err
+	}
+	return bip39.NewMnemonic(entropy)
+}
+
+// GenerateRSAKeysFromMnemonic derives an operator's RSA key pair from a BIP-39 mnemonic and optional password: the BIP-39
+// seed of the mnemonic is the seed of GenerateRSAKeysFromSeed
+func GenerateRSAKeysFromMnemonic(mnemonic, password string) (*rsa.PrivateKey, *rsa.PublicKey, error) {
+	seed, err := bip39.NewSeedWithErrorChecking(mnemonic, password)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid mnemonic: %v", err)
+	}
+	return GenerateRSAKeysFromSeed(seed)
+}
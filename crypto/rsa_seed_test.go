@@ -0,0 +1,76 @@
+package crypto
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenerateRSAKeysFromSeed(t *testing.T) {
+	seed := bytes.Repeat([]byte{0x01}, MinRSASeedLen)
+	sk, pk, err := GenerateRSAKeysFromSeed(seed)
+	require.NoError(t, err)
+	require.Equal(t, 2048, pk.N.BitLen())
+	require.Equal(t, 65537, pk.E)
+	require.NoError(t, sk.Validate())
+
+	t.Run("deterministic", func(t *testing.T) {
+		again, _, err := GenerateRSAKeysFromSeed(seed)
+		require.NoError(t, err)
+		require.True(t, sk.Equal(again))
+
+		other, _, err := GenerateRSAKeysFromSeed(bytes.Repeat([]byte{0x02}, MinRSASeedLen))
+		require.NoError(t, err)
+		require.False(t, sk.Equal(other))
+	})
+
+	t.Run("known key", func(t *testing.T) {
+		fingerprint := sha256.Sum256(pk.N.Bytes())
+		require.EqualValues(t, "55dafc3da79fae48ccef66523d9e2ac5093dadfb336e685933a79bc12e84381b", hex.EncodeToString(fingerprint[:]))
+	})
+
+	t.Run("sign and encrypt", func(t *testing.T) {
+		sig, err := SignRSA(sk, []byte("msg"))
+		require.NoError(t, err)
+		require.NoError(t, VerifyRSA(pk, []byte("msg"), sig))
+
+		ciphertext, err := EncryptHybrid(pk, []byte("share"), nil)
+		require.NoError(t, err)
+		recovered, _, err := GenerateRSAKeysFromSeed(seed)
+		require.NoError(t, err)
+		msg, err := DecryptHybrid(recovered, ciphertext, nil)
+		require.NoError(t, err)
+		require.EqualValues(t, []byte("share"), msg)
+	})
+
+	t.Run("short seed", func(t *testing.T) {
+		_, _, err := GenerateRSAKeysFromSeed(seed[:MinRSASeedLen-1])
+		require.EqualError(t, err, "seed shorter than 32 bytes")
+	})
+}
+
+func TestGenerateRSAKeysFromMnemonic(t *testing.T) {
+	mnemonic, err := NewRSAMnemonic()
+	require.NoError(t, err)
+	require.Len(t, bytes.Fields([]byte(mnemonic)), 24)
+
+	sk, _, err := GenerateRSAKeysFromMnemonic(mnemonic, "")
+	require.NoError(t, err)
+	again, _, err := GenerateRSAKeysFromMnemonic(mnemonic, "")
+	require.NoError(t, err)
+	require.True(t, sk.Equal(again))
+
+	t.Run("password", func(t *testing.T) {
+		other, _, err := GenerateRSAKeysFromMnemonic(mnemonic, "password")
+		require.NoError(t, err)
+		require.False(t, sk.Equal(other))
+	})
+
+	t.Run("invalid mnemonic", func(t *testing.T) {
+		_, _, err := GenerateRSAKeysFromMnemonic("abandon abandon", "")
+		require.ErrorContains(t, err, "invalid mnemonic")
+	})
+}
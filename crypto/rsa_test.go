@@ -0,0 +1,80 @@
+package crypto
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/stretchr/testify/require"
+)
+
+// encodePublicKey returns the base64 encoded PEM block of a PKIX encoded public key
+func encodePublicKey(t *testing.T, pk interface{}, blockType string) []byte {
+	der, err := x509.MarshalPKIXPublicKey(pk)
+	require.NoError(t, err)
+	return []byte(base64.StdEncoding.EncodeToString(pem.EncodeToMemory(&pem.Block{Type: blockType, Bytes: der})))
+}
+
+func TestParseRSAPublicKey(t *testing.T) {
+	_, pk, err := GenerateRSAKeys()
+	require.NoError(t, err)
+	encoded, err := EncodeRSAPublicKey(pk)
+	require.NoError(t, err)
+
+	t.Run("operator public key", func(t *testing.T) {
+		parsed, err := ParseRSAPublicKey(encoded)
+		require.NoError(t, err)
+		require.True(t, pk.Equal(parsed))
+
+		parsed, err = ParseRSAPublicKey(append(append([]byte(" "), encoded...), '\n'))
+		require.NoError(t, err)
+		require.True(t, pk.Equal(parsed))
+
+		parsed, err = ParseRSAPublicKey(encodePublicKey(t, pk, "PUBLIC KEY"))
+		require.NoError(t, err)
+		require.True(t, pk.Equal(parsed))
+	})
+
+	t.Run("abi encoded", func(t *testing.T) {
+		stringType, err := abi.NewType("string", "", nil)
+		require.NoError(t, err)
+		onchain, err := abi.Arguments{{Type: stringType}}.Pack(string(encoded))
+		require.NoError(t, err)
+		parsed, err := ParseRSAPublicKey(onchain)
+		require.NoError(t, err)
+		require.True(t, pk.Equal(parsed))
+
+		onchain[len(onchain)-1] = 1
+		_, err = ParseRSAPublicKey(onchain)
+		require.Error(t, err)
+	})
+
+	t.Run("not an RSA key", func(t *testing.T) {
+		sk, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		require.NoError(t, err)
+		_, err = ParseRSAPublicKey(encodePublicKey(t, &sk.PublicKey, "PUBLIC KEY"))
+		require.EqualError(t, err, "not an RSA public key")
+	})
+
+	t.Run("short key", func(t *testing.T) {
+		sk, err := rsa.GenerateKey(rand.Reader, 1024)
+		require.NoError(t, err)
+		_, err = ParseRSAPublicKey(encodePublicKey(t, &sk.PublicKey, "RSA PUBLIC KEY"))
+		require.EqualError(t, err, "RSA key of 1024 bits, at least 2048 expected")
+	})
+
+	t.Run("invalid encoding", func(t *testing.T) {
+		_, err := ParseRSAPublicKey([]byte("not base64!"))
+		require.Error(t, err)
+		_, err = ParseRSAPublicKey([]byte(base64.StdEncoding.EncodeToString([]byte("not PEM"))))
+		require.EqualError(t, err, "decode PEM block")
+		_, err = ParseRSAPublicKey(encodePublicKey(t, pk, "PRIVATE KEY"))
+		require.EqualError(t, err, "unexpected PEM block type PRIVATE KEY")
+	})
+}
@@ -0,0 +1,32 @@
+package crypto
+
+import (
+	"crypto/rand"
+	"fmt"
+)
+
+// Seal encrypts msg with AES-GCM key, label is the GCM additional data and decryption fails with any other label.
+// The ciphertext is the random GCM nonce followed by the sealed msg.
+func Seal(key, msg, label []byte) ([]byte, error) {
+	gcm, err := newGCM(key)
+	if err != nil {
+		return nil, err
+	}
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+	return gcm.Seal(nonce, nonce, msg, label), nil
+}
+
+// Open decrypts a ciphertext of Seal with key and label
+func Open(key, ciphertext, label []byte) ([]byte, error) {
+	gcm, err := newGCM(key)
+	if err != nil {
+		return nil, err
+	}
+	if len(ciphertext) < gcm.NonceSize() {
+		return nil, fmt.Errorf("ciphertext too short")
+	}
+	return gcm.Open(nil, ciphertext[:gcm.NonceSize()], ciphertext[gcm.NonceSize():], label)
+}
@@ -0,0 +1,39 @@
+package crypto
+
+import (
+	"crypto/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSeal(t *testing.T) {
+	key := make([]byte, KDFKeyLen)
+	_, err := rand.Read(key)
+	require.NoError(t, err)
+
+	ciphertext, err := Seal(key, []byte("state"), []byte("label"))
+	require.NoError(t, err)
+	require.Len(t, ciphertext, 12+len("state")+16)
+
+	t.Run("open", func(t *testing.T) {
+		msg, err := Open(key, ciphertext, []byte("label"))
+		require.NoError(t, err)
+		require.EqualValues(t, []byte("state"), msg)
+	})
+
+	t.Run("other label", func(t *testing.T) {
+		_, err := Open(key, ciphertext, []byte("other label"))
+		require.Error(t, err)
+	})
+
+	t.Run("too short", func(t *testing.T) {
+		_, err := Open(key, ciphertext[:8], []byte("label"))
+		require.EqualError(t, err, "ciphertext too short")
+	})
+
+	t.Run("invalid key", func(t *testing.T) {
+		_, err := Seal(key[:16], []byte("state"), []byte("label"))
+		require.EqualError(t, err, "invalid hybrid key length")
+	})
+}
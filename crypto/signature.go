@@ -13,34 +13,43 @@ import (
 	ssz "github.com/ferranbt/fastssz"
 )
 
-// VerifySignedMessageByOwner returns nil if signature over message is valid (signed by owner)
+// VerifySignedMessageByOwner returns nil if signature over message is valid (signed by owner).
+// Signatures of Safe owners are first combined with eip1271.SafeSignatures.
+// If client is nil the owner is assumed to be an EOA and the signature is verified offline (see VerifySignedMessageByEOA),
+// contract owners can only be verified with a client.
 func VerifySignedMessageByOwner(
 	client eip1271.ETHClient,
 	owner [20]byte,
 	msg ssz.HashRoot,
 	signature []byte,
 ) error {
-	isEOASignature, err := IsEOAAccount(client, owner)
+	hash, err := msg.HashTreeRoot()
 	if err != nil {
 		return err
 	}
+	return VerifySignedRootByOwner(client, owner, hash, signature)
+}
 
-	hash, err := msg.HashTreeRoot()
+// VerifySignedRootByOwner is VerifySignedMessageByOwner over the root the owner signed
+func VerifySignedRootByOwner(
+	client eip1271.ETHClient,
+	owner [20]byte,
+	hash [32]byte,
+	signature []byte,
+) error {
+	if client == nil {
+		return verifyEOASignature(owner, hash, signature)
+	}
+
+	isEOASignature, err := IsEOAAccount(client, owner)
 	if err != nil {
 		return err
 	}
 
 	if isEOASignature {
-		pk, err := eth_crypto.SigToPub(hash[:], signature)
-		if err != nil {
+		if err := verifyEOASignature(owner, hash, signature); err != nil {
 			return err
 		}
-
-		address := eth_crypto.PubkeyToAddress(*pk)
-
-		if common.Address(owner).Cmp(address) != 0 {
-			return fmt.Errorf("invalid signed reshare signature")
-		}
 	} else {
 		// EIP 1271 signature
 		// gnosis implementation https://github.com/safe-global/safe-smart-account/blob/2278f7ccd502878feb5cec21dd6255b82df374b5/contracts/Safe.sol#L265
@@ -76,3 +85,47 @@ func IsEOAAccount(client eip1271.ETHClient, address common.Address) (bool, error
 	}
 	return len(code) == 0, nil
 }
+
+// VerifySignedMessageByEOA returns nil if signature over message is signed by the EOA owner, recovering the signer locally without any chain access
+func VerifySignedMessageByEOA(owner [20]byte, msg ssz.HashRoot, signature []byte) error {
+	hash, err := msg.HashTreeRoot()
+	if err != nil {
+		return err
+	}
+	return verifyEOASignature(owner, hash, signature)
+}
+
+func verifyEOASignature(owner [20]byte, hash [32]byte, signature []byte) error {
+	if err := ValidateSignatureMalleability(signature); err != nil {
+		return err
+	}
+	pk, err := eth_crypto.SigToPub(hash[:], signature)
+	if err != nil {
+		return err
+	}
+
+	address := eth_crypto.PubkeyToAddress(*pk)
+
+	if common.Address(owner).Cmp(address) != 0 {
+		// hardware wallets sign the root as a personal message
+		pk, err = eth_crypto.SigToPub(personalMessageHash(hash), signature)
+		if err != nil || common.Address(owner).Cmp(eth_crypto.PubkeyToAddress(*pk)) != 0 {
+			return fmt.Errorf("invalid signed reshare signature")
+		}
+	}
+	return nil
+}
+
+// ValidateSignatureMalleability returns nil if signature is a canonical 65 byte [R || S || V] ECDSA signature: V is 0 or 1 and S is in the lower half of the curve order.
+// Rejecting the malleable (high S) twin of a signature makes owner signatures unique, e.g. as replay protection keys.
+func ValidateSignatureMalleability(signature []byte) error {
+	if len(signature) != 65 {
+		return fmt.Errorf("invalid signature length %d", len(signature))
+	}
+	r := new(big.Int).SetBytes(signature[:32])
+	s := new(big.Int).SetBytes(signature[32:64])
+	if !eth_crypto.ValidateSignatureValues(signature[64], r, s, true) {
+		return fmt.Errorf("malleable or invalid signature")
+	}
+	return nil
+}
@@ -1,6 +1,7 @@
 package crypto
 
 import (
+	"math/big"
 	"testing"
 
 	"github.com/bloxapp/dkg-spec/eip1271"
@@ -140,4 +141,58 @@ func TestVerifySignedReshare(t *testing.T) {
 			plain,
 			sig), "signature invalid")
 	})
+	t.Run("offline EOA signature", func(t *testing.T) {
+		sk, err := eth_crypto.GenerateKey()
+		require.NoError(t, err)
+		address := eth_crypto.PubkeyToAddress(sk.PublicKey)
+
+		plain := SSZBytes("testing vector")
+		hash, err := plain.HashTreeRoot()
+		require.NoError(t, err)
+
+		sig, err := eth_crypto.Sign(hash[:], sk)
+		require.NoError(t, err)
+
+		require.NoError(t, VerifySignedMessageByOwner(nil, address, plain, sig))
+		require.NoError(t, VerifySignedMessageByEOA(address, plain, sig))
+		require.EqualError(t, VerifySignedMessageByOwner(nil, [20]byte{}, plain, sig), "invalid signed reshare signature")
+
+		// contract signatures (e.g. concatenated Safe owner signatures) need a client
+		require.Error(t, VerifySignedMessageByOwner(nil, address, plain, append(sig, sig...)))
+	})
+	t.Run("malleable EOA signature", func(t *testing.T) {
+		stubClient := &stubs.Client{
+			CallContractF: func(call ethereum.CallMsg) ([]byte, error) {
+				return nil, nil
+			},
+		}
+		sk, err := eth_crypto.GenerateKey()
+		require.NoError(t, err)
+		address := eth_crypto.PubkeyToAddress(sk.PublicKey)
+
+		plain := SSZBytes("testing vector")
+		hash, err := plain.HashTreeRoot()
+		require.NoError(t, err)
+		sig, err := eth_crypto.Sign(hash[:], sk)
+		require.NoError(t, err)
+		require.NoError(t, ValidateSignatureMalleability(sig))
+
+		// (r, n - s, v ^ 1) recovers the same signer
+		twin := append([]byte{}, sig...)
+		s := new(big.Int).Sub(eth_crypto.S256().Params().N, new(big.Int).SetBytes(sig[32:64]))
+		s.FillBytes(twin[32:64])
+		twin[64] ^= 1
+		pk, err := eth_crypto.SigToPub(hash[:], twin)
+		require.NoError(t, err)
+		require.EqualValues(t, address, eth_crypto.PubkeyToAddress(*pk))
+
+		require.EqualError(t, ValidateSignatureMalleability(twin), "malleable or invalid signature")
+		require.EqualError(t, VerifySignedMessageByOwner(stubClient, address, plain, twin), "malleable or invalid signature")
+		require.EqualError(t, VerifySignedMessageByOwner(nil, address, plain, twin), "malleable or invalid signature")
+
+		unnormalized := append([]byte{}, sig...)
+		unnormalized[64] += 27
+		require.EqualError(t, ValidateSignatureMalleability(unnormalized), "malleable or invalid signature")
+		require.EqualError(t, ValidateSignatureMalleability(sig[:64]), "invalid signature length 64")
+	})
 }
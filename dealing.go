@@ -0,0 +1,142 @@
+//go:build !verifyonly
+
+package spec
+
+import (
+	"fmt"
+
+	"github.com/bloxapp/dkg-spec/crypto/bls"
+)
+
+// ProveDealing returns the commitments to the coefficients of the polynomial operatorID dealt in the DKG of requestID,
+// and their proof signed with the polynomial's constant term. Operators set them as their result's DealingCommitments
+// and DealingProof.
+func ProveDealing(requestID [24]byte, operatorID uint64, coefficients []bls.SecretKey) ([][]byte, []byte, error) {
+	if len(coefficients) == 0 {
+		return nil, nil, fmt.Errorf("no coefficients")
+	}
+	dealing := &Dealing{
+		RequestID:   requestID,
+		OperatorID:  operatorID,
+		Commitments: make([][]byte, len(coefficients)),
+	}
+	for i := range coefficients {
+		dealing.Commitments[i] = coefficients[i].GetPublicKey().Serialize()
+	}
+	root, err := dealing.SigningRoot()
+	if err != nil {
+		return nil, nil, err
+	}
+	return dealing.Commitments, coefficients[0].SignByte(root[:]).Serialize(), nil
+}
+
+// VerifyDealingProof returns nil if result commits to a polynomial of degree t-1 and proves knowledge of its constant term
+func VerifyDealingProof(t uint64, result *Result) error {
+	if uint64(len(result.DealingCommitments)) != t {
+		return codedError(CodeInconsistentDealing, "operator %d: %d dealing commitments, expected %d", result.OperatorID, len(result.DealingCommitments), t)
+	}
+	commitments, err := dealingCommitments(result)
+	if err != nil {
+		return err
+	}
+	dealing := &Dealing{
+		RequestID:   result.RequestID,
+		OperatorID:  result.OperatorID,
+		Commitments: result.DealingCommitments,
+	}
+	root, err := dealing.SigningRoot()
+	if err != nil {
+		return err
+	}
+	sig := &bls.Sign{}
+	if err := sig.Deserialize(result.DealingProof); err != nil {
+		return codedError(CodeInconsistentDealing, "operator %d: invalid dealing proof: %v", result.OperatorID, err)
+	}
+	if !sig.VerifyByte(&commitments[0], root[:]) {
+		return codedError(CodeInconsistentDealing, "operator %d: invalid dealing proof", result.OperatorID)
+	}
+	return nil
+}
+
+// AuditDealings returns nil if the operators of init dealt consistently in its DKG: every operator proved its dealing,
+// the validator public key is the sum of the dealt constant terms and each operator's share public key is the sum of
+// every dealt polynomial evaluated at its ID. It needs the results of all operators, as all of them deal.
+func AuditDealings(init *Init, results []*Result) error {
+	if len(results) != len(init.Operators) {
+		return codedError(CodeResultsCountMismatch, "dealing audit needs results of all %d operators", len(init.Operators))
+	}
+	ordered, err := CanonicalResults(results)
+	if err != nil {
+		return err
+	}
+
+	dealt := make([][]bls.PublicKey, len(ordered))
+	for i, result := range ordered {
+		if GetOperator(init.Operators, result.OperatorID) == nil {
+			return codedError(CodeOperatorNotFound, "operator %d not in the ceremony", result.OperatorID)
+		}
+		if err := VerifyDealingProof(init.T, result); err != nil {
+			return err
+		}
+		if dealt[i], err = dealingCommitments(result); err != nil {
+			return err
+		}
+	}
+
+	validatorPK := &bls.PublicKey{}
+	for i := range dealt {
+		if i == 0 {
+			*validatorPK = dealt[i][0]
+		} else {
+			validatorPK.Add(&dealt[i][0])
+		}
+	}
+	for _, result := range ordered {
+		proof := result.SignedProof.Proof
+		if proof == nil {
+			return fmt.Errorf("operator %d: missing proof", result.OperatorID)
+		}
+		expected := &bls.PublicKey{}
+		if err := expected.Deserialize(proof.ValidatorPubKey); err != nil {
+			return err
+		}
+		if !expected.IsEqual(validatorPK) {
+			return codedError(CodeInconsistentDealing, "operator %d: validator public key isn't the sum of the dealt constant terms", result.OperatorID)
+		}
+
+		id, err := blsID(result.OperatorID)
+		if err != nil {
+			return err
+		}
+		sharePK := &bls.PublicKey{}
+		for i := range dealt {
+			evaluated := &bls.PublicKey{}
+			if err := evaluated.Set(dealt[i], id); err != nil {
+				return err
+			}
+			if i == 0 {
+				sharePK = evaluated
+			} else {
+				sharePK.Add(evaluated)
+			}
+		}
+		expected = &bls.PublicKey{}
+		if err := expected.Deserialize(proof.SharePubKey); err != nil {
+			return err
+		}
+		if !expected.IsEqual(sharePK) {
+			return codedError(CodeInconsistentDealing, "operator %d: share public key doesn't match the dealings", result.OperatorID)
+		}
+	}
+	return nil
+}
+
+func dealingCommitments(result *Result) ([]bls.PublicKey, error) {
+	ret := make([]bls.PublicKey, len(result.DealingCommitments))
+	for i, commitment := range result.DealingCommitments {
+		if err := ret[i].Deserialize(commitment); err != nil {
+			return nil, codedError(CodeInconsistentDealing, "operator %d: invalid dealing commitment %d: %v", result.OperatorID, i, err)
+		}
+	}
+	return ret, nil
+}
@@ -0,0 +1,87 @@
+package spec
+
+import "bytes"
+
+// DepositChecker looks up deposits already made for a validator, e.g. in the deposit contract logs or on the beacon chain
+type DepositChecker interface {
+	// ExistingWithdrawalCredentials returns the withdrawal credentials of every known deposit for validatorPK
+	ExistingWithdrawalCredentials(validatorPK []byte) ([][]byte, error)
+}
+
+// ValidateNoConflictingDeposit returns nil if no deposit exists for validatorPK with withdrawal credentials other than withdrawalCredentials
+func ValidateNoConflictingDeposit(
+	checker DepositChecker,
+	validatorPK []byte,
+	withdrawalCredentials []byte,
+) error {
+	existing, err := checker.ExistingWithdrawalCredentials(validatorPK)
+	if err != nil {
+		return codedError(CodeChainLookupFailed, "failed to fetch existing deposits: %v", err)
+	}
+	for _, credentials := range existing {
+		if !bytes.Equal(credentials, withdrawalCredentials) {
+			return codedError(CodeConflictingDeposit, "validator already deposited with different withdrawal credentials")
+		}
+	}
+	return nil
+}
+
+// ValidatorStatus is a validator status as defined by the beacon node API
+type ValidatorStatus string
+
+const (
+	ValidatorPendingInitialized ValidatorStatus = "pending_initialized"
+	ValidatorPendingQueued      ValidatorStatus = "pending_queued"
+	ValidatorActiveOngoing      ValidatorStatus = "active_ongoing"
+	ValidatorActiveExiting      ValidatorStatus = "active_exiting"
+	ValidatorActiveSlashed      ValidatorStatus = "active_slashed"
+	ValidatorExitedUnslashed    ValidatorStatus = "exited_unslashed"
+	ValidatorExitedSlashed      ValidatorStatus = "exited_slashed"
+	ValidatorWithdrawalPossible ValidatorStatus = "withdrawal_possible"
+	ValidatorWithdrawalDone     ValidatorStatus = "withdrawal_done"
+)
+
+// Exiting returns true if the validator initiated (or was forced into) an exit
+func (s ValidatorStatus) Exiting() bool {
+	switch s {
+	case ValidatorPendingInitialized, ValidatorPendingQueued, ValidatorActiveOngoing:
+		return false
+	default:
+		return true
+	}
+}
+
+// ChainValidator is a validator registered on the beacon chain
+type ChainValidator struct {
+	Index                 uint64
+	Status                ValidatorStatus
+	WithdrawalCredentials []byte
+}
+
+// ValidatorChecker looks up validators registered on the beacon chain
+type ValidatorChecker interface {
+	// Validator returns validatorPK's beacon chain registration, nil if it's not registered
+	Validator(validatorPK []byte) (*ChainValidator, error)
+}
+
+// ValidateValidatorOnChain returns validatorPK's beacon chain registration if it's registered with withdrawalCredentials and not exiting
+func ValidateValidatorOnChain(
+	checker ValidatorChecker,
+	validatorPK []byte,
+	withdrawalCredentials []byte,
+) (*ChainValidator, error) {
+	validator, err := checker.Validator(validatorPK)
+	if err != nil {
+		return nil, codedError(CodeChainLookupFailed, "failed to fetch validator: %v", err)
+	}
+	if validator == nil {
+		return nil, codedError(CodeValidatorNotFound, "validator not found on chain")
+	}
+	if !bytes.Equal(validator.WithdrawalCredentials, withdrawalCredentials) {
+		return nil, codedError(CodeWithdrawalCredentialsMismatch, "validator withdrawal credentials mismatch")
+	}
+	if validator.Status.Exiting() {
+		return nil, codedError(CodeValidatorExiting, "validator is exiting or exited (%s)", validator.Status)
+	}
+	return validator, nil
+}
@@ -0,0 +1,119 @@
+package depositcontract
+
+import (
+	"bytes"
+	"context"
+	_ "embed"
+	"encoding/binary"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+//go:embed abi.abi
+var abiJSON []byte
+
+// ABI is the subset of the beacon chain deposit contract ABI used to look up deposits
+var ABI = mustParseABI(abiJSON)
+
+func mustParseABI(byts []byte) abi.ABI {
+	ret, err := abi.JSON(bytes.NewReader(byts))
+	if err != nil {
+		panic(err)
+	}
+	return ret
+}
+
+// DepositEvent is a DepositEvent emitted by the deposit contract
+type DepositEvent struct {
+	PubKey                []byte
+	WithdrawalCredentials []byte
+	// Amount in gwei
+	Amount      uint64
+	Signature   []byte
+	Index       uint64
+	BlockNumber uint64
+}
+
+// ParseDepositEvent parses a DepositEvent log
+func ParseDepositEvent(log types.Log) (*DepositEvent, error) {
+	if len(log.Topics) != 1 || log.Topics[0] != ABI.Events["DepositEvent"].ID {
+		return nil, fmt.Errorf("not a deposit event")
+	}
+	values := make(map[string]interface{})
+	if err := ABI.Events["DepositEvent"].Inputs.UnpackIntoMap(values, log.Data); err != nil {
+		return nil, err
+	}
+	amount := values["amount"].([]byte)
+	index := values["index"].([]byte)
+	if len(amount) != 8 || len(index) != 8 {
+		return nil, fmt.Errorf("invalid deposit event")
+	}
+	return &DepositEvent{
+		PubKey:                values["pubkey"].([]byte),
+		WithdrawalCredentials: values["withdrawal_credentials"].([]byte),
+		// the deposit contract encodes amount and index as little endian
+		Amount:      binary.LittleEndian.Uint64(amount),
+		Signature:   values["signature"].([]byte),
+		Index:       binary.LittleEndian.Uint64(index),
+		BlockNumber: log.BlockNumber,
+	}, nil
+}
+
+// FilterDeposits fetches all deposits for validatorPK made to contract from fromBlock onwards.
+// Deposit event fields are not indexed, all deposits in the range are fetched and filtered locally.
+func FilterDeposits(
+	ctx context.Context,
+	client ethereum.LogFilterer,
+	contract common.Address,
+	validatorPK []byte,
+	fromBlock *big.Int,
+) ([]*DepositEvent, error) {
+	logs, err := client.FilterLogs(ctx, ethereum.FilterQuery{
+		FromBlock: fromBlock,
+		Addresses: []common.Address{contract},
+		Topics:    [][]common.Hash{{ABI.Events["DepositEvent"].ID}},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var ret []*DepositEvent
+	for _, log := range logs {
+		if log.Removed {
+			continue // reorged out
+		}
+		event, err := ParseDepositEvent(log)
+		if err != nil {
+			return nil, err
+		}
+		if bytes.Equal(event.PubKey, validatorPK) {
+			ret = append(ret, event)
+		}
+	}
+	return ret, nil
+}
+
+// Checker looks up existing deposits in the deposit contract logs, it implements spec.DepositChecker
+type Checker struct {
+	Client    ethereum.LogFilterer
+	Contract  common.Address
+	FromBlock *big.Int
+}
+
+// ExistingWithdrawalCredentials returns the withdrawal credentials of all deposits made for validatorPK
+func (c *Checker) ExistingWithdrawalCredentials(validatorPK []byte) ([][]byte, error) {
+	deposits, err := FilterDeposits(context.Background(), c.Client, c.Contract, validatorPK, c.FromBlock)
+	if err != nil {
+		return nil, err
+	}
+	ret := make([][]byte, 0, len(deposits))
+	for _, deposit := range deposits {
+		ret = append(ret, deposit.WithdrawalCredentials)
+	}
+	return ret, nil
+}
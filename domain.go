@@ -0,0 +1,124 @@
+package spec
+
+import (
+	"bytes"
+	"fmt"
+
+	ssz "github.com/ferranbt/fastssz"
+)
+
+// Domain is mixed into the signing root of every message signed in the spec,
+// so a signature over one kind of message can never be replayed as another
+type Domain [4]byte
+
+var (
+	// DomainOwnerNonce is the domain of the validator's owner nonce signature, see PartialNonceRoot
+	DomainOwnerNonce = Domain{'D', 'K', 'G', 0x01}
+	// DomainProof is the domain of the operators' ceremony proof signatures
+	DomainProof = Domain{'D', 'K', 'G', 0x02}
+	// DomainExit is the domain of the operators' signatures over exit messages
+	DomainExit = Domain{'D', 'K', 'G', 0x03}
+	// DomainTranscript is the domain of the initiator's ceremony summary signature
+	DomainTranscript = Domain{'D', 'K', 'G', 0x04}
+	// DomainResult is the domain of the operators' signed responses, carrying their results
+	DomainResult = Domain{'D', 'K', 'G', 0x05}
+	// DomainPong is the domain of the operators' answers to health checks
+	DomainPong = Domain{'D', 'K', 'G', 0x06}
+	// DomainInit is the domain of the initiator's signature over an init message
+	DomainInit = Domain{'D', 'K', 'G', 0x07}
+	// DomainDealing is the domain of the operators' proofs of their DKG dealings
+	DomainDealing = Domain{'D', 'K', 'G', 0x08}
+	// DomainPossession is the domain of the proofs of possession of BLS share keys
+	DomainPossession = Domain{'D', 'K', 'G', 0x09}
+	// DomainReshare is the domain of the owner's signature over a reshare message
+	DomainReshare = Domain{'D', 'K', 'G', 0x0a}
+	// DomainResign is the domain of the owner's signature over a resign message
+	DomainResign = Domain{'D', 'K', 'G', 0x0b}
+)
+
+var domainNames = map[Domain]string{
+	DomainOwnerNonce: "owner_nonce",
+	DomainProof:      "proof",
+	DomainExit:       "exit",
+	DomainTranscript: "transcript",
+	DomainResult:     "result",
+	DomainPong:       "pong",
+	DomainInit:       "init",
+	DomainDealing:    "dealing",
+	DomainPossession: "possession",
+	DomainReshare:    "reshare",
+	DomainResign:     "resign",
+}
+
+func (d Domain) String() string {
+	if name, found := domainNames[d]; found {
+		return name
+	}
+	return fmt.Sprintf("unknown(%x)", d[:])
+}
+
+// ValidateDomain returns nil if d is one of the spec's domains
+func ValidateDomain(d Domain) error {
+	if _, found := domainNames[d]; !found {
+		return codedError(CodeInvalidDomain, "unknown signing domain %x", d[:])
+	}
+	return nil
+}
+
+// ComputeSigningRoot returns the root signed for msg under domain d
+func ComputeSigningRoot(msg ssz.HashRoot, d Domain) ([32]byte, error) {
+	if err := ValidateDomain(d); err != nil {
+		return [32]byte{}, err
+	}
+	root, err := msg.HashTreeRoot()
+	if err != nil {
+		return [32]byte{}, err
+	}
+	return (&SigningData{
+		ObjectRoot: root,
+		Domain:     d,
+	}).HashTreeRoot()
+}
+
+// ValidateSigningRoot returns nil if root is msg's signing root under domain d, e.g. for a remote signer checking what it's asked to sign
+func ValidateSigningRoot(root []byte, msg ssz.HashRoot, d Domain) error {
+	expected, err := ComputeSigningRoot(msg, d)
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(expected[:], root) {
+		return codedError(CodeInvalidDomain, "signing root not produced under the %s domain", d)
+	}
+	return nil
+}
+
+// SigningRoot returns the root operators sign the proof over
+func (p *Proof) SigningRoot() ([32]byte, error) { return ComputeSigningRoot(p, DomainProof) }
+
+// SigningRoot returns the root the initiator signs the summary over
+func (s *CeremonySummary) SigningRoot() ([32]byte, error) {
+	return ComputeSigningRoot(s, DomainTranscript)
+}
+
+// SigningRoot returns the root operators sign the envelope over
+func (e *ResponseEnvelope) SigningRoot() ([32]byte, error) {
+	return ComputeSigningRoot(e, DomainResult)
+}
+
+// SigningRoot returns the root operators sign the pong over
+func (p *Pong) SigningRoot() ([32]byte, error) { return ComputeSigningRoot(p, DomainPong) }
+
+// SigningRoot returns the root the initiator signs the init over, see InitiatorMessage
+func (s *SignedInit) SigningRoot() ([32]byte, error) {
+	return ComputeSigningRoot(&InitiatorMessage{
+		Init:            s.Init,
+		RequestID:       s.RequestID,
+		InitiatorPubKey: s.InitiatorPubKey,
+	}, DomainInit)
+}
+
+// SigningRoot returns the root operators sign their dealing over
+func (d *Dealing) SigningRoot() ([32]byte, error) { return ComputeSigningRoot(d, DomainDealing) }
+
+// SigningRoot returns the root a key's proof of possession signs
+func (p *Possession) SigningRoot() ([32]byte, error) { return ComputeSigningRoot(p, DomainPossession) }
@@ -0,0 +1,125 @@
+//go:build !verifyonly
+
+package spec
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/bloxapp/dkg-spec/crypto"
+	"github.com/bloxapp/dkg-spec/eip1271"
+)
+
+// DryRunCheck is the outcome of a single dry run validation step
+type DryRunCheck struct {
+	Name string `json:"name"`
+	// Error is empty if the check passed
+	Error string `json:"error,omitempty"`
+}
+
+// DryRunReport lists every validation an operator runs for a message, in the order the operator runs them.
+// Unlike the operator, a dry run doesn't stop at the first failing check.
+type DryRunReport struct {
+	Checks []DryRunCheck `json:"checks"`
+}
+
+// Passed returns true if every check passed
+func (r *DryRunReport) Passed() bool {
+	return r.Err() == nil
+}
+
+// Err returns an error listing the failed checks, nil if every check passed
+func (r *DryRunReport) Err() error {
+	var failed []string
+	for _, check := range r.Checks {
+		if check.Error != "" {
+			failed = append(failed, fmt.Sprintf("%s: %s", check.Name, check.Error))
+		}
+	}
+	if len(failed) == 0 {
+		return nil
+	}
+	return fmt.Errorf("dry run failed: %s", strings.Join(failed, "; "))
+}
+
+func (r *DryRunReport) check(name string, err error) {
+	check := DryRunCheck{Name: name}
+	if err != nil {
+		check.Error = err.Error()
+	}
+	r.Checks = append(r.Checks, check)
+}
+
+// DryRunOperatorInit runs OperatorInit's validations on init without generating or signing anything.
+// The conflicting deposit check requires the validator public key and is left to the ceremony.
+func DryRunOperatorInit(init *Init) *DryRunReport {
+	ret := &DryRunReport{}
+	ret.check("init message", ValidateInitMessage(init))
+	ret.check("deposit", dryRunDeposit(init.Fork, init.WithdrawalCredentials, DepositAmount))
+	return ret
+}
+
+// DryRunOperatorReshare runs OperatorReshare's validations on signedReshare without generating or signing anything.
+// As for OperatorReshare, the on chain check is skipped if validatorChecker is nil.
+func DryRunOperatorReshare(
+	signedReshare *SignedReshare,
+	operator *Operator,
+	proof *SignedProof,
+	params *CeremonyParams,
+	client eip1271.ETHClient,
+	validatorChecker ValidatorChecker,
+) *DryRunReport {
+	ret := &DryRunReport{}
+	ret.check("owner signature", VerifyOwnerSignature(client, &signedReshare.Reshare, signedReshare.Signature))
+	ret.check("reshare message", ValidateReshareMessage(&signedReshare.Reshare, operator, proof, params))
+	if validatorChecker != nil {
+		_, err := validateValidatorOnChainForFork(
+			validatorChecker,
+			signedReshare.Reshare.ValidatorPubKey,
+			signedReshare.Reshare.Fork,
+			signedReshare.Reshare.WithdrawalCredentials,
+		)
+		ret.check("validator on chain", err)
+	}
+	ret.check("deposit", dryRunDeposit(signedReshare.Reshare.Fork, signedReshare.Reshare.WithdrawalCredentials, DepositAmount))
+	return ret
+}
+
+// DryRunOperatorResign runs OperatorResign's validations on signedResign without signing anything.
+// As for OperatorResign, the on chain check is skipped if validatorChecker is nil.
+func DryRunOperatorResign(
+	signedResign *SignedResign,
+	operator *Operator,
+	proof *SignedProof,
+	params *CeremonyParams,
+	client eip1271.ETHClient,
+	validatorChecker ValidatorChecker,
+) *DryRunReport {
+	ret := &DryRunReport{}
+	ret.check("owner signature", VerifyOwnerSignature(client, &signedResign.Resign, signedResign.Signature))
+	ret.check("resign message", ValidateResignMessage(&signedResign.Resign, operator, proof, params))
+	if validatorChecker != nil {
+		_, err := validateValidatorOnChainForFork(
+			validatorChecker,
+			signedResign.Resign.ValidatorPubKey,
+			signedResign.Resign.Fork,
+			signedResign.Resign.WithdrawalCredentials,
+		)
+		ret.check("validator on chain", err)
+	}
+	ret.check("deposit", dryRunDeposit(signedResign.Resign.Fork, signedResign.Resign.WithdrawalCredentials, signedResign.Resign.Amount))
+	return ret
+}
+
+// dryRunDeposit returns nil if a deposit of amount gwei with withdrawalCredentials can be signed on fork
+func dryRunDeposit(fork [4]byte, withdrawalCredentials []byte, amount uint64) error {
+	maxAmount, err := crypto.MaxDepositAmount(fork, withdrawalCredentials)
+	if err != nil {
+		return err
+	}
+	if amount > uint64(maxAmount) {
+		return fmt.Errorf("deposit amount exceeds max effective balance")
+	}
+	_, err = crypto.DepositWithdrawalCredentials(fork, withdrawalCredentials)
+	return err
+}
@@ -0,0 +1,121 @@
+package eip1271
+
+import (
+	"bytes"
+	"fmt"
+	"math/big"
+	"sort"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+var (
+	// safeDomainSeparatorTypeHash is keccak256("EIP712Domain(uint256 chainId,address verifyingContract)")
+	safeDomainSeparatorTypeHash = crypto.Keccak256([]byte("EIP712Domain(uint256 chainId,address verifyingContract)"))
+	// safeMessageTypeHash is keccak256("SafeMessage(bytes message)")
+	safeMessageTypeHash = crypto.Keccak256([]byte("SafeMessage(bytes message)"))
+)
+
+// SafeMessageHash returns the hash Safe owners sign for the Safe at safe (deployed on chainID) to validate dataHash with EIP-1271,
+// as computed by the Safe's CompatibilityFallbackHandler
+func SafeMessageHash(chainID *big.Int, safe common.Address, dataHash [32]byte) [32]byte {
+	domainSeparator := crypto.Keccak256(
+		safeDomainSeparatorTypeHash,
+		math.U256Bytes(new(big.Int).Set(chainID)),
+		common.LeftPadBytes(safe[:], 32),
+	)
+	// the handler wraps dataHash as abi.encode(dataHash)
+	safeMessageHash := crypto.Keccak256(safeMessageTypeHash, crypto.Keccak256(dataHash[:]))
+	var ret [32]byte
+	copy(ret[:], crypto.Keccak256([]byte{0x19, 0x01}, domainSeparator, safeMessageHash))
+	return ret
+}
+
+// SafeSignatures collects Safe owners' individual signatures over a Safe message hash (see SafeMessageHash) off-chain,
+// and encodes them into the single signature the Safe checks with EIP-1271
+type SafeSignatures struct {
+	messageHash [32]byte
+	signatures  map[common.Address][]byte
+}
+
+// NewSafeSignatures returns an empty collection of signatures over messageHash
+func NewSafeSignatures(messageHash [32]byte) *SafeSignatures {
+	return &SafeSignatures{
+		messageHash: messageHash,
+		signatures:  map[common.Address][]byte{},
+	}
+}
+
+// Add adds owner's 65 byte [R || S || V] ECDSA signature, either over the message hash itself or over it as an EIP-191 personal message
+// (e.g. signed with a hardware wallet). V may be 0/1 or 27/28.
+func (s *SafeSignatures) Add(owner common.Address, signature []byte) error {
+	if len(signature) != 65 {
+		return fmt.Errorf("invalid signature length %d", len(signature))
+	}
+	if _, found := s.signatures[owner]; found {
+		return fmt.Errorf("duplicate signature of owner %s", owner.Hex())
+	}
+	sig := make([]byte, 65)
+	copy(sig, signature)
+	switch sig[64] {
+	case 0, 1:
+	case 27, 28:
+		sig[64] -= 27
+	default:
+		return fmt.Errorf("invalid signature recovery id %d", sig[64])
+	}
+	if !crypto.ValidateSignatureValues(sig[64], new(big.Int).SetBytes(sig[:32]), new(big.Int).SetBytes(sig[32:64]), true) {
+		return fmt.Errorf("malleable or invalid signature")
+	}
+
+	// Safe encodes V as 27/28 for signatures over the message hash, and offsets it by 4 for personal message signatures
+	switch {
+	case recovers(s.messageHash[:], sig, owner):
+		sig[64] += 27
+	case recovers(accounts.TextHash(s.messageHash[:]), sig, owner):
+		sig[64] += 27 + 4
+	default:
+		return fmt.Errorf("signature not by owner %s", owner.Hex())
+	}
+	s.signatures[owner] = sig
+	return nil
+}
+
+// Len returns the number of owners who signed
+func (s *SafeSignatures) Len() int {
+	return len(s.signatures)
+}
+
+// Encode returns the signatures concatenated in ascending owner order, as the Safe requires.
+// It returns error if fewer than threshold owners signed, as the Safe would reject the signature anyway.
+func (s *SafeSignatures) Encode(threshold int) ([]byte, error) {
+	if threshold <= 0 {
+		return nil, fmt.Errorf("invalid threshold %d", threshold)
+	}
+	if len(s.signatures) < threshold {
+		return nil, fmt.Errorf("not enough owner signatures, %d of %d", len(s.signatures), threshold)
+	}
+	owners := make([]common.Address, 0, len(s.signatures))
+	for owner := range s.signatures {
+		owners = append(owners, owner)
+	}
+	sort.Slice(owners, func(i, j int) bool {
+		return bytes.Compare(owners[i][:], owners[j][:]) < 0
+	})
+	ret := make([]byte, 0, 65*len(owners))
+	for _, owner := range owners {
+		ret = append(ret, s.signatures[owner]...)
+	}
+	return ret, nil
+}
+
+func recovers(hash []byte, sig []byte, owner common.Address) bool {
+	pk, err := crypto.SigToPub(hash, sig)
+	if err != nil {
+		return false
+	}
+	return crypto.PubkeyToAddress(*pk) == owner
+}
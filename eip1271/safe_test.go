@@ -0,0 +1,147 @@
+package eip1271
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"math/big"
+	"sort"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+	"github.com/stretchr/testify/require"
+)
+
+// checkSignatures mimics the Safe's checkNSignatures for ECDSA signatures
+func checkSignatures(t *testing.T, messageHash [32]byte, owners []common.Address, threshold int, signatures []byte) {
+	require.Len(t, signatures, 65*threshold)
+	last := common.Address{}
+	for i := 0; i < threshold; i++ {
+		sig := append([]byte{}, signatures[i*65:(i+1)*65]...)
+		hash := messageHash[:]
+		if sig[64] > 30 {
+			hash = accounts.TextHash(messageHash[:])
+			sig[64] -= 4
+		}
+		sig[64] -= 27
+		pk, err := crypto.SigToPub(hash, sig)
+		require.NoError(t, err)
+		owner := crypto.PubkeyToAddress(*pk)
+		require.Contains(t, owners, owner)
+		require.Equal(t, 1, bytes.Compare(owner[:], last[:]))
+		last = owner
+	}
+}
+
+func TestSafeSignatures(t *testing.T) {
+	chainID := big.NewInt(17000)
+	safe := common.HexToAddress("0x0000000000000000000000000000000000005afe")
+	dataHash := [32]byte{1, 2, 3}
+	messageHash := SafeMessageHash(chainID, safe, dataHash)
+
+	sks := make([]*ecdsa.PrivateKey, 3)
+	owners := make([]common.Address, 3)
+	for i := range sks {
+		sk, err := crypto.GenerateKey()
+		require.NoError(t, err)
+		sks[i] = sk
+		owners[i] = crypto.PubkeyToAddress(sk.PublicKey)
+	}
+
+	t.Run("safe message hash", func(t *testing.T) {
+		typedData := apitypes.TypedData{
+			Types: apitypes.Types{
+				"EIP712Domain": {
+					{Name: "chainId", Type: "uint256"},
+					{Name: "verifyingContract", Type: "address"},
+				},
+				"SafeMessage": {
+					{Name: "message", Type: "bytes"},
+				},
+			},
+			PrimaryType: "SafeMessage",
+			Domain: apitypes.TypedDataDomain{
+				ChainId:           (*math.HexOrDecimal256)(chainID),
+				VerifyingContract: safe.Hex(),
+			},
+			Message: apitypes.TypedDataMessage{
+				"message": hexutil.Encode(dataHash[:]),
+			},
+		}
+		expected, _, err := apitypes.TypedDataAndHash(typedData)
+		require.NoError(t, err)
+		require.EqualValues(t, expected, messageHash[:])
+	})
+
+	t.Run("ordered and concatenated", func(t *testing.T) {
+		signatures := NewSafeSignatures(messageHash)
+		for i, sk := range sks {
+			sig, err := crypto.Sign(messageHash[:], sk)
+			require.NoError(t, err)
+			if i == 1 {
+				// hardware wallet personal message signature
+				sig, err = crypto.Sign(accounts.TextHash(messageHash[:]), sk)
+				require.NoError(t, err)
+				sig[64] += 27
+			}
+			require.NoError(t, signatures.Add(owners[i], sig))
+		}
+		require.EqualValues(t, 3, signatures.Len())
+
+		encoded, err := signatures.Encode(2)
+		require.NoError(t, err)
+		checkSignatures(t, messageHash, owners, 3, encoded)
+
+		sorted := append([]common.Address{}, owners...)
+		sort.Slice(sorted, func(i, j int) bool { return bytes.Compare(sorted[i][:], sorted[j][:]) < 0 })
+		for i, owner := range sorted {
+			if owner == owners[1] {
+				require.True(t, encoded[i*65+64] > 30)
+			} else {
+				require.True(t, encoded[i*65+64] < 30)
+			}
+		}
+	})
+
+	t.Run("not enough signatures", func(t *testing.T) {
+		signatures := NewSafeSignatures(messageHash)
+		sig, err := crypto.Sign(messageHash[:], sks[0])
+		require.NoError(t, err)
+		require.NoError(t, signatures.Add(owners[0], sig))
+		_, err = signatures.Encode(2)
+		require.EqualError(t, err, "not enough owner signatures, 1 of 2")
+		_, err = signatures.Encode(0)
+		require.EqualError(t, err, "invalid threshold 0")
+	})
+
+	t.Run("invalid signatures", func(t *testing.T) {
+		signatures := NewSafeSignatures(messageHash)
+		sig, err := crypto.Sign(messageHash[:], sks[0])
+		require.NoError(t, err)
+
+		require.EqualError(t, signatures.Add(owners[1], sig), "signature not by owner "+owners[1].Hex())
+		require.NoError(t, signatures.Add(owners[0], sig))
+		require.EqualError(t, signatures.Add(owners[0], sig), "duplicate signature of owner "+owners[0].Hex())
+		require.EqualError(t, signatures.Add(owners[2], sig[:64]), "invalid signature length 64")
+
+		sig[64] = 5
+		require.EqualError(t, signatures.Add(owners[2], sig), "invalid signature recovery id 5")
+
+		// high S twin of a valid signature
+		sig, err = crypto.Sign(messageHash[:], sks[2])
+		require.NoError(t, err)
+		new(big.Int).Sub(crypto.S256().Params().N, new(big.Int).SetBytes(sig[32:64])).FillBytes(sig[32:64])
+		sig[64] ^= 1
+		require.EqualError(t, signatures.Add(owners[2], sig), "malleable or invalid signature")
+
+		// signed for another Safe
+		other := SafeMessageHash(chainID, common.Address{1}, dataHash)
+		sig, err = crypto.Sign(other[:], sks[2])
+		require.NoError(t, err)
+		require.Error(t, signatures.Add(owners[2], sig))
+	})
+}
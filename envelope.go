@@ -0,0 +1,80 @@
+package spec
+
+import (
+	"bytes"
+	"crypto/rsa"
+	"crypto/sha256"
+	"fmt"
+	"time"
+
+	"github.com/bloxapp/dkg-spec/crypto"
+)
+
+// SignResponse returns a signed envelope authenticating payload as sent by operatorID at timestamp
+func SignResponse(
+	operatorID uint64,
+	payload []byte,
+	timestamp time.Time,
+	sk *rsa.PrivateKey,
+) (*SignedResponseEnvelope, error) {
+	envelope := &ResponseEnvelope{
+		OperatorID:  operatorID,
+		Timestamp:   uint64(timestamp.Unix()),
+		PayloadHash: sha256.Sum256(payload),
+	}
+	hash, err := envelope.SigningRoot()
+	if err != nil {
+		return nil, err
+	}
+	sig, err := crypto.SignRSA(sk, hash[:])
+	if err != nil {
+		return nil, err
+	}
+	return &SignedResponseEnvelope{
+		Envelope:  envelope,
+		Signature: sig,
+	}, nil
+}
+
+// VerifyResponse returns nil if signed authenticates payload as sent by one of operators.
+// If maxAge is positive the envelope's timestamp must be within maxAge of now.
+func VerifyResponse(
+	operators []*Operator,
+	payload []byte,
+	signed *SignedResponseEnvelope,
+	now time.Time,
+	maxAge time.Duration,
+) error {
+	if signed.Envelope == nil {
+		return fmt.Errorf("missing envelope")
+	}
+	operator := GetOperator(operators, signed.Envelope.OperatorID)
+	if operator == nil {
+		return fmt.Errorf("operator not found")
+	}
+
+	payloadHash := sha256.Sum256(payload)
+	if !bytes.Equal(payloadHash[:], signed.Envelope.PayloadHash[:]) {
+		return fmt.Errorf("invalid payload hash")
+	}
+
+	if maxAge > 0 {
+		age := now.Sub(time.Unix(int64(signed.Envelope.Timestamp), 0))
+		if age > maxAge {
+			return fmt.Errorf("response expired")
+		}
+		if age < -maxAge {
+			return fmt.Errorf("response timestamp in the future")
+		}
+	}
+
+	hash, err := signed.Envelope.SigningRoot()
+	if err != nil {
+		return err
+	}
+	pk, err := crypto.ParseRSAPublicKey(operator.PubKey)
+	if err != nil {
+		return err
+	}
+	return crypto.VerifyRSA(pk, hash[:], signed.Signature)
+}
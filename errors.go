@@ -0,0 +1,185 @@
+package spec
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrorCode identifies a validation failure across implementations, numeric values and names never change once released
+type ErrorCode uint16
+
+const (
+	CodeUnknown ErrorCode = 0
+
+	// message validation
+	CodeOperatorsNotOrdered    ErrorCode = 100
+	CodeInvalidThreshold       ErrorCode = 101
+	CodeInvalidClusterSize     ErrorCode = 102
+	CodeSameOperators          ErrorCode = 103
+	CodeInvalidValidatorPubKey ErrorCode = 104
+	CodeUnknownMessageType     ErrorCode = 105
+	CodeNonCanonicalEncoding   ErrorCode = 106
+	CodeUnknownField           ErrorCode = 107
+	CodeReshareOverlap         ErrorCode = 108
+	CodeOperatorKeyMismatch    ErrorCode = 109
+	CodeThresholdUnreachable   ErrorCode = 110
+	CodeAmountNotAllowed       ErrorCode = 111
+	CodeBatchInconsistent      ErrorCode = 112
+	CodeNonceMismatch          ErrorCode = 113
+	CodeInvalidSchedule        ErrorCode = 114
+	CodeOutsideSchedule        ErrorCode = 115
+
+	// signatures and proofs
+	CodeInvalidOwnerSignature     ErrorCode = 200
+	CodeProofOwnerMismatch        ErrorCode = 201
+	CodeProofValidatorMismatch    ErrorCode = 202
+	CodeInvalidProofSignature     ErrorCode = 203
+	CodeInvalidPartialSignature   ErrorCode = 204
+	CodeInvalidMasterSignature    ErrorCode = 205
+	CodeProofParamsMismatch       ErrorCode = 206
+	CodeInvalidDomain             ErrorCode = 207
+	CodeInvalidInitiatorSignature ErrorCode = 208
+	CodeInvalidPossessionProof    ErrorCode = 209
+
+	// results
+	CodeOperatorNotFound        ErrorCode = 300
+	CodeRequestIDMismatch       ErrorCode = 301
+	CodeResultsCountMismatch    ErrorCode = 302
+	CodeRecoveredPubKeyMismatch ErrorCode = 303
+	CodeInconsistentDealing     ErrorCode = 304
+	CodeDuplicateContribution   ErrorCode = 305
+	CodeLineageMismatch         ErrorCode = 306
+	CodeCeremonyAborted         ErrorCode = 307
+
+	// chain state
+	CodeConflictingDeposit            ErrorCode = 400
+	CodeValidatorNotFound             ErrorCode = 401
+	CodeWithdrawalCredentialsMismatch ErrorCode = 402
+	CodeValidatorExiting              ErrorCode = 403
+	CodeChainLookupFailed             ErrorCode = 404
+
+	// operator policy
+	CodeInitiatorNotAllowed ErrorCode = 500
+	CodeOwnerNotAllowed     ErrorCode = 501
+	CodePolicyRejected      ErrorCode = 502
+	CodeFeeMismatch         ErrorCode = 503
+)
+
+var errorCodeNames = map[ErrorCode]string{
+	CodeUnknown:                       "unknown",
+	CodeOperatorsNotOrdered:           "operators_not_ordered",
+	CodeInvalidThreshold:              "invalid_threshold",
+	CodeInvalidClusterSize:            "invalid_cluster_size",
+	CodeSameOperators:                 "same_operators",
+	CodeInvalidValidatorPubKey:        "invalid_validator_pubkey",
+	CodeUnknownMessageType:            "unknown_message_type",
+	CodeNonCanonicalEncoding:          "non_canonical_encoding",
+	CodeUnknownField:                  "unknown_field",
+	CodeReshareOverlap:                "reshare_overlap",
+	CodeOperatorKeyMismatch:           "operator_key_mismatch",
+	CodeThresholdUnreachable:          "threshold_unreachable",
+	CodeAmountNotAllowed:              "amount_not_allowed",
+	CodeBatchInconsistent:             "batch_inconsistent",
+	CodeNonceMismatch:                 "nonce_mismatch",
+	CodeInvalidSchedule:               "invalid_schedule",
+	CodeOutsideSchedule:               "outside_schedule",
+	CodeInvalidOwnerSignature:         "invalid_owner_signature",
+	CodeProofOwnerMismatch:            "proof_owner_mismatch",
+	CodeProofValidatorMismatch:        "proof_validator_mismatch",
+	CodeInvalidProofSignature:         "invalid_proof_signature",
+	CodeInvalidPartialSignature:       "invalid_partial_signature",
+	CodeInvalidMasterSignature:        "invalid_master_signature",
+	CodeProofParamsMismatch:           "proof_params_mismatch",
+	CodeInvalidDomain:                 "invalid_domain",
+	CodeInvalidInitiatorSignature:     "invalid_initiator_signature",
+	CodeInvalidPossessionProof:        "invalid_possession_proof",
+	CodeOperatorNotFound:              "operator_not_found",
+	CodeRequestIDMismatch:             "request_id_mismatch",
+	CodeResultsCountMismatch:          "results_count_mismatch",
+	CodeRecoveredPubKeyMismatch:       "recovered_pubkey_mismatch",
+	CodeInconsistentDealing:           "inconsistent_dealing",
+	CodeDuplicateContribution:         "duplicate_contribution",
+	CodeLineageMismatch:               "lineage_mismatch",
+	CodeCeremonyAborted:               "ceremony_aborted",
+	CodeConflictingDeposit:            "conflicting_deposit",
+	CodeValidatorNotFound:             "validator_not_found",
+	CodeWithdrawalCredentialsMismatch: "withdrawal_credentials_mismatch",
+	CodeValidatorExiting:              "validator_exiting",
+	CodeChainLookupFailed:             "chain_lookup_failed",
+	CodeInitiatorNotAllowed:           "initiator_not_allowed",
+	CodeOwnerNotAllowed:               "owner_not_allowed",
+	CodePolicyRejected:                "policy_rejected",
+	CodeFeeMismatch:                   "fee_mismatch",
+}
+
+func (c ErrorCode) String() string {
+	if name, found := errorCodeNames[c]; found {
+		return name
+	}
+	return fmt.Sprintf("code_%d", uint16(c))
+}
+
+// ParseErrorCode returns the code named name
+func ParseErrorCode(name string) (ErrorCode, error) {
+	for code, codeName := range errorCodeNames {
+		if codeName == name {
+			return code, nil
+		}
+	}
+	return CodeUnknown, fmt.Errorf("unknown error code %s", name)
+}
+
+// MarshalText encodes the code by name, e.g. in JSON
+func (c ErrorCode) MarshalText() ([]byte, error) {
+	return []byte(c.String()), nil
+}
+
+// UnmarshalText decodes codes unknown to this version (e.g. added by newer implementations) as CodeUnknown
+func (c *ErrorCode) UnmarshalText(text []byte) error {
+	code, err := ParseErrorCode(string(text))
+	if err != nil {
+		code = CodeUnknown
+	}
+	*c = code
+	return nil
+}
+
+// ValidationError is a validation failure with its code, its message is the wrapped error's
+type ValidationError struct {
+	Code ErrorCode
+	Err  error
+}
+
+func (e *ValidationError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *ValidationError) Unwrap() error {
+	return e.Err
+}
+
+// ErrorCodeOf returns the code of the innermost ValidationError wrapped by err, CodeUnknown if there's none
+func ErrorCodeOf(err error) ErrorCode {
+	code := CodeUnknown
+	for err != nil {
+		var validationErr *ValidationError
+		if !errors.As(err, &validationErr) {
+			break
+		}
+		code = validationErr.Code
+		err = validationErr.Err
+	}
+	return code
+}
+
+func codedError(code ErrorCode, format string, args ...interface{}) error {
+	return &ValidationError{Code: code, Err: fmt.Errorf(format, args...)}
+}
+
+// withCode returns err with code, nil if err is nil
+func withCode(code ErrorCode, err error) error {
+	if err == nil {
+		return nil
+	}
+	return &ValidationError{Code: code, Err: err}
+}
@@ -0,0 +1,74 @@
+package spec
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// NewFeeTerms returns the terms of a fee of amount base units of token (zero for ether), paid under the agreement reference
+func NewFeeTerms(token common.Address, amount *big.Int, reference [32]byte) (FeeTerms, error) {
+	if amount.Sign() < 0 || amount.BitLen() > 256 {
+		return FeeTerms{}, fmt.Errorf("fee amount %s out of range", amount)
+	}
+	ret := FeeTerms{
+		Token:            token,
+		PaymentReference: reference,
+	}
+	amount.FillBytes(ret.Amount[:])
+	return ret, nil
+}
+
+// IsZero returns true if there are no fee terms, i.e. the ceremony is free
+func (f FeeTerms) IsZero() bool {
+	return f == FeeTerms{}
+}
+
+// AmountBig returns the fee amount in the token's base units
+func (f FeeTerms) AmountBig() *big.Int {
+	return new(big.Int).SetBytes(f.Amount[:])
+}
+
+func (f FeeTerms) String() string {
+	if f.IsZero() {
+		return "none"
+	}
+	token := "wei"
+	if f.Token != [20]byte{} {
+		token = "of token " + common.Address(f.Token).Hex()
+	}
+	return fmt.Sprintf("%s %s (reference 0x%x)", f.AmountBig(), token, f.PaymentReference[:])
+}
+
+// MessageFee returns the fee terms message is bound to, zero for resign messages which carry none
+func MessageFee(message CeremonyMessage) FeeTerms {
+	switch msg := message.(type) {
+	case *Init:
+		return msg.Fee
+	case *Reshare:
+		return msg.Fee
+	default:
+		return FeeTerms{}
+	}
+}
+
+// ValidateFee returns nil if message is bound to the agreed fee terms
+func ValidateFee(message CeremonyMessage, agreed FeeTerms) error {
+	if fee := MessageFee(message); fee != agreed {
+		return codedError(CodeFeeMismatch, "fee terms %s differ from the agreed terms %s", fee, agreed)
+	}
+	return nil
+}
+
+// FeePolicy returns a policy accepting messages bound to the fee terms agreed with their owner, as returned by agreed
+func FeePolicy(agreed func(ctx context.Context, owner [20]byte) (FeeTerms, error)) PolicyFunc {
+	return func(ctx context.Context, message CeremonyMessage) error {
+		terms, err := agreed(ctx, message.GetOwner())
+		if err != nil {
+			return err
+		}
+		return ValidateFee(message, terms)
+	}
+}
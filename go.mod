@@ -5,12 +5,14 @@ go 1.20
 require (
 	github.com/attestantio/go-eth2-client v0.21.1
 	github.com/bloxapp/eth2-key-manager v1.4.0
+	github.com/consensys/gnark-crypto v0.12.1
 	github.com/ethereum/go-ethereum v1.13.14
 	github.com/ferranbt/fastssz v0.1.3
 	github.com/google/uuid v1.3.0
 	github.com/herumi/bls-eth-go-binary v1.34.2
 	github.com/stretchr/testify v1.9.0
-	github.com/wealdtech/go-eth2-types/v2 v2.8.2
+	github.com/tyler-smith/go-bip39 v1.1.0
+	golang.org/x/crypto v0.20.0
 	golang.org/x/exp v0.0.0-20240409090435-93d18d7e34b8
 )
 
@@ -20,7 +22,6 @@ require (
 	github.com/bits-and-blooms/bitset v1.10.0 // indirect
 	github.com/btcsuite/btcd/btcec/v2 v2.2.1 // indirect
 	github.com/consensys/bavard v0.1.13 // indirect
-	github.com/consensys/gnark-crypto v0.12.1 // indirect
 	github.com/crate-crypto/go-kzg-4844 v0.7.0 // indirect
 	github.com/davecgh/go-spew v1.1.1 // indirect
 	github.com/deckarep/golang-set/v2 v2.1.0 // indirect
@@ -46,10 +47,9 @@ require (
 	github.com/supranational/blst v0.3.11 // indirect
 	github.com/tklauser/go-sysconf v0.3.12 // indirect
 	github.com/tklauser/numcpus v0.6.1 // indirect
-	github.com/tyler-smith/go-bip39 v1.1.0 // indirect
 	github.com/wealdtech/go-bytesutil v1.1.1 // indirect
+	github.com/wealdtech/go-eth2-types/v2 v2.8.2 // indirect
 	github.com/wealdtech/go-eth2-util v1.6.3 // indirect
-	golang.org/x/crypto v0.20.0 // indirect
 	golang.org/x/mod v0.17.0 // indirect
 	golang.org/x/sync v0.7.0 // indirect
 	golang.org/x/sys v0.19.0 // indirect
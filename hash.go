@@ -0,0 +1,71 @@
+package spec
+
+import (
+	"runtime"
+	"sync"
+
+	ssz "github.com/ferranbt/fastssz"
+)
+
+// ParallelHashThreshold is the list length from which message lists are hashed on multiple goroutines (see HashTreeRoots),
+// 0 disables parallel hashing. It's meant to be set once, before hashing starts.
+var ParallelHashThreshold = 256
+
+// HashTreeRoots returns the hash tree root of each message (e.g. a batch of SignedReshare), in order
+func HashTreeRoots[T ssz.HashRoot](msgs []T) ([][32]byte, error) {
+	return computeRoots(len(msgs), func(hh *ssz.Hasher, i int) ([32]byte, error) {
+		if err := msgs[i].HashTreeRootWith(hh); err != nil {
+			return [32]byte{}, err
+		}
+		return hh.HashRoot()
+	})
+}
+
+// computeRoots returns rootF(i) for every i in [0, n), splitting the work across GOMAXPROCS goroutines once n reaches ParallelHashThreshold.
+// Every goroutine reuses a single pooled hasher, reset before each call.
+func computeRoots(n int, rootF func(hh *ssz.Hasher, i int) ([32]byte, error)) ([][32]byte, error) {
+	ret := make([][32]byte, n)
+	computeRange := func(from, to int) error {
+		hh := ssz.DefaultHasherPool.Get()
+		defer ssz.DefaultHasherPool.Put(hh)
+		for i := from; i < to; i++ {
+			hh.Reset()
+			root, err := rootF(hh, i)
+			if err != nil {
+				return err
+			}
+			ret[i] = root
+		}
+		return nil
+	}
+
+	workers := runtime.GOMAXPROCS(0)
+	if ParallelHashThreshold <= 0 || n < ParallelHashThreshold || workers < 2 {
+		if err := computeRange(0, n); err != nil {
+			return nil, err
+		}
+		return ret, nil
+	}
+
+	chunk := (n + workers - 1) / workers
+	errs := make([]error, workers)
+	var wg sync.WaitGroup
+	for w := 0; w < workers && w*chunk < n; w++ {
+		from, to := w*chunk, (w+1)*chunk
+		if to > n {
+			to = n
+		}
+		wg.Add(1)
+		go func(w, from, to int) {
+			defer wg.Done()
+			errs[w] = computeRange(from, to)
+		}(w, from, to)
+	}
+	wg.Wait()
+	for _, err := range errs {
+		if err != nil {
+			return nil, err
+		}
+	}
+	return ret, nil
+}
@@ -0,0 +1,223 @@
+//go:build !verifyonly
+
+package spec
+
+import (
+	"fmt"
+
+	"github.com/bloxapp/dkg-spec/eip1271"
+)
+
+// ValidatorHistory accumulates the ceremonies of a validator over its lifetime, in order: the ceremony creating it (whose
+// artifacts carry its Init) and then every reshare (whose artifacts carry the SignedReshare authorizing it and the Lineage it
+// emitted). Ceremonies keeping the validator's operators (e.g. re-sign) aren't part of the custody history.
+type ValidatorHistory struct {
+	ceremonies []*CeremonyArtifacts
+}
+
+// NewValidatorHistory returns the history of the validator created by first
+func NewValidatorHistory(first *CeremonyArtifacts) (*ValidatorHistory, error) {
+	if first.Init == nil {
+		return nil, fmt.Errorf("ceremony %x didn't create a validator", first.RequestID)
+	}
+	return &ValidatorHistory{ceremonies: []*CeremonyArtifacts{first}}, nil
+}
+
+// AddReshare appends the artifacts of the validator's latest reshare ceremony
+func (h *ValidatorHistory) AddReshare(artifacts *CeremonyArtifacts) error {
+	if artifacts.SignedReshare == nil || artifacts.Lineage == nil {
+		return fmt.Errorf("ceremony %x isn't a reshare", artifacts.RequestID)
+	}
+	for _, ceremony := range h.ceremonies {
+		if ceremony.RequestID == artifacts.RequestID {
+			return fmt.Errorf("duplicate ceremony %x", artifacts.RequestID)
+		}
+	}
+	h.ceremonies = append(h.ceremonies, artifacts)
+	return nil
+}
+
+// Ceremonies returns the artifacts of the validator's ceremonies, in order
+func (h *ValidatorHistory) Ceremonies() []*CeremonyArtifacts {
+	return append([]*CeremonyArtifacts{}, h.ceremonies...)
+}
+
+// ValidatorPubKey returns the public key of the validator the history is of
+func (h *ValidatorHistory) ValidatorPubKey() []byte {
+	return h.ceremonies[0].Proofs.ValidatorPubKey()
+}
+
+// Archive returns an archive holding the artifacts of every ceremony of the history, see ReadValidatorHistory
+func (h *ValidatorHistory) Archive() (*Archive, error) {
+	ret := NewArchive()
+	for _, ceremony := range h.ceremonies {
+		if err := ret.AddCeremony(ceremony); err != nil {
+			return nil, err
+		}
+	}
+	return ret, nil
+}
+
+// ReadValidatorHistory returns the history of the validator whose ceremonies a holds. Ceremonies are ordered by following
+// each reshare's lineage back to the proofs it reshared from, starting at the (single) ceremony creating the validator.
+func ReadValidatorHistory(a *Archive) (*ValidatorHistory, error) {
+	requestIDs, err := a.RequestIDs()
+	if err != nil {
+		return nil, err
+	}
+	var ret *ValidatorHistory
+	reshares := make(map[[32]byte]*CeremonyArtifacts, len(requestIDs))
+	for _, requestID := range requestIDs {
+		ceremony, err := a.Ceremony(requestID)
+		if err != nil {
+			return nil, err
+		}
+		if ceremony.Init != nil {
+			if ret != nil {
+				return nil, fmt.Errorf("ceremonies %x and %x both created the validator", ret.ceremonies[0].RequestID, requestID)
+			}
+			if ret, err = NewValidatorHistory(ceremony); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		if ceremony.Lineage == nil {
+			return nil, fmt.Errorf("ceremony %x has no lineage", requestID)
+		}
+		if other, found := reshares[ceremony.Lineage.PriorProofsRoot]; found {
+			return nil, codedError(CodeLineageMismatch, "ceremonies %x and %x both reshared the same proofs", other.RequestID, requestID)
+		}
+		reshares[ceremony.Lineage.PriorProofsRoot] = ceremony
+	}
+	if ret == nil {
+		return nil, fmt.Errorf("no ceremony created the validator")
+	}
+
+	for len(reshares) > 0 {
+		root, err := ret.ceremonies[len(ret.ceremonies)-1].Proofs.HashTreeRoot()
+		if err != nil {
+			return nil, err
+		}
+		next, found := reshares[root]
+		if !found {
+			return nil, codedError(CodeLineageMismatch, "%d ceremonies aren't linked to the history", len(reshares))
+		}
+		delete(reshares, root)
+		if err := ret.AddReshare(next); err != nil {
+			return nil, err
+		}
+	}
+	return ret, nil
+}
+
+// Verify checks the whole history end to end: every ceremony's results (see ValidateResults) and proofs, the owner's
+// signature over every reshare (see VerifyOwnerSignature, client may be nil for EOA owners), every reshare's
+// lineage (see VerifyLineage) and the continuity of the proofs across reshares (see VerifyProofChain).
+func (h *ValidatorHistory) Verify(client eip1271.ETHClient) error {
+	first := h.ceremonies[0]
+	init := first.Init
+	validatorPK := h.ValidatorPubKey()
+	if err := ValidateInitMessage(init); err != nil {
+		return fmt.Errorf("ceremony %x: %w", first.RequestID, err)
+	}
+	if err := verifyHistoryCeremony(
+		first,
+		init.Operators,
+		init.WithdrawalCredentials,
+		validatorPK,
+		init.Fork,
+		init.Owner,
+		init.Nonce,
+		init.T,
+		init.Params(),
+	); err != nil {
+		return fmt.Errorf("ceremony %x: %w", first.RequestID, err)
+	}
+
+	hops := make([]*ProofChainHop, 0, len(h.ceremonies)-1)
+	// params of the previous ceremony, which the next reshare's old operators hold shares of
+	params := init.Params()
+	for i, ceremony := range h.ceremonies[1:] {
+		signedReshare := ceremony.SignedReshare
+		reshare := &signedReshare.Reshare
+		if err := VerifyOwnerSignature(client, reshare, signedReshare.Signature); err != nil {
+			return withCode(CodeInvalidOwnerSignature, fmt.Errorf("ceremony %x: %w", ceremony.RequestID, err))
+		}
+		if ceremony.Lineage.RequestID != ceremony.RequestID {
+			return codedError(CodeLineageMismatch, "ceremony %x: lineage of another ceremony", ceremony.RequestID)
+		}
+		if err := VerifyLineage(ceremony.Lineage, signedReshare, h.ceremonies[i].Proofs, params, ceremony.Proofs); err != nil {
+			return fmt.Errorf("ceremony %x: %w", ceremony.RequestID, err)
+		}
+		params = reshare.NewParams()
+		if err := verifyHistoryCeremony(
+			ceremony,
+			reshare.NewOperators,
+			reshare.WithdrawalCredentials,
+			validatorPK,
+			reshare.Fork,
+			reshare.Owner,
+			reshare.Nonce,
+			reshare.NewT,
+			params,
+		); err != nil {
+			return fmt.Errorf("ceremony %x: %w", ceremony.RequestID, err)
+		}
+		hops = append(hops, &ProofChainHop{Reshare: reshare, Proofs: ceremony.Proofs})
+	}
+	return VerifyProofChain(init.Operators, first.Proofs, init.Params(), hops)
+}
+
+// verifyHistoryCeremony returns nil if the ceremony's results are valid and its proofs are the results' proofs
+func verifyHistoryCeremony(
+	ceremony *CeremonyArtifacts,
+	operators []*Operator,
+	withdrawalCredentials []byte,
+	validatorPK []byte,
+	fork [4]byte,
+	owner [20]byte,
+	nonce uint64,
+	t uint64,
+	params *CeremonyParams,
+) error {
+	if _, _, _, err := ValidateResults(
+		operators,
+		withdrawalCredentials,
+		validatorPK,
+		fork,
+		DepositAmount,
+		owner,
+		nonce,
+		ceremony.RequestID,
+		int(t),
+		params,
+		ceremony.Results,
+	); err != nil {
+		return err
+	}
+	if len(ceremony.Proofs) != len(operators) {
+		return codedError(CodeResultsCountMismatch, "%d proofs for %d operators", len(ceremony.Proofs), len(operators))
+	}
+	for i, proof := range ceremony.Proofs {
+		if proof == nil {
+			return fmt.Errorf("proof %d is empty", i)
+		}
+		proofRoot, err := proof.HashTreeRoot()
+		if err != nil {
+			return err
+		}
+		for _, result := range ceremony.Results {
+			if result.OperatorID != operators[i].ID {
+				continue
+			}
+			resultRoot, err := result.SignedProof.HashTreeRoot()
+			if err != nil {
+				return err
+			}
+			if proofRoot != resultRoot {
+				return fmt.Errorf("proof of operator %d isn't its result's", operators[i].ID)
+			}
+		}
+	}
+	return nil
+}
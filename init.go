@@ -1,17 +1,20 @@
 package spec
 
-import (
-	"bytes"
-	"fmt"
-)
+import "bytes"
+
+// ClusterSizes are the cluster sizes accepted by the current spec version, see ThresholdForCluster
+var ClusterSizes = []int{4, 7, 10, 13}
 
 // ValidateInitMessage returns nil if init message is valid
 func ValidateInitMessage(init *Init) error {
 	if !UniqueAndOrderedOperators(init.Operators) {
-		return fmt.Errorf("operators not unique or not ordered")
+		return codedError(CodeOperatorsNotOrdered, "operators not unique or not ordered")
 	}
 	if !ValidThresholdSet(init.T, init.Operators) {
-		return fmt.Errorf("threshold set is invalid")
+		return codedError(CodeInvalidThreshold, "threshold set is invalid")
+	}
+	if init.NotAfter != 0 && init.NotBefore > init.NotAfter {
+		return codedError(CodeInvalidSchedule, "ceremony window ends before it starts")
 	}
 
 	return nil
@@ -19,36 +22,25 @@ func ValidateInitMessage(init *Init) error {
 
 // ValidThresholdSet returns true if the number of operators and threshold is valid
 func ValidThresholdSet(t uint64, operators []*Operator) bool {
-	if len(operators) == 4 && t == 3 { // 2f+1 = 3
-		return true
-	}
-	if len(operators) == 7 && t == 5 { // 2f+1 = 5
-		return true
-	}
-	if len(operators) == 10 && t == 7 { // 2f+1 = 7
-		return true
-	}
-	if len(operators) == 13 && t == 9 { // 2f+1 = 9
-		return true
-	}
-	return false
+	expected, err := ThresholdForSize(len(operators))
+	return err == nil && t == expected
 }
 
 // ThresholdForCluster returns the threshold for provided group, or error
 func ThresholdForCluster(operators []*Operator) (uint64, error) {
-	if len(operators) == 4 { // 2f+1 = 3
-		return 3, nil
-	}
-	if len(operators) == 7 { // 2f+1 = 5
-		return 5, nil
-	}
-	if len(operators) == 10 { // 2f+1 = 7
-		return 7, nil
-	}
-	if len(operators) == 13 { // 2f+1 = 9
-		return 9, nil
+	return ThresholdForSize(len(operators))
+}
+
+// ThresholdForSize returns the threshold of a cluster of n operators tolerating f faulty ones, n = 3f+1 and t = 2f+1.
+// n must be one of ClusterSizes.
+func ThresholdForSize(n int) (uint64, error) {
+	for _, size := range ClusterSizes {
+		if n == size {
+			f := uint64(n-1) / 3
+			return 2*f + 1, nil
+		}
 	}
-	return 0, fmt.Errorf("invalid cluster size")
+	return 0, codedError(CodeInvalidClusterSize, "invalid cluster size")
 }
 
 // UniqueAndOrderedOperators returns true if array of operators are unique and ordered (no duplicate IDs)
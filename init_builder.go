@@ -0,0 +1,162 @@
+//go:build !verifyonly
+
+package spec
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/bloxapp/dkg-spec/crypto"
+)
+
+// InitBuilder assembles an Init message step by step, validating each step.
+// The first failing step is kept and returned by Build, later steps are ignored.
+// Defaults: mainnet phase0 fork, threshold derived from the number of operators and nonce 0.
+type InitBuilder struct {
+	init         Init
+	thresholdSet bool
+	err          error
+}
+
+// NewInitBuilder returns an InitBuilder with default values
+func NewInitBuilder() *InitBuilder {
+	return &InitBuilder{}
+}
+
+// Operators sets the ceremony operators, ordered by ID and with their public keys in canonical encoding (see
+// crypto.NormalizeRSAPublicKey)
+func (b *InitBuilder) Operators(operators ...*Operator) *InitBuilder {
+	if b.err != nil {
+		return b
+	}
+	ordered, err := orderedCluster(operators)
+	if err != nil {
+		b.err = fmt.Errorf("operators: %v", err)
+		return b
+	}
+	if ordered, err = normalizedOperators(ordered); err != nil {
+		b.err = fmt.Errorf("operators: %v", err)
+		return b
+	}
+	b.init.Operators = ordered
+	return b
+}
+
+// Threshold overrides the threshold derived from the number of operators
+func (b *InitBuilder) Threshold(t uint64) *InitBuilder {
+	if b.err != nil {
+		return b
+	}
+	b.init.T = t
+	b.thresholdSet = true
+	return b
+}
+
+// WithdrawalCredentials sets the withdrawal credentials, either a 20 bytes ETH1 address or 32 bytes compounding credentials
+func (b *InitBuilder) WithdrawalCredentials(withdrawalCredentials []byte) *InitBuilder {
+	if b.err != nil {
+		return b
+	}
+	if err := checkWithdrawalCredentials(withdrawalCredentials); err != nil {
+		b.err = err
+		return b
+	}
+	b.init.WithdrawalCredentials = withdrawalCredentials
+	return b
+}
+
+// Fork sets the fork, it must be known (see crypto.RegisterFork)
+func (b *InitBuilder) Fork(fork [4]byte) *InitBuilder {
+	if b.err != nil {
+		return b
+	}
+	if _, err := crypto.GetForkBehavior(fork); err != nil {
+		b.err = fmt.Errorf("fork: %v", err)
+		return b
+	}
+	b.init.Fork = fork
+	return b
+}
+
+// Owner sets the owner address
+func (b *InitBuilder) Owner(owner [20]byte) *InitBuilder {
+	if b.err != nil {
+		return b
+	}
+	if owner == ([20]byte{}) {
+		b.err = fmt.Errorf("owner: zero address")
+		return b
+	}
+	b.init.Owner = owner
+	return b
+}
+
+// Nonce sets the owner nonce
+func (b *InitBuilder) Nonce(nonce uint64) *InitBuilder {
+	if b.err != nil {
+		return b
+	}
+	b.init.Nonce = nonce
+	return b
+}
+
+// Schedule sets the window operators accept the ceremony in, a zero time leaves its bound open
+func (b *InitBuilder) Schedule(notBefore, notAfter time.Time) *InitBuilder {
+	if b.err != nil {
+		return b
+	}
+	b.init.NotBefore, b.init.NotAfter = unixOrZero(notBefore), unixOrZero(notAfter)
+	return b
+}
+
+// Build returns the assembled Init, or the first error encountered
+func (b *InitBuilder) Build() (*Init, error) {
+	if b.err != nil {
+		return nil, b.err
+	}
+	if len(b.init.Operators) == 0 {
+		return nil, fmt.Errorf("operators: not set")
+	}
+	if len(b.init.WithdrawalCredentials) == 0 {
+		return nil, fmt.Errorf("withdrawal credentials: not set")
+	}
+	if b.init.Owner == ([20]byte{}) {
+		return nil, fmt.Errorf("owner: not set")
+	}
+	if err := checkForkWithdrawalCredentials(b.init.Fork, b.init.WithdrawalCredentials); err != nil {
+		return nil, err
+	}
+
+	ret := b.init
+	ret.Operators = append([]*Operator{}, b.init.Operators...)
+	ret.WithdrawalCredentials = append([]byte{}, b.init.WithdrawalCredentials...)
+	if !b.thresholdSet {
+		ret.T, _ = ThresholdForCluster(ret.Operators)
+	}
+	if err := ValidateInitMessage(&ret); err != nil {
+		return nil, err
+	}
+	return &ret, nil
+}
+
+func checkWithdrawalCredentials(withdrawalCredentials []byte) error {
+	switch {
+	case len(withdrawalCredentials) == 20:
+	case len(withdrawalCredentials) == 32 && withdrawalCredentials[0] == crypto.CompoundingWithdrawalPrefixByte:
+	default:
+		return fmt.Errorf("withdrawal credentials: expected 20 bytes address or 32 bytes compounding credentials")
+	}
+	return nil
+}
+
+// checkForkWithdrawalCredentials returns nil if fork is known and accepts withdrawalCredentials
+func checkForkWithdrawalCredentials(fork [4]byte, withdrawalCredentials []byte) error {
+	behavior, err := crypto.GetForkBehavior(fork)
+	if err != nil {
+		return fmt.Errorf("fork: %v", err)
+	}
+	if len(withdrawalCredentials) == 32 && !behavior.Compounding {
+		return fmt.Errorf("withdrawal credentials: compounding credentials not supported on %s", behavior.Name)
+	}
+	return nil
+}
@@ -1,3 +1,5 @@
+//go:build !verifyonly
+
 package spec
 
 import (
@@ -20,10 +22,12 @@ func RunDKG(init *Init) ([]*Result, error) {
 		init.WithdrawalCredentials,
 		results[0].SignedProof.Proof.ValidatorPubKey,
 		init.Fork,
+		DepositAmount,
 		init.Owner,
 		init.Nonce,
 		id,
-		len(init.Operators),
+		int(init.T),
+		init.Params(),
 		results)
 	return results, err
 }
@@ -35,7 +39,7 @@ func RunReshare(
 	signedReshare *SignedReshare,
 	proofs map[*Operator]SignedProof,
 	client eip1271.ETHClient,
-) ([]*Result, error) {
+) ([]*Result, *Lineage, error) {
 	id := NewID()
 
 	var results []*Result
@@ -47,23 +51,46 @@ func RunReshare(
 		withdrawalCredentials,
 		validatorPK,
 		fork,
+		DepositAmount,
 		signedReshare.Reshare.Owner,
 		signedReshare.Reshare.Nonce,
 		id,
-		len(signedReshare.Reshare.NewOperators),
+		int(signedReshare.Reshare.NewT),
+		signedReshare.Reshare.NewParams(),
 		results)
-	return results, err
+	if err != nil {
+		return results, nil, err
+	}
+
+	priorProofs := make(CeremonyProofs, 0, len(proofs))
+	for _, operator := range signedReshare.Reshare.OldOperators {
+		for proofOperator, proof := range proofs {
+			if proofOperator.ID == operator.ID {
+				proof := proof
+				priorProofs = append(priorProofs, &proof)
+			}
+		}
+	}
+	newProofs := make(CeremonyProofs, len(results))
+	for i, result := range results {
+		newProofs[i] = &result.SignedProof
+	}
+	lineage, err := NewLineage(id, signedReshare, priorProofs, newProofs)
+	return results, lineage, err
 }
 
+// RunResign is called when an initiator wants to re-sign a validator, params being the parameters of the ceremony proofs were
+// issued in, which resigned proofs keep (nil if proofs don't commit to them)
 func RunResign(
 	validatorPK []byte,
 	withdrawalCredentials []byte,
 	fork [4]byte,
 	signedResign *SignedResign,
 	proofs map[*Operator]SignedProof,
+	params *CeremonyParams,
 	client eip1271.ETHClient,
 ) ([]*Result, error) {
-	operators := maps.Keys(proofs)
+	operators := OrderOperators(maps.Keys(proofs))
 
 	t, err := ThresholdForCluster(operators)
 	if err != nil {
@@ -77,20 +104,17 @@ func RunResign(
 		DKG ceremony ...
 	*/
 
-	expectedResultsCount := int(t)
-	if len(results) > expectedResultsCount {
-		expectedResultsCount = len(results)
-	}
-
 	_, _, _, err = ValidateResults(
 		operators,
 		withdrawalCredentials,
 		validatorPK,
 		fork,
+		signedResign.Resign.Amount,
 		signedResign.Resign.Owner,
 		signedResign.Resign.Nonce,
 		id,
-		expectedResultsCount, // resign only requires a threshold of signers
+		int(t),
+		params,
 		results)
 	return results, err
 }
@@ -0,0 +1,92 @@
+//go:build !verifyonly
+
+package spec
+
+import (
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"fmt"
+
+	"github.com/bloxapp/dkg-spec/crypto"
+)
+
+// SignInit returns init of the ceremony requestID signed by the initiator holding sk, identifying it to the operators
+func SignInit(init *Init, requestID [24]byte, sk *rsa.PrivateKey) (*SignedInit, error) {
+	pk, err := crypto.EncodeRSAPublicKey(&sk.PublicKey)
+	if err != nil {
+		return nil, err
+	}
+	ret := &SignedInit{
+		Init:            init,
+		RequestID:       requestID,
+		InitiatorPubKey: pk,
+	}
+	hash, err := ret.SigningRoot()
+	if err != nil {
+		return nil, err
+	}
+	ret.Signature, err = crypto.SignRSA(sk, hash[:])
+	if err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// VerifySignedInit returns nil if signed is signed by its initiator public key
+func VerifySignedInit(signed *SignedInit) error {
+	if signed.Init == nil {
+		return fmt.Errorf("missing init")
+	}
+	pk, err := crypto.ParseRSAPublicKey(signed.InitiatorPubKey)
+	if err != nil {
+		return withCode(CodeInvalidInitiatorSignature, err)
+	}
+	hash, err := signed.SigningRoot()
+	if err != nil {
+		return err
+	}
+	if err := crypto.VerifyRSA(pk, hash[:], signed.Signature); err != nil {
+		return codedError(CodeInvalidInitiatorSignature, "invalid initiator signature: %v", err)
+	}
+	return nil
+}
+
+// verifySignedInitRequest verifies the initiator's signature over signed and that it was signed for the ceremony requestID
+func verifySignedInitRequest(signed *SignedInit, requestID [24]byte) error {
+	if err := VerifySignedInit(signed); err != nil {
+		return err
+	}
+	if signed.RequestID != requestID {
+		return codedError(CodeRequestIDMismatch, "init signed for request %x", signed.RequestID)
+	}
+	return nil
+}
+
+// InitiatorID returns the ID operators know an initiator by, the sha256 of its DER encoded public key.
+// It doesn't depend on the public key's PEM formatting.
+func InitiatorID(initiatorPubKey []byte) ([32]byte, error) {
+	pk, err := crypto.ParseRSAPublicKey(initiatorPubKey)
+	if err != nil {
+		return [32]byte{}, err
+	}
+	der, err := x509.MarshalPKIXPublicKey(pk)
+	if err != nil {
+		return [32]byte{}, err
+	}
+	return sha256.Sum256(der), nil
+}
+
+// OperatorSignedInit verifies the initiator's signature over the init of the ceremony requestID and runs OperatorInit on it
+func OperatorSignedInit(
+	signed *SignedInit,
+	requestID [24]byte,
+	operatorID uint64,
+	sk *rsa.PrivateKey,
+	depositChecker DepositChecker,
+) (*Result, error) {
+	if err := verifySignedInitRequest(signed, requestID); err != nil {
+		return nil, err
+	}
+	return OperatorInit(signed.Init, requestID, operatorID, sk, depositChecker)
+}
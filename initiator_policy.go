@@ -0,0 +1,77 @@
+//go:build !verifyonly
+
+package spec
+
+import (
+	"context"
+
+	"github.com/ethereum/go-ethereum/common"
+	"golang.org/x/exp/slices"
+)
+
+// InitiatorPolicy is an operator's allowlist and denylist of initiators (by InitiatorID) and owner addresses.
+// Denylists take precedence over allowlists, and an empty allowlist allows everyone not denied.
+type InitiatorPolicy struct {
+	AllowedInitiators []common.Hash    `json:"allowed_initiators,omitempty"`
+	DeniedInitiators  []common.Hash    `json:"denied_initiators,omitempty"`
+	AllowedOwners     []common.Address `json:"allowed_owners,omitempty"`
+	DeniedOwners      []common.Address `json:"denied_owners,omitempty"`
+}
+
+// CheckInitiator returns nil if the policy accepts the initiator with initiatorPubKey.
+// Unsigned messages (empty initiatorPubKey) are only accepted if no initiators are allowlisted.
+func (p *InitiatorPolicy) CheckInitiator(initiatorPubKey []byte) error {
+	if len(initiatorPubKey) == 0 {
+		if len(p.AllowedInitiators) > 0 {
+			return codedError(CodeInitiatorNotAllowed, "unidentified initiator")
+		}
+		return nil
+	}
+	id, err := InitiatorID(initiatorPubKey)
+	if err != nil {
+		return withCode(CodeInitiatorNotAllowed, err)
+	}
+	if slices.Contains(p.DeniedInitiators, common.Hash(id)) {
+		return codedError(CodeInitiatorNotAllowed, "initiator %x denied", id)
+	}
+	if len(p.AllowedInitiators) > 0 && !slices.Contains(p.AllowedInitiators, common.Hash(id)) {
+		return codedError(CodeInitiatorNotAllowed, "initiator %x not allowed", id)
+	}
+	return nil
+}
+
+// CheckOwner returns nil if the policy accepts ceremonies of owner
+func (p *InitiatorPolicy) CheckOwner(owner [20]byte) error {
+	if slices.Contains(p.DeniedOwners, common.Address(owner)) {
+		return codedError(CodeOwnerNotAllowed, "owner %s denied", common.Address(owner).Hex())
+	}
+	if len(p.AllowedOwners) > 0 && !slices.Contains(p.AllowedOwners, common.Address(owner)) {
+		return codedError(CodeOwnerNotAllowed, "owner %s not allowed", common.Address(owner).Hex())
+	}
+	return nil
+}
+
+// Check returns nil if the policy accepts msg sent by the initiator with initiatorPubKey, empty if the message isn't signed
+// by its initiator (e.g. reshare and resign messages)
+func (p *InitiatorPolicy) Check(initiatorPubKey []byte, msg CeremonyMessage) error {
+	if err := p.CheckInitiator(initiatorPubKey); err != nil {
+		return err
+	}
+	return p.CheckOwner(msg.GetOwner())
+}
+
+// CheckSignedInit verifies the initiator's signature over signed for the ceremony requestID and returns nil if the policy
+// accepts it. The signature is checked first so rejections are attributed to the actual initiator.
+func (p *InitiatorPolicy) CheckSignedInit(signed *SignedInit, requestID [24]byte) error {
+	if err := verifySignedInitRequest(signed, requestID); err != nil {
+		return err
+	}
+	return p.Check(signed.InitiatorPubKey, signed.Init)
+}
+
+// PolicyFunc returns the policy as a PolicyFunc, reading the initiator from the context (see ContextWithInitiator)
+func (p *InitiatorPolicy) PolicyFunc() PolicyFunc {
+	return func(ctx context.Context, message CeremonyMessage) error {
+		return p.Check(InitiatorFromContext(ctx), message)
+	}
+}
@@ -0,0 +1,82 @@
+package spec
+
+import (
+	"bytes"
+
+	ssz "github.com/ferranbt/fastssz"
+)
+
+// HashTreeRoot ssz hashes the ceremony proofs as a list of SignedProof
+func (cp CeremonyProofs) HashTreeRoot() ([32]byte, error) {
+	return HashTreeRootList(cp, MaxOperators)
+}
+
+// HashTreeRootWith ssz hashes the ceremony proofs as a list of SignedProof with a hasher
+func (cp CeremonyProofs) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	return HashTreeRootListWith(hh, cp, MaxOperators)
+}
+
+// NewLineage returns the lineage a reshare ceremony emits: requestID is the ceremony's, priorProofs are the old operators'
+// proofs the shares were reshared from and proofs are the new operators' proofs, both ordered as the operators
+func NewLineage(requestID [24]byte, signedReshare *SignedReshare, priorProofs, proofs CeremonyProofs) (*Lineage, error) {
+	priorProofsRoot, err := priorProofs.HashTreeRoot()
+	if err != nil {
+		return nil, err
+	}
+	signedReshareRoot, err := signedReshare.HashTreeRoot()
+	if err != nil {
+		return nil, err
+	}
+	proofsRoot, err := proofs.HashTreeRoot()
+	if err != nil {
+		return nil, err
+	}
+	return &Lineage{
+		ValidatorPubKey:   signedReshare.Reshare.ValidatorPubKey,
+		RequestID:         requestID,
+		PriorProofsRoot:   priorProofsRoot,
+		SignedReshareRoot: signedReshareRoot,
+		ProofsRoot:        proofsRoot,
+	}, nil
+}
+
+// VerifyLineage returns nil if lineage commits to signedReshare and both proof sets, and the proofs are a valid hop of
+// the validator's proof chain (see VerifyProofChain) issued in the lineage's ceremony. priorParams are the parameters of
+// the ceremony priorProofs were issued in, nil if they don't commit to them.
+// The owner's signature over the reshare is verified separately, see VerifyOwnerSignature.
+func VerifyLineage(
+	lineage *Lineage,
+	signedReshare *SignedReshare,
+	priorProofs CeremonyProofs,
+	priorParams *CeremonyParams,
+	proofs CeremonyProofs,
+) error {
+	expected, err := NewLineage(lineage.RequestID, signedReshare, priorProofs, proofs)
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(lineage.ValidatorPubKey, expected.ValidatorPubKey) {
+		return codedError(CodeLineageMismatch, "lineage of another validator")
+	}
+	if lineage.SignedReshareRoot != expected.SignedReshareRoot {
+		return codedError(CodeLineageMismatch, "lineage of another reshare")
+	}
+	if lineage.PriorProofsRoot != expected.PriorProofsRoot {
+		return codedError(CodeLineageMismatch, "prior proofs don't match the lineage")
+	}
+	if lineage.ProofsRoot != expected.ProofsRoot {
+		return codedError(CodeLineageMismatch, "proofs don't match the lineage")
+	}
+
+	reshare := &signedReshare.Reshare
+	hop := &ProofChainHop{Reshare: reshare, Proofs: proofs}
+	if err := VerifyProofChain(reshare.OldOperators, priorProofs, priorParams, []*ProofChainHop{hop}); err != nil {
+		return err
+	}
+	for i, proof := range proofs {
+		if proof.Proof.RequestID != lineage.RequestID {
+			return codedError(CodeRequestIDMismatch, "proof of operator %d issued in another ceremony", reshare.NewOperators[i].ID)
+		}
+	}
+	return nil
+}
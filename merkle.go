@@ -0,0 +1,122 @@
+package spec
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+
+	ssz "github.com/ferranbt/fastssz"
+)
+
+// MerkleProof proves a single validator's results are included in a bulk results root
+type MerkleProof struct {
+	// Index of the validator in the bulk batch
+	Index uint64
+	// Count of validators in the bulk batch
+	Count uint64
+	// Branch from leaf to tree root (excluding the length mix in)
+	Branch [][32]byte
+}
+
+// ResultsLeaf returns the leaf committing to a single validator's results, a Merkle root over each result's hash tree root mixed in with the results count
+func ResultsLeaf(results []*Result) ([32]byte, error) {
+	roots, err := HashTreeRoots(results)
+	if err != nil {
+		return [32]byte{}, err
+	}
+	layers := merkleLayers(roots)
+	return mixInLength(layers[len(layers)-1][0], uint64(len(results))), nil
+}
+
+// BulkResultsRoot returns the Merkle root over the per validator results of a bulk ceremony (in batch order)
+func BulkResultsRoot(bulk [][]*Result) ([32]byte, error) {
+	leaves, err := bulkLeaves(bulk)
+	if err != nil {
+		return [32]byte{}, err
+	}
+	layers := merkleLayers(leaves)
+	return mixInLength(layers[len(layers)-1][0], uint64(len(bulk))), nil
+}
+
+// BulkResultsProof returns an inclusion proof for the results of validator at index
+func BulkResultsProof(bulk [][]*Result, index int) (*MerkleProof, error) {
+	if index < 0 || index >= len(bulk) {
+		return nil, fmt.Errorf("index out of range")
+	}
+	leaves, err := bulkLeaves(bulk)
+	if err != nil {
+		return nil, err
+	}
+	layers := merkleLayers(leaves)
+
+	ret := &MerkleProof{
+		Index: uint64(index),
+		Count: uint64(len(bulk)),
+	}
+	idx := index
+	for _, layer := range layers[:len(layers)-1] {
+		ret.Branch = append(ret.Branch, layer[idx^1])
+		idx /= 2
+	}
+	return ret, nil
+}
+
+// VerifyBulkResultsProof returns true if leaf (see ResultsLeaf) is included in root at proof.Index
+func VerifyBulkResultsProof(root [32]byte, leaf [32]byte, proof *MerkleProof) bool {
+	if proof.Index >= proof.Count || len(proof.Branch) != merkleDepth(proof.Count) {
+		return false
+	}
+	node := leaf
+	idx := proof.Index
+	for _, sibling := range proof.Branch {
+		if idx%2 == 0 {
+			node = hashPair(node, sibling)
+		} else {
+			node = hashPair(sibling, node)
+		}
+		idx /= 2
+	}
+	return mixInLength(node, proof.Count) == root
+}
+
+func bulkLeaves(bulk [][]*Result) ([][32]byte, error) {
+	return computeRoots(len(bulk), func(_ *ssz.Hasher, i int) ([32]byte, error) {
+		return ResultsLeaf(bulk[i])
+	})
+}
+
+// merkleLayers returns all tree layers, from the (zero padded to a power of 2) leaves up to the root
+func merkleLayers(leaves [][32]byte) [][][32]byte {
+	depth := merkleDepth(uint64(len(leaves)))
+	layer := make([][32]byte, 1<<depth)
+	copy(layer, leaves)
+
+	ret := [][][32]byte{layer}
+	for len(layer) > 1 {
+		next := make([][32]byte, len(layer)/2)
+		for i := range next {
+			next[i] = hashPair(layer[2*i], layer[2*i+1])
+		}
+		ret = append(ret, next)
+		layer = next
+	}
+	return ret
+}
+
+func merkleDepth(count uint64) int {
+	depth := 0
+	for uint64(1)<<depth < count {
+		depth++
+	}
+	return depth
+}
+
+func hashPair(a, b [32]byte) [32]byte {
+	return sha256.Sum256(append(a[:], b[:]...))
+}
+
+func mixInLength(root [32]byte, length uint64) [32]byte {
+	var l [32]byte
+	binary.LittleEndian.PutUint64(l[:8], length)
+	return hashPair(root, l)
+}
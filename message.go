@@ -0,0 +1,122 @@
+package spec
+
+import (
+	"fmt"
+
+	"github.com/bloxapp/dkg-spec/crypto"
+	"github.com/bloxapp/dkg-spec/eip1271"
+
+	ssz "github.com/ferranbt/fastssz"
+)
+
+// MessageType identifies the kind of ceremony a CeremonyMessage starts
+type MessageType uint8
+
+const (
+	InitMessageType MessageType = iota + 1
+	ReshareMessageType
+	ResignMessageType
+)
+
+func (t MessageType) String() string {
+	switch t {
+	case InitMessageType:
+		return "init"
+	case ReshareMessageType:
+		return "reshare"
+	case ResignMessageType:
+		return "resign"
+	default:
+		return fmt.Sprintf("unknown(%d)", uint8(t))
+	}
+}
+
+// CeremonyMessage is implemented by every message starting a ceremony (Init, Reshare and Resign),
+// letting dispatch, logging and storage code handle them generically.
+// Owner and nonce getters are prefixed with Get as the messages already have Owner and Nonce fields.
+type CeremonyMessage interface {
+	ssz.Marshaler
+	ssz.Unmarshaler
+	ssz.HashRoot
+
+	Type() MessageType
+	GetOwner() [20]byte
+	GetNonce() uint64
+	// SigningRoot returns the root the owner signs
+	SigningRoot() ([32]byte, error)
+	// Validate returns nil if the message is valid on its own, checks requiring a ceremony proof are left to the operator
+	Validate() error
+}
+
+var (
+	_ CeremonyMessage = (*Init)(nil)
+	_ CeremonyMessage = (*Reshare)(nil)
+	_ CeremonyMessage = (*Resign)(nil)
+)
+
+// NewCeremonyMessage returns an empty message of type t, e.g. for decoding
+func NewCeremonyMessage(t MessageType) (CeremonyMessage, error) {
+	switch t {
+	case InitMessageType:
+		return &Init{}, nil
+	case ReshareMessageType:
+		return &Reshare{}, nil
+	case ResignMessageType:
+		return &Resign{}, nil
+	default:
+		return nil, codedError(CodeUnknownMessageType, "unknown message type %d", t)
+	}
+}
+
+// VerifyOwnerSignature returns nil if signature is msg's owner signature over msg's SigningRoot, see
+// crypto.VerifySignedRootByOwner (client may be nil for EOA owners)
+func VerifyOwnerSignature(client eip1271.ETHClient, msg CeremonyMessage, signature []byte) error {
+	root, err := msg.SigningRoot()
+	if err != nil {
+		return err
+	}
+	return crypto.VerifySignedRootByOwner(client, msg.GetOwner(), root, signature)
+}
+
+func (i *Init) Type() MessageType { return InitMessageType }
+
+func (i *Init) GetOwner() [20]byte { return i.Owner }
+
+func (i *Init) GetNonce() uint64 { return i.Nonce }
+
+// SigningRoot returns the init's hash tree root, inits aren't signed by the owner (see SignedInit for the initiator's signature)
+func (i *Init) SigningRoot() ([32]byte, error) { return i.HashTreeRoot() }
+
+func (i *Init) Validate() error { return ValidateInitMessage(i) }
+
+func (r *Reshare) Type() MessageType { return ReshareMessageType }
+
+func (r *Reshare) GetOwner() [20]byte { return r.Owner }
+
+func (r *Reshare) GetNonce() uint64 { return r.Nonce }
+
+// SigningRoot returns the root the owner signs the reshare over
+func (r *Reshare) SigningRoot() ([32]byte, error) { return ComputeSigningRoot(r, DomainReshare) }
+
+func (r *Reshare) Validate() error {
+	if !UniqueAndOrderedOperators(r.OldOperators) {
+		return codedError(CodeOperatorsNotOrdered, "old operators are not unique and ordered")
+	}
+	return validateReshareOperators(r)
+}
+
+func (r *Resign) Type() MessageType { return ResignMessageType }
+
+func (r *Resign) GetOwner() [20]byte { return r.Owner }
+
+func (r *Resign) GetNonce() uint64 { return r.Nonce }
+
+// SigningRoot returns the root the owner signs the resign over
+func (r *Resign) SigningRoot() ([32]byte, error) { return ComputeSigningRoot(r, DomainResign) }
+
+func (r *Resign) Validate() error {
+	if len(r.ValidatorPubKey) != 48 {
+		return codedError(CodeInvalidValidatorPubKey, "invalid validator public key length")
+	}
+	return nil
+}
@@ -0,0 +1,96 @@
+// Package mobile is a gomobile friendly wrapper around ceremony verification, build with:
+//
+//	gomobile bind -target=ios ./mobile
+//	gomobile bind -target=android ./mobile
+//
+// Only types supported by gomobile cross the boundary: messages are passed JSON encoded (the same encodings used by
+// proofs.json and the operator API), keys and addresses as byte slices.
+package mobile
+
+import (
+	"encoding/json"
+	"fmt"
+
+	spec "github.com/bloxapp/dkg-spec"
+	"github.com/bloxapp/dkg-spec/verify"
+)
+
+// AggregatedResult is the outcome of a successful ceremony results aggregation
+type AggregatedResult struct {
+	ValidatorPubKey []byte
+	// DepositDataJSON is the JSON encoded phase0.DepositData
+	DepositDataJSON []byte
+	// OwnerNonceSignature is the validator's signature over the owner and nonce
+	OwnerNonceSignature []byte
+}
+
+// VerifyCeremonyProof returns nil if signedProofJSON is a valid proof signed by operatorPubKey (base64 encoded PEM RSA public key)
+func VerifyCeremonyProof(operatorPubKey []byte, signedProofJSON []byte) error {
+	return verify.VerifyCeremonyProof(operatorPubKey, signedProofJSON)
+}
+
+// VerifyCeremonyProofs returns nil if every ceremony in proofsJSON (proofs.json, single or bulk) is valid for operatorsJSON
+func VerifyCeremonyProofs(operatorsJSON []byte, proofsJSON []byte) error {
+	return verify.ValidateCeremonyProofs(operatorsJSON, proofsJSON)
+}
+
+// AggregateResults verifies resultsJSON and combines them into the final deposit data of amount gwei and owner/nonce signature
+func AggregateResults(
+	operatorsJSON []byte,
+	withdrawalCredentials []byte,
+	validatorPK []byte,
+	fork []byte,
+	amount int64,
+	owner []byte,
+	nonce int64,
+	requestID []byte,
+	resultsJSON []byte,
+) (*AggregatedResult, error) {
+	operators := []*spec.Operator{}
+	if err := json.Unmarshal(operatorsJSON, &operators); err != nil {
+		return nil, err
+	}
+	results := []*spec.Result{}
+	if err := json.Unmarshal(resultsJSON, &results); err != nil {
+		return nil, err
+	}
+	if len(fork) != 4 {
+		return nil, fmt.Errorf("invalid fork length")
+	}
+	if len(owner) != 20 {
+		return nil, fmt.Errorf("invalid owner length")
+	}
+	if len(requestID) != 24 {
+		return nil, fmt.Errorf("invalid request ID length")
+	}
+	if amount < 0 {
+		return nil, fmt.Errorf("invalid amount")
+	}
+	if nonce < 0 {
+		return nil, fmt.Errorf("invalid nonce")
+	}
+
+	pk, depositData, ownerNonceSig, err := spec.Aggregate(
+		operators,
+		withdrawalCredentials,
+		validatorPK,
+		[4]byte(fork),
+		uint64(amount),
+		[20]byte(owner),
+		uint64(nonce),
+		[24]byte(requestID),
+		results,
+	)
+	if err != nil {
+		return nil, err
+	}
+	depositDataJSON, err := json.Marshal(depositData)
+	if err != nil {
+		return nil, err
+	}
+	return &AggregatedResult{
+		ValidatorPubKey:     pk.Serialize(),
+		DepositDataJSON:     depositDataJSON,
+		OwnerNonceSignature: ownerNonceSig.Serialize(),
+	}, nil
+}
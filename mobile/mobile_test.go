@@ -0,0 +1,119 @@
+package mobile
+
+import (
+	"encoding/json"
+	"testing"
+
+	spec "github.com/bloxapp/dkg-spec"
+	"github.com/bloxapp/dkg-spec/testing/fixtures"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/stretchr/testify/require"
+)
+
+func mustJSON(t *testing.T, v interface{}) []byte {
+	byts, err := json.Marshal(v)
+	require.NoError(t, err)
+	return byts
+}
+
+func TestVerifyCeremonyProof(t *testing.T) {
+	t.Run("valid", func(t *testing.T) {
+		require.NoError(t, VerifyCeremonyProof(
+			fixtures.EncodedOperatorPK(fixtures.TestOperator1SK),
+			mustJSON(t, &fixtures.TestOperator1Proof4Operators),
+		))
+	})
+
+	t.Run("wrong operator", func(t *testing.T) {
+		require.Error(t, VerifyCeremonyProof(
+			fixtures.EncodedOperatorPK(fixtures.TestOperator2SK),
+			mustJSON(t, &fixtures.TestOperator1Proof4Operators),
+		))
+	})
+
+	t.Run("invalid json", func(t *testing.T) {
+		require.Error(t, VerifyCeremonyProof(fixtures.EncodedOperatorPK(fixtures.TestOperator1SK), []byte("{")))
+	})
+}
+
+func TestVerifyCeremonyProofs(t *testing.T) {
+	proofs := spec.CeremonyProofs{}
+	for _, result := range fixtures.Results4Operators() {
+		proof := result.SignedProof
+		proofs = append(proofs, &proof)
+	}
+
+	t.Run("single", func(t *testing.T) {
+		require.NoError(t, VerifyCeremonyProofs(mustJSON(t, fixtures.GenerateOperators(4)), mustJSON(t, proofs)))
+	})
+
+	t.Run("bulk", func(t *testing.T) {
+		require.NoError(t, VerifyCeremonyProofs(
+			mustJSON(t, fixtures.GenerateOperators(4)),
+			mustJSON(t, []spec.CeremonyProofs{proofs, proofs}),
+		))
+	})
+
+	t.Run("operators mismatch", func(t *testing.T) {
+		require.EqualError(t, VerifyCeremonyProofs(
+			mustJSON(t, fixtures.GenerateOperators(7)),
+			mustJSON(t, proofs),
+		), "ceremony 0: mismatch proofs count")
+	})
+}
+
+func TestAggregateResults(t *testing.T) {
+	validatorPK := fixtures.ShareSK(fixtures.TestValidator4Operators).GetPublicKey().Serialize()
+
+	t.Run("valid", func(t *testing.T) {
+		res, err := AggregateResults(
+			mustJSON(t, fixtures.GenerateOperators(4)),
+			fixtures.TestWithdrawalCred,
+			validatorPK,
+			fixtures.TestFork[:],
+			int64(spec.DepositAmount),
+			fixtures.TestOwnerAddress[:],
+			int64(fixtures.TestNonce),
+			fixtures.TestRequestID[:],
+			mustJSON(t, fixtures.Results4Operators()),
+		)
+		require.NoError(t, err)
+		require.EqualValues(t, validatorPK, res.ValidatorPubKey)
+		require.Len(t, res.OwnerNonceSignature, 96)
+
+		depositData := &phase0.DepositData{}
+		require.NoError(t, json.Unmarshal(res.DepositDataJSON, depositData))
+		require.EqualValues(t, validatorPK, depositData.PublicKey[:])
+	})
+
+	t.Run("invalid fork length", func(t *testing.T) {
+		_, err := AggregateResults(
+			mustJSON(t, fixtures.GenerateOperators(4)),
+			fixtures.TestWithdrawalCred,
+			validatorPK,
+			[]byte{0, 0},
+			int64(spec.DepositAmount),
+			fixtures.TestOwnerAddress[:],
+			int64(fixtures.TestNonce),
+			fixtures.TestRequestID[:],
+			mustJSON(t, fixtures.Results4Operators()),
+		)
+		require.EqualError(t, err, "invalid fork length")
+	})
+
+	t.Run("negative nonce", func(t *testing.T) {
+		_, err := AggregateResults(
+			mustJSON(t, fixtures.GenerateOperators(4)),
+			fixtures.TestWithdrawalCred,
+			validatorPK,
+			fixtures.TestFork[:],
+			int64(spec.DepositAmount),
+			fixtures.TestOwnerAddress[:],
+			-1,
+			fixtures.TestRequestID[:],
+			mustJSON(t, fixtures.Results4Operators()),
+		)
+		require.EqualError(t, err, "invalid nonce")
+	})
+}
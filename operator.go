@@ -1,45 +1,90 @@
+//go:build !verifyonly
+
 package spec
 
 import (
+	"bytes"
 	"crypto/rsa"
+	"fmt"
+	"time"
 
 	"github.com/bloxapp/dkg-spec/crypto"
+	"github.com/bloxapp/dkg-spec/crypto/bls"
 	"github.com/bloxapp/dkg-spec/eip1271"
-	"github.com/herumi/bls-eth-go-binary/bls"
 )
 
-// OperatorInit is called on operator side when a new init message is received from initiator
+// OperatorInit is called on operator side when a new init message is received from initiator.
+// If depositChecker is not nil, deposit data is signed only if no conflicting deposit exists for the validator.
 func OperatorInit(
 	init *Init,
 	requestID [24]byte,
 	operatorID uint64,
 	sk *rsa.PrivateKey,
+	depositChecker DepositChecker,
 ) (*Result, error) {
-	if err := ValidateInitMessage(init); err != nil {
+	start := time.Now()
+	err := ValidateInitMessage(init)
+	if err == nil {
+		err = ValidateSchedule(init, start)
+	}
+	observePhase(requestID, PhaseValidation, start)
+	if err != nil {
+		return nil, err
+	}
+
+	// the polynomial of degree T-1 the operator deals, committed to with ProveDealing for audits (see AuditDealings)
+	coefficients := make([]bls.SecretKey, init.T)
+	for i := range coefficients {
+		coefficients[i].SetByCSPRNG()
+	}
+	dealingCommitments, dealingProof, err := ProveDealing(requestID, operatorID, coefficients)
+	if err != nil {
 		return nil, err
 	}
 
 	var share *bls.SecretKey
 	var validatorPK []byte
+	var commitments [][]byte
 	/*
 		DKG ceremony
 		ALL participants must participate
+		the operator deals coefficients, its share is the sum of every operator's dealt polynomial evaluated at its ID
+		commitments to the joint public polynomial are the sums of every operator's dealing commitments
 	*/
 
+	if depositChecker != nil {
+		// deposits hold the 32 bytes credentials deposited for the init's withdrawal credentials
+		withdrawalCredentials, err := crypto.DepositWithdrawalCredentials(init.Fork, init.WithdrawalCredentials)
+		if err != nil {
+			return nil, err
+		}
+		start := time.Now()
+		err = ValidateNoConflictingDeposit(depositChecker, validatorPK, withdrawalCredentials)
+		observePhase(requestID, PhaseChainCheck, start)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	// sign deposit data
-	depositDataRoot, err := crypto.DepositDataRootForFork(
-		init.Fork,
+	defer observePhase(requestID, PhaseSigning, time.Now())
+	depositDataSig, ownerNonceSig, err := partialSignatures(
+		func(root []byte) (*bls.Sign, error) {
+			return share.SignByte(root), nil
+		},
 		validatorPK,
 		init.WithdrawalCredentials,
-		crypto.MaxEffectiveBalanceInGwei,
+		init.Fork,
+		DepositAmount,
+		init.Owner,
+		init.Nonce,
 	)
 	if err != nil {
 		return nil, err
 	}
-	depositDataSig := share.SignByte(depositDataRoot[:])
 
 	// sign proof
-	encryptedShare, err := crypto.Encrypt(&sk.PublicKey, share.Serialize())
+	encryptedShare, err := EncryptShare(&sk.PublicKey, share.Serialize(), validatorPK, init.Owner)
 	if err != nil {
 		return nil, err
 	}
@@ -48,12 +93,14 @@ func OperatorInit(
 		EncryptedShare:  encryptedShare,
 		SharePubKey:     share.GetPublicKey().Serialize(),
 		Owner:           init.Owner,
+		RequestID:       requestID,
+		Commitments:     commitments,
 	}
-	byts, err := proof.MarshalSSZ()
+	proof.ParamsHash, err = init.Params().HashTreeRoot()
 	if err != nil {
 		return nil, err
 	}
-	proofSig, err := crypto.SignRSA(sk, byts)
+	proofSig, err := signProof(sk, proof)
 	if err != nil {
 		return nil, err
 	}
@@ -62,45 +109,116 @@ func OperatorInit(
 		OperatorID:                 operatorID,
 		RequestID:                  requestID,
 		DepositPartialSignature:    depositDataSig.Serialize(),
-		OwnerNoncePartialSignature: share.SignByte(PartialNonceRoot(init.Owner, init.Nonce)).Serialize(),
+		OwnerNoncePartialSignature: ownerNonceSig.Serialize(),
 		SignedProof: SignedProof{
 			Proof:     proof,
 			Signature: proofSig,
 		},
+		DealingCommitments: dealingCommitments,
+		DealingProof:       dealingProof,
 	}, nil
 }
 
-// OperatorReshare is called when an operator receives a reshare message
+// OperatorReshareDeal is called on old operators when a reshare message is received, re-dealing their share to the new operators.
+// Each deal is sent to its recipient only, which combines the deals of at least OldT old operators with OperatorReshare.
+// params are the parameters of the ceremony proof was issued in (see ValidateReshareMessage), nil if proof doesn't commit to them.
+func OperatorReshareDeal(
+	signedReshare *SignedReshare,
+	operator *Operator,
+	proof *SignedProof,
+	params *CeremonyParams,
+	share *bls.SecretKey,
+	requestID [24]byte,
+	client eip1271.ETHClient,
+) ([]*ReshareDeal, error) {
+	if err := VerifyOwnerSignature(client, &signedReshare.Reshare, signedReshare.Signature); err != nil {
+		return nil, withCode(CodeInvalidOwnerSignature, err)
+	}
+	if err := ValidateReshareMessage(&signedReshare.Reshare, operator, proof, params); err != nil {
+		return nil, err
+	}
+	if !bytes.Equal(share.GetPublicKey().Serialize(), proof.Proof.SharePubKey) {
+		return nil, fmt.Errorf("share doesn't match proof")
+	}
+	return DealReshare(&signedReshare.Reshare, requestID, operator.ID, share)
+}
+
+// OperatorReshare is called on new operators when a reshare message is received, with the old operators' deals addressed to
+// the operator.
+// Every new operator must be given the deals of the same old operators, see ReshareContributors.
+// proof is the operator's proof of the validator's ceremony, nil for operators joining the cluster, and params the parameters
+// of the ceremony it was issued in (see ValidateReshareMessage), nil if proof doesn't commit to them.
+// If validatorChecker is not nil, the validator must be registered on the beacon chain with the reshare's withdrawal credentials.
 func OperatorReshare(
 	signedReshare *SignedReshare,
 	operator *Operator,
 	proof *SignedProof,
+	params *CeremonyParams,
+	deals []*ReshareDeal,
 	requestID [24]byte,
 	sk *rsa.PrivateKey,
 	client eip1271.ETHClient,
+	validatorChecker ValidatorChecker,
 ) (*Result, error) {
-	if err := crypto.VerifySignedMessageByOwner(
-		client,
-		signedReshare.Reshare.Owner,
-		signedReshare,
-		signedReshare.Signature,
-	); err != nil {
-		return nil, err
+	start := time.Now()
+	err := VerifyOwnerSignature(client, &signedReshare.Reshare, signedReshare.Signature)
+	observePhase(requestID, PhaseOwnerSignature, start)
+	if err != nil {
+		return nil, withCode(CodeInvalidOwnerSignature, err)
 	}
-	if err := ValidateReshareMessage(&signedReshare.Reshare, operator, proof); err != nil {
+	start = time.Now()
+	if proof != nil {
+		err = ValidateReshareMessage(&signedReshare.Reshare, operator, proof, params)
+	} else if GetOperator(signedReshare.Reshare.OldOperators, operator.ID) == nil {
+		// operators joining the cluster hold no proof of the validator's ceremony
+		err = signedReshare.Reshare.Validate()
+	} else {
+		err = fmt.Errorf("missing proof")
+	}
+	observePhase(requestID, PhaseValidation, start)
+	if err != nil {
 		return nil, err
 	}
+	var validator *ChainValidator
+	if validatorChecker != nil {
+		start := time.Now()
+		validator, err = validateValidatorOnChainForFork(
+			validatorChecker,
+			signedReshare.Reshare.ValidatorPubKey,
+			signedReshare.Reshare.Fork,
+			signedReshare.Reshare.WithdrawalCredentials,
+		)
+		observePhase(requestID, PhaseChainCheck, start)
+		if err != nil {
+			return nil, err
+		}
+	}
 
-	var share *bls.SecretKey
-	/*
-		reshare ceremony
-		All new participants must participate
-		T out of old participants must participate
-	*/
-
-	return BuildResult(
+	paramsHash, err := signedReshare.Reshare.NewParams().HashTreeRoot()
+	if err != nil {
+		return nil, err
+	}
+	start = time.Now()
+	decrypted, err := DecryptReshareDeals(requestID, deals, operator.ID, sk)
+	if err != nil {
+		observePhase(requestID, PhaseSigning, start)
+		return nil, err
+	}
+	share, err := CombineReshareDeals(&signedReshare.Reshare, operator.ID, decrypted)
+	if err != nil {
+		observePhase(requestID, PhaseSigning, start)
+		return nil, err
+	}
+	commitments, err := CombineReshareCommitments(&signedReshare.Reshare, decrypted)
+	if err != nil {
+		observePhase(requestID, PhaseSigning, start)
+		return nil, err
+	}
+	result, err := BuildResult(
 		operator.ID,
 		requestID,
+		paramsHash,
+		commitments,
 		share,
 		sk,
 		signedReshare.Reshare.ValidatorPubKey,
@@ -109,39 +227,82 @@ func OperatorReshare(
 		signedReshare.Reshare.Fork,
 		signedReshare.Reshare.Nonce,
 	)
+	observePhase(requestID, PhaseSigning, start)
+	if err != nil {
+		return nil, err
+	}
+	if validator != nil {
+		result.ValidatorIndex = validator.Index
+	}
+	return result, nil
 }
 
-// OperatorResign is called when an operator receives a re-sign message
+// OperatorResign is called when an operator receives a re-sign message, partial signatures are produced by shares.
+// params are the parameters of the ceremony proof was issued in, the re-sign's amount is validated against (see
+// ValidateResignMessage), nil if proof doesn't commit to them.
+// If validatorChecker is not nil, the validator must be registered on the beacon chain with the re-sign's withdrawal credentials.
 func OperatorResign(
 	signedResign *SignedResign,
 	operator *Operator,
 	proof *SignedProof,
+	params *CeremonyParams,
 	requestID [24]byte,
-	share *bls.SecretKey,
+	shares ShareProvider,
 	sk *rsa.PrivateKey,
 	client eip1271.ETHClient,
+	validatorChecker ValidatorChecker,
 ) (*Result, error) {
-	if err := crypto.VerifySignedMessageByOwner(
-		client,
-		signedResign.Resign.Owner,
-		signedResign,
-		signedResign.Signature,
-	); err != nil {
+	start := time.Now()
+	err := VerifyOwnerSignature(client, &signedResign.Resign, signedResign.Signature)
+	observePhase(requestID, PhaseOwnerSignature, start)
+	if err != nil {
+		return nil, withCode(CodeInvalidOwnerSignature, err)
+	}
+	start = time.Now()
+	err = ValidateResignMessage(&signedResign.Resign, operator, proof, params)
+	observePhase(requestID, PhaseValidation, start)
+	if err != nil {
 		return nil, err
 	}
-	if err := ValidateResignMessage(&signedResign.Resign, operator, proof); err != nil {
+	var validator *ChainValidator
+	if validatorChecker != nil {
+		start := time.Now()
+		validator, err = validateValidatorOnChainForFork(
+			validatorChecker,
+			signedResign.Resign.ValidatorPubKey,
+			signedResign.Resign.Fork,
+			signedResign.Resign.WithdrawalCredentials,
+		)
+		observePhase(requestID, PhaseChainCheck, start)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	start = time.Now()
+	result, err := BuildResignResult(operator.ID, requestID, shares, sk, proof, &signedResign.Resign)
+	observePhase(requestID, PhaseSigning, start)
+	if err != nil {
 		return nil, err
 	}
+	if validator != nil {
+		result.ValidatorIndex = validator.Index
+	}
+	return result, nil
+}
 
-	return BuildResult(
-		operator.ID,
-		requestID,
-		share,
-		sk,
-		signedResign.Resign.ValidatorPubKey,
-		signedResign.Resign.Owner,
-		signedResign.Resign.WithdrawalCredentials,
-		signedResign.Resign.Fork,
-		signedResign.Resign.Nonce,
-	)
+// validateValidatorOnChainForFork is ValidateValidatorOnChain with the credentials deposited for withdrawalCredentials on fork
+// (see crypto.DepositWithdrawalCredentials), messages holding a withdrawal address where the beacon chain holds 32 bytes
+// credentials
+func validateValidatorOnChainForFork(
+	checker ValidatorChecker,
+	validatorPK []byte,
+	fork [4]byte,
+	withdrawalCredentials []byte,
+) (*ChainValidator, error) {
+	credentials, err := crypto.DepositWithdrawalCredentials(fork, withdrawalCredentials)
+	if err != nil {
+		return nil, err
+	}
+	return ValidateValidatorOnChain(checker, validatorPK, credentials)
 }
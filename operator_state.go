@@ -0,0 +1,215 @@
+package spec
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"sort"
+
+	"github.com/bloxapp/dkg-spec/crypto"
+)
+
+// OperatorStateVersion is the current OperatorState version
+const OperatorStateVersion = uint64(1)
+
+// NewOperatorState returns an empty state of operator
+func NewOperatorState(operator *Operator) *OperatorState {
+	return &OperatorState{
+		Version:  OperatorStateVersion,
+		Operator: operator,
+	}
+}
+
+// Processed returns true if the operator took part in ceremony requestID
+func (s *OperatorState) Processed(requestID [24]byte) bool {
+	i := s.processedIndex(requestID)
+	return i < len(s.ProcessedRequestIDs) && bytes.Equal(s.ProcessedRequestIDs[i], requestID[:])
+}
+
+// MarkProcessed records the operator took part in ceremony requestID, returns error if it already did
+func (s *OperatorState) MarkProcessed(requestID [24]byte) error {
+	if s.Processed(requestID) {
+		return fmt.Errorf("request %x already processed", requestID)
+	}
+	i := s.processedIndex(requestID)
+	s.ProcessedRequestIDs = append(s.ProcessedRequestIDs, nil)
+	copy(s.ProcessedRequestIDs[i+1:], s.ProcessedRequestIDs[i:])
+	s.ProcessedRequestIDs[i] = append([]byte{}, requestID[:]...)
+	return nil
+}
+
+// AddResult records the operator's result of a ceremony: the ceremony is processed and the operator stores the result's share,
+// replacing the validator's previous share if any
+func (s *OperatorState) AddResult(result *Result) error {
+	if result.OperatorID != s.Operator.ID {
+		return codedError(CodeOperatorNotFound, "result of operator %d", result.OperatorID)
+	}
+	proof := result.SignedProof.Proof
+	if proof == nil {
+		return fmt.Errorf("missing proof")
+	}
+	if !bytes.Equal(proof.RequestID[:], result.RequestID[:]) {
+		return codedError(CodeRequestIDMismatch, "invalid proof request ID")
+	}
+	root, err := result.HashTreeRoot()
+	if err != nil {
+		return err
+	}
+	if err := s.MarkProcessed(result.RequestID); err != nil {
+		return err
+	}
+
+	share := &StoredShare{
+		ValidatorPubKey: proof.ValidatorPubKey,
+		SharePubKey:     proof.SharePubKey,
+		RequestID:       result.RequestID,
+		ResultRoot:      root,
+	}
+	i := sort.Search(len(s.Shares), func(i int) bool {
+		return bytes.Compare(s.Shares[i].ValidatorPubKey, share.ValidatorPubKey) >= 0
+	})
+	if i < len(s.Shares) && bytes.Equal(s.Shares[i].ValidatorPubKey, share.ValidatorPubKey) {
+		s.Shares[i] = share
+		return nil
+	}
+	s.Shares = append(s.Shares, nil)
+	copy(s.Shares[i+1:], s.Shares[i:])
+	s.Shares[i] = share
+	return nil
+}
+
+// Share returns the operator's stored share of validatorPK, nil if it stores none
+func (s *OperatorState) Share(validatorPK []byte) *StoredShare {
+	for _, share := range s.Shares {
+		if bytes.Equal(share.ValidatorPubKey, validatorPK) {
+			return share
+		}
+	}
+	return nil
+}
+
+// Validate returns nil if the state is of the current version, its request IDs and shares are ordered without duplicates and
+// every share was produced in a processed ceremony
+func (s *OperatorState) Validate() error {
+	if s.Version != OperatorStateVersion {
+		return fmt.Errorf("unsupported operator state version %d", s.Version)
+	}
+	if s.Operator == nil {
+		return fmt.Errorf("missing operator")
+	}
+	for i := 1; i < len(s.ProcessedRequestIDs); i++ {
+		if bytes.Compare(s.ProcessedRequestIDs[i-1], s.ProcessedRequestIDs[i]) >= 0 {
+			return codedError(CodeNonCanonicalEncoding, "processed request IDs are not unique and ordered")
+		}
+	}
+	for i, share := range s.Shares {
+		if i > 0 && bytes.Compare(s.Shares[i-1].ValidatorPubKey, share.ValidatorPubKey) >= 0 {
+			return codedError(CodeNonCanonicalEncoding, "shares are not unique and ordered")
+		}
+		if !s.Processed(share.RequestID) {
+			return fmt.Errorf("share of %x produced in unprocessed request %x", share.ValidatorPubKey, share.RequestID)
+		}
+	}
+	return nil
+}
+
+// operatorStateLabel is the GCM additional data of encrypted operator states
+var operatorStateLabel = []byte("DKG operator state")
+
+// EncryptOperatorState returns state encrypted with a key derived from passphrase with the standard scrypt parameters and a
+// random salt, see crypto.NewKDFParams
+func EncryptOperatorState(state *OperatorState, passphrase []byte) (*EncryptedOperatorState, error) {
+	if err := state.Validate(); err != nil {
+		return nil, err
+	}
+	byts, err := state.MarshalSSZ()
+	if err != nil {
+		return nil, err
+	}
+	params, err := crypto.NewKDFParams(crypto.KDFScrypt)
+	if err != nil {
+		return nil, err
+	}
+	ret := &EncryptedOperatorState{}
+	if ret.KDF, err = params.MarshalBinary(); err != nil {
+		return nil, err
+	}
+	key, err := ret.key(passphrase)
+	if err != nil {
+		return nil, err
+	}
+	if ret.Ciphertext, err = crypto.Seal(key, byts, operatorStateLabel); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// DecryptOperatorState returns the state encrypted with passphrase, returns error if it isn't valid
+func DecryptOperatorState(encrypted *EncryptedOperatorState, passphrase []byte) (*OperatorState, error) {
+	key, err := encrypted.key(passphrase)
+	if err != nil {
+		return nil, err
+	}
+	byts, err := crypto.Open(key, encrypted.Ciphertext, operatorStateLabel)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt operator state: %v", err)
+	}
+	ret := &OperatorState{}
+	if err := ret.UnmarshalSSZ(byts); err != nil {
+		return nil, fmt.Errorf("failed to decode operator state: %v", err)
+	}
+	if err := ret.Validate(); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// key returns the key derived from passphrase with the state's KDF parameters
+func (e *EncryptedOperatorState) key(passphrase []byte) ([]byte, error) {
+	params := &crypto.KDFParams{}
+	if err := params.UnmarshalBinary(e.KDF); err != nil {
+		return nil, err
+	}
+	return params.DeriveKey(passphrase)
+}
+
+// ExportOperatorState writes state to w SSZ encoded and encrypted with passphrase, see EncryptOperatorState
+func ExportOperatorState(w io.Writer, state *OperatorState, passphrase []byte) error {
+	encrypted, err := EncryptOperatorState(state, passphrase)
+	if err != nil {
+		return err
+	}
+	byts, err := encrypted.MarshalSSZ()
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(byts)
+	return err
+}
+
+// ImportOperatorState reads the state ExportOperatorState wrote to r, returns error if it isn't valid or isn't operator's state
+func ImportOperatorState(r io.Reader, operator *Operator, passphrase []byte) (*OperatorState, error) {
+	byts, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	encrypted := &EncryptedOperatorState{}
+	if err := encrypted.UnmarshalSSZ(byts); err != nil {
+		return nil, fmt.Errorf("failed to decode encrypted operator state: %v", err)
+	}
+	ret, err := DecryptOperatorState(encrypted, passphrase)
+	if err != nil {
+		return nil, err
+	}
+	if ret.Operator.ID != operator.ID || !bytes.Equal(ret.Operator.PubKey, operator.PubKey) {
+		return nil, codedError(CodeOperatorKeyMismatch, "state of operator %d", ret.Operator.ID)
+	}
+	return ret, nil
+}
+
+// processedIndex returns the index requestID is (or would be) at in ProcessedRequestIDs
+func (s *OperatorState) processedIndex(requestID [24]byte) int {
+	return sort.Search(len(s.ProcessedRequestIDs), func(i int) bool {
+		return bytes.Compare(s.ProcessedRequestIDs[i], requestID[:]) >= 0
+	})
+}
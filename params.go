@@ -0,0 +1,67 @@
+package spec
+
+import (
+	"bytes"
+
+	"golang.org/x/exp/slices"
+)
+
+// DepositAmount is the amount in gwei of the deposit data signed by ceremonies
+const DepositAmount = uint64(32000000000)
+
+// NewCeremonyParams returns the parameters of a ceremony of operators with threshold t, signing deposit data for fork
+func NewCeremonyParams(operators []*Operator, t uint64, fork [4]byte) *CeremonyParams {
+	ids := make([]uint64, len(operators))
+	for i, operator := range operators {
+		ids[i] = operator.ID
+	}
+	return &CeremonyParams{
+		OperatorIDs: ids,
+		T:           t,
+		Fork:        fork,
+		Amount:      DepositAmount,
+	}
+}
+
+// Params returns the parameters of the init's ceremony
+func (i *Init) Params() *CeremonyParams {
+	return NewCeremonyParams(i.Operators, i.T, i.Fork)
+}
+
+// NewParams returns the parameters of the reshare's ceremony
+func (r *Reshare) NewParams() *CeremonyParams {
+	return NewCeremonyParams(r.NewOperators, r.NewT, r.Fork)
+}
+
+// ValidateProofParams returns nil if proof was issued under params.
+// Proofs issued before proofs committed to their parameters (see ProofV2) have no parameters hash and aren't checked.
+func ValidateProofParams(proof *Proof, params *CeremonyParams) error {
+	if proof.ParamsHash == [32]byte{} {
+		return nil
+	}
+	root, err := params.HashTreeRoot()
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(root[:], proof.ParamsHash[:]) {
+		return codedError(CodeProofParamsMismatch, "proof issued under different ceremony parameters")
+	}
+	return nil
+}
+
+// validateOriginalParams returns nil if proof was issued under params, the parameters of the ceremony which produced the
+// shares of operators with threshold t. Their fork and amount are the original ceremony's, which later reshares and
+// resigns may change, so they're given rather than derived from the message at hand.
+// Proofs which don't commit to their parameters aren't checked, params may then be nil.
+func validateOriginalParams(proof *Proof, params *CeremonyParams, operators []*Operator, t uint64) error {
+	if proof.ParamsHash == [32]byte{} {
+		return nil
+	}
+	if params == nil {
+		return codedError(CodeProofParamsMismatch, "missing the parameters of the proof's ceremony")
+	}
+	if params.T != t || !slices.Equal(params.OperatorIDs, NewCeremonyParams(operators, t, params.Fork).OperatorIDs) {
+		return codedError(CodeProofParamsMismatch, "proof issued under different ceremony parameters")
+	}
+	return ValidateProofParams(proof, params)
+}
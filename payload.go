@@ -0,0 +1,76 @@
+package spec
+
+import (
+	"crypto/rsa"
+	"encoding/binary"
+	"fmt"
+
+	"github.com/bloxapp/dkg-spec/crypto"
+
+	ssz "github.com/ferranbt/fastssz"
+)
+
+// payloadLabel prefixes the label binding an encrypted payload's ciphertext to its operator, request and type
+var payloadLabel = []byte("DKG payload")
+
+// EncryptPayload encrypts msg of type t for operator, see EncryptedPayload. The message sent for each type is Init for init,
+// SignedReshare for reshare and SignedResign for resign ceremonies.
+func EncryptPayload(operator *Operator, requestID [24]byte, t MessageType, msg ssz.Marshaler) (*EncryptedPayload, error) {
+	if _, err := NewCeremonyMessage(t); err != nil {
+		return nil, err
+	}
+	pk, err := crypto.ParseRSAPublicKey(operator.PubKey)
+	if err != nil {
+		return nil, err
+	}
+	byts, err := msg.MarshalSSZ()
+	if err != nil {
+		return nil, err
+	}
+	ret := &EncryptedPayload{
+		OperatorID: operator.ID,
+		RequestID:  requestID,
+		Type:       uint8(t),
+	}
+	if ret.Ciphertext, err = crypto.EncryptHybrid(pk, byts, ret.label()); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// EncryptPayloads encrypts msg for each of operators, in operators order
+func EncryptPayloads(operators []*Operator, requestID [24]byte, t MessageType, msg ssz.Marshaler) ([]*EncryptedPayload, error) {
+	ret := make([]*EncryptedPayload, 0, len(operators))
+	for _, operator := range operators {
+		payload, err := EncryptPayload(operator, requestID, t, msg)
+		if err != nil {
+			return nil, fmt.Errorf("operator %d: %v", operator.ID, err)
+		}
+		ret = append(ret, payload)
+	}
+	return ret, nil
+}
+
+// DecryptPayload decrypts payload received by operator operatorID with its key sk into msg, which must be the message of the
+// payload's type (see EncryptPayload)
+func DecryptPayload(operatorID uint64, sk *rsa.PrivateKey, payload *EncryptedPayload, msg ssz.Unmarshaler) error {
+	if payload.OperatorID != operatorID {
+		return fmt.Errorf("payload encrypted to operator %d", payload.OperatorID)
+	}
+	if _, err := NewCeremonyMessage(MessageType(payload.Type)); err != nil {
+		return err
+	}
+	byts, err := crypto.DecryptHybrid(sk, payload.Ciphertext, payload.label())
+	if err != nil {
+		return fmt.Errorf("failed to decrypt payload: %v", err)
+	}
+	return msg.UnmarshalSSZ(byts)
+}
+
+func (p *EncryptedPayload) label() []byte {
+	ret := make([]byte, 0, len(payloadLabel)+8+len(p.RequestID)+1)
+	ret = append(ret, payloadLabel...)
+	ret = binary.LittleEndian.AppendUint64(ret, p.OperatorID)
+	ret = append(ret, p.RequestID[:]...)
+	return append(ret, p.Type)
+}
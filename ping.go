@@ -0,0 +1,119 @@
+//go:build !verifyonly
+
+package spec
+
+import (
+	"bytes"
+	"crypto/rand"
+	"crypto/rsa"
+	"fmt"
+	"time"
+
+	"github.com/bloxapp/dkg-spec/crypto"
+)
+
+// NewPing returns a ping with a random nonce sent at now
+func NewPing(now time.Time) (*Ping, error) {
+	ret := &Ping{Timestamp: uint64(now.Unix())}
+	if _, err := rand.Read(ret.Nonce[:]); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// SignPong returns operatorID's signed answer to ping at now, advertising the spec version and forks it supports
+// and its current load
+func SignPong(
+	operatorID uint64,
+	ping *Ping,
+	activeCeremonies uint64,
+	maxCeremonies uint64,
+	now time.Time,
+	sk *rsa.PrivateKey,
+) (*SignedPong, error) {
+	compatibility := currentCompatibility()
+	forks := make([][]byte, 0, len(compatibility.Forks))
+	for _, fork := range compatibility.Forks {
+		forks = append(forks, append([]byte{}, fork.Fork[:]...))
+	}
+	pong := &Pong{
+		OperatorID:       operatorID,
+		PingNonce:        ping.Nonce,
+		Timestamp:        uint64(now.Unix()),
+		SpecVersion:      []byte(compatibility.SpecVersion),
+		Forks:            forks,
+		ActiveCeremonies: activeCeremonies,
+		MaxCeremonies:    maxCeremonies,
+	}
+	hash, err := pong.SigningRoot()
+	if err != nil {
+		return nil, err
+	}
+	sig, err := crypto.SignRSA(sk, hash[:])
+	if err != nil {
+		return nil, err
+	}
+	return &SignedPong{
+		Pong:      pong,
+		Signature: sig,
+	}, nil
+}
+
+// VerifyPong returns nil if signed answers ping and is signed by one of operators.
+// If maxAge is positive the pong's timestamp must be within maxAge of now.
+func VerifyPong(
+	operators []*Operator,
+	ping *Ping,
+	signed *SignedPong,
+	now time.Time,
+	maxAge time.Duration,
+) error {
+	if signed.Pong == nil {
+		return fmt.Errorf("missing pong")
+	}
+	operator := GetOperator(operators, signed.Pong.OperatorID)
+	if operator == nil {
+		return fmt.Errorf("operator not found")
+	}
+	if signed.Pong.PingNonce != ping.Nonce {
+		return fmt.Errorf("pong doesn't answer ping")
+	}
+	if maxAge > 0 {
+		age := now.Sub(time.Unix(int64(signed.Pong.Timestamp), 0))
+		if age > maxAge {
+			return fmt.Errorf("pong expired")
+		}
+		if age < -maxAge {
+			return fmt.Errorf("pong timestamp in the future")
+		}
+	}
+
+	hash, err := signed.Pong.SigningRoot()
+	if err != nil {
+		return err
+	}
+	pk, err := crypto.ParseRSAPublicKey(operator.PubKey)
+	if err != nil {
+		return err
+	}
+	return crypto.VerifyRSA(pk, hash[:], signed.Signature)
+}
+
+// Ready returns nil if the operator can take part in a ceremony of fork right now: it implements the same spec version,
+// supports fork and has capacity for another ceremony
+func (p *Pong) Ready(fork [4]byte) error {
+	if string(p.SpecVersion) != SpecVersion {
+		return fmt.Errorf("operator %d runs spec %s, expected %s", p.OperatorID, p.SpecVersion, SpecVersion)
+	}
+	supported := false
+	for _, f := range p.Forks {
+		supported = supported || bytes.Equal(f, fork[:])
+	}
+	if !supported {
+		return fmt.Errorf("operator %d doesn't support fork %x", p.OperatorID, fork[:])
+	}
+	if p.MaxCeremonies > 0 && p.ActiveCeremonies >= p.MaxCeremonies {
+		return fmt.Errorf("operator %d at capacity, %d of %d ceremonies running", p.OperatorID, p.ActiveCeremonies, p.MaxCeremonies)
+	}
+	return nil
+}
@@ -0,0 +1,74 @@
+package spec
+
+import "context"
+
+// PolicyFunc is an operator's custom rule run on a ceremony message before any ceremony work, e.g. accepting KYC'd owners
+// or specific networks only. Returning an error rejects the ceremony, errors without a code are reported as CodePolicyRejected.
+type PolicyFunc func(ctx context.Context, message CeremonyMessage) error
+
+// Policies returns a policy rejecting messages any of policies rejects, evaluated in order
+func Policies(policies ...PolicyFunc) PolicyFunc {
+	return func(ctx context.Context, message CeremonyMessage) error {
+		for _, policy := range policies {
+			if err := policy(ctx, message); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+}
+
+// ApplyPolicy returns nil if policy accepts message, a nil policy accepts every message
+func ApplyPolicy(ctx context.Context, policy PolicyFunc, message CeremonyMessage) error {
+	if policy == nil {
+		return nil
+	}
+	err := policy(ctx, message)
+	if err != nil && ErrorCodeOf(err) == CodeUnknown {
+		return withCode(CodePolicyRejected, err)
+	}
+	return err
+}
+
+// ForkPolicy returns a policy accepting ceremonies for forks only
+func ForkPolicy(forks ...[4]byte) PolicyFunc {
+	return func(ctx context.Context, message CeremonyMessage) error {
+		fork, err := messageFork(message)
+		if err != nil {
+			return err
+		}
+		for _, f := range forks {
+			if f == fork {
+				return nil
+			}
+		}
+		return codedError(CodePolicyRejected, "fork %x not accepted", fork[:])
+	}
+}
+
+type initiatorContextKey struct{}
+
+// ContextWithInitiator returns ctx carrying the public key of the initiator who sent the message, for policies to read
+// with InitiatorFromContext
+func ContextWithInitiator(ctx context.Context, initiatorPubKey []byte) context.Context {
+	return context.WithValue(ctx, initiatorContextKey{}, initiatorPubKey)
+}
+
+// InitiatorFromContext returns the initiator public key set with ContextWithInitiator, nil if unknown
+func InitiatorFromContext(ctx context.Context) []byte {
+	pk, _ := ctx.Value(initiatorContextKey{}).([]byte)
+	return pk
+}
+
+func messageFork(message CeremonyMessage) ([4]byte, error) {
+	switch msg := message.(type) {
+	case *Init:
+		return msg.Fork, nil
+	case *Reshare:
+		return msg.Fork, nil
+	case *Resign:
+		return msg.Fork, nil
+	default:
+		return [4]byte{}, codedError(CodeUnknownMessageType, "unknown message type %s", message.Type())
+	}
+}
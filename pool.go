@@ -0,0 +1,50 @@
+package spec
+
+import (
+	"bytes"
+	"sync"
+
+	ssz "github.com/ferranbt/fastssz"
+)
+
+// maxPooledBufferSize caps buffers returned to the pools, a single huge message shouldn't stay pinned in memory
+const maxPooledBufferSize = 1 << 20
+
+var sszBufferPool = sync.Pool{
+	New: func() interface{} {
+		buf := make([]byte, 0, 4096)
+		return &buf
+	},
+}
+
+var jsonBufferPool = sync.Pool{
+	New: func() interface{} {
+		return &bytes.Buffer{}
+	},
+}
+
+// withSSZ calls f with obj's SSZ encoding held in a pooled buffer, f must not retain it
+func withSSZ(obj ssz.Marshaler, f func(byts []byte) error) error {
+	bufp := sszBufferPool.Get().(*[]byte)
+	byts, err := obj.MarshalSSZTo((*bufp)[:0])
+	if err == nil {
+		err = f(byts)
+	}
+	if cap(byts) <= maxPooledBufferSize {
+		*bufp = byts[:0]
+		sszBufferPool.Put(bufp)
+	}
+	return err
+}
+
+func getJSONBuffer() *bytes.Buffer {
+	return jsonBufferPool.Get().(*bytes.Buffer)
+}
+
+func putJSONBuffer(buf *bytes.Buffer) {
+	if buf.Cap() > maxPooledBufferSize {
+		return
+	}
+	buf.Reset()
+	jsonBufferPool.Put(buf)
+}
@@ -0,0 +1,109 @@
+//go:build !verifyonly
+
+package spec
+
+import (
+	"bytes"
+
+	"github.com/bloxapp/dkg-spec/crypto"
+	"github.com/bloxapp/dkg-spec/crypto/bls"
+)
+
+// PartialSignature is an operator's contribution to a master signature, with the proof of possession of its share key
+type PartialSignature struct {
+	OperatorID  uint64 `json:"operator_id"`
+	SharePubKey []byte `json:"share_pub"`
+	Signature   []byte `json:"signature"`
+	// PossessionProof of the share key, see ProvePossession
+	PossessionProof []byte `json:"possession_proof"`
+}
+
+// ProvePossession returns sk's proof of possession, its signature over its own public key
+func ProvePossession(sk *bls.SecretKey) ([]byte, error) {
+	root, err := (&Possession{PubKey: sk.GetPublicKey().Serialize()}).SigningRoot()
+	if err != nil {
+		return nil, err
+	}
+	return sk.SignByte(root[:]).Serialize(), nil
+}
+
+// VerifyPossession returns nil if proof proves possession of pubKey's secret key
+func VerifyPossession(pubKey, proof []byte) error {
+	pk, err := BLSPKEncode(pubKey)
+	if err != nil {
+		return codedError(CodeInvalidPossessionProof, "invalid public key: %v", err)
+	}
+	sig, err := BLSSignatureEncode(proof)
+	if err != nil {
+		return codedError(CodeInvalidPossessionProof, "invalid proof of possession: %v", err)
+	}
+	root, err := (&Possession{PubKey: pubKey}).SigningRoot()
+	if err != nil {
+		return err
+	}
+	if !sig.VerifyByte(pk, root[:]) {
+		return codedError(CodeInvalidPossessionProof, "invalid proof of possession of %x", pubKey)
+	}
+	return nil
+}
+
+// AggregatePartialSignatures returns the master signature of validatorPK over root recovered from partials. Before
+// aggregating it rejects duplicate contributions (of an operator, or of a share key under several operator IDs) and
+// checks every share key's proof of possession, so no operator can contribute a key derived from the others' keys, then
+// checks the partial signatures and the recovered public key and signature.
+func AggregatePartialSignatures(validatorPK []byte, root []byte, partials []*PartialSignature) (*bls.Sign, error) {
+	if len(partials) == 0 {
+		return nil, codedError(CodeResultsCountMismatch, "no partial signatures")
+	}
+	ids := make([]uint64, 0, len(partials))
+	pks := make([]*bls.PublicKey, 0, len(partials))
+	sigs := make([]*bls.Sign, 0, len(partials))
+	roots := make([][]byte, 0, len(partials))
+	byOperator := make(map[uint64]bool, len(partials))
+	byKey := make(map[string]uint64, len(partials))
+	for _, partial := range partials {
+		if byOperator[partial.OperatorID] {
+			return nil, codedError(CodeDuplicateContribution, "duplicate contribution of operator %d", partial.OperatorID)
+		}
+		byOperator[partial.OperatorID] = true
+		if other, found := byKey[string(partial.SharePubKey)]; found {
+			return nil, codedError(CodeDuplicateContribution, "operator %d contributes the share key of operator %d", partial.OperatorID, other)
+		}
+		byKey[string(partial.SharePubKey)] = partial.OperatorID
+
+		if err := VerifyPossession(partial.SharePubKey, partial.PossessionProof); err != nil {
+			return nil, codedError(CodeInvalidPossessionProof, "operator %d: %v", partial.OperatorID, err)
+		}
+		pk, err := BLSPKEncode(partial.SharePubKey)
+		if err != nil {
+			return nil, err
+		}
+		sig, err := BLSSignatureEncode(partial.Signature)
+		if err != nil {
+			return nil, codedError(CodeInvalidPartialSignature, "operator %d: %v", partial.OperatorID, err)
+		}
+		ids = append(ids, partial.OperatorID)
+		pks = append(pks, pk)
+		sigs = append(sigs, sig)
+		roots = append(roots, root)
+	}
+	if err := crypto.BatchVerifyPartialSigs(sigs, pks, roots); err != nil {
+		return nil, codedError(CodeInvalidPartialSignature, "%v", err)
+	}
+
+	recoveredPK, err := crypto.RecoverValidatorPublicKey(ids, pks)
+	if err != nil {
+		return nil, err
+	}
+	if !bytes.Equal(recoveredPK.Serialize(), validatorPK) {
+		return nil, codedError(CodeRecoveredPubKeyMismatch, "invalid recovered validator pubkey")
+	}
+	master, err := crypto.RecoverBLSSignature(ids, sigs)
+	if err != nil {
+		return nil, err
+	}
+	if !master.VerifyByte(recoveredPK, root) {
+		return nil, codedError(CodeInvalidMasterSignature, "invalid master signature")
+	}
+	return master, nil
+}
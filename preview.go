@@ -0,0 +1,109 @@
+//go:build !verifyonly
+
+package spec
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/bloxapp/dkg-spec/crypto"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// PreviewField is a single labeled value of a SigningPreview
+type PreviewField struct {
+	Label string `json:"label"`
+	Value string `json:"value"`
+}
+
+// SigningPreview is the human-readable form of a message the owner is asked to sign, for wallet UIs and CLIs to display
+// before requesting the signature. The owner signs SigningRoot itself, not the rendered text (no EIP-191 prefix is added).
+type SigningPreview struct {
+	Title  string         `json:"title"`
+	Fields []PreviewField `json:"fields"`
+	// SigningRoot is the 0x prefixed hex root the owner signs
+	SigningRoot string `json:"signing_root"`
+}
+
+// Text returns the preview as text, one "label: value" line per field followed by the signing root
+func (p *SigningPreview) Text() string {
+	var b strings.Builder
+	b.WriteString(p.Title)
+	b.WriteString("\n")
+	for _, field := range p.Fields {
+		b.WriteString(fmt.Sprintf("%s: %s\n", field.Label, field.Value))
+	}
+	b.WriteString(fmt.Sprintf("Signing root: %s", p.SigningRoot))
+	return b.String()
+}
+
+func (p *SigningPreview) add(label, value string) {
+	p.Fields = append(p.Fields, PreviewField{Label: label, Value: value})
+}
+
+// PreviewReshare returns the preview of the reshare message the owner signs
+func PreviewReshare(reshare *Reshare) (*SigningPreview, error) {
+	ret, err := newSigningPreview("DKG reshare request", reshare)
+	if err != nil {
+		return nil, err
+	}
+	ret.add("Owner", common.Address(reshare.Owner).Hex())
+	ret.add("Nonce", strconv.FormatUint(reshare.Nonce, 10))
+	ret.add("Network", previewFork(reshare.Fork))
+	ret.add("Validator", "0x"+hex.EncodeToString(reshare.ValidatorPubKey))
+	ret.add("Withdrawal credentials", "0x"+hex.EncodeToString(reshare.WithdrawalCredentials))
+	ret.add("Old operators", previewOperators(reshare.OldOperators, reshare.OldT))
+	ret.add("New operators", previewOperators(reshare.NewOperators, reshare.NewT))
+	if !reshare.Fee.IsZero() {
+		ret.add("Fee", reshare.Fee.String())
+	}
+	return ret, nil
+}
+
+// PreviewResign returns the preview of the resign message the owner signs
+func PreviewResign(resign *Resign) (*SigningPreview, error) {
+	ret, err := newSigningPreview("DKG resign request", resign)
+	if err != nil {
+		return nil, err
+	}
+	ret.add("Owner", common.Address(resign.Owner).Hex())
+	ret.add("Nonce", strconv.FormatUint(resign.Nonce, 10))
+	ret.add("Network", previewFork(resign.Fork))
+	ret.add("Validator", "0x"+hex.EncodeToString(resign.ValidatorPubKey))
+	ret.add("Withdrawal credentials", "0x"+hex.EncodeToString(resign.WithdrawalCredentials))
+	return ret, nil
+}
+
+// newSigningPreview returns an empty preview of msg with the root operators verify the owner's signature over (see
+// VerifyOwnerSignature)
+func newSigningPreview(title string, msg CeremonyMessage) (*SigningPreview, error) {
+	root, err := msg.SigningRoot()
+	if err != nil {
+		return nil, err
+	}
+	return &SigningPreview{
+		Title:       title,
+		SigningRoot: "0x" + hex.EncodeToString(root[:]),
+	}, nil
+}
+
+// previewFork returns the fork's name and version, forks unknown to this spec are shown as such rather than rejected
+func previewFork(fork [4]byte) string {
+	name := "unknown"
+	if behavior, err := crypto.GetForkBehavior(fork); err == nil {
+		name = behavior.Name
+	}
+	return fmt.Sprintf("%s (fork 0x%x)", name, fork[:])
+}
+
+// previewOperators returns the operator IDs and threshold, e.g. "1, 2, 3, 4 (threshold 3)"
+func previewOperators(operators []*Operator, t uint64) string {
+	ids := make([]string, len(operators))
+	for i, operator := range operators {
+		ids[i] = strconv.FormatUint(operator.ID, 10)
+	}
+	return fmt.Sprintf("%s (threshold %d)", strings.Join(ids, ", "), t)
+}
@@ -2,7 +2,6 @@ package spec
 
 import (
 	"bytes"
-	"fmt"
 
 	"github.com/bloxapp/dkg-spec/crypto"
 )
@@ -14,11 +13,11 @@ func ValidateCeremonyProof(
 	signedProof SignedProof,
 ) error {
 	if !bytes.Equal(ownerAddress[:], signedProof.Proof.Owner[:]) {
-		return fmt.Errorf("invalid owner address")
+		return codedError(CodeProofOwnerMismatch, "invalid owner address")
 	}
 	// verify validator pk
 	if !bytes.Equal(validatorPK, signedProof.Proof.ValidatorPubKey) {
-		return fmt.Errorf("invalid proof validator pubkey")
+		return codedError(CodeProofValidatorMismatch, "invalid proof validator pubkey")
 	}
 	if err := VerifyCeremonyProof(operator.PubKey, signedProof); err != nil {
 		return err
@@ -26,15 +25,31 @@ func ValidateCeremonyProof(
 	return nil
 }
 
-// VerifyCeremonyProof returns error if ceremony signed proof is invalid
+// VerifyCeremonyProof returns error if ceremony signed proof is invalid.
+// Proofs issued before the current Proof version are verified against their version's signing root
+// (see VersionedProof.SigningRoot), so they stay valid once decoded.
 func VerifyCeremonyProof(pkBytes []byte, proof SignedProof) error {
-	hash, err := proof.Proof.HashTreeRoot()
-	if err != nil {
-		return err
-	}
 	pk, err := crypto.ParseRSAPublicKey(pkBytes)
 	if err != nil {
 		return err
 	}
-	return crypto.VerifyRSA(pk, hash[:], proof.Signature)
+	var verifyErr error
+	for _, version := range proofVersions(proof.Proof) {
+		versioned, err := encodeProof(proof.Proof, version)
+		if err != nil {
+			return err
+		}
+		hash, err := versioned.SigningRoot()
+		if err != nil {
+			return err
+		}
+		err = crypto.VerifyRSA(pk, hash[:], proof.Signature)
+		if err == nil {
+			return nil
+		}
+		if verifyErr == nil {
+			verifyErr = err
+		}
+	}
+	return withCode(CodeInvalidProofSignature, verifyErr)
 }
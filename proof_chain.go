@@ -0,0 +1,85 @@
+package spec
+
+import (
+	"bytes"
+	"fmt"
+)
+
+// ProofChainHop is a reshare of a validator and the ceremony proofs of its new operators, ordered as the new operators
+type ProofChainHop struct {
+	Reshare *Reshare
+	Proofs  CeremonyProofs
+}
+
+// VerifyProofChain returns nil if hops are successive reshares of the validator of the initial ceremony, whose proofs
+// (ordered as operators) are the first link of the chain and params its parameters, nil if its proofs don't commit to them.
+// At every hop:
+//   - the reshare is of the same validator and owner, and its old operators are the previous hop's operators
+//   - the previous hop's proofs, which the old operators reshare from, were issued under the previous hop's parameters
+//   - the new operators signed a proof each for the same validator and owner, issued under the reshare's new parameters
+func VerifyProofChain(operators []*Operator, proofs CeremonyProofs, params *CeremonyParams, hops []*ProofChainHop) error {
+	if err := ValidateCeremonyProofs(operators, proofs); err != nil {
+		return fmt.Errorf("initial ceremony: %w", err)
+	}
+	validatorPK := proofs.ValidatorPubKey()
+	owner := proofs.Owner()
+
+	for i, hop := range hops {
+		if err := verifyProofChainHop(validatorPK, owner, operators, proofs, params, hop); err != nil {
+			return fmt.Errorf("reshare %d: %w", i, err)
+		}
+		operators = hop.Reshare.NewOperators
+		proofs = hop.Proofs
+		params = hop.Reshare.NewParams()
+	}
+	return nil
+}
+
+// verifyProofChainHop returns nil if hop reshares the validator from operators, whose proofs are oldProofs issued under oldParams
+func verifyProofChainHop(
+	validatorPK []byte,
+	owner [20]byte,
+	operators []*Operator,
+	oldProofs CeremonyProofs,
+	oldParams *CeremonyParams,
+	hop *ProofChainHop,
+) error {
+	reshare := hop.Reshare
+	if reshare == nil {
+		return fmt.Errorf("missing reshare")
+	}
+	if !bytes.Equal(reshare.ValidatorPubKey, validatorPK) {
+		return codedError(CodeProofValidatorMismatch, "reshare of another validator")
+	}
+	if !bytes.Equal(reshare.Owner[:], owner[:]) {
+		return codedError(CodeProofOwnerMismatch, "reshare of another owner")
+	}
+	if !EqualOperators(reshare.OldOperators, operators) {
+		return codedError(CodeOperatorKeyMismatch, "old operators aren't the previous operators")
+	}
+	if err := validateReshareOperators(reshare); err != nil {
+		return err
+	}
+	for i, proof := range oldProofs {
+		if err := validateOriginalParams(proof.Proof, oldParams, reshare.OldOperators, reshare.OldT); err != nil {
+			return fmt.Errorf("old proof of operator %d: %w", operators[i].ID, err)
+		}
+	}
+
+	if len(hop.Proofs) != len(reshare.NewOperators) {
+		return codedError(CodeResultsCountMismatch, "%d proofs for %d new operators", len(hop.Proofs), len(reshare.NewOperators))
+	}
+	for i, proof := range hop.Proofs {
+		operator := reshare.NewOperators[i]
+		if proof == nil || proof.Proof == nil {
+			return fmt.Errorf("proof of operator %d is empty", operator.ID)
+		}
+		if err := ValidateCeremonyProof(owner, validatorPK, operator, *proof); err != nil {
+			return fmt.Errorf("invalid proof for operator %d: %w", operator.ID, err)
+		}
+		if err := ValidateProofParams(proof.Proof, reshare.NewParams()); err != nil {
+			return fmt.Errorf("proof of operator %d: %w", operator.ID, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,112 @@
+package spec
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+)
+
+/*
+proofs.json is the file the ssv-dkg CLI writes next to the deposit data and keyshares of a ceremony.
+A single ceremony is stored as a JSON array of SignedProof, one per operator and ordered as the ceremony operators:
+
+	[
+	  {
+	    "proof": {"validator": "<hex>", "encrypted_share": "<hex>", "share_pub": "<hex>", "owner": "<hex>", "request_id": "<hex>", "params_hash": "<hex>"},
+	    "signature": "<hex>"
+	  },
+	  ...
+	]
+
+A bulk ceremony is stored as an array of the above, one entry per validator.
+Ceremony metadata (validator public key and owner) is carried by the proofs themselves and exposed through CeremonyProofs.
+*/
+
+// CeremonyProofs are the signed proofs of a single ceremony, one per operator and ordered as the ceremony operators
+type CeremonyProofs []*SignedProof
+
+// ValidatorPubKey returns the validator public key the ceremony proofs refer to
+func (cp CeremonyProofs) ValidatorPubKey() []byte {
+	if len(cp) == 0 || cp[0] == nil || cp[0].Proof == nil {
+		return nil
+	}
+	return cp[0].Proof.ValidatorPubKey
+}
+
+// Owner returns the owner address the ceremony proofs refer to
+func (cp CeremonyProofs) Owner() [20]byte {
+	if len(cp) == 0 || cp[0] == nil || cp[0].Proof == nil {
+		return [20]byte{}
+	}
+	return cp[0].Proof.Owner
+}
+
+// ValidateCeremonyProofs returns nil if proofs hold a valid signed proof for each operator (in order), all for the same validator and owner
+func ValidateCeremonyProofs(operators []*Operator, proofs CeremonyProofs) error {
+	if len(proofs) != len(operators) {
+		return fmt.Errorf("mismatch proofs count")
+	}
+	for i, proof := range proofs {
+		if proof == nil || proof.Proof == nil {
+			return fmt.Errorf("proof %d is empty", i)
+		}
+		if err := ValidateCeremonyProof(proofs.Owner(), proofs.ValidatorPubKey(), operators[i], *proof); err != nil {
+			return fmt.Errorf("invalid proof for operator %d: %v", operators[i].ID, err)
+		}
+	}
+	return nil
+}
+
+// ReadProofs decodes a proofs.json stream, both single and bulk ceremony layouts are accepted
+func ReadProofs(r io.Reader) ([]CeremonyProofs, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+
+	var bulk []CeremonyProofs
+	if err := json.Unmarshal(data, &bulk); err == nil {
+		return bulk, nil
+	}
+
+	var single CeremonyProofs
+	if err := json.Unmarshal(data, &single); err != nil {
+		return nil, fmt.Errorf("failed to decode proofs: %v", err)
+	}
+	return []CeremonyProofs{single}, nil
+}
+
+// WriteProofs encodes proofs as proofs.json, a single ceremony is written with the single ceremony layout
+func WriteProofs(w io.Writer, proofs []CeremonyProofs) error {
+	var v interface{} = proofs
+	if len(proofs) == 1 {
+		v = proofs[0]
+	}
+	byts, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(byts)
+	return err
+}
+
+// LoadProofs reads a proofs.json file from path
+func LoadProofs(path string) ([]CeremonyProofs, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return ReadProofs(f)
+}
+
+// SaveProofs writes proofs to path as a proofs.json file
+func SaveProofs(path string, proofs []CeremonyProofs) error {
+	buf := &bytes.Buffer{}
+	if err := WriteProofs(buf, proofs); err != nil {
+		return err
+	}
+	return os.WriteFile(path, buf.Bytes(), 0o600)
+}
@@ -0,0 +1,175 @@
+package spec
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"sort"
+
+	"github.com/bloxapp/dkg-spec/crypto/pvss"
+)
+
+// In PVSS mode every operator of an init deals a random secret with pvss.Deal to all operators, encrypting shares to the
+// operators' PVSS public keys (published by the operators alongside their RSA keys). The dealings are public: anyone
+// can verify them and derive the validator public key and every operator's share public key, so the initiator and the
+// owner check the operators' results against the dealings instead of trusting them.
+
+// PVSSDealing is an operator's dealing in a PVSS ceremony
+type PVSSDealing struct {
+	OperatorID uint64           `json:"operator_id"`
+	Transcript *pvss.Transcript `json:"transcript"`
+}
+
+// PVSSOutcome is what a PVSS ceremony's dealings commit the operators to
+type PVSSOutcome struct {
+	ValidatorPubKey []byte `json:"validator"`
+	// SharePubKeys by operator ID
+	SharePubKeys map[uint64][]byte `json:"share_pubs"`
+}
+
+// PVSSContext returns the context binding operatorID's dealing to the ceremony requestID
+func PVSSContext(requestID [24]byte, operatorID uint64) []byte {
+	ret := append([]byte("DKG PVSS"), requestID[:]...)
+	return binary.LittleEndian.AppendUint64(ret, operatorID)
+}
+
+// PVSSRecipients returns operators as PVSS recipients, keys are the operators' PVSS public keys by operator ID
+func PVSSRecipients(operators []*Operator, keys map[uint64][]byte) ([]*pvss.Recipient, error) {
+	ret := make([]*pvss.Recipient, len(operators))
+	for i, operator := range operators {
+		key, found := keys[operator.ID]
+		if !found {
+			return nil, fmt.Errorf("missing PVSS key of operator %d", operator.ID)
+		}
+		ret[i] = &pvss.Recipient{ID: operator.ID, PubKey: key}
+	}
+	return ret, nil
+}
+
+// DealPVSS returns operatorID's dealing of a random secret to the operators of init
+func DealPVSS(init *Init, requestID [24]byte, operatorID uint64, keys map[uint64][]byte) (*PVSSDealing, error) {
+	if err := ValidateInitMessage(init); err != nil {
+		return nil, err
+	}
+	if GetOperator(init.Operators, operatorID) == nil {
+		return nil, codedError(CodeOperatorNotFound, "operator %d not in the ceremony", operatorID)
+	}
+	recipients, err := PVSSRecipients(init.Operators, keys)
+	if err != nil {
+		return nil, err
+	}
+	secret, err := pvss.RandomSecret()
+	if err != nil {
+		return nil, err
+	}
+	transcript, err := pvss.Deal(PVSSContext(requestID, operatorID), secret, int(init.T), recipients)
+	if err != nil {
+		return nil, err
+	}
+	return &PVSSDealing{OperatorID: operatorID, Transcript: transcript}, nil
+}
+
+// VerifyPVSSCeremony verifies the dealings of every operator of init and returns what they commit the operators to
+func VerifyPVSSCeremony(init *Init, requestID [24]byte, keys map[uint64][]byte, dealings []*PVSSDealing) (*PVSSOutcome, error) {
+	if err := ValidateInitMessage(init); err != nil {
+		return nil, err
+	}
+	ordered, err := orderedDealings(init, dealings)
+	if err != nil {
+		return nil, err
+	}
+	recipients, err := PVSSRecipients(init.Operators, keys)
+	if err != nil {
+		return nil, err
+	}
+	transcripts := make([]*pvss.Transcript, len(ordered))
+	for i, dealing := range ordered {
+		if dealing.Transcript == nil {
+			return nil, codedError(CodeInconsistentDealing, "dealing of operator %d: missing transcript", dealing.OperatorID)
+		}
+		if err := pvss.Verify(PVSSContext(requestID, dealing.OperatorID), dealing.Transcript, int(init.T), recipients); err != nil {
+			return nil, codedError(CodeInconsistentDealing, "dealing of operator %d: %v", dealing.OperatorID, err)
+		}
+		transcripts[i] = dealing.Transcript
+	}
+
+	commitments, err := pvss.AggregateCommitments(transcripts)
+	if err != nil {
+		return nil, err
+	}
+	joint := &pvss.Transcript{Commitments: commitments}
+	ret := &PVSSOutcome{
+		ValidatorPubKey: commitments[0],
+		SharePubKeys:    make(map[uint64][]byte, len(init.Operators)),
+	}
+	for _, operator := range init.Operators {
+		if ret.SharePubKeys[operator.ID], err = pvss.SharePublicKey(joint, operator.ID); err != nil {
+			return nil, err
+		}
+	}
+	return ret, nil
+}
+
+// ValidatePVSSResults returns nil if every result's proof matches the outcome of the ceremony's dealings
+func ValidatePVSSResults(outcome *PVSSOutcome, results []*Result) error {
+	for _, result := range results {
+		proof := result.SignedProof.Proof
+		if proof == nil {
+			return fmt.Errorf("operator %d: missing proof", result.OperatorID)
+		}
+		if !bytes.Equal(proof.ValidatorPubKey, outcome.ValidatorPubKey) {
+			return codedError(CodeInconsistentDealing, "operator %d: validator public key doesn't match the dealings", result.OperatorID)
+		}
+		expected, found := outcome.SharePubKeys[result.OperatorID]
+		if !found {
+			return codedError(CodeOperatorNotFound, "operator %d not in the ceremony", result.OperatorID)
+		}
+		if !bytes.Equal(proof.SharePubKey, expected) {
+			return codedError(CodeInconsistentDealing, "operator %d: share public key doesn't match the dealings", result.OperatorID)
+		}
+	}
+	return nil
+}
+
+// CombinePVSSShares verifies the dealings and returns operatorID's share of the validator key (a 32 byte big-endian
+// BLS secret key), the sum of the shares dealt to it
+func CombinePVSSShares(
+	init *Init,
+	requestID [24]byte,
+	operatorID uint64,
+	sk *pvss.PrivateKey,
+	keys map[uint64][]byte,
+	dealings []*PVSSDealing,
+) ([]byte, error) {
+	if _, err := VerifyPVSSCeremony(init, requestID, keys, dealings); err != nil {
+		return nil, err
+	}
+	ordered, err := orderedDealings(init, dealings)
+	if err != nil {
+		return nil, err
+	}
+	shares := make([][]byte, len(ordered))
+	for i, dealing := range ordered {
+		if shares[i], err = pvss.Decrypt(sk, operatorID, dealing.Transcript); err != nil {
+			return nil, fmt.Errorf("dealing of operator %d: %w", dealing.OperatorID, err)
+		}
+	}
+	return pvss.AddShares(shares)
+}
+
+// orderedDealings returns dealings ordered by operator ID, one by each operator of init
+func orderedDealings(init *Init, dealings []*PVSSDealing) ([]*PVSSDealing, error) {
+	ret := append([]*PVSSDealing{}, dealings...)
+	sort.Slice(ret, func(i, j int) bool {
+		return ret[i].OperatorID < ret[j].OperatorID
+	})
+	if len(ret) != len(init.Operators) {
+		return nil, codedError(CodeResultsCountMismatch, "%d dealings, expected one by each of %d operators", len(ret), len(init.Operators))
+	}
+	for i, dealing := range ret {
+		if dealing.OperatorID != init.Operators[i].ID {
+			return nil, codedError(CodeOperatorNotFound, "unexpected dealing of operator %d", dealing.OperatorID)
+		}
+	}
+	return ret, nil
+}
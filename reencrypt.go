@@ -0,0 +1,118 @@
+//go:build !verifyonly
+
+package spec
+
+import (
+	"bytes"
+	"crypto/rsa"
+	"fmt"
+
+	"github.com/bloxapp/dkg-spec/crypto"
+	"github.com/bloxapp/dkg-spec/crypto/bls"
+
+	"golang.org/x/exp/slices"
+)
+
+// DecryptShare returns the share encrypted in proof to sk
+func DecryptShare(sk *rsa.PrivateKey, proof *Proof) (*bls.SecretKey, error) {
+	envelope, err := DecodeShareEnvelope(proof.EncryptedShare)
+	if err != nil {
+		return nil, err
+	}
+	byts, err := envelope.Decrypt(sk, ShareEncryptionLabel(proof.ValidatorPubKey, proof.Owner))
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt share: %w", err)
+	}
+	share := &bls.SecretKey{}
+	if err := share.Deserialize(byts); err != nil {
+		return nil, err
+	}
+	if !bytes.Equal(share.GetPublicKey().Serialize(), proof.SharePubKey) {
+		return nil, fmt.Errorf("share doesn't match proof")
+	}
+	return share, nil
+}
+
+// ReencryptProof re-encrypts the share of proof, signed by the operator's old key, to its rotated key newSK.
+// The returned proof is signed by newSK, every other field is kept so the cluster doesn't need to reshare.
+func ReencryptProof(proof *SignedProof, oldSK, newSK *rsa.PrivateKey) (*SignedProof, error) {
+	oldPK, err := crypto.EncodeRSAPublicKey(&oldSK.PublicKey)
+	if err != nil {
+		return nil, err
+	}
+	if err := VerifyCeremonyProof(oldPK, *proof); err != nil {
+		return nil, err
+	}
+	share, err := DecryptShare(oldSK, proof.Proof)
+	if err != nil {
+		return nil, err
+	}
+
+	// the share is re-encrypted with the scheme of the old proof
+	envelope, err := DecodeShareEnvelope(proof.Proof.EncryptedShare)
+	if err != nil {
+		return nil, err
+	}
+	envelope, err = EncryptShareEnvelope(
+		&newSK.PublicKey,
+		share.Serialize(),
+		ShareEncryptionLabel(proof.Proof.ValidatorPubKey, proof.Proof.Owner),
+		envelope.Version,
+		envelope.Algorithm,
+	)
+	if err != nil {
+		return nil, err
+	}
+	encryptedShare, err := envelope.Encode()
+	if err != nil {
+		return nil, err
+	}
+	newProof := *proof.Proof
+	newProof.EncryptedShare = encryptedShare
+	sig, err := signProof(newSK, &newProof)
+	if err != nil {
+		return nil, err
+	}
+	return &SignedProof{
+		Proof:     &newProof,
+		Signature: sig,
+	}, nil
+}
+
+// ValidateReencryptedProof returns nil if newProof, signed by the operator's rotated key newPubKey, replaces oldProof signed by oldPubKey.
+// The encrypted share itself can only be checked by its holder (see DecryptShare).
+func ValidateReencryptedProof(
+	oldPubKey []byte,
+	newPubKey []byte,
+	oldProof SignedProof,
+	newProof SignedProof,
+) error {
+	if bytes.Equal(oldPubKey, newPubKey) {
+		return fmt.Errorf("operator key not rotated")
+	}
+	if err := VerifyCeremonyProof(oldPubKey, oldProof); err != nil {
+		return fmt.Errorf("invalid old proof: %w", err)
+	}
+	if err := VerifyCeremonyProof(newPubKey, newProof); err != nil {
+		return fmt.Errorf("invalid new proof: %w", err)
+	}
+	if !bytes.Equal(oldProof.Proof.ValidatorPubKey, newProof.Proof.ValidatorPubKey) {
+		return codedError(CodeProofValidatorMismatch, "invalid proof validator pubkey")
+	}
+	if !bytes.Equal(oldProof.Proof.Owner[:], newProof.Proof.Owner[:]) {
+		return codedError(CodeProofOwnerMismatch, "invalid owner address")
+	}
+	if !bytes.Equal(oldProof.Proof.SharePubKey, newProof.Proof.SharePubKey) {
+		return fmt.Errorf("invalid proof share pubkey")
+	}
+	if !bytes.Equal(oldProof.Proof.RequestID[:], newProof.Proof.RequestID[:]) {
+		return codedError(CodeRequestIDMismatch, "invalid proof request ID")
+	}
+	if oldProof.Proof.ParamsHash != newProof.Proof.ParamsHash {
+		return codedError(CodeProofParamsMismatch, "invalid proof params hash")
+	}
+	if !slices.EqualFunc(oldProof.Proof.Commitments, newProof.Proof.Commitments, bytes.Equal) {
+		return codedError(CodeInconsistentDealing, "invalid proof commitments")
+	}
+	return nil
+}
@@ -1,37 +1,136 @@
 package spec
 
 import (
+	"bytes"
 	"fmt"
 	"sort"
+
+	"github.com/bloxapp/dkg-spec/crypto"
 )
 
-// ValidateReshareMessage returns nil if re-share message is valid
+// ValidateReshareMessage returns nil if re-share message is valid. params are the parameters of the ceremony proof was
+// issued in, which produced the old operators' shares, nil if proof doesn't commit to them.
 func ValidateReshareMessage(
 	reshare *Reshare,
 	operator *Operator,
 	proof *SignedProof,
+	params *CeremonyParams,
 ) error {
 	if !UniqueAndOrderedOperators(reshare.OldOperators) {
-		return fmt.Errorf("old operators are not unique and ordered")
+		return codedError(CodeOperatorsNotOrdered, "old operators are not unique and ordered")
 	}
 
 	if err := ValidateCeremonyProof(reshare.Owner, reshare.ValidatorPubKey, operator, *proof); err != nil {
 		return err
 	}
+	if err := validateReshareOperators(reshare); err != nil {
+		return err
+	}
 
+	// the reshare may sign for another fork than the old shares' ceremony did
+	return validateOriginalParams(proof.Proof, params, reshare.OldOperators, reshare.OldT)
+}
+
+// validateReshareOperators returns nil if the new operators and both thresholds of reshare are valid
+func validateReshareOperators(reshare *Reshare) error {
 	if !UniqueAndOrderedOperators(reshare.NewOperators) {
-		return fmt.Errorf("new operators are not unique and ordered")
+		return codedError(CodeOperatorsNotOrdered, "new operators are not unique and ordered")
 	}
 	if EqualOperators(reshare.OldOperators, reshare.NewOperators) {
-		return fmt.Errorf("old and new operators are the same")
+		return codedError(CodeSameOperators, "old and new operators are the same")
 	}
 	if !ValidThresholdSet(reshare.OldT, reshare.OldOperators) {
-		return fmt.Errorf("old threshold set is invalid")
+		return codedError(CodeInvalidThreshold, "old threshold set is invalid")
 	}
 	if !ValidThresholdSet(reshare.NewT, reshare.NewOperators) {
-		return fmt.Errorf("new threshold set is invalid")
+		return codedError(CodeInvalidThreshold, "new threshold set is invalid")
+	}
+
+	return validateReshareOverlap(reshare)
+}
+
+// ReshareOverlapLimits makes reshares keep the old and new operators overlapping: fewer than OldT old operators may leave
+// and fewer than NewT new operators may join, so every signing quorum of the new cluster includes an operator of the old one.
+// It's a continuity policy rather than a security bound, as resharing keeps the validator key and old shares stay valid
+// whatever the overlap, so it's off by default and full committee migrations (e.g. 4 operators to 4 new ones) are accepted.
+// It's meant to be set once, before reshares are validated.
+var ReshareOverlapLimits = false
+
+// validateReshareOverlap returns nil if the old and new operators overlap as the spec requires:
+//   - a key belongs to a single operator, keys being compared in canonical encoding (see crypto.NormalizeRSAPublicKey).
+//     An operator may have another key in the new operators, having rotated it (see ReencryptProof)
+//   - with ReshareOverlapLimits, fewer than OldT old operators leave and fewer than NewT new operators join
+func validateReshareOverlap(reshare *Reshare) error {
+	oldOperators, err := normalizedOperators(reshare.OldOperators)
+	if err != nil {
+		return err
+	}
+	newOperators, err := normalizedOperators(reshare.NewOperators)
+	if err != nil {
+		return err
+	}
+	leaving := 0
+	for _, old := range oldOperators {
+		kept := false
+		for _, operator := range newOperators {
+			if old.ID != operator.ID && bytes.Equal(old.PubKey, operator.PubKey) {
+				return codedError(CodeOperatorKeyMismatch, "operators %d and %d have the same key", old.ID, operator.ID)
+			}
+			kept = kept || old.ID == operator.ID
+		}
+		if !kept {
+			leaving++
+		}
+	}
+	if !ReshareOverlapLimits {
+		return nil
+	}
+	if uint64(leaving) >= reshare.OldT {
+		return codedError(CodeReshareOverlap, "%d old operators leave, at least the old threshold (%d)", leaving, reshare.OldT)
+	}
+	joining := len(reshare.NewOperators) - (len(reshare.OldOperators) - leaving)
+	if uint64(joining) >= reshare.NewT {
+		return codedError(CodeReshareOverlap, "%d new operators join, at least the new threshold (%d)", joining, reshare.NewT)
+	}
+	return nil
+}
+
+// normalizedOperators returns operators with their public keys in canonical encoding (see crypto.NormalizeRSAPublicKey),
+// operators whose keys aren't canonical are copied
+func normalizedOperators(operators []*Operator) ([]*Operator, error) {
+	ret := make([]*Operator, len(operators))
+	for i, op := range operators {
+		pk, err := crypto.NormalizeRSAPublicKey(op.PubKey)
+		if err != nil {
+			return nil, fmt.Errorf("operator %d has invalid public key: %v", op.ID, err)
+		}
+		if bytes.Equal(pk, op.PubKey) {
+			ret[i] = op
+			continue
+		}
+		normalized := *op
+		normalized.PubKey = pk
+		ret[i] = &normalized
 	}
+	return ret, nil
+}
 
+// ValidateReshareResponders returns nil if the old operators responding to reshare (e.g. reachable by the initiator, or whose deals
+// were received) can complete it: responders are unique old operators, at least OldT of them.
+func ValidateReshareResponders(reshare *Reshare, responders []uint64) error {
+	seen := make(map[uint64]bool, len(responders))
+	for _, id := range responders {
+		if GetOperator(reshare.OldOperators, id) == nil {
+			return fmt.Errorf("operator %d not in old operators", id)
+		}
+		if seen[id] {
+			return fmt.Errorf("duplicate responder %d", id)
+		}
+		seen[id] = true
+	}
+	if uint64(len(responders)) < reshare.OldT {
+		return codedError(CodeThresholdUnreachable, "old threshold unreachable: %d of %d old operators responding", len(responders), reshare.OldT)
+	}
 	return nil
 }
 
@@ -0,0 +1,188 @@
+//go:build !verifyonly
+
+package spec
+
+import (
+	"bytes"
+	"fmt"
+)
+
+// ReshareRequest is a Reshare ready to be signed by the owner, with the ceremony proof each old operator validates it against
+type ReshareRequest struct {
+	Reshare *Reshare
+	// Proofs of the old operators, as expected by RunReshare
+	Proofs map[*Operator]SignedProof
+}
+
+// ReshareBuilder assembles a batch of Reshare messages moving validators from one cluster to another.
+// Proofs are given per validator ceremony (see CeremonyProofs) and matched to the old operators by position, thresholds are derived from the clusters' sizes.
+// As with InitBuilder the first failing step is kept and returned by Build.
+type ReshareBuilder struct {
+	oldOperators          []*Operator
+	newOperators          []*Operator
+	proofs                []CeremonyProofs
+	withdrawalCredentials []byte
+	fork                  [4]byte
+	nonce                 uint64
+	err                   error
+}
+
+// NewReshareBuilder returns a ReshareBuilder with default values (mainnet phase0 fork and nonce 0)
+func NewReshareBuilder() *ReshareBuilder {
+	return &ReshareBuilder{}
+}
+
+// OldOperators sets the operators the validators currently belong to
+func (b *ReshareBuilder) OldOperators(operators ...*Operator) *ReshareBuilder {
+	if b.err != nil {
+		return b
+	}
+	ordered, err := orderedCluster(operators)
+	if err != nil {
+		b.err = fmt.Errorf("old operators: %v", err)
+		return b
+	}
+	if ordered, err = normalizedOperators(ordered); err != nil {
+		b.err = fmt.Errorf("old operators: %v", err)
+		return b
+	}
+	b.oldOperators = ordered
+	return b
+}
+
+// NewOperators sets the operators the validators are reshared to
+func (b *ReshareBuilder) NewOperators(operators ...*Operator) *ReshareBuilder {
+	if b.err != nil {
+		return b
+	}
+	ordered, err := orderedCluster(operators)
+	if err != nil {
+		b.err = fmt.Errorf("new operators: %v", err)
+		return b
+	}
+	if ordered, err = normalizedOperators(ordered); err != nil {
+		b.err = fmt.Errorf("new operators: %v", err)
+		return b
+	}
+	b.newOperators = ordered
+	return b
+}
+
+// Proofs adds the ceremony proofs of validators to reshare, one Reshare is built per ceremony
+func (b *ReshareBuilder) Proofs(proofs ...CeremonyProofs) *ReshareBuilder {
+	if b.err != nil {
+		return b
+	}
+	b.proofs = append(b.proofs, proofs...)
+	return b
+}
+
+// WithdrawalCredentials sets the withdrawal credentials, either a 20 bytes ETH1 address or 32 bytes compounding credentials
+func (b *ReshareBuilder) WithdrawalCredentials(withdrawalCredentials []byte) *ReshareBuilder {
+	if b.err != nil {
+		return b
+	}
+	if err := checkWithdrawalCredentials(withdrawalCredentials); err != nil {
+		b.err = err
+		return b
+	}
+	b.withdrawalCredentials = withdrawalCredentials
+	return b
+}
+
+// Fork sets the fork
+func (b *ReshareBuilder) Fork(fork [4]byte) *ReshareBuilder {
+	if b.err != nil {
+		return b
+	}
+	b.fork = fork
+	return b
+}
+
+// Nonce sets the owner nonce of the first Reshare, each following Reshare uses the next nonce
+func (b *ReshareBuilder) Nonce(nonce uint64) *ReshareBuilder {
+	if b.err != nil {
+		return b
+	}
+	b.nonce = nonce
+	return b
+}
+
+// Build validates every ceremony's proofs against the old operators and returns one ReshareRequest per ceremony, in the order proofs were added
+func (b *ReshareBuilder) Build() ([]*ReshareRequest, error) {
+	if b.err != nil {
+		return nil, b.err
+	}
+	if len(b.oldOperators) == 0 {
+		return nil, fmt.Errorf("old operators: not set")
+	}
+	if len(b.newOperators) == 0 {
+		return nil, fmt.Errorf("new operators: not set")
+	}
+	if len(b.proofs) == 0 {
+		return nil, fmt.Errorf("proofs: not set")
+	}
+	if len(b.withdrawalCredentials) == 0 {
+		return nil, fmt.Errorf("withdrawal credentials: not set")
+	}
+	if err := checkForkWithdrawalCredentials(b.fork, b.withdrawalCredentials); err != nil {
+		return nil, err
+	}
+	oldT, _ := ThresholdForCluster(b.oldOperators)
+	newT, _ := ThresholdForCluster(b.newOperators)
+
+	owner := b.proofs[0].Owner()
+	ret := make([]*ReshareRequest, 0, len(b.proofs))
+	for i, proofs := range b.proofs {
+		if err := ValidateCeremonyProofs(b.oldOperators, proofs); err != nil {
+			return nil, fmt.Errorf("ceremony %d: %v", i, err)
+		}
+		if proofs.Owner() != owner {
+			return nil, fmt.Errorf("ceremony %d: owner mismatch", i)
+		}
+		for j := 0; j < i; j++ {
+			if bytes.Equal(b.proofs[j].ValidatorPubKey(), proofs.ValidatorPubKey()) {
+				return nil, fmt.Errorf("ceremony %d: duplicate validator", i)
+			}
+		}
+
+		reshare := &Reshare{
+			ValidatorPubKey:       proofs.ValidatorPubKey(),
+			OldOperators:          b.oldOperators,
+			NewOperators:          b.newOperators,
+			OldT:                  oldT,
+			NewT:                  newT,
+			Fork:                  b.fork,
+			WithdrawalCredentials: b.withdrawalCredentials,
+			Owner:                 owner,
+			Nonce:                 b.nonce + uint64(i),
+		}
+		if err := reshare.Validate(); err != nil {
+			return nil, fmt.Errorf("ceremony %d: %v", i, err)
+		}
+		operatorProofs := make(map[*Operator]SignedProof, len(b.oldOperators))
+		for j, op := range b.oldOperators {
+			operatorProofs[op] = *proofs[j]
+		}
+		ret = append(ret, &ReshareRequest{
+			Reshare: reshare,
+			Proofs:  operatorProofs,
+		})
+	}
+	return ret, nil
+}
+
+// orderedCluster returns a copy of operators ordered by ID, or error if they are not a valid cluster
+func orderedCluster(operators []*Operator) ([]*Operator, error) {
+	if _, err := ThresholdForCluster(operators); err != nil {
+		return nil, err
+	}
+	ordered := OrderOperators(append([]*Operator{}, operators...))
+	if len(ordered) > 0 && ordered[0].ID == 0 {
+		return nil, fmt.Errorf("operator with ID 0")
+	}
+	if !UniqueAndOrderedOperators(ordered) {
+		return nil, fmt.Errorf("duplicate operator IDs")
+	}
+	return ordered, nil
+}
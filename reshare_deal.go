@@ -0,0 +1,314 @@
+//go:build !verifyonly
+
+package spec
+
+import (
+	"bytes"
+	"crypto/rsa"
+	"encoding/binary"
+	"fmt"
+	"sort"
+
+	"github.com/bloxapp/dkg-spec/crypto"
+	"github.com/bloxapp/dkg-spec/crypto/bls"
+
+	"golang.org/x/exp/slices"
+)
+
+// ReshareDeal is an old operator's share re-dealt to one new operator: the share is the constant term of a random
+// polynomial of degree NewT-1, evaluated at the new operator's ID. Only the recipient can decrypt its sub-share.
+type ReshareDeal struct {
+	// OperatorID of the old operator dealing its share
+	OperatorID uint64
+	// RecipientID of the new operator the deal is addressed to
+	RecipientID uint64
+	// Commitments to the polynomial's coefficients, Commitments[0] is the dealt share's public key
+	Commitments []*bls.PublicKey
+	// EncryptedShare is the recipient's sub-share, encrypted to its RSA key (see crypto.EncryptHybrid and ReshareDealLabel)
+	EncryptedShare []byte
+}
+
+// DecryptedReshareDeal is a deal decrypted by its recipient, see DecryptReshareDeal
+type DecryptedReshareDeal struct {
+	// OperatorID of the old operator dealing its share
+	OperatorID uint64
+	// Commitments to the polynomial's coefficients, Commitments[0] is the dealt share's public key
+	Commitments []*bls.PublicKey
+	// Share is the recipient's sub-share
+	Share *bls.SecretKey
+}
+
+// ReshareDealLabel returns the OAEP label and GCM additional data binding a deal's encrypted share to the reshare ceremony
+// requestID, its dealer and its recipient, so it can't be replayed in another ceremony nor to another operator
+func ReshareDealLabel(requestID [24]byte, dealerID uint64, recipientID uint64) []byte {
+	ret := make([]byte, 0, len(requestID)+16)
+	ret = append(ret, requestID[:]...)
+	ret = binary.BigEndian.AppendUint64(ret, dealerID)
+	return binary.BigEndian.AppendUint64(ret, recipientID)
+}
+
+// DealReshare re-deals share of old operator operatorID to the new operators of reshare, returns a deal per new operator in
+// NewOperators order
+func DealReshare(reshare *Reshare, requestID [24]byte, operatorID uint64, share *bls.SecretKey) ([]*ReshareDeal, error) {
+	if GetOperator(reshare.OldOperators, operatorID) == nil {
+		return nil, fmt.Errorf("operator %d not in old operators", operatorID)
+	}
+	if !ValidThresholdSet(reshare.NewT, reshare.NewOperators) {
+		return nil, codedError(CodeInvalidThreshold, "new threshold set is invalid")
+	}
+
+	coefficients := make([]bls.SecretKey, reshare.NewT)
+	if err := coefficients[0].Deserialize(share.Serialize()); err != nil {
+		return nil, err
+	}
+	for i := 1; i < len(coefficients); i++ {
+		coefficients[i].SetByCSPRNG()
+	}
+	commitments := make([]*bls.PublicKey, len(coefficients))
+	for i := range coefficients {
+		commitments[i] = coefficients[i].GetPublicKey()
+	}
+
+	ret := make([]*ReshareDeal, 0, len(reshare.NewOperators))
+	for _, op := range reshare.NewOperators {
+		id, err := blsID(op.ID)
+		if err != nil {
+			return nil, err
+		}
+		subShare := &bls.SecretKey{}
+		if err := subShare.Set(coefficients, id); err != nil {
+			return nil, err
+		}
+		pk, err := crypto.ParseRSAPublicKey(op.PubKey)
+		if err != nil {
+			return nil, err
+		}
+		encrypted, err := crypto.EncryptHybrid(pk, subShare.Serialize(), ReshareDealLabel(requestID, operatorID, op.ID))
+		if err != nil {
+			return nil, err
+		}
+		ret = append(ret, &ReshareDeal{
+			OperatorID:     operatorID,
+			RecipientID:    op.ID,
+			Commitments:    commitments,
+			EncryptedShare: encrypted,
+		})
+	}
+	return ret, nil
+}
+
+// DecryptReshareDeal returns deal as decrypted by its recipient, new operator operatorID with RSA key sk
+func DecryptReshareDeal(requestID [24]byte, deal *ReshareDeal, operatorID uint64, sk *rsa.PrivateKey) (*DecryptedReshareDeal, error) {
+	if deal.RecipientID != operatorID {
+		return nil, fmt.Errorf("deal of operator %d: addressed to operator %d", deal.OperatorID, deal.RecipientID)
+	}
+	byts, err := crypto.DecryptHybrid(sk, deal.EncryptedShare, ReshareDealLabel(requestID, deal.OperatorID, operatorID))
+	if err != nil {
+		return nil, fmt.Errorf("deal of operator %d: %w", deal.OperatorID, err)
+	}
+	share := &bls.SecretKey{}
+	if err := share.Deserialize(byts); err != nil {
+		return nil, fmt.Errorf("deal of operator %d: %w", deal.OperatorID, err)
+	}
+	return &DecryptedReshareDeal{
+		OperatorID:  deal.OperatorID,
+		Commitments: deal.Commitments,
+		Share:       share,
+	}, nil
+}
+
+// DecryptReshareDeals is DecryptReshareDeal over deals, all addressed to new operator operatorID
+func DecryptReshareDeals(requestID [24]byte, deals []*ReshareDeal, operatorID uint64, sk *rsa.PrivateKey) ([]*DecryptedReshareDeal, error) {
+	ret := make([]*DecryptedReshareDeal, len(deals))
+	for i, deal := range deals {
+		decrypted, err := DecryptReshareDeal(requestID, deal, operatorID, sk)
+		if err != nil {
+			return nil, err
+		}
+		ret[i] = decrypted
+	}
+	return ret, nil
+}
+
+// VerifyReshareDeal returns nil if deal's share for new operator operatorID matches its commitments
+func VerifyReshareDeal(reshare *Reshare, deal *DecryptedReshareDeal, operatorID uint64) error {
+	if GetOperator(reshare.OldOperators, deal.OperatorID) == nil {
+		return fmt.Errorf("dealer %d not in old operators", deal.OperatorID)
+	}
+	if uint64(len(deal.Commitments)) != reshare.NewT {
+		return fmt.Errorf("deal of operator %d: invalid commitments count", deal.OperatorID)
+	}
+	if deal.Share == nil {
+		return fmt.Errorf("deal of operator %d: missing share", deal.OperatorID)
+	}
+	id, err := blsID(operatorID)
+	if err != nil {
+		return err
+	}
+	commitments := make([]bls.PublicKey, len(deal.Commitments))
+	for i, commitment := range deal.Commitments {
+		commitments[i] = *commitment
+	}
+	expected := &bls.PublicKey{}
+	if err := expected.Set(commitments, id); err != nil {
+		return err
+	}
+	if !expected.IsEqual(deal.Share.GetPublicKey()) {
+		return fmt.Errorf("deal of operator %d: share doesn't match commitments", deal.OperatorID)
+	}
+	return nil
+}
+
+// CombineReshareDeals returns new operator operatorID's share of the validator, interpolated from the decrypted deals of at
+// least OldT old operators. Every deal is verified, and the dealt shares must recover the validator public key.
+func CombineReshareDeals(reshare *Reshare, operatorID uint64, deals []*DecryptedReshareDeal) (*bls.SecretKey, error) {
+	if GetOperator(reshare.NewOperators, operatorID) == nil {
+		return nil, fmt.Errorf("operator %d not in new operators", operatorID)
+	}
+	ordered := append([]*DecryptedReshareDeal{}, deals...)
+	sort.Slice(ordered, func(i, j int) bool {
+		return ordered[i].OperatorID < ordered[j].OperatorID
+	})
+	for i, deal := range ordered {
+		if i > 0 && ordered[i-1].OperatorID == deal.OperatorID {
+			return nil, fmt.Errorf("duplicate deal of operator %d", deal.OperatorID)
+		}
+	}
+	// rejected before verifying any deal, as the old threshold can't be reached anyway
+	if uint64(len(ordered)) < reshare.OldT {
+		return nil, codedError(CodeThresholdUnreachable, "not enough deals: %d of %d", len(ordered), reshare.OldT)
+	}
+	for _, deal := range ordered {
+		if err := VerifyReshareDeal(reshare, deal, operatorID); err != nil {
+			return nil, err
+		}
+	}
+
+	ids := make([]bls.ID, len(ordered))
+	sharePKs := make([]bls.PublicKey, len(ordered))
+	subShares := make([]bls.SecretKey, len(ordered))
+	for i, deal := range ordered {
+		id, err := blsID(deal.OperatorID)
+		if err != nil {
+			return nil, err
+		}
+		ids[i] = *id
+		sharePKs[i] = *deal.Commitments[0]
+		subShares[i] = *deal.Share
+	}
+	validatorPK := &bls.PublicKey{}
+	if err := validatorPK.Recover(sharePKs, ids); err != nil {
+		return nil, err
+	}
+	if !bytes.Equal(validatorPK.Serialize(), reshare.ValidatorPubKey) {
+		return nil, fmt.Errorf("dealt shares don't recover the validator public key")
+	}
+	ret := &bls.SecretKey{}
+	if err := ret.Recover(subShares, ids); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// CombineReshareCommitments returns the commitments to the new operators' public polynomial combined from the deals'
+// commitments, as CombineReshareDeals combines the shares: the first commitment is the validator public key and every
+// new operator's share public key is the polynomial evaluated at its ID. The deals must have been verified.
+func CombineReshareCommitments(reshare *Reshare, deals []*DecryptedReshareDeal) ([][]byte, error) {
+	if len(deals) == 0 {
+		return nil, codedError(CodeThresholdUnreachable, "no deals")
+	}
+	ids := make([]bls.ID, len(deals))
+	for i, deal := range deals {
+		if uint64(len(deal.Commitments)) != reshare.NewT {
+			return nil, fmt.Errorf("deal of operator %d: invalid commitments count", deal.OperatorID)
+		}
+		id, err := blsID(deal.OperatorID)
+		if err != nil {
+			return nil, err
+		}
+		ids[i] = *id
+	}
+	ret := make([][]byte, reshare.NewT)
+	coefficients := make([]bls.PublicKey, len(deals))
+	for k := range ret {
+		for i, deal := range deals {
+			coefficients[i] = *deal.Commitments[k]
+		}
+		combined := &bls.PublicKey{}
+		if err := combined.Recover(coefficients, ids); err != nil {
+			return nil, err
+		}
+		ret[k] = combined.Serialize()
+	}
+	if !bytes.Equal(ret[0], reshare.ValidatorPubKey) {
+		return nil, fmt.Errorf("dealt shares don't recover the validator public key")
+	}
+	return ret, nil
+}
+
+// ReshareContributors are the old operators whose deals the new operators combine, in ascending ID order.
+// Every new operator must combine the deals of the same contributors: any OldT old operators recover the validator's secret,
+// but each set of contributors deals different new shares.
+type ReshareContributors []uint64
+
+// SelectReshareContributors returns the contributors among the old operators which responded to reshare with a deal (see
+// ValidateReshareResponders): the OldT responders with the lowest IDs. The reshare proceeds without the other old operators.
+func SelectReshareContributors(reshare *Reshare, responders []uint64) (ReshareContributors, error) {
+	if err := ValidateReshareResponders(reshare, responders); err != nil {
+		return nil, err
+	}
+	ret := append(ReshareContributors{}, responders...)
+	sort.Slice(ret, func(i, j int) bool {
+		return ret[i] < ret[j]
+	})
+	return ret[:reshare.OldT], nil
+}
+
+// Absent returns the IDs of reshare's old operators which didn't contribute
+func (c ReshareContributors) Absent(reshare *Reshare) []uint64 {
+	var ret []uint64
+	for _, operator := range reshare.OldOperators {
+		if !slices.Contains(c, operator.ID) {
+			ret = append(ret, operator.ID)
+		}
+	}
+	return ret
+}
+
+// Deals returns the contributors' deals in contributors order, leaving out deals of other old operators.
+// It returns error if a contributor's deal is missing.
+func (c ReshareContributors) Deals(deals []*DecryptedReshareDeal) ([]*DecryptedReshareDeal, error) {
+	byOperator := make(map[uint64]*DecryptedReshareDeal, len(deals))
+	for _, deal := range deals {
+		byOperator[deal.OperatorID] = deal
+	}
+	ret := make([]*DecryptedReshareDeal, 0, len(c))
+	for _, id := range c {
+		deal, found := byOperator[id]
+		if !found {
+			return nil, codedError(CodeThresholdUnreachable, "missing deal of contributor %d", id)
+		}
+		ret = append(ret, deal)
+	}
+	return ret, nil
+}
+
+// CombineContributorDeals is CombineReshareDeals over the deals of contributors only, see ReshareContributors
+func CombineContributorDeals(reshare *Reshare, operatorID uint64, contributors ReshareContributors, deals []*DecryptedReshareDeal) (*bls.SecretKey, error) {
+	if err := ValidateReshareResponders(reshare, contributors); err != nil {
+		return nil, err
+	}
+	contributed, err := contributors.Deals(deals)
+	if err != nil {
+		return nil, err
+	}
+	return CombineReshareDeals(reshare, operatorID, contributed)
+}
+
+func blsID(operatorID uint64) (*bls.ID, error) {
+	ret := &bls.ID{}
+	if err := ret.SetDecString(fmt.Sprintf("%d", operatorID)); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
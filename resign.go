@@ -1,14 +1,17 @@
+//go:build !verifyonly
+
 package spec
 
-// ValidateResignMessage returns nil if re-sign message is valid
+// ValidateResignMessage returns nil if re-sign message is valid, its amount being allowed by DefaultAmountPolicy (see
+// ValidateResignAmount). params are the parameters of the ceremony proof was issued in, nil if proof doesn't commit to them.
 func ValidateResignMessage(
 	resign *Resign,
 	operator *Operator,
 	proof *SignedProof,
+	params *CeremonyParams,
 ) error {
 	if err := ValidateCeremonyProof(resign.Owner, resign.ValidatorPubKey, operator, *proof); err != nil {
 		return err
 	}
-
-	return nil
+	return ValidateResignAmount(nil, resign, proof.Proof, params)
 }
@@ -0,0 +1,87 @@
+//go:build !verifyonly
+
+package spec
+
+import (
+	"github.com/bloxapp/dkg-spec/crypto"
+)
+
+// MinDepositAmount is the min amount in gwei of a deposit accepted by the deposit contract
+const MinDepositAmount = uint64(1000000000)
+
+// AmountRule returns nil if a resign may sign deposit data for requested gwei, the original ceremony having signed for original gwei
+type AmountRule func(original, requested uint64, fork [4]byte, withdrawalCredentials []byte) error
+
+// AmountPolicy is the set of rules a resign's deposit amount must all pass, so operators don't sign deposit data
+// for amounts the owner didn't expect (e.g. a lower amount than the validator was created with)
+type AmountPolicy []AmountRule
+
+// DefaultAmountPolicy allows amounts legal on the resign's fork, never lower than the original ceremony's
+var DefaultAmountPolicy = AmountPolicy{AmountForkLegal, AmountIncreaseOnly}
+
+// AmountUnchanged only allows the original ceremony's amount
+func AmountUnchanged(original, requested uint64, fork [4]byte, withdrawalCredentials []byte) error {
+	if requested != original {
+		return codedError(CodeAmountNotAllowed, "amount %d differs from the original %d", requested, original)
+	}
+	return nil
+}
+
+// AmountIncreaseOnly allows the original ceremony's amount or higher
+func AmountIncreaseOnly(original, requested uint64, fork [4]byte, withdrawalCredentials []byte) error {
+	if requested < original {
+		return codedError(CodeAmountNotAllowed, "amount %d lower than the original %d", requested, original)
+	}
+	return nil
+}
+
+// AmountForkLegal allows amounts the deposit contract accepts, up to the max effective balance of the withdrawal credentials on fork
+func AmountForkLegal(original, requested uint64, fork [4]byte, withdrawalCredentials []byte) error {
+	maxAmount, err := crypto.MaxDepositAmount(fork, withdrawalCredentials)
+	if err != nil {
+		return err
+	}
+	if requested < MinDepositAmount || requested > uint64(maxAmount) {
+		return codedError(CodeAmountNotAllowed, "amount %d out of range [%d, %d] for fork %x", requested, MinDepositAmount, maxAmount, fork[:])
+	}
+	return nil
+}
+
+// Validate returns nil if all rules allow requested
+func (p AmountPolicy) Validate(original, requested uint64, fork [4]byte, withdrawalCredentials []byte) error {
+	for _, rule := range p {
+		if err := rule(original, requested, fork, withdrawalCredentials); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ValidateResignAmount returns nil if policy allows resign to sign deposit data for its amount, proof being the operator's proof
+// of the validator and original the parameters of the ceremony it was issued in (see ValidateProofParams), nil if proof
+// doesn't commit to them. A nil policy is DefaultAmountPolicy.
+func ValidateResignAmount(policy AmountPolicy, resign *Resign, proof *Proof, original *CeremonyParams) error {
+	if policy == nil {
+		policy = DefaultAmountPolicy
+	}
+	amount, err := proofAmount(proof, original)
+	if err != nil {
+		return err
+	}
+	return policy.Validate(amount, resign.Amount, resign.Fork, resign.WithdrawalCredentials)
+}
+
+// proofAmount returns the deposit amount of the ceremony proof was issued in, params being its parameters.
+// Proofs issued before proofs committed to their ceremony's parameters were all issued by ceremonies signing DepositAmount.
+func proofAmount(proof *Proof, params *CeremonyParams) (uint64, error) {
+	if proof.ParamsHash == [32]byte{} {
+		return DepositAmount, nil
+	}
+	if params == nil {
+		return 0, codedError(CodeProofParamsMismatch, "missing the parameters of the proof's ceremony")
+	}
+	if err := ValidateProofParams(proof, params); err != nil {
+		return 0, err
+	}
+	return params.Amount, nil
+}
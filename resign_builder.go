@@ -0,0 +1,168 @@
+//go:build !verifyonly
+
+package spec
+
+import (
+	"fmt"
+)
+
+// DefaultResignBatchSize is the default maximum number of Resign messages per batch
+const DefaultResignBatchSize = 100
+
+// NonceProvider returns owner nonces, e.g. by counting the owner's validator registrations on the SSV network contract
+type NonceProvider interface {
+	// NextNonce returns the next unused nonce of owner
+	NextNonce(owner [20]byte) (uint64, error)
+}
+
+// ResignBuilder assembles bulk Resign messages for validators of a single owner.
+// Validators added more than once are resigned once, each Resign gets the next owner nonce, and messages are chunked into batches of at most BatchSize.
+// As with InitBuilder the first failing step is kept and returned by Build.
+type ResignBuilder struct {
+	validators            [][]byte
+	withdrawalCredentials []byte
+	fork                  [4]byte
+	owner                 [20]byte
+	nonce                 uint64
+	nonceProvider         NonceProvider
+	batchSize             int
+	err                   error
+}
+
+// NewResignBuilder returns a ResignBuilder with default values (mainnet phase0 fork, nonce 0 and DefaultResignBatchSize)
+func NewResignBuilder() *ResignBuilder {
+	return &ResignBuilder{
+		batchSize: DefaultResignBatchSize,
+	}
+}
+
+// Validators adds validator public keys to resign
+func (b *ResignBuilder) Validators(validatorPKs ...[]byte) *ResignBuilder {
+	if b.err != nil {
+		return b
+	}
+	for _, pk := range validatorPKs {
+		if len(pk) != 48 {
+			b.err = fmt.Errorf("validators: invalid validator public key length")
+			return b
+		}
+	}
+	b.validators = append(b.validators, validatorPKs...)
+	return b
+}
+
+// WithdrawalCredentials sets the withdrawal credentials, either a 20 bytes ETH1 address or 32 bytes compounding credentials
+func (b *ResignBuilder) WithdrawalCredentials(withdrawalCredentials []byte) *ResignBuilder {
+	if b.err != nil {
+		return b
+	}
+	if err := checkWithdrawalCredentials(withdrawalCredentials); err != nil {
+		b.err = err
+		return b
+	}
+	b.withdrawalCredentials = withdrawalCredentials
+	return b
+}
+
+// Fork sets the fork
+func (b *ResignBuilder) Fork(fork [4]byte) *ResignBuilder {
+	if b.err != nil {
+		return b
+	}
+	b.fork = fork
+	return b
+}
+
+// Owner sets the owner address
+func (b *ResignBuilder) Owner(owner [20]byte) *ResignBuilder {
+	if b.err != nil {
+		return b
+	}
+	if owner == ([20]byte{}) {
+		b.err = fmt.Errorf("owner: zero address")
+		return b
+	}
+	b.owner = owner
+	return b
+}
+
+// Nonce sets the nonce of the first Resign, ignored if a NonceProvider is set
+func (b *ResignBuilder) Nonce(nonce uint64) *ResignBuilder {
+	if b.err != nil {
+		return b
+	}
+	b.nonce = nonce
+	return b
+}
+
+// NonceProvider sets the provider the first nonce is fetched from on Build
+func (b *ResignBuilder) NonceProvider(provider NonceProvider) *ResignBuilder {
+	if b.err != nil {
+		return b
+	}
+	b.nonceProvider = provider
+	return b
+}
+
+// BatchSize sets the maximum number of Resign messages per batch
+func (b *ResignBuilder) BatchSize(size int) *ResignBuilder {
+	if b.err != nil {
+		return b
+	}
+	if size <= 0 {
+		b.err = fmt.Errorf("batch size: must be positive")
+		return b
+	}
+	b.batchSize = size
+	return b
+}
+
+// Build returns the Resign messages chunked into batches, in the order validators were added
+func (b *ResignBuilder) Build() ([][]*Resign, error) {
+	if b.err != nil {
+		return nil, b.err
+	}
+	if len(b.validators) == 0 {
+		return nil, fmt.Errorf("validators: not set")
+	}
+	if len(b.withdrawalCredentials) == 0 {
+		return nil, fmt.Errorf("withdrawal credentials: not set")
+	}
+	if b.owner == ([20]byte{}) {
+		return nil, fmt.Errorf("owner: not set")
+	}
+	if err := checkForkWithdrawalCredentials(b.fork, b.withdrawalCredentials); err != nil {
+		return nil, err
+	}
+	nonce := b.nonce
+	if b.nonceProvider != nil {
+		var err error
+		if nonce, err = b.nonceProvider.NextNonce(b.owner); err != nil {
+			return nil, fmt.Errorf("failed to fetch owner nonce: %v", err)
+		}
+	}
+
+	seen := make(map[[48]byte]bool, len(b.validators))
+	resigns := make([]*Resign, 0, len(b.validators))
+	for _, pk := range b.validators {
+		if seen[[48]byte(pk)] {
+			continue
+		}
+		seen[[48]byte(pk)] = true
+		resigns = append(resigns, &Resign{
+			ValidatorPubKey:       pk,
+			Fork:                  b.fork,
+			WithdrawalCredentials: b.withdrawalCredentials,
+			Owner:                 b.owner,
+			Nonce:                 nonce,
+		})
+		nonce++
+	}
+
+	ret := make([][]*Resign, 0, (len(resigns)+b.batchSize-1)/b.batchSize)
+	for len(resigns) > b.batchSize {
+		ret = append(ret, resigns[:b.batchSize:b.batchSize])
+		resigns = resigns[b.batchSize:]
+	}
+	return append(ret, resigns), nil
+}
@@ -1,3 +1,5 @@
+//go:build !verifyonly
+
 package spec
 
 import (
@@ -6,16 +8,19 @@ import (
 	"fmt"
 
 	"github.com/bloxapp/dkg-spec/crypto"
+	"github.com/bloxapp/dkg-spec/crypto/bls"
 
 	"github.com/attestantio/go-eth2-client/spec/phase0"
 	"github.com/ethereum/go-ethereum/common"
-	eth_crypto "github.com/ethereum/go-ethereum/crypto"
-	"github.com/herumi/bls-eth-go-binary/bls"
 )
 
+// BuildResult returns the operator's result of ceremony requestID, with a proof committing to paramsHash (see CeremonyParams)
+// and carrying commitments to the ceremony's public polynomial, nil if the operator doesn't know them
 func BuildResult(
 	operatorID uint64,
 	requestID [24]byte,
+	paramsHash [32]byte,
+	commitments [][]byte,
 	share *bls.SecretKey,
 	sk *rsa.PrivateKey,
 	validatorPK []byte,
@@ -24,20 +29,23 @@ func BuildResult(
 	fork [4]byte,
 	nonce uint64,
 ) (*Result, error) {
-	// sign deposit data
-	depositDataRoot, err := crypto.DepositDataRootForFork(
-		fork,
+	depositDataSig, ownerNonceSig, err := partialSignatures(
+		func(root []byte) (*bls.Sign, error) {
+			return share.SignByte(root), nil
+		},
 		validatorPK,
 		withdrawalCredentials,
-		crypto.MaxEffectiveBalanceInGwei,
+		fork,
+		DepositAmount,
+		owner,
+		nonce,
 	)
 	if err != nil {
 		return nil, err
 	}
-	depositDataSig := share.SignByte(depositDataRoot[:])
 
 	// sign proof
-	encryptedShare, err := crypto.Encrypt(&sk.PublicKey, share.Serialize())
+	encryptedShare, err := EncryptShare(&sk.PublicKey, share.Serialize(), validatorPK, owner)
 	if err != nil {
 		return nil, err
 	}
@@ -46,42 +54,139 @@ func BuildResult(
 		EncryptedShare:  encryptedShare,
 		SharePubKey:     share.GetPublicKey().Serialize(),
 		Owner:           owner,
+		RequestID:       requestID,
+		ParamsHash:      paramsHash,
+		Commitments:     commitments,
 	}
-	hash, err := newProof.HashTreeRoot()
+	proofSig, err := signProof(sk, newProof)
 	if err != nil {
 		return nil, err
 	}
-	proofSig, err := crypto.SignRSA(sk, hash[:])
+
+	return &Result{
+		OperatorID:                 operatorID,
+		RequestID:                  requestID,
+		DepositPartialSignature:    depositDataSig.Serialize(),
+		OwnerNoncePartialSignature: ownerNonceSig.Serialize(),
+		SignedProof: SignedProof{
+			Proof:     newProof,
+			Signature: proofSig,
+		},
+	}, nil
+}
+
+// BuildResignResult returns resign's result with partial signatures of shares.
+// The share doesn't change on re-sign, the result carries the ceremony proof it was validated against re-issued for requestID.
+func BuildResignResult(
+	operatorID uint64,
+	requestID [24]byte,
+	shares ShareProvider,
+	sk *rsa.PrivateKey,
+	proof *SignedProof,
+	resign *Resign,
+) (*Result, error) {
+	sharePK, err := shares.SharePubKey(resign.ValidatorPubKey)
+	if err != nil {
+		return nil, err
+	}
+	if !bytes.Equal(sharePK.Serialize(), proof.Proof.SharePubKey) {
+		return nil, fmt.Errorf("share doesn't match proof")
+	}
+	depositDataSig, ownerNonceSig, err := partialSignatures(
+		func(root []byte) (*bls.Sign, error) {
+			return shares.SignRoot(resign.ValidatorPubKey, root)
+		},
+		resign.ValidatorPubKey,
+		resign.WithdrawalCredentials,
+		resign.Fork,
+		resign.Amount,
+		resign.Owner,
+		resign.Nonce,
+	)
+	if err != nil {
+		return nil, err
+	}
+	newProof := *proof.Proof
+	newProof.RequestID = requestID
+	proofSig, err := signProof(sk, &newProof)
 	if err != nil {
 		return nil, err
 	}
-
 	return &Result{
 		OperatorID:                 operatorID,
 		RequestID:                  requestID,
 		DepositPartialSignature:    depositDataSig.Serialize(),
-		OwnerNoncePartialSignature: share.SignByte(PartialNonceRoot(owner, nonce)).Serialize(),
+		OwnerNoncePartialSignature: ownerNonceSig.Serialize(),
 		SignedProof: SignedProof{
-			Proof:     newProof,
+			Proof:     &newProof,
 			Signature: proofSig,
 		},
 	}, nil
 }
 
-// ValidateResults returns nil if results array is valid
+// signProof returns the operator's RSA signature over proof
+func signProof(sk *rsa.PrivateKey, proof *Proof) ([]byte, error) {
+	hash, err := proof.SigningRoot()
+	if err != nil {
+		return nil, err
+	}
+	return crypto.SignRSA(sk, hash[:])
+}
+
+// partialSignatures signs the roots of the deposit data of amount gwei and of the owner nonce with sign
+func partialSignatures(
+	sign func(root []byte) (*bls.Sign, error),
+	validatorPK []byte,
+	withdrawalCredentials []byte,
+	fork [4]byte,
+	amount uint64,
+	owner [20]byte,
+	nonce uint64,
+) (depositDataSig, ownerNonceSig *bls.Sign, err error) {
+	depositDataRoot, err := crypto.DepositDataRootForFork(
+		fork,
+		validatorPK,
+		withdrawalCredentials,
+		phase0.Gwei(amount),
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+	depositDataSig, err = sign(depositDataRoot[:])
+	if err != nil {
+		return nil, nil, err
+	}
+	ownerNonceSig, err = sign(PartialNonceRoot(owner, nonce))
+	if err != nil {
+		return nil, nil, err
+	}
+	return depositDataSig, ownerNonceSig, nil
+}
+
+// ValidateResults returns nil if results array is valid, results signing deposit data of amount gwei.
+// params are the parameters the results' proofs were issued under (see Proof.ParamsHash): the ceremony's own for init and
+// reshare, the original ceremony's for resign as resigned proofs keep it, nil if the proofs don't commit to them.
 func ValidateResults(
 	operators []*Operator,
 	withdrawalCredentials []byte,
 	validatorPK []byte,
 	fork [4]byte,
+	amount uint64,
 	ownerAddress [20]byte,
 	nonce uint64,
 	requestID [24]byte,
-	t int, // threshold for minimum results needed
+	t int, // threshold the ceremony was run with
+	params *CeremonyParams,
 	results []*Result,
 ) (*bls.PublicKey, *phase0.DepositData, *bls.Sign, error) {
+	if t < 0 || !ValidThresholdSet(uint64(t), operators) {
+		return nil, nil, nil, codedError(CodeInvalidThreshold, "threshold set is invalid")
+	}
 	if len(results) != len(operators) {
-		return nil, nil, nil, fmt.Errorf("mistmatch results count")
+		return nil, nil, nil, codedError(CodeResultsCountMismatch, "mistmatch results count")
+	}
+	if _, err := CanonicalResults(results); err != nil {
+		return nil, nil, nil, err
 	}
 
 	// recover and validate validator pk
@@ -90,7 +195,10 @@ func ValidateResults(
 		return nil, nil, nil, err
 	}
 	if !bytes.Equal(validatorPK, pk) {
-		return nil, nil, nil, fmt.Errorf("invalid recovered validator pubkey")
+		return nil, nil, nil, codedError(CodeRecoveredPubKeyMismatch, "invalid recovered validator pubkey")
+	}
+	if err := VerifyShareInterpolation(validatorPK, uint64(t), results); err != nil {
+		return nil, nil, nil, err
 	}
 
 	ids := make([]uint64, 0, len(results))
@@ -100,7 +208,13 @@ func ValidateResults(
 
 	// validate individual result
 	for _, result := range results {
-		if err := ValidateResult(operators, ownerAddress, requestID, withdrawalCredentials, validatorPK, fork, nonce, result); err != nil {
+		if err := ValidateResult(operators, ownerAddress, requestID, withdrawalCredentials, validatorPK, fork, amount, nonce, result); err != nil {
+			return nil, nil, nil, err
+		}
+		if err := validateOriginalParams(result.SignedProof.Proof, params, operators, uint64(t)); err != nil {
+			return nil, nil, nil, err
+		}
+		if err := validateResultCommitments(result, results[0], uint64(t)); err != nil {
 			return nil, nil, nil, err
 		}
 		pub, deposit, ownerNonce, err := GetPartialSigsFromResult(result)
@@ -122,29 +236,27 @@ func ValidateResults(
 	if err != nil {
 		return nil, nil, nil, err
 	}
-	network, err := crypto.GetNetworkByFork(fork)
+	depositCredentials, err := crypto.DepositWithdrawalCredentials(fork, withdrawalCredentials)
 	if err != nil {
 		return nil, nil, nil, err
 	}
 	depositData := &phase0.DepositData{
 		PublicKey:             phase0.BLSPubKey(validatorRecoveredPK.Serialize()),
-		Amount:                crypto.MaxEffectiveBalanceInGwei,
-		WithdrawalCredentials: crypto.ETH1WithdrawalCredentials(withdrawalCredentials),
+		Amount:                phase0.Gwei(amount),
+		WithdrawalCredentials: depositCredentials,
 		Signature:             phase0.BLSSignature(masterDepositSig.Serialize()),
 	}
-	err = crypto.VerifyDepositData(network, depositData)
+	err = crypto.VerifyDepositDataForFork(fork, depositData)
 	if err != nil {
-		return nil, nil, nil, fmt.Errorf("failed to verify master deposit signature: %v", err)
+		return nil, nil, nil, codedError(CodeInvalidMasterSignature, "failed to verify master deposit signature: %v", err)
 	}
-	data := fmt.Sprintf("%s:%d", common.Address(ownerAddress).String(), nonce)
-	hash := eth_crypto.Keccak256([]byte(data))
-	if !masterOwnerNonceSig.VerifyByte(validatorRecoveredPK, hash) {
-		return nil, nil, nil, fmt.Errorf("failed to verify master owner/nonce signature: %v", err)
+	if !masterOwnerNonceSig.VerifyByte(validatorRecoveredPK, PartialNonceRoot(ownerAddress, nonce)) {
+		return nil, nil, nil, codedError(CodeInvalidMasterSignature, "failed to verify master owner/nonce signature: %v", err)
 	}
 	return validatorRecoveredPK, depositData, masterOwnerNonceSig, nil
 }
 
-// ValidateResult returns nil if result is valid against init object
+// ValidateResult returns nil if result is valid against init object, signing deposit data of amount gwei
 func ValidateResult(
 	operators []*Operator,
 	ownerAddress [20]byte,
@@ -152,28 +264,33 @@ func ValidateResult(
 	withdrawalCredentials []byte,
 	validatorPK []byte,
 	fork [4]byte,
+	amount uint64,
 	nonce uint64,
 	result *Result,
 ) error {
 	// verify operator
 	operator := GetOperator(operators, result.OperatorID)
 	if operator == nil {
-		return fmt.Errorf("operator not found")
+		return codedError(CodeOperatorNotFound, "operator not found")
 	}
 
 	// verify request ID
 	if !bytes.Equal(requestID[:], result.RequestID[:]) {
-		return fmt.Errorf("invalid request ID")
+		return codedError(CodeRequestIDMismatch, "invalid request ID")
+	}
+	if result.SignedProof.Proof == nil || !bytes.Equal(requestID[:], result.SignedProof.Proof.RequestID[:]) {
+		return codedError(CodeRequestIDMismatch, "invalid proof request ID")
 	}
 
 	if err := VerifyPartialSignatures(
 		withdrawalCredentials,
 		fork,
+		amount,
 		ownerAddress,
 		nonce,
 		result,
 	); err != nil {
-		return fmt.Errorf("failed to verify partial signatures: %v", err)
+		return codedError(CodeInvalidPartialSignature, "failed to verify partial signatures: %v", err)
 	}
 
 	// verify ceremony proof
@@ -183,7 +300,7 @@ func ValidateResult(
 		operator,
 		result.SignedProof,
 	); err != nil {
-		return fmt.Errorf("failed to validate ceremony proof: %v", err)
+		return fmt.Errorf("failed to validate ceremony proof: %w", err)
 	}
 
 	return nil
@@ -211,9 +328,100 @@ func RecoverValidatorPKFromResults(results []*Result) ([]byte, error) {
 	return validatorRecoveredPK.Serialize(), nil
 }
 
+// VerifyShareInterpolation returns nil if the share public keys of results, at their operator IDs, interpolate to
+// validatorPK with a polynomial of degree t-1: every t of them recover validatorPK. Recovering from all results only
+// catches a single inconsistent share, as errors in several shares can cancel out.
+func VerifyShareInterpolation(validatorPK []byte, t uint64, results []*Result) error {
+	if t == 0 || uint64(len(results)) < t {
+		return codedError(CodeThresholdUnreachable, "%d results, threshold is %d", len(results), t)
+	}
+	ordered, err := CanonicalResults(results)
+	if err != nil {
+		return err
+	}
+	ids := make([]uint64, len(ordered))
+	pks := make([]*bls.PublicKey, len(ordered))
+	for i, result := range ordered {
+		if result.SignedProof.Proof == nil {
+			return fmt.Errorf("operator %d: missing proof", result.OperatorID)
+		}
+		if pks[i], err = BLSPKEncode(result.SignedProof.Proof.SharePubKey); err != nil {
+			return err
+		}
+		ids[i] = result.OperatorID
+	}
+
+	// the first t-1 shares and validatorPK define the polynomial, every other share must be on it
+	subsetIDs := append([]uint64{}, ids[:t-1]...)
+	subsetPKs := append([]*bls.PublicKey{}, pks[:t-1]...)
+	for i := int(t - 1); i < len(ordered); i++ {
+		recovered, err := crypto.RecoverValidatorPublicKey(append(subsetIDs, ids[i]), append(subsetPKs, pks[i]))
+		if err != nil {
+			return err
+		}
+		if !bytes.Equal(recovered.Serialize(), validatorPK) {
+			return codedError(CodeRecoveredPubKeyMismatch, "share public keys of operators %v and %d don't interpolate to the validator pubkey", subsetIDs, ids[i])
+		}
+	}
+	return nil
+}
+
+// VerifyProofCommitments returns nil if proof's share public key is the evaluation at operatorID of the public polynomial
+// it commits to, whose constant term is the validator public key. It needs nothing but the proof, so anyone holding an
+// operator's proof can check its share against the validator without contacting the other operators.
+func VerifyProofCommitments(proof *Proof, operatorID uint64) error {
+	if len(proof.Commitments) == 0 {
+		return codedError(CodeInconsistentDealing, "proof carries no commitments")
+	}
+	if !bytes.Equal(proof.Commitments[0], proof.ValidatorPubKey) {
+		return codedError(CodeInconsistentDealing, "commitments don't start with the validator public key")
+	}
+	commitments := make([]bls.PublicKey, len(proof.Commitments))
+	for i, commitment := range proof.Commitments {
+		if err := commitments[i].Deserialize(commitment); err != nil {
+			return codedError(CodeInconsistentDealing, "invalid commitment %d: %v", i, err)
+		}
+	}
+	id, err := blsID(operatorID)
+	if err != nil {
+		return err
+	}
+	expected := &bls.PublicKey{}
+	if err := expected.Set(commitments, id); err != nil {
+		return err
+	}
+	if !bytes.Equal(expected.Serialize(), proof.SharePubKey) {
+		return codedError(CodeInconsistentDealing, "share public key of operator %d doesn't match the commitments", operatorID)
+	}
+	return nil
+}
+
+// validateResultCommitments returns nil if result's proof carries no commitments, as first's, or commits to the same
+// polynomial of degree t-1 as first's and its share matches it
+func validateResultCommitments(result, first *Result, t uint64) error {
+	proof := result.SignedProof.Proof
+	if len(proof.Commitments) != len(first.SignedProof.Proof.Commitments) {
+		return codedError(CodeInconsistentDealing, "operator %d: %d commitments, operator %d's proof has %d", result.OperatorID, len(proof.Commitments), first.OperatorID, len(first.SignedProof.Proof.Commitments))
+	}
+	if len(proof.Commitments) == 0 {
+		return nil
+	}
+	if uint64(len(proof.Commitments)) != t {
+		return codedError(CodeInconsistentDealing, "operator %d: %d commitments, expected %d", result.OperatorID, len(proof.Commitments), t)
+	}
+	for i, commitment := range proof.Commitments {
+		if !bytes.Equal(commitment, first.SignedProof.Proof.Commitments[i]) {
+			return codedError(CodeInconsistentDealing, "operator %d: commitments differ from operator %d's", result.OperatorID, first.OperatorID)
+		}
+	}
+	return VerifyProofCommitments(proof, result.OperatorID)
+}
+
+// VerifyPartialSignatures verifies result's partial signatures of the deposit data of amount gwei and of the owner nonce
 func VerifyPartialSignatures(
 	withdrawalCredentials []byte,
 	fork [4]byte,
+	amount uint64,
 	ownerAddress [20]byte,
 	nonce uint64,
 	result *Result,
@@ -236,6 +444,7 @@ func VerifyPartialSignatures(
 	if err := VerifyPartialDepositDataSignatures(
 		withdrawalCredentials,
 		fork,
+		amount,
 		result.SignedProof.Proof.ValidatorPubKey,
 		[]*bls.Sign{depositSig},
 		[]*bls.PublicKey{pk},
@@ -250,10 +459,73 @@ func VerifyPartialSignatures(
 	return nil
 }
 
+// BatchVerifyPartialSignatures verifies the deposit (of amount gwei) and owner nonce partial signatures of results at once,
+// results[i] being of the ceremony with nonces[i]. For the hundreds of results of a bulk ceremony it's much faster than
+// VerifyPartialSignatures for every result, which it falls back to when the batch fails to report the invalid result.
+func BatchVerifyPartialSignatures(
+	withdrawalCredentials []byte,
+	fork [4]byte,
+	amount uint64,
+	ownerAddress [20]byte,
+	nonces []uint64,
+	results []*Result,
+) error {
+	if len(nonces) != len(results) {
+		return fmt.Errorf("%d nonces for %d results", len(nonces), len(results))
+	}
+	if len(results) == 0 {
+		return nil
+	}
+	sigs := make([]bls.Sign, 0, 2*len(results))
+	pks := make([]bls.PublicKey, 0, 2*len(results))
+	roots := make([][]byte, 0, 2*len(results))
+	depositRoots := make(map[string][]byte)
+	for i, result := range results {
+		if result.SignedProof.Proof == nil {
+			return codedError(CodeInvalidPartialSignature, "operator %d: missing proof", result.OperatorID)
+		}
+		pk, depositSig, nonceSig, err := GetPartialSigsFromResult(result)
+		if err != nil {
+			return codedError(CodeInvalidPartialSignature, "operator %d: %v", result.OperatorID, err)
+		}
+		// results of a ceremony share the deposit root
+		validatorPK := result.SignedProof.Proof.ValidatorPubKey
+		depositRoot, found := depositRoots[string(validatorPK)]
+		if !found {
+			if depositRoot, err = depositMessageRoot(withdrawalCredentials, fork, amount, validatorPK); err != nil {
+				return err
+			}
+			depositRoots[string(validatorPK)] = depositRoot
+		}
+		sigs = append(sigs, *depositSig, *nonceSig)
+		pks = append(pks, *pk, *pk)
+		roots = append(roots, depositRoot, PartialNonceRoot(ownerAddress, nonces[i]))
+	}
+	if bls.MultiVerify(sigs, pks, roots) {
+		return nil
+	}
+
+	for i, result := range results {
+		if err := VerifyPartialSignatures(withdrawalCredentials, fork, amount, ownerAddress, nonces[i], result); err != nil {
+			return codedError(CodeInvalidPartialSignature, "operator %d of request %x: failed to verify partial signatures: %v", result.OperatorID, result.RequestID, err)
+		}
+	}
+	// every signature is valid on its own, only possible for invalid points
+	return codedError(CodeInvalidPartialSignature, "failed to verify partial signatures")
+}
+
 // PartialNonceRoot returns root for singing owner nonce
 func PartialNonceRoot(address common.Address, nonce uint64) []byte {
-	data := fmt.Sprintf("%s:%d", address.String(), nonce)
-	return eth_crypto.Keccak256([]byte(data))
+	root, err := (&OwnerNonce{
+		Domain: DomainOwnerNonce,
+		Owner:  address,
+		Nonce:  nonce,
+	}).HashTreeRoot()
+	if err != nil {
+		// fixed size, can't fail
+		panic(err)
+	}
+	return root[:]
 }
 
 func VerifyPartialNonceSignatures(
@@ -267,7 +539,7 @@ func VerifyPartialNonceSignatures(
 	// Verify partial signatures and recovered threshold signature
 	err := crypto.VerifyPartialSigs(sigs, pks, hash)
 	if err != nil {
-		return fmt.Errorf("failed to verify nonce partial signatures")
+		return codedError(CodeInvalidPartialSignature, "failed to verify nonce partial signatures")
 	}
 	return nil
 }
@@ -275,50 +547,24 @@ func VerifyPartialNonceSignatures(
 func VerifyPartialDepositDataSignatures(
 	withdrawalCredentials []byte,
 	fork [4]byte,
+	amount uint64,
 	validatorPubKey []byte,
 	sigs []*bls.Sign,
 	pks []*bls.PublicKey,
 ) error {
-	network, err := crypto.GetNetworkByFork(fork)
+	shareRoot, err := depositMessageRoot(withdrawalCredentials, fork, amount, validatorPubKey)
 	if err != nil {
 		return err
 	}
 
-	shareRoot, err := crypto.ComputeDepositMessageSigningRoot(network, &phase0.DepositMessage{
-		PublicKey:             phase0.BLSPubKey(validatorPubKey),
-		Amount:                crypto.MaxEffectiveBalanceInGwei,
-		WithdrawalCredentials: crypto.ETH1WithdrawalCredentials(withdrawalCredentials)})
-	if err != nil {
-		return fmt.Errorf("failed to compute deposit data root")
-	}
-
 	// Verify partial signatures and recovered threshold signature
-	err = crypto.VerifyPartialSigs(sigs, pks, shareRoot[:])
+	err = crypto.VerifyPartialSigs(sigs, pks, shareRoot)
 	if err != nil {
-		return fmt.Errorf("failed to verify deposit partial signatures")
+		return codedError(CodeInvalidPartialSignature, "failed to verify deposit partial signatures")
 	}
 	return nil
 }
 
-// GetOperator returns operator by ID or nil if not found
-func GetOperator(operators []*Operator, id uint64) *Operator {
-	for _, operator := range operators {
-		if operator.ID == id {
-			return operator
-		}
-	}
-	return nil
-}
-
-func OperatorIDByPubKey(operators []*Operator, pkBytes []byte) (uint64, error) {
-	for _, op := range operators {
-		if bytes.Equal(op.PubKey, pkBytes) {
-			return op.ID, nil
-		}
-	}
-	return 0, fmt.Errorf("wrong operator")
-}
-
 func BLSPKEncode(pkBytes []byte) (*bls.PublicKey, error) {
 	ret := &bls.PublicKey{}
 	if err := ret.Deserialize(pkBytes); err != nil {
@@ -363,3 +609,19 @@ func ReconstructMasterSignatures(ids []uint64, sigsPartialDeposit, sigsPartialSS
 	}
 	return reconstructedDepositMasterSig, reconstructedOwnerNonceMasterSig, nil
 }
+
+// depositMessageRoot returns the signing root of validatorPubKey's deposit message of amount gwei partial deposit signatures sign
+func depositMessageRoot(withdrawalCredentials []byte, fork [4]byte, amount uint64, validatorPubKey []byte) ([]byte, error) {
+	depositCredentials, err := crypto.DepositWithdrawalCredentials(fork, withdrawalCredentials)
+	if err != nil {
+		return nil, err
+	}
+	root, err := crypto.ComputeDepositMessageSigningRootForFork(fork, &phase0.DepositMessage{
+		PublicKey:             phase0.BLSPubKey(validatorPubKey),
+		Amount:                phase0.Gwei(amount),
+		WithdrawalCredentials: depositCredentials})
+	if err != nil {
+		return nil, fmt.Errorf("failed to compute deposit data root")
+	}
+	return root[:], nil
+}
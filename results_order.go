@@ -0,0 +1,57 @@
+package spec
+
+import (
+	"bytes"
+	"sort"
+)
+
+// CanonicalResults returns a copy of results in canonical order, by request ID and then operator ID, so roots over result sets
+// (e.g. summaries and BulkResultsRoot leaves) don't depend on the order operators responded in.
+// It returns error if an operator has more than one result for the same request.
+func CanonicalResults(results []*Result) ([]*Result, error) {
+	ret := append([]*Result{}, results...)
+	sort.SliceStable(ret, func(i, j int) bool {
+		return compareResults(ret[i], ret[j]) < 0
+	})
+	for i, result := range ret {
+		if result == nil {
+			return nil, codedError(CodeResultsCountMismatch, "empty result")
+		}
+		if i > 0 && compareResults(ret[i-1], result) == 0 {
+			return nil, codedError(CodeResultsCountMismatch, "duplicate result of operator %d for request %x", result.OperatorID, result.RequestID)
+		}
+	}
+	return ret, nil
+}
+
+// IsCanonicalResults returns true if results are in canonical order without duplicates, see CanonicalResults
+func IsCanonicalResults(results []*Result) bool {
+	for i, result := range results {
+		if result == nil || (i > 0 && compareResults(results[i-1], result) >= 0) {
+			return false
+		}
+	}
+	return true
+}
+
+// compareResults orders results by request ID and then operator ID, nil results last
+func compareResults(a, b *Result) int {
+	switch {
+	case a == nil && b == nil:
+		return 0
+	case a == nil:
+		return 1
+	case b == nil:
+		return -1
+	}
+	if c := bytes.Compare(a.RequestID[:], b.RequestID[:]); c != 0 {
+		return c
+	}
+	switch {
+	case a.OperatorID < b.OperatorID:
+		return -1
+	case a.OperatorID > b.OperatorID:
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,25 @@
+package spec
+
+import "time"
+
+// ValidateSchedule returns nil if init's ceremony window includes now
+func ValidateSchedule(init *Init, now time.Time) error {
+	if init.NotBefore != 0 && now.Before(time.Unix(int64(init.NotBefore), 0)) {
+		return codedError(CodeOutsideSchedule, "ceremony not accepted before %s", formatUnix(init.NotBefore))
+	}
+	if init.NotAfter != 0 && now.After(time.Unix(int64(init.NotAfter), 0)) {
+		return codedError(CodeOutsideSchedule, "ceremony not accepted after %s", formatUnix(init.NotAfter))
+	}
+	return nil
+}
+
+func unixOrZero(t time.Time) uint64 {
+	if t.IsZero() {
+		return 0
+	}
+	return uint64(t.Unix())
+}
+
+func formatUnix(t uint64) string {
+	return time.Unix(int64(t), 0).UTC().Format(time.RFC3339)
+}
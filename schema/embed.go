@@ -0,0 +1,14 @@
+package schema
+
+import (
+	"embed"
+	"path"
+)
+
+//go:embed json/*.schema.json
+var files embed.FS
+
+// Get returns the embedded (generated) schema of message type name
+func Get(name string) ([]byte, error) {
+	return files.ReadFile(path.Join("json", FileName(name)))
+}
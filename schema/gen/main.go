@@ -0,0 +1,21 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+
+	"github.com/bloxapp/dkg-spec/schema"
+)
+
+// writes all message schemas into ./json, run from the schema package directory (see go:generate)
+func main() {
+	schemas, err := schema.Generate()
+	if err != nil {
+		panic(err)
+	}
+	for name, byts := range schemas {
+		if err := os.WriteFile(filepath.Join("json", name), byts, 0o644); err != nil {
+			panic(err)
+		}
+	}
+}
@@ -0,0 +1,264 @@
+package schema
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+const (
+	draft   = "https://json-schema.org/draft/2020-12/schema"
+	idRoot  = "https://github.com/bloxapp/dkg-spec/schema/"
+	base64P = "^[A-Za-z0-9+/]*={0,2}$"
+	hexP    = "^([0-9a-fA-F]{2})*$"
+)
+
+// Schema is the subset of JSON Schema (draft 2020-12) used to describe the JSON forms of spec messages
+type Schema struct {
+	Schema               string             `json:"$schema,omitempty"`
+	ID                   string             `json:"$id,omitempty"`
+	Ref                  string             `json:"$ref,omitempty"`
+	Title                string             `json:"title,omitempty"`
+	Description          string             `json:"description,omitempty"`
+	Type                 string             `json:"type,omitempty"`
+	Properties           map[string]*Schema `json:"properties,omitempty"`
+	Required             []string           `json:"required,omitempty"`
+	AdditionalProperties *bool              `json:"additionalProperties,omitempty"`
+	Items                *Schema            `json:"items,omitempty"`
+	MinLength            *int               `json:"minLength,omitempty"`
+	MaxLength            *int               `json:"maxLength,omitempty"`
+	MinItems             *int               `json:"minItems,omitempty"`
+	MaxItems             *int               `json:"maxItems,omitempty"`
+	Minimum              *int               `json:"minimum,omitempty"`
+	Maximum              *int               `json:"maximum,omitempty"`
+	Pattern              string             `json:"pattern,omitempty"`
+	Defs                 map[string]*Schema `json:"$defs,omitempty"`
+}
+
+// Messages lists the message types schemas are generated for
+var Messages = []string{
+	"Init",
+	"SignedInit",
+	"Reshare",
+	"SignedReshare",
+	"Resign",
+	"SignedResign",
+	"Proof",
+	"SignedProof",
+	"Result",
+}
+
+func intPtr(i int) *int {
+	return &i
+}
+
+func boolPtr(b bool) *bool {
+	return &b
+}
+
+func ref(name string) *Schema {
+	return &Schema{Ref: "#/$defs/" + name}
+}
+
+func object(description string, properties map[string]*Schema, required ...string) *Schema {
+	return &Schema{
+		Type:                 "object",
+		Description:          description,
+		Properties:           properties,
+		Required:             required,
+		AdditionalProperties: boolPtr(false),
+	}
+}
+
+// hexBytes is a hex encoded (no 0x prefix) byte string of exactly size bytes, or at most max bytes if size is 0
+func hexBytes(description string, size, max int) *Schema {
+	ret := &Schema{Type: "string", Description: description, Pattern: hexP}
+	if size == 0 {
+		ret.MaxLength = intPtr(2 * max)
+	} else {
+		ret.MinLength = intPtr(2 * size)
+		ret.MaxLength = intPtr(2 * size)
+	}
+	return ret
+}
+
+// base64Bytes is a standard base64 encoded byte string ([]byte default JSON encoding)
+func base64Bytes(description string) *Schema {
+	return &Schema{Type: "string", Description: description, Pattern: base64P}
+}
+
+// byteArray is a fixed size byte array ([N]byte default JSON encoding)
+func byteArray(description string, size int) *Schema {
+	return &Schema{
+		Type:        "array",
+		Description: description,
+		Items:       &Schema{Type: "integer", Minimum: intPtr(0), Maximum: intPtr(255)},
+		MinItems:    intPtr(size),
+		MaxItems:    intPtr(size),
+	}
+}
+
+func uint64Schema(description string) *Schema {
+	return &Schema{Type: "integer", Description: description, Minimum: intPtr(0)}
+}
+
+func operators(description string) *Schema {
+	return &Schema{Type: "array", Description: description, Items: ref("Operator"), MaxItems: intPtr(13)}
+}
+
+// Definitions returns the schemas of all message types (and the types they embed) keyed by type name
+func Definitions() map[string]*Schema {
+	return map[string]*Schema{
+		"Operator": object("Operator participating in a ceremony", map[string]*Schema{
+			"ip":         {Type: "string", Description: "ip:port"},
+			"id":         uint64Schema("Operator ID"),
+			"public_key": base64Bytes("base64 encoded PEM RSA public key"),
+		}, "ip", "id", "public_key"),
+		"Init": object("Init message starting a new DKG ceremony", map[string]*Schema{
+			"Operators":             operators("Operators involved in the DKG"),
+			"T":                     uint64Schema("Threshold for signing"),
+			"WithdrawalCredentials": base64Bytes("Withdrawal credentials for deposit data"),
+			"Fork":                  byteArray("Ethereum fork for signing", 4),
+			"Owner":                 byteArray("Owner address", 20),
+			"Nonce":                 uint64Schema("Owner nonce"),
+			"Fee":                   ref("FeeTerms"),
+			"NotBefore":             uint64Schema("Unix time operators start accepting the ceremony at, 0 if unbounded"),
+			"NotAfter":              uint64Schema("Unix time operators stop accepting the ceremony after, 0 if unbounded"),
+		}, "Operators", "T", "WithdrawalCredentials", "Fork", "Owner", "Nonce"),
+		"SignedInit": object("Init message signed by the initiator for its ceremony", map[string]*Schema{
+			"Init":            ref("Init"),
+			"RequestID":       byteArray("Request ID of the ceremony the init starts", 24),
+			"InitiatorPubKey": base64Bytes("base64 encoded PEM RSA public key of the initiator"),
+			"Signature":       base64Bytes("Initiator RSA signature over the init signing root, under the init domain"),
+		}, "Init", "RequestID", "InitiatorPubKey", "Signature"),
+		"FeeTerms": object("Fee terms agreed with the operators, all zero if the ceremony is free", map[string]*Schema{
+			"Token":            byteArray("ERC-20 token address the fee is paid in, zero for ether", 20),
+			"Amount":           byteArray("Big-endian uint256 fee amount in the token's base units", 32),
+			"PaymentReference": byteArray("Payment agreement reference, e.g. an invoice hash", 32),
+		}, "Token", "Amount", "PaymentReference"),
+		"Reshare": object("Reshare message moving a validator to a new set of operators", map[string]*Schema{
+			"ValidatorPubKey":       base64Bytes("Validator public key"),
+			"OldOperators":          operators("Operators currently holding the shares"),
+			"NewOperators":          operators("Operators receiving the new shares"),
+			"OldT":                  uint64Schema("Old threshold for signing"),
+			"NewT":                  uint64Schema("New threshold for signing"),
+			"Fork":                  byteArray("Ethereum fork for signing", 4),
+			"WithdrawalCredentials": base64Bytes("Withdrawal credentials for deposit data"),
+			"Owner":                 byteArray("Owner address", 20),
+			"Nonce":                 uint64Schema("Owner nonce"),
+			"Fee":                   ref("FeeTerms"),
+		}, "ValidatorPubKey", "OldOperators", "NewOperators", "OldT", "NewT", "Fork", "WithdrawalCredentials", "Owner", "Nonce"),
+		"CeremonyParams": object("Parameters of the ceremony producing a share, see the proof's params_hash", map[string]*Schema{
+			"OperatorIDs": {Type: "array", Description: "Operator IDs of the cluster, ordered", Items: uint64Schema("Operator ID"), MaxItems: intPtr(13)},
+			"T":           uint64Schema("Threshold of the cluster"),
+			"Fork":        byteArray("Ethereum fork the deposit data was signed for", 4),
+			"Amount":      uint64Schema("Amount in gwei of the deposit data"),
+		}, "OperatorIDs", "T", "Fork", "Amount"),
+		"SignedReshare": object("Reshare message signed by the owner", map[string]*Schema{
+			"Reshare":   ref("Reshare"),
+			"Signature": base64Bytes("Owner signature over the reshare signing root, under the reshare domain"),
+		}, "Reshare", "Signature"),
+		"Resign": object("Resign message requesting new signatures for an existing validator", map[string]*Schema{
+			"ValidatorPubKey":       base64Bytes("Validator public key"),
+			"Fork":                  byteArray("Ethereum fork for signing", 4),
+			"WithdrawalCredentials": base64Bytes("Withdrawal credentials for deposit data"),
+			"Owner":                 byteArray("Owner address", 20),
+			"Nonce":                 uint64Schema("Owner nonce"),
+			"Amount":                uint64Schema("Amount in gwei of the deposit data to sign"),
+		}, "ValidatorPubKey", "Fork", "WithdrawalCredentials", "Owner", "Nonce", "Amount"),
+		"SignedResign": object("Resign message signed by the owner", map[string]*Schema{
+			"Resign":    ref("Resign"),
+			"Signature": base64Bytes("Owner signature over the resign signing root, under the resign domain"),
+		}, "Resign", "Signature"),
+		"Proof": object("Proof for a DKG ceremony", map[string]*Schema{
+			"validator":       hexBytes("Validator public key", 48, 0),
+			"encrypted_share": hexBytes("Share encrypted with the operator's RSA key", 0, 512),
+			"share_pub":       hexBytes("Share BLS public key", 48, 0),
+			"owner":           hexBytes("Owner address", 20, 0),
+			"request_id":      hexBytes("Request ID of the ceremony the proof was issued in", 24, 0),
+			"params_hash":     hexBytes("Hash tree root of the parameters of the ceremony the share was produced in", 32, 0),
+			"commitments": {
+				Type:        "array",
+				Description: "Commitments to the coefficients of the ceremony's public polynomial, the first being the validator public key",
+				Items:       hexBytes("Coefficient BLS public key", 48, 0),
+				MaxItems:    intPtr(13),
+			},
+		}, "validator", "encrypted_share", "share_pub", "owner"),
+		"SignedProof": object("Proof signed by the operator's RSA key", map[string]*Schema{
+			"proof":     ref("Proof"),
+			"signature": hexBytes("RSA signature over the proof hash tree root", 256, 0),
+		}, "proof", "signature"),
+		"Result": object("Result marking a specific operator's end of a ceremony", map[string]*Schema{
+			"OperatorID":                 uint64Schema("Operator ID"),
+			"RequestID":                  byteArray("Ceremony request ID", 24),
+			"DepositPartialSignature":    base64Bytes("Partial signature over deposit data"),
+			"OwnerNoncePartialSignature": base64Bytes("Partial signature over owner and nonce"),
+			"SignedProof":                ref("SignedProof"),
+			"ValidatorIndex":             uint64Schema("Validator beacon chain index, 0 if not looked up"),
+			"DealingCommitments": {
+				Type:        "array",
+				Description: "Commitments to the coefficients of the polynomial the operator dealt, empty for reshare and resign",
+				Items:       base64Bytes("Coefficient BLS public key"),
+				MaxItems:    intPtr(13),
+			},
+			"DealingProof": base64Bytes("Signature over the dealing by the polynomial's constant term, empty for reshare and resign"),
+		}, "OperatorID", "RequestID", "DepositPartialSignature", "OwnerNoncePartialSignature", "SignedProof", "ValidatorIndex"),
+	}
+}
+
+// For returns the standalone schema of message type name
+func For(name string) (*Schema, error) {
+	defs := Definitions()
+	def, found := defs[name]
+	if !found {
+		return nil, fmt.Errorf("unknown message type %s", name)
+	}
+	ret := *def
+	ret.Schema = draft
+	ret.ID = idRoot + FileName(name)
+	ret.Title = name
+
+	referenced := make(map[string]*Schema)
+	collectRefs(def, defs, referenced)
+	if len(referenced) > 0 {
+		ret.Defs = referenced
+	}
+	return &ret, nil
+}
+
+// collectRefs adds every definition (transitively) referenced by s to referenced
+func collectRefs(s *Schema, defs map[string]*Schema, referenced map[string]*Schema) {
+	if s == nil {
+		return
+	}
+	if name := strings.TrimPrefix(s.Ref, "#/$defs/"); s.Ref != "" && referenced[name] == nil {
+		referenced[name] = defs[name]
+		collectRefs(defs[name], defs, referenced)
+	}
+	for _, property := range s.Properties {
+		collectRefs(property, defs, referenced)
+	}
+	collectRefs(s.Items, defs, referenced)
+}
+
+// FileName returns the file name of message type name's schema
+func FileName(name string) string {
+	return name + ".schema.json"
+}
+
+// Generate returns the encoded schemas of all message types keyed by file name
+func Generate() (map[string][]byte, error) {
+	ret := make(map[string][]byte)
+	for _, name := range Messages {
+		s, err := For(name)
+		if err != nil {
+			return nil, err
+		}
+		byts, err := json.MarshalIndent(s, "", "  ")
+		if err != nil {
+			return nil, err
+		}
+		ret[FileName(name)] = append(byts, '\n')
+	}
+	return ret, nil
+}
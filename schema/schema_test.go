@@ -0,0 +1,125 @@
+package schema
+
+import (
+	"encoding/json"
+	"regexp"
+	"testing"
+
+	spec "github.com/bloxapp/dkg-spec"
+	"github.com/bloxapp/dkg-spec/testing/fixtures"
+
+	"github.com/stretchr/testify/require"
+)
+
+// requireConforms checks object keys, required keys and string patterns of value against s (resolving refs against defs)
+func requireConforms(t *testing.T, s *Schema, defs map[string]*Schema, value interface{}) {
+	if s.Ref != "" {
+		s = defs[s.Ref[len("#/$defs/"):]]
+	}
+	switch s.Type {
+	case "object":
+		obj, ok := value.(map[string]interface{})
+		require.True(t, ok)
+		for _, key := range s.Required {
+			require.Contains(t, obj, key)
+		}
+		for key, v := range obj {
+			property, found := s.Properties[key]
+			require.True(t, found, "unexpected property %s", key)
+			requireConforms(t, property, defs, v)
+		}
+	case "array":
+		arr, ok := value.([]interface{})
+		require.True(t, ok)
+		if s.MinItems != nil {
+			require.GreaterOrEqual(t, len(arr), *s.MinItems)
+		}
+		if s.MaxItems != nil {
+			require.LessOrEqual(t, len(arr), *s.MaxItems)
+		}
+		for _, v := range arr {
+			requireConforms(t, s.Items, defs, v)
+		}
+	case "string":
+		str, ok := value.(string)
+		require.True(t, ok)
+		if s.MinLength != nil {
+			require.GreaterOrEqual(t, len(str), *s.MinLength)
+		}
+		if s.MaxLength != nil {
+			require.LessOrEqual(t, len(str), *s.MaxLength)
+		}
+		if s.Pattern != "" {
+			require.Regexp(t, regexp.MustCompile(s.Pattern), str)
+		}
+	case "integer":
+		_, ok := value.(float64)
+		require.True(t, ok)
+	}
+}
+
+func TestEmbeddedSchemas(t *testing.T) {
+	generated, err := Generate()
+	require.NoError(t, err)
+	require.Len(t, generated, len(Messages))
+	for _, name := range Messages {
+		embedded, err := Get(name)
+		require.NoError(t, err)
+		require.EqualValues(t, string(generated[FileName(name)]), string(embedded), "run go generate ./schema")
+	}
+}
+
+func TestSchemasMatchJSON(t *testing.T) {
+	reshare := fixtures.TestReshare4Operators
+	reshare.WithdrawalCredentials = fixtures.TestWithdrawalCred[:32]
+	resign := spec.Resign{
+		ValidatorPubKey:       reshare.ValidatorPubKey,
+		WithdrawalCredentials: fixtures.TestWithdrawalCred[:32],
+		Owner:                 fixtures.TestOwnerAddress,
+		Amount:                spec.DepositAmount,
+	}
+
+	init := &spec.Init{
+		Operators:             fixtures.GenerateOperators(4),
+		T:                     3,
+		WithdrawalCredentials: fixtures.TestWithdrawalCred[:32],
+		Fork:                  fixtures.TestFork,
+		Owner:                 fixtures.TestOwnerAddress,
+	}
+
+	messages := map[string]interface{}{
+		"Init": init,
+		"SignedInit": &spec.SignedInit{
+			Init:            init,
+			RequestID:       fixtures.TestRequestID,
+			InitiatorPubKey: init.Operators[0].PubKey,
+			Signature:       make([]byte, 256),
+		},
+		"Reshare":       &reshare,
+		"SignedReshare": &spec.SignedReshare{Reshare: reshare, Signature: make([]byte, 65)},
+		"Resign":        &resign,
+		"SignedResign":  &spec.SignedResign{Resign: resign, Signature: make([]byte, 65)},
+		"Proof":         fixtures.TestOperator1Proof4Operators.Proof,
+		"SignedProof":   &fixtures.TestOperator1Proof4Operators,
+		"Result":        fixtures.Results4Operators()[0],
+	}
+	require.Len(t, messages, len(Messages))
+
+	for _, name := range Messages {
+		t.Run(name, func(t *testing.T) {
+			s, err := For(name)
+			require.NoError(t, err)
+
+			byts, err := json.Marshal(messages[name])
+			require.NoError(t, err)
+			var value interface{}
+			require.NoError(t, json.Unmarshal(byts, &value))
+			requireConforms(t, s, s.Defs, value)
+		})
+	}
+
+	t.Run("unknown", func(t *testing.T) {
+		_, err := For("Unknown")
+		require.EqualError(t, err, "unknown message type Unknown")
+	})
+}
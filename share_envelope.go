@@ -0,0 +1,199 @@
+package spec
+
+import (
+	"bytes"
+	"crypto/rsa"
+	"fmt"
+
+	"github.com/bloxapp/dkg-spec/crypto"
+)
+
+// ShareEnvelopeVersion is the encoding version of an encrypted share (see Proof.EncryptedShare)
+type ShareEnvelopeVersion uint8
+
+const (
+	// ShareEnvelopeLegacy is a bare RSA PKCS#1 v1.5 ciphertext, as registered on the SSV contract
+	ShareEnvelopeLegacy ShareEnvelopeVersion = 0
+	// ShareEnvelopeV1 is ShareEnvelopeMagic, version, algorithm and ciphertext
+	ShareEnvelopeV1 ShareEnvelopeVersion = 1
+	// ShareEnvelopeV2 is encoded as ShareEnvelopeV1, with hybrid encryption
+	ShareEnvelopeV2 ShareEnvelopeVersion = 2
+)
+
+// ShareEncryption is the scheme a share is encrypted with
+type ShareEncryption uint8
+
+const (
+	// ShareEncryptionRSAPKCS1v15 is RSA PKCS#1 v1.5 encryption with the operator's key
+	ShareEncryptionRSAPKCS1v15 ShareEncryption = 1
+	// ShareEncryptionRSAOAEPAESGCM is AES-256-GCM encryption with a random key, wrapped with RSA-OAEP to the operator's key.
+	// The ciphertext is bound to its validator and owner (see ShareEncryptionLabel).
+	ShareEncryptionRSAOAEPAESGCM ShareEncryption = 2
+)
+
+// ShareEnvelopeMagic prefixes versioned envelopes, anything else is decoded as a legacy ciphertext
+var ShareEnvelopeMagic = [3]byte{'D', 'K', 'G'}
+
+const shareEnvelopeHeaderLen = len(ShareEnvelopeMagic) + 2
+
+// ShareEncryptionLabel returns the OAEP label and GCM additional data binding an encrypted share to validatorPK and owner,
+// so it can't be transplanted into another validator's proof. RSA PKCS#1 v1.5 ciphertexts aren't bound.
+func ShareEncryptionLabel(validatorPK []byte, owner [20]byte) []byte {
+	ret := make([]byte, 0, len(validatorPK)+len(owner))
+	ret = append(ret, validatorPK...)
+	return append(ret, owner[:]...)
+}
+
+// ShareEnvelope is an encrypted share with the version and scheme needed to decrypt it
+type ShareEnvelope struct {
+	Version    ShareEnvelopeVersion
+	Algorithm  ShareEncryption
+	Ciphertext []byte
+}
+
+// Validate returns nil if the envelope's version, algorithm and ciphertext are supported
+func (e *ShareEnvelope) Validate() error {
+	if err := validateShareEncryption(e.Version, e.Algorithm); err != nil {
+		return err
+	}
+	if len(e.Ciphertext) == 0 {
+		return fmt.Errorf("empty share ciphertext")
+	}
+	if e.Version == ShareEnvelopeLegacy && bytes.HasPrefix(e.Ciphertext, ShareEnvelopeMagic[:]) {
+		return fmt.Errorf("legacy share ciphertext is ambiguous with a versioned envelope")
+	}
+	return nil
+}
+
+func validateShareEncryption(version ShareEnvelopeVersion, algorithm ShareEncryption) error {
+	switch version {
+	case ShareEnvelopeLegacy:
+		if algorithm != ShareEncryptionRSAPKCS1v15 {
+			return fmt.Errorf("unsupported legacy share encryption %d", algorithm)
+		}
+	case ShareEnvelopeV1:
+		if algorithm != ShareEncryptionRSAPKCS1v15 {
+			return fmt.Errorf("unsupported share encryption %d", algorithm)
+		}
+	case ShareEnvelopeV2:
+		if algorithm != ShareEncryptionRSAOAEPAESGCM {
+			return fmt.Errorf("unsupported share encryption %d", algorithm)
+		}
+	default:
+		return fmt.Errorf("unsupported share envelope version %d", version)
+	}
+	return nil
+}
+
+// Encode returns the envelope's encoding, legacy envelopes encode to the bare ciphertext
+func (e *ShareEnvelope) Encode() ([]byte, error) {
+	if err := e.Validate(); err != nil {
+		return nil, err
+	}
+	if e.Version == ShareEnvelopeLegacy {
+		return e.Ciphertext, nil
+	}
+	ret := make([]byte, 0, shareEnvelopeHeaderLen+len(e.Ciphertext))
+	ret = append(ret, ShareEnvelopeMagic[:]...)
+	ret = append(ret, byte(e.Version), byte(e.Algorithm))
+	return append(ret, e.Ciphertext...), nil
+}
+
+// DecodeShareEnvelope returns the envelope encoded in byts (e.g. Proof.EncryptedShare)
+func DecodeShareEnvelope(byts []byte) (*ShareEnvelope, error) {
+	ret := &ShareEnvelope{
+		Version:    ShareEnvelopeLegacy,
+		Algorithm:  ShareEncryptionRSAPKCS1v15,
+		Ciphertext: byts,
+	}
+	if bytes.HasPrefix(byts, ShareEnvelopeMagic[:]) {
+		if len(byts) < shareEnvelopeHeaderLen {
+			return nil, fmt.Errorf("share envelope too short")
+		}
+		ret = &ShareEnvelope{
+			Version:    ShareEnvelopeVersion(byts[len(ShareEnvelopeMagic)]),
+			Algorithm:  ShareEncryption(byts[len(ShareEnvelopeMagic)+1]),
+			Ciphertext: byts[shareEnvelopeHeaderLen:],
+		}
+	}
+	if err := ret.Validate(); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// EncryptShareEnvelope encrypts share to pk with algorithm in an envelope of version, bound to label if algorithm supports it.
+// Legacy ciphertexts starting with ShareEnvelopeMagic are re-encrypted, as they can't be told apart from versioned envelopes.
+func EncryptShareEnvelope(
+	pk *rsa.PublicKey,
+	share []byte,
+	label []byte,
+	version ShareEnvelopeVersion,
+	algorithm ShareEncryption,
+) (*ShareEnvelope, error) {
+	if err := validateShareEncryption(version, algorithm); err != nil {
+		return nil, err
+	}
+	for {
+		var ciphertext []byte
+		var err error
+		switch algorithm {
+		case ShareEncryptionRSAOAEPAESGCM:
+			ciphertext, err = crypto.EncryptHybrid(pk, share, label)
+		default:
+			ciphertext, err = crypto.Encrypt(pk, share)
+		}
+		if err != nil {
+			return nil, err
+		}
+		ret := &ShareEnvelope{
+			Version:    version,
+			Algorithm:  algorithm,
+			Ciphertext: ciphertext,
+		}
+		if ret.Validate() == nil {
+			return ret, nil
+		}
+	}
+}
+
+// ProofShareEnvelope is the envelope operators encrypt the shares of their proofs in (see EncryptShare). It defaults to
+// ShareEnvelopeLegacy, the 256 bytes ciphertexts the SSV contract registers and VerifyProofsAgainstCluster compares
+// proofs with. Setting it to ShareEnvelopeV2 binds shares to their validator and owner, for proofs whose shares aren't
+// registered as is. It's meant to be set once, before ceremonies start.
+var ProofShareEnvelope = ShareEnvelopeLegacy
+
+// EncryptShare returns the encoded envelope of share encrypted to pk, as operators encrypt the shares of their proofs:
+// a ProofShareEnvelope, bound to validatorPK and owner (see ShareEncryptionLabel) if it supports it
+func EncryptShare(pk *rsa.PublicKey, share []byte, validatorPK []byte, owner [20]byte) ([]byte, error) {
+	algorithm := ShareEncryptionRSAPKCS1v15
+	if ProofShareEnvelope == ShareEnvelopeV2 {
+		algorithm = ShareEncryptionRSAOAEPAESGCM
+	}
+	envelope, err := EncryptShareEnvelope(
+		pk,
+		share,
+		ShareEncryptionLabel(validatorPK, owner),
+		ProofShareEnvelope,
+		algorithm,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return envelope.Encode()
+}
+
+// Decrypt returns the share in the envelope, decrypted with sk and the label it was encrypted with
+func (e *ShareEnvelope) Decrypt(sk *rsa.PrivateKey, label []byte) ([]byte, error) {
+	if err := e.Validate(); err != nil {
+		return nil, err
+	}
+	switch e.Algorithm {
+	case ShareEncryptionRSAPKCS1v15:
+		return crypto.Decrypt(sk, e.Ciphertext)
+	case ShareEncryptionRSAOAEPAESGCM:
+		return crypto.DecryptHybrid(sk, e.Ciphertext, label)
+	default:
+		return nil, fmt.Errorf("unsupported share encryption %d", e.Algorithm)
+	}
+}
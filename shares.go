@@ -0,0 +1,38 @@
+//go:build !verifyonly
+
+package spec
+
+import (
+	"github.com/bloxapp/dkg-spec/crypto/bls"
+)
+
+// ShareProvider holds an operator's validator shares, e.g. a keystore or a remote signer
+type ShareProvider interface {
+	// SharePubKey returns the public key of the operator's share of validatorPK
+	SharePubKey(validatorPK []byte) (*bls.PublicKey, error)
+	// SignRoot signs root with the operator's share of validatorPK
+	SignRoot(validatorPK []byte, root []byte) (*bls.Sign, error)
+}
+
+// ShareLookup is a ShareProvider looking up the operator's secret share of validatorPK
+type ShareLookup func(validatorPK []byte) (*bls.SecretKey, error)
+
+var _ ShareProvider = ShareLookup(nil)
+
+// SharePubKey returns the public key of the share of validatorPK
+func (f ShareLookup) SharePubKey(validatorPK []byte) (*bls.PublicKey, error) {
+	share, err := f(validatorPK)
+	if err != nil {
+		return nil, err
+	}
+	return share.GetPublicKey(), nil
+}
+
+// SignRoot signs root with the share of validatorPK
+func (f ShareLookup) SignRoot(validatorPK []byte, root []byte) (*bls.Sign, error) {
+	share, err := f(validatorPK)
+	if err != nil {
+		return nil, err
+	}
+	return share.SignByte(root), nil
+}
@@ -0,0 +1,87 @@
+//go:build !verifyonly
+
+package spec
+
+import (
+	"encoding/hex"
+	"fmt"
+
+	"github.com/bloxapp/dkg-spec/crypto"
+)
+
+/*
+Slashing protection interchange format (EIP-3076) for validators created by a ceremony.
+A validator fresh out of a DKG has never signed, its history is a genesis entry (slot 0, epoch 0) only.
+https://eips.ethereum.org/EIPS/eip-3076
+*/
+
+// InterchangeFormatVersion is the EIP-3076 interchange format version
+const InterchangeFormatVersion = "5"
+
+type SlashingProtectionInterchange struct {
+	Metadata *InterchangeMetadata    `json:"metadata"`
+	Data     []*InterchangeValidator `json:"data"`
+}
+
+type InterchangeMetadata struct {
+	InterchangeFormatVersion string `json:"interchange_format_version"`
+	GenesisValidatorsRoot    string `json:"genesis_validators_root"`
+}
+
+type InterchangeValidator struct {
+	PubKey             string                    `json:"pubkey"`
+	SignedBlocks       []*InterchangeBlock       `json:"signed_blocks"`
+	SignedAttestations []*InterchangeAttestation `json:"signed_attestations"`
+}
+
+type InterchangeBlock struct {
+	Slot        string `json:"slot"`
+	SigningRoot string `json:"signing_root,omitempty"`
+}
+
+type InterchangeAttestation struct {
+	SourceEpoch string `json:"source_epoch"`
+	TargetEpoch string `json:"target_epoch"`
+	SigningRoot string `json:"signing_root,omitempty"`
+}
+
+// NewSlashingProtection returns an interchange with a genesis entry for each of validatorPKs on fork's network
+func NewSlashingProtection(fork [4]byte, validatorPKs ...[]byte) (*SlashingProtectionInterchange, error) {
+	behavior, err := crypto.GetForkBehavior(fork)
+	if err != nil {
+		return nil, err
+	}
+	genesisValidatorsRoot := behavior.GenesisValidatorsRoot
+
+	ret := &SlashingProtectionInterchange{
+		Metadata: &InterchangeMetadata{
+			InterchangeFormatVersion: InterchangeFormatVersion,
+			GenesisValidatorsRoot:    "0x" + hex.EncodeToString(genesisValidatorsRoot[:]),
+		},
+		Data: make([]*InterchangeValidator, 0, len(validatorPKs)),
+	}
+	for _, pk := range validatorPKs {
+		if len(pk) != 48 {
+			return nil, fmt.Errorf("invalid validator pubkey length")
+		}
+		ret.Data = append(ret.Data, &InterchangeValidator{
+			PubKey:             "0x" + hex.EncodeToString(pk),
+			SignedBlocks:       []*InterchangeBlock{{Slot: "0"}},
+			SignedAttestations: []*InterchangeAttestation{{SourceEpoch: "0", TargetEpoch: "0"}},
+		})
+	}
+	return ret, nil
+}
+
+// CeremoniesSlashingProtection returns an interchange with a genesis entry for every validator created by ceremonies
+func CeremoniesSlashingProtection(fork [4]byte, ceremonies []CeremonyProofs) (*SlashingProtectionInterchange, error) {
+	validatorPKs := make([][]byte, 0, len(ceremonies))
+	for _, proofs := range ceremonies {
+		pk := proofs.ValidatorPubKey()
+		if pk == nil {
+			return nil, fmt.Errorf("ceremony without proofs")
+		}
+		validatorPKs = append(validatorPKs, pk)
+	}
+	return NewSlashingProtection(fork, validatorPKs...)
+}
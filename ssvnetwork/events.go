@@ -0,0 +1,153 @@
+package ssvnetwork
+
+import (
+	"bytes"
+	"context"
+	_ "embed"
+	"fmt"
+	"math/big"
+	"sort"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+const (
+	// SharesSignatureLen is the length of the owner/nonce BLS signature prefixing the shares data
+	SharesSignatureLen = 96
+	// SharePubKeyLen is the length of a BLS share public key
+	SharePubKeyLen = 48
+	// EncryptedShareLen is the length of a share encrypted with a 2048 bit RSA operator key
+	EncryptedShareLen = 256
+)
+
+//go:embed abi.abi
+var abiJSON []byte
+
+// ABI is the subset of the SSV network contract ABI used to track validator registrations
+var ABI = mustParseABI(abiJSON)
+
+func mustParseABI(byts []byte) abi.ABI {
+	ret, err := abi.JSON(bytes.NewReader(byts))
+	if err != nil {
+		panic(err)
+	}
+	return ret
+}
+
+// ValidatorEvent is a ValidatorAdded or ValidatorRemoved event emitted by the SSV network contract
+type ValidatorEvent struct {
+	Removed     bool
+	Owner       common.Address
+	OperatorIDs []uint64
+	PublicKey   []byte
+	// Shares is the registered shares data, empty for ValidatorRemoved
+	Shares      []byte
+	BlockNumber uint64
+	LogIndex    uint
+}
+
+// ParseValidatorEvent parses a ValidatorAdded or ValidatorRemoved log
+func ParseValidatorEvent(log types.Log) (*ValidatorEvent, error) {
+	if len(log.Topics) != 2 {
+		return nil, fmt.Errorf("unexpected topics count")
+	}
+	event, err := ABI.EventByID(log.Topics[0])
+	if err != nil {
+		return nil, err
+	}
+
+	values := make(map[string]interface{})
+	if err := event.Inputs.NonIndexed().UnpackIntoMap(values, log.Data); err != nil {
+		return nil, err
+	}
+
+	ret := &ValidatorEvent{
+		Owner:       common.BytesToAddress(log.Topics[1].Bytes()),
+		BlockNumber: log.BlockNumber,
+		LogIndex:    log.Index,
+	}
+	switch event.Name {
+	case "ValidatorAdded":
+		ret.Shares = values["shares"].([]byte)
+	case "ValidatorRemoved":
+		ret.Removed = true
+	default:
+		return nil, fmt.Errorf("unexpected event %s", event.Name)
+	}
+	ret.OperatorIDs = values["operatorIds"].([]uint64)
+	ret.PublicKey = values["publicKey"].([]byte)
+	return ret, nil
+}
+
+// FilterValidatorEvents fetches all validator events of owner emitted by contract from fromBlock onwards, ordered as emitted
+func FilterValidatorEvents(
+	ctx context.Context,
+	client ethereum.LogFilterer,
+	contract common.Address,
+	owner common.Address,
+	fromBlock *big.Int,
+) ([]*ValidatorEvent, error) {
+	logs, err := client.FilterLogs(ctx, ethereum.FilterQuery{
+		FromBlock: fromBlock,
+		Addresses: []common.Address{contract},
+		Topics: [][]common.Hash{
+			{ABI.Events["ValidatorAdded"].ID, ABI.Events["ValidatorRemoved"].ID},
+			{common.BytesToHash(owner.Bytes())},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	ret := make([]*ValidatorEvent, 0, len(logs))
+	for _, log := range logs {
+		if log.Removed {
+			continue // reorged out
+		}
+		event, err := ParseValidatorEvent(log)
+		if err != nil {
+			return nil, err
+		}
+		ret = append(ret, event)
+	}
+	sort.SliceStable(ret, func(i, j int) bool {
+		if ret[i].BlockNumber != ret[j].BlockNumber {
+			return ret[i].BlockNumber < ret[j].BlockNumber
+		}
+		return ret[i].LogIndex < ret[j].LogIndex
+	})
+	return ret, nil
+}
+
+// CurrentRegistration returns the ValidatorAdded event currently in effect for validatorPK, or nil if not registered
+func CurrentRegistration(events []*ValidatorEvent, validatorPK []byte) *ValidatorEvent {
+	var ret *ValidatorEvent
+	for _, event := range events {
+		if !bytes.Equal(event.PublicKey, validatorPK) {
+			continue
+		}
+		if event.Removed {
+			ret = nil
+		} else {
+			ret = event
+		}
+	}
+	return ret
+}
+
+// ParseShares splits registered shares data (signature | share pubkeys | encrypted shares) into its share pubkeys and encrypted shares
+func ParseShares(shares []byte, operatorCount int) (sharePubKeys [][]byte, encryptedShares [][]byte, err error) {
+	if len(shares) != SharesSignatureLen+operatorCount*(SharePubKeyLen+EncryptedShareLen) {
+		return nil, nil, fmt.Errorf("invalid shares length")
+	}
+	pksOffset := SharesSignatureLen
+	encOffset := pksOffset + operatorCount*SharePubKeyLen
+	for i := 0; i < operatorCount; i++ {
+		sharePubKeys = append(sharePubKeys, shares[pksOffset+i*SharePubKeyLen:pksOffset+(i+1)*SharePubKeyLen])
+		encryptedShares = append(encryptedShares, shares[encOffset+i*EncryptedShareLen:encOffset+(i+1)*EncryptedShareLen])
+	}
+	return sharePubKeys, encryptedShares, nil
+}
@@ -0,0 +1,74 @@
+package spec
+
+import (
+	ssz "github.com/ferranbt/fastssz"
+)
+
+// ToSSZList returns msgs as a list of ssz.HashRoot, for APIs taking messages of mixed types
+func ToSSZList[T ssz.HashRoot](msgs []T) []ssz.HashRoot {
+	ret := make([]ssz.HashRoot, len(msgs))
+	for i, msg := range msgs {
+		ret[i] = msg
+	}
+	return ret
+}
+
+// SizeSSZList returns the size of msgs encoded as an SSZ list of variable size messages
+func SizeSSZList[T ssz.Marshaler](msgs []T) (size int) {
+	for _, msg := range msgs {
+		size += 4 + msg.SizeSSZ()
+	}
+	return
+}
+
+// MarshalSSZList ssz marshals msgs as a list of variable size messages of at most limit elements
+func MarshalSSZList[T ssz.Marshaler](msgs []T, limit int) ([]byte, error) {
+	return MarshalSSZListTo(make([]byte, 0, SizeSSZList(msgs)), msgs, limit)
+}
+
+// MarshalSSZListTo ssz marshals msgs as a list of variable size messages of at most limit elements to a target array
+func MarshalSSZListTo[T ssz.Marshaler](buf []byte, msgs []T, limit int) (dst []byte, err error) {
+	dst = buf
+	if size := len(msgs); size > limit {
+		err = ssz.ErrListTooBigFn("list", size, limit)
+		return
+	}
+	offset := 4 * len(msgs)
+	for _, msg := range msgs {
+		dst = ssz.WriteOffset(dst, offset)
+		offset += msg.SizeSSZ()
+	}
+	for _, msg := range msgs {
+		if dst, err = msg.MarshalSSZTo(dst); err != nil {
+			return
+		}
+	}
+	return
+}
+
+// HashTreeRootList ssz hashes msgs as a list of at most limit elements
+func HashTreeRootList[T ssz.HashRoot](msgs []T, limit uint64) ([32]byte, error) {
+	hh := ssz.DefaultHasherPool.Get()
+	defer ssz.DefaultHasherPool.Put(hh)
+	if err := HashTreeRootListWith(hh, msgs, limit); err != nil {
+		return [32]byte{}, err
+	}
+	return hh.HashRoot()
+}
+
+// HashTreeRootListWith ssz hashes msgs as a list of at most limit elements with a hasher
+func HashTreeRootListWith[T ssz.HashRoot](hh ssz.HashWalker, msgs []T, limit uint64) (err error) {
+	indx := hh.Index()
+	num := uint64(len(msgs))
+	if num > limit {
+		err = ssz.ErrIncorrectListSize
+		return
+	}
+	for _, msg := range msgs {
+		if err = msg.HashTreeRootWith(hh); err != nil {
+			return
+		}
+	}
+	hh.MerkleizeWithMixin(indx, num, limit)
+	return
+}
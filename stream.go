@@ -0,0 +1,108 @@
+package spec
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+
+	ssz "github.com/ferranbt/fastssz"
+)
+
+// MaxStreamMessageSize bounds a single streamed message, well above the largest SSZ message of the spec
+const MaxStreamMessageSize = 1 << 20
+
+// StreamEncoder writes a stream of SSZ messages, each prefixed with its 4 bytes little endian length.
+// Only a single message is held in memory at a time, letting initiators send thousands of messages (e.g. SignedReshare).
+type StreamEncoder struct {
+	w   io.Writer
+	buf []byte
+}
+
+// NewStreamEncoder returns an encoder writing to w
+func NewStreamEncoder(w io.Writer) *StreamEncoder {
+	return &StreamEncoder{w: w}
+}
+
+// Encode writes msg to the stream
+func (e *StreamEncoder) Encode(msg ssz.Marshaler) error {
+	size := msg.SizeSSZ()
+	if size > MaxStreamMessageSize {
+		return fmt.Errorf("message too large")
+	}
+	byts, err := msg.MarshalSSZTo(binary.LittleEndian.AppendUint32(e.buf[:0], uint32(size)))
+	if err != nil {
+		return err
+	}
+	e.buf = byts
+	_, err = e.w.Write(byts)
+	return err
+}
+
+// StreamDecoder reads a stream of length prefixed SSZ messages written by StreamEncoder
+type StreamDecoder struct {
+	r   io.Reader
+	buf []byte
+}
+
+// NewStreamDecoder returns a decoder reading from r
+func NewStreamDecoder(r io.Reader) *StreamDecoder {
+	return &StreamDecoder{r: r}
+}
+
+// Decode reads the next message into msg, which must be empty.
+// It returns io.EOF once the stream ends cleanly and io.ErrUnexpectedEOF if it ends mid message.
+func (d *StreamDecoder) Decode(msg ssz.Unmarshaler) error {
+	var prefix [4]byte
+	if _, err := io.ReadFull(d.r, prefix[:]); err != nil {
+		return err
+	}
+	size := binary.LittleEndian.Uint32(prefix[:])
+	if size > MaxStreamMessageSize {
+		return fmt.Errorf("message too large")
+	}
+	if cap(d.buf) < int(size) {
+		d.buf = make([]byte, size)
+	}
+	d.buf = d.buf[:size]
+	if _, err := io.ReadFull(d.r, d.buf); err != nil {
+		if errors.Is(err, io.EOF) {
+			return io.ErrUnexpectedEOF
+		}
+		return err
+	}
+	return msg.UnmarshalSSZ(d.buf)
+}
+
+// EncodeSignedReshares writes messages to w as a stream
+func EncodeSignedReshares(w io.Writer, messages []*SignedReshare) error {
+	return EncodeStream(w, messages)
+}
+
+// EncodeStream writes messages to w as a stream
+func EncodeStream[T ssz.Marshaler](w io.Writer, messages []T) error {
+	enc := NewStreamEncoder(w)
+	for _, msg := range messages {
+		if err := enc.Encode(msg); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// DecodeSignedReshares calls f with every message of the stream read from r, in order, stopping at the first error
+func DecodeSignedReshares(r io.Reader, f func(msg *SignedReshare) error) error {
+	dec := NewStreamDecoder(r)
+	for {
+		msg := &SignedReshare{}
+		if err := dec.Decode(msg); err != nil {
+			if err == io.EOF {
+				return nil
+			}
+			return err
+		}
+		if err := f(msg); err != nil {
+			return err
+		}
+	}
+}
@@ -0,0 +1,98 @@
+//go:build !verifyonly
+
+package spec
+
+import (
+	"crypto/rsa"
+	"fmt"
+
+	"github.com/bloxapp/dkg-spec/crypto"
+)
+
+// NewCeremonySummary returns the summary of ceremony requestID's results, in canonical order (see CanonicalResults).
+// Duplicate results are kept as given, for ValidateCeremonySummary to reject.
+func NewCeremonySummary(requestID [24]byte, validatorPK []byte, owner [20]byte, nonce uint64, results []*Result) *CeremonySummary {
+	if canonical, err := CanonicalResults(results); err == nil {
+		results = canonical
+	}
+	return &CeremonySummary{
+		RequestID:       requestID,
+		ValidatorPubKey: validatorPK,
+		Owner:           owner,
+		Nonce:           nonce,
+		Results:         results,
+	}
+}
+
+// SignCeremonySummary returns summary signed by the initiator's key sk
+func SignCeremonySummary(summary *CeremonySummary, sk *rsa.PrivateKey) (*SignedCeremonySummary, error) {
+	hash, err := summary.SigningRoot()
+	if err != nil {
+		return nil, err
+	}
+	sig, err := crypto.SignRSA(sk, hash[:])
+	if err != nil {
+		return nil, err
+	}
+	return &SignedCeremonySummary{
+		Summary:   summary,
+		Signature: sig,
+	}, nil
+}
+
+// VerifyCeremonySummary returns nil if signed is signed by the initiator's initiatorPubKey (base64 encoded PEM RSA public key)
+func VerifyCeremonySummary(initiatorPubKey []byte, signed *SignedCeremonySummary) error {
+	if signed.Summary == nil {
+		return fmt.Errorf("missing summary")
+	}
+	hash, err := signed.Summary.SigningRoot()
+	if err != nil {
+		return err
+	}
+	pk, err := crypto.ParseRSAPublicKey(initiatorPubKey)
+	if err != nil {
+		return err
+	}
+	return crypto.VerifyRSA(pk, hash[:], signed.Signature)
+}
+
+// ValidateCeremonySummary returns nil if signed is signed by the initiator and its results are valid for the ceremony the owner
+// requested of operators, withdrawalCredentials, fork and deposit amount in gwei (DepositAmount but for resigns).
+// params are the parameters the results' proofs were issued under, see ValidateResults.
+func ValidateCeremonySummary(
+	initiatorPubKey []byte,
+	operators []*Operator,
+	withdrawalCredentials []byte,
+	fork [4]byte,
+	amount uint64,
+	params *CeremonyParams,
+	signed *SignedCeremonySummary,
+) error {
+	if err := VerifyCeremonySummary(initiatorPubKey, signed); err != nil {
+		return err
+	}
+	summary := signed.Summary
+	for _, result := range summary.Results {
+		if result == nil || result.SignedProof.Proof == nil {
+			return fmt.Errorf("empty result")
+		}
+	}
+	t, err := ThresholdForCluster(operators)
+	if err != nil {
+		return err
+	}
+	_, _, _, err = ValidateResults(
+		operators,
+		withdrawalCredentials,
+		summary.ValidatorPubKey,
+		fork,
+		amount,
+		summary.Owner,
+		summary.Nonce,
+		summary.RequestID,
+		int(t),
+		params,
+		summary.Results,
+	)
+	return err
+}
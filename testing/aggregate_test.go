@@ -0,0 +1,177 @@
+package testing
+
+import (
+	"errors"
+	"testing"
+
+	spec "github.com/bloxapp/dkg-spec"
+	"github.com/bloxapp/dkg-spec/testing/fixtures"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestAggregate(t *testing.T) {
+	t.Run("valid 4 operators", func(t *testing.T) {
+		pk, depositData, _, err := spec.Aggregate(
+			fixtures.GenerateOperators(4),
+			fixtures.TestWithdrawalCred,
+			fixtures.ShareSK(fixtures.TestValidator4Operators).GetPublicKey().Serialize(),
+			fixtures.TestFork,
+			spec.DepositAmount,
+			fixtures.TestOwnerAddress,
+			fixtures.TestNonce,
+			fixtures.TestRequestID,
+			fixtures.Results4Operators(),
+		)
+		require.NoError(t, err)
+		require.EqualValues(t, fixtures.ShareSK(fixtures.TestValidator4Operators).GetPublicKey().Serialize(), pk.Serialize())
+		require.EqualValues(t, pk.Serialize(), depositData.PublicKey[:])
+	})
+
+	t.Run("valid 13 operators", func(t *testing.T) {
+		_, _, _, err := spec.Aggregate(
+			fixtures.GenerateOperators(13),
+			fixtures.TestWithdrawalCred,
+			fixtures.ShareSK(fixtures.TestValidator13Operators).GetPublicKey().Serialize(),
+			fixtures.TestFork,
+			spec.DepositAmount,
+			fixtures.TestOwnerAddress,
+			fixtures.TestNonce,
+			fixtures.TestRequestID,
+			fixtures.Results13Operators(),
+		)
+		require.NoError(t, err)
+	})
+
+	t.Run("bad partial signature attributed", func(t *testing.T) {
+		res := fixtures.Results4Operators()
+		res[1].DepositPartialSignature = fixtures.DecodeHexNoError(fixtures.TestOperator1DepositSignature4Operators)
+
+		_, _, _, err := spec.Aggregate(
+			fixtures.GenerateOperators(4),
+			fixtures.TestWithdrawalCred,
+			fixtures.ShareSK(fixtures.TestValidator4Operators).GetPublicKey().Serialize(),
+			fixtures.TestFork,
+			spec.DepositAmount,
+			fixtures.TestOwnerAddress,
+			fixtures.TestNonce,
+			fixtures.TestRequestID,
+			res,
+		)
+		faultErr := &spec.FaultyOperatorsError{}
+		require.True(t, errors.As(err, &faultErr))
+		require.EqualValues(t, []uint64{2}, faultErr.OperatorIDs())
+		require.EqualError(t, err, "faulty operators: [2]")
+	})
+
+	t.Run("missing and duplicate results attributed", func(t *testing.T) {
+		res := fixtures.Results4Operators()
+		res[3] = res[2]
+
+		_, _, _, err := spec.Aggregate(
+			fixtures.GenerateOperators(4),
+			fixtures.TestWithdrawalCred,
+			fixtures.ShareSK(fixtures.TestValidator4Operators).GetPublicKey().Serialize(),
+			fixtures.TestFork,
+			spec.DepositAmount,
+			fixtures.TestOwnerAddress,
+			fixtures.TestNonce,
+			fixtures.TestRequestID,
+			res,
+		)
+		faultErr := &spec.FaultyOperatorsError{}
+		require.True(t, errors.As(err, &faultErr))
+		require.EqualValues(t, []uint64{3, 4}, faultErr.OperatorIDs())
+		require.EqualError(t, faultErr.Faults[1].Err, "missing result")
+	})
+
+	t.Run("invalid request ID attributed", func(t *testing.T) {
+		res := fixtures.Results7Operators()
+		res[0].RequestID = [24]byte{}
+		res[6].RequestID = [24]byte{}
+
+		_, _, _, err := spec.Aggregate(
+			fixtures.GenerateOperators(7),
+			fixtures.TestWithdrawalCred,
+			fixtures.ShareSK(fixtures.TestValidator7Operators).GetPublicKey().Serialize(),
+			fixtures.TestFork,
+			spec.DepositAmount,
+			fixtures.TestOwnerAddress,
+			fixtures.TestNonce,
+			fixtures.TestRequestID,
+			res,
+		)
+		faultErr := &spec.FaultyOperatorsError{}
+		require.True(t, errors.As(err, &faultErr))
+		require.EqualValues(t, []uint64{1, 7}, faultErr.OperatorIDs())
+	})
+}
+
+func TestAggregatePartial(t *testing.T) {
+	validatorPK := fixtures.ShareSK(fixtures.TestValidator4Operators).GetPublicKey().Serialize()
+	aggregate := func(threshold uint64, results []*spec.Result) (*spec.PartialAggregation, error) {
+		return spec.AggregatePartial(
+			fixtures.GenerateOperators(4),
+			threshold,
+			fixtures.TestWithdrawalCred,
+			validatorPK,
+			fixtures.TestFork,
+			spec.DepositAmount,
+			fixtures.TestOwnerAddress,
+			fixtures.TestNonce,
+			fixtures.TestRequestID,
+			results,
+		)
+	}
+
+	t.Run("all valid", func(t *testing.T) {
+		ret, err := aggregate(3, fixtures.Results4Operators())
+		require.NoError(t, err)
+		require.Empty(t, ret.Faults)
+		require.EqualValues(t, validatorPK, ret.ValidatorPK.Serialize())
+	})
+
+	t.Run("threshold of valid results", func(t *testing.T) {
+		all, _, _, err := spec.Aggregate(
+			fixtures.GenerateOperators(4),
+			fixtures.TestWithdrawalCred,
+			validatorPK,
+			fixtures.TestFork,
+			spec.DepositAmount,
+			fixtures.TestOwnerAddress,
+			fixtures.TestNonce,
+			fixtures.TestRequestID,
+			fixtures.Results4Operators(),
+		)
+		require.NoError(t, err)
+
+		res := fixtures.Results4Operators()
+		res[1].DepositPartialSignature = fixtures.DecodeHexNoError(fixtures.TestOperator1DepositSignature4Operators)
+		ret, err := aggregate(3, res)
+		require.NoError(t, err)
+		require.EqualValues(t, all.Serialize(), ret.ValidatorPK.Serialize())
+		require.Len(t, ret.Faults, 1)
+		require.EqualValues(t, 2, ret.Faults[0].OperatorID)
+
+		// a missing result
+		ret, err = aggregate(3, fixtures.Results4Operators()[1:])
+		require.NoError(t, err)
+		require.Len(t, ret.Faults, 1)
+		require.EqualValues(t, 1, ret.Faults[0].OperatorID)
+		require.EqualError(t, ret.Faults[0].Err, "missing result")
+	})
+
+	t.Run("below threshold", func(t *testing.T) {
+		res := fixtures.Results4Operators()[:3]
+		res[1].DepositPartialSignature = fixtures.DecodeHexNoError(fixtures.TestOperator1DepositSignature4Operators)
+		_, err := aggregate(3, res)
+		faultErr := &spec.FaultyOperatorsError{}
+		require.True(t, errors.As(err, &faultErr))
+		require.EqualValues(t, []uint64{2, 4}, faultErr.OperatorIDs())
+	})
+
+	t.Run("invalid threshold", func(t *testing.T) {
+		_, err := aggregate(2, fixtures.Results4Operators())
+		require.EqualError(t, err, "threshold set is invalid")
+	})
+}
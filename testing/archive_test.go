@@ -0,0 +1,113 @@
+package testing
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"io"
+	"testing"
+
+	spec "github.com/bloxapp/dkg-spec"
+	"github.com/bloxapp/dkg-spec/testing/fixtures"
+
+	"github.com/stretchr/testify/require"
+)
+
+// tamperArchive rewrites an archive replacing the content of entry name
+func tamperArchive(t *testing.T, byts []byte, name string, data []byte) []byte {
+	gzr, err := gzip.NewReader(bytes.NewReader(byts))
+	require.NoError(t, err)
+	tr := tar.NewReader(gzr)
+
+	ret := &bytes.Buffer{}
+	gzw := gzip.NewWriter(ret)
+	tw := tar.NewWriter(gzw)
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+		content, err := io.ReadAll(tr)
+		require.NoError(t, err)
+		if header.Name == name {
+			content = data
+			header.Size = int64(len(data))
+		}
+		require.NoError(t, tw.WriteHeader(header))
+		_, err = tw.Write(content)
+		require.NoError(t, err)
+	}
+	require.NoError(t, tw.Close())
+	require.NoError(t, gzw.Close())
+	return ret.Bytes()
+}
+
+func TestArchive(t *testing.T) {
+	artifacts := &spec.CeremonyArtifacts{
+		RequestID:  fixtures.TestRequestID,
+		Proofs:     proofs4Operators(),
+		Results:    fixtures.Results4Operators(),
+		Transcript: []byte("transcript"),
+	}
+
+	t.Run("round trip", func(t *testing.T) {
+		archive := spec.NewArchive()
+		require.NoError(t, archive.AddCeremony(artifacts))
+
+		buf := &bytes.Buffer{}
+		require.NoError(t, spec.WriteArchive(buf, archive))
+
+		read, err := spec.ReadArchive(buf)
+		require.NoError(t, err)
+		require.EqualValues(t, archive.Names(), read.Names())
+
+		ids, err := read.RequestIDs()
+		require.NoError(t, err)
+		require.EqualValues(t, [][24]byte{fixtures.TestRequestID}, ids)
+
+		ceremony, err := read.Ceremony(fixtures.TestRequestID)
+		require.NoError(t, err)
+		require.EqualValues(t, artifacts.RequestID, ceremony.RequestID)
+		require.EqualValues(t, artifacts.Proofs, ceremony.Proofs)
+		require.EqualValues(t, artifacts.Transcript, ceremony.Transcript)
+		// results are SSZ encoded, which decodes empty lists as empty rather than nil slices
+		require.Len(t, ceremony.Results, len(artifacts.Results))
+		for i := range artifacts.Results {
+			requireSameRoot(t, artifacts.Results[i], ceremony.Results[i])
+		}
+	})
+
+	t.Run("duplicate ceremony", func(t *testing.T) {
+		archive := spec.NewArchive()
+		require.NoError(t, archive.AddCeremony(artifacts))
+		require.ErrorContains(t, archive.AddCeremony(artifacts), "duplicate entry")
+	})
+
+	t.Run("unknown ceremony", func(t *testing.T) {
+		_, err := spec.NewArchive().Ceremony(fixtures.TestRequestID)
+		require.EqualError(t, err, "ceremony not found")
+	})
+
+	t.Run("tampered entry", func(t *testing.T) {
+		archive := spec.NewArchive()
+		require.NoError(t, archive.AddCeremony(artifacts))
+		buf := &bytes.Buffer{}
+		require.NoError(t, spec.WriteArchive(buf, archive))
+
+		tampered := tamperArchive(t, buf.Bytes(), archive.Names()[len(archive.Names())-1], []byte("tampered"))
+		_, err := spec.ReadArchive(bytes.NewReader(tampered))
+		require.ErrorContains(t, err, "failed integrity check")
+	})
+
+	t.Run("tampered manifest", func(t *testing.T) {
+		archive := spec.NewArchive()
+		require.NoError(t, archive.Add("a", []byte("a")))
+		buf := &bytes.Buffer{}
+		require.NoError(t, spec.WriteArchive(buf, archive))
+
+		tampered := tamperArchive(t, buf.Bytes(), "manifest.json", []byte(`{"version":1,"entries":[]}`))
+		_, err := spec.ReadArchive(bytes.NewReader(tampered))
+		require.EqualError(t, err, "invalid manifest hash")
+	})
+}
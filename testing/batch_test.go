@@ -0,0 +1,165 @@
+package testing
+
+import (
+	"testing"
+
+	spec "github.com/bloxapp/dkg-spec"
+	"github.com/bloxapp/dkg-spec/testing/fixtures"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateReshareBatch(t *testing.T) {
+	reshareOf := func(validator string) *spec.SignedReshare {
+		reshare := fixtures.TestReshare4Operators
+		reshare.ValidatorPubKey = fixtures.ShareSK(validator).GetPublicKey().Serialize()
+		return &spec.SignedReshare{Reshare: reshare, Signature: make([]byte, 65)}
+	}
+
+	t.Run("valid", func(t *testing.T) {
+		require.NoError(t, spec.ValidateReshareBatch(fixtures.TestOwnerAddress, []*spec.SignedReshare{
+			reshareOf(fixtures.TestValidator4Operators),
+			reshareOf(fixtures.TestValidator7Operators),
+		}))
+	})
+
+	t.Run("empty", func(t *testing.T) {
+		err := spec.ValidateReshareBatch(fixtures.TestOwnerAddress, nil)
+		require.EqualError(t, err, "empty batch")
+		require.EqualValues(t, spec.CodeBatchInconsistent, spec.ErrorCodeOf(err))
+	})
+
+	t.Run("other owner", func(t *testing.T) {
+		other := reshareOf(fixtures.TestValidator7Operators)
+		other.Reshare.Owner = [20]byte{1}
+		err := spec.ValidateReshareBatch(fixtures.TestOwnerAddress, []*spec.SignedReshare{reshareOf(fixtures.TestValidator4Operators), other})
+		require.ErrorContains(t, err, "message 1: owner 0100000000000000000000000000000000000000 differs from the batch owner")
+		require.EqualValues(t, spec.CodeBatchInconsistent, spec.ErrorCodeOf(err))
+	})
+
+	t.Run("duplicate validator", func(t *testing.T) {
+		err := spec.ValidateReshareBatch(fixtures.TestOwnerAddress, []*spec.SignedReshare{
+			reshareOf(fixtures.TestValidator4Operators),
+			reshareOf(fixtures.TestValidator7Operators),
+			reshareOf(fixtures.TestValidator4Operators),
+		})
+		require.ErrorContains(t, err, "message 2: validator")
+		require.ErrorContains(t, err, "already in message 0")
+	})
+
+	t.Run("different operators", func(t *testing.T) {
+		other := reshareOf(fixtures.TestValidator7Operators)
+		other.Reshare.NewOperators = fixtures.GenerateOperators(7)
+		err := spec.ValidateReshareBatch(fixtures.TestOwnerAddress, []*spec.SignedReshare{reshareOf(fixtures.TestValidator4Operators), other})
+		require.EqualError(t, err, "message 1: new operators differ from message 0")
+
+		other = reshareOf(fixtures.TestValidator7Operators)
+		other.Reshare.OldOperators = fixtures.GenerateOperators(7)[:4]
+		other.Reshare.OldOperators[3] = fixtures.GenerateOperators(7)[5]
+		err = spec.ValidateReshareBatch(fixtures.TestOwnerAddress, []*spec.SignedReshare{reshareOf(fixtures.TestValidator4Operators), other})
+		require.EqualError(t, err, "message 1: old operators differ from message 0")
+	})
+
+	t.Run("unordered operators", func(t *testing.T) {
+		unordered := reshareOf(fixtures.TestValidator4Operators)
+		ops := fixtures.GenerateOperators(4)
+		unordered.Reshare.OldOperators = []*spec.Operator{ops[1], ops[0], ops[2], ops[3]}
+		err := spec.ValidateReshareBatch(fixtures.TestOwnerAddress, []*spec.SignedReshare{unordered})
+		require.EqualError(t, err, "message 0: operators not unique or not ordered")
+		require.EqualValues(t, spec.CodeOperatorsNotOrdered, spec.ErrorCodeOf(err))
+	})
+}
+
+func TestValidateResignBatch(t *testing.T) {
+	resignOf := func(validator string) *spec.SignedResign {
+		return &spec.SignedResign{
+			Resign: spec.Resign{
+				ValidatorPubKey:       fixtures.ShareSK(validator).GetPublicKey().Serialize(),
+				Fork:                  fixtures.TestFork,
+				WithdrawalCredentials: fixtures.TestWithdrawalCred[:32],
+				Owner:                 fixtures.TestOwnerAddress,
+				Amount:                spec.DepositAmount,
+			},
+			Signature: make([]byte, 65),
+		}
+	}
+
+	t.Run("valid", func(t *testing.T) {
+		require.NoError(t, spec.ValidateResignBatch(fixtures.TestOwnerAddress, []*spec.SignedResign{
+			resignOf(fixtures.TestValidator4Operators),
+			resignOf(fixtures.TestValidator7Operators),
+		}))
+	})
+
+	t.Run("other owner", func(t *testing.T) {
+		err := spec.ValidateResignBatch([20]byte{1}, []*spec.SignedResign{resignOf(fixtures.TestValidator4Operators)})
+		require.ErrorContains(t, err, "message 0: owner")
+	})
+
+	t.Run("invalid validator", func(t *testing.T) {
+		invalid := resignOf(fixtures.TestValidator4Operators)
+		invalid.Resign.ValidatorPubKey = invalid.Resign.ValidatorPubKey[:47]
+		err := spec.ValidateResignBatch(fixtures.TestOwnerAddress, []*spec.SignedResign{invalid})
+		require.EqualError(t, err, "message 0: invalid validator public key length")
+		require.EqualValues(t, spec.CodeInvalidValidatorPubKey, spec.ErrorCodeOf(err))
+	})
+
+	t.Run("duplicate validator", func(t *testing.T) {
+		err := spec.ValidateResignBatch(fixtures.TestOwnerAddress, []*spec.SignedResign{
+			resignOf(fixtures.TestValidator4Operators),
+			resignOf(fixtures.TestValidator4Operators),
+		})
+		require.ErrorContains(t, err, "already in message 0")
+	})
+
+	t.Run("nil message", func(t *testing.T) {
+		err := spec.ValidateResignBatch(fixtures.TestOwnerAddress, []*spec.SignedResign{resignOf(fixtures.TestValidator4Operators), nil})
+		require.EqualError(t, err, "message 1: empty message")
+	})
+}
+
+func TestValidateInitBatchNonces(t *testing.T) {
+	initsOf := func(nonces ...uint64) []*spec.Init {
+		ret := make([]*spec.Init, len(nonces))
+		for i, nonce := range nonces {
+			ret[i] = &spec.Init{
+				Operators:             fixtures.GenerateOperators(4),
+				T:                     3,
+				WithdrawalCredentials: fixtures.TestWithdrawalCred[:32],
+				Fork:                  fixtures.TestFork,
+				Owner:                 fixtures.TestOwnerAddress,
+				Nonce:                 nonce,
+			}
+		}
+		return ret
+	}
+
+	t.Run("contiguous", func(t *testing.T) {
+		require.NoError(t, spec.ValidateInitBatchNonces(fixtures.TestOwnerAddress, 5, initsOf(5, 6, 7)))
+		require.NoError(t, spec.ValidateInitBatchNonces(fixtures.TestOwnerAddress, 5, initsOf(7, 5, 6)))
+	})
+
+	t.Run("already used", func(t *testing.T) {
+		err := spec.ValidateInitBatchNonces(fixtures.TestOwnerAddress, 5, initsOf(4, 5, 6))
+		require.EqualError(t, err, "message 0: nonce 4 already used, owner's next nonce is 5")
+		require.EqualValues(t, spec.CodeNonceMismatch, spec.ErrorCodeOf(err))
+	})
+
+	t.Run("gap", func(t *testing.T) {
+		err := spec.ValidateInitBatchNonces(fixtures.TestOwnerAddress, 5, initsOf(5, 6, 8))
+		require.EqualError(t, err, "message 2: nonce 8 leaves a gap, expected nonces 5 to 7")
+	})
+
+	t.Run("overlapping", func(t *testing.T) {
+		err := spec.ValidateInitBatchNonces(fixtures.TestOwnerAddress, 5, initsOf(5, 6, 6))
+		require.EqualError(t, err, "message 2: nonce 6 already in message 1")
+	})
+
+	t.Run("other owner", func(t *testing.T) {
+		inits := initsOf(5, 6)
+		inits[1].Owner = [20]byte{1}
+		err := spec.ValidateInitBatchNonces(fixtures.TestOwnerAddress, 5, inits)
+		require.ErrorContains(t, err, "message 1: owner")
+		require.EqualValues(t, spec.CodeBatchInconsistent, spec.ErrorCodeOf(err))
+	})
+}
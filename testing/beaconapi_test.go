@@ -0,0 +1,59 @@
+package testing
+
+import (
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	spec "github.com/bloxapp/dkg-spec"
+	"github.com/bloxapp/dkg-spec/beaconapi"
+	"github.com/bloxapp/dkg-spec/crypto"
+	"github.com/bloxapp/dkg-spec/testing/fixtures"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestBeaconAPIClient(t *testing.T) {
+	validatorPK := fixtures.TestOperator1Proof4Operators.Proof.ValidatorPubKey
+	withdrawalCredentials := crypto.ETH1WithdrawalCredentials(fixtures.TestOwnerAddress[:])
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/eth/v1/beacon/states/head/validators/0x" + hex.EncodeToString(validatorPK):
+			fmt.Fprintf(w, `{"execution_optimistic":false,"finalized":false,"data":{"index":"123","balance":"32000000000","status":"active_ongoing","validator":{"pubkey":"0x%x","withdrawal_credentials":"0x%x","effective_balance":"32000000000","slashed":false}}}`, validatorPK, withdrawalCredentials)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprint(w, `{"code":404,"message":"Validator not found"}`)
+		}
+	}))
+	defer server.Close()
+	client := beaconapi.NewClient(server.URL, nil)
+
+	t.Run("validator", func(t *testing.T) {
+		validator, err := client.Validator(validatorPK)
+		require.NoError(t, err)
+		require.EqualValues(t, &spec.ChainValidator{
+			Index:                 123,
+			Status:                spec.ValidatorActiveOngoing,
+			WithdrawalCredentials: withdrawalCredentials,
+		}, validator)
+
+		validator, err = spec.ValidateValidatorOnChain(client, validatorPK, withdrawalCredentials)
+		require.NoError(t, err)
+		require.EqualValues(t, 123, validator.Index)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		validator, err := client.Validator(make([]byte, 48))
+		require.NoError(t, err)
+		require.Nil(t, validator)
+	})
+
+	t.Run("deposit checker", func(t *testing.T) {
+		require.NoError(t, spec.ValidateNoConflictingDeposit(client, make([]byte, 48), withdrawalCredentials))
+		require.NoError(t, spec.ValidateNoConflictingDeposit(client, validatorPK, withdrawalCredentials))
+		require.EqualError(t, spec.ValidateNoConflictingDeposit(client, validatorPK, make([]byte, 32)), "validator already deposited with different withdrawal credentials")
+	})
+}
@@ -0,0 +1,129 @@
+package testing
+
+import (
+	"encoding/json"
+	"testing"
+
+	spec "github.com/bloxapp/dkg-spec"
+	"github.com/bloxapp/dkg-spec/testing/fixtures"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestBulkResults(t *testing.T) {
+	otherRequestID := [24]byte{0xaa}
+	// results of a second ceremony, for another validator
+	results7Operators := func() []*spec.Result {
+		ret := fixtures.Results7Operators()
+		for i, result := range ret {
+			copied := *result
+			copied.RequestID = otherRequestID
+			ret[i] = &copied
+		}
+		return ret
+	}
+	build := func(t *testing.T) *spec.BulkResults {
+		bulk := spec.NewBulkResults()
+		results4 := fixtures.Results4Operators()
+		results7 := results7Operators()
+		// operators respond out of order
+		for _, i := range []int{3, 1, 0, 2} {
+			require.NoError(t, bulk.Add(results4[i]))
+			require.NoError(t, bulk.Add(results7[i]))
+		}
+		for _, result := range results7[4:] {
+			require.NoError(t, bulk.Add(result))
+		}
+		return bulk
+	}
+
+	t.Run("lookup", func(t *testing.T) {
+		bulk := build(t)
+		require.EqualValues(t, 2, bulk.Len())
+
+		vr := bulk.ByRequestID(fixtures.TestRequestID)
+		require.NotNil(t, vr)
+		require.EqualValues(t, fixtures.Results4Operators(), vr.Results)
+		require.Same(t, vr, bulk.ByValidator(fixtures.ShareSK(fixtures.TestValidator4Operators).GetPublicKey().Serialize()))
+
+		vr = bulk.ByValidator(fixtures.ShareSK(fixtures.TestValidator7Operators).GetPublicKey().Serialize())
+		require.NotNil(t, vr)
+		require.EqualValues(t, otherRequestID, vr.RequestID)
+		require.EqualValues(t, results7Operators(), vr.Results)
+
+		require.Nil(t, bulk.ByRequestID([24]byte{0xbb}))
+		require.Nil(t, bulk.ByValidator([]byte{1, 2, 3}))
+	})
+
+	t.Run("batch order", func(t *testing.T) {
+		bulk := build(t)
+		expected := [][]*spec.Result{fixtures.Results4Operators(), results7Operators()}
+		require.EqualValues(t, expected, bulk.Results())
+
+		root, err := spec.BulkResultsRoot(bulk.Results())
+		require.NoError(t, err)
+		expectedRoot, err := spec.BulkResultsRoot(expected)
+		require.NoError(t, err)
+		require.EqualValues(t, expectedRoot, root)
+	})
+
+	t.Run("completeness", func(t *testing.T) {
+		bulk := spec.NewBulkResults()
+		for _, result := range fixtures.Results4Operators()[:3] {
+			require.NoError(t, bulk.Add(result))
+		}
+		require.EqualValues(t, []uint64{4}, bulk.ByRequestID(fixtures.TestRequestID).Missing(fixtures.GenerateOperators(4)))
+		err := bulk.Complete(fixtures.GenerateOperators(4))
+		require.ErrorContains(t, err, "missing results of operators [4]")
+		require.EqualValues(t, spec.CodeResultsCountMismatch, spec.ErrorCodeOf(err))
+
+		require.NoError(t, bulk.Add(fixtures.Results4Operators()[3]))
+		require.NoError(t, bulk.Complete(fixtures.GenerateOperators(4)))
+	})
+
+	t.Run("duplicate result", func(t *testing.T) {
+		bulk := spec.NewBulkResults()
+		require.NoError(t, bulk.Add(fixtures.Results4Operators()[0]))
+		require.ErrorContains(t, bulk.Add(fixtures.Results4Operators()[0]), "duplicate result of operator 1 for request")
+	})
+
+	t.Run("conflicting validator", func(t *testing.T) {
+		bulk := spec.NewBulkResults()
+		require.NoError(t, bulk.Add(fixtures.Results4Operators()[0]))
+
+		// same request, another validator
+		err := bulk.Add(fixtures.Results7Operators()[1])
+		require.ErrorContains(t, err, "results are for validator")
+		require.EqualValues(t, spec.CodeProofValidatorMismatch, spec.ErrorCodeOf(err))
+
+		// same validator, another request
+		result := *fixtures.Results4Operators()[1]
+		result.RequestID = otherRequestID
+		err = bulk.Add(&result)
+		require.ErrorContains(t, err, "already has results of another request")
+		require.EqualValues(t, spec.CodeRequestIDMismatch, spec.ErrorCodeOf(err))
+	})
+
+	t.Run("empty result", func(t *testing.T) {
+		require.EqualError(t, spec.NewBulkResults().Add(&spec.Result{}), "empty result")
+	})
+
+	t.Run("json", func(t *testing.T) {
+		bulk := build(t)
+		byts, err := json.Marshal(bulk)
+		require.NoError(t, err)
+
+		decoded := &spec.BulkResults{}
+		require.NoError(t, json.Unmarshal(byts, decoded))
+		require.EqualValues(t, bulk.Results(), decoded.Results())
+		require.NotNil(t, decoded.ByRequestID(otherRequestID))
+
+		// the same operator twice
+		var raw [][]*spec.Result
+		require.NoError(t, json.Unmarshal(byts, &raw))
+		raw[1] = append(raw[1], raw[1][0])
+		byts, err = json.Marshal(raw)
+		require.NoError(t, err)
+		require.ErrorContains(t, json.Unmarshal(byts, decoded), "ceremony 1: duplicate result of operator 1")
+	})
+}
@@ -0,0 +1,72 @@
+package testing
+
+import (
+	"encoding/json"
+	"testing"
+
+	spec "github.com/bloxapp/dkg-spec"
+	"github.com/bloxapp/dkg-spec/testing/fixtures"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCapabilities(t *testing.T) {
+	electra := [4]byte{0x05, 0x00, 0x00, 0x00}
+
+	t.Run("current", func(t *testing.T) {
+		c := spec.CurrentCapabilities(100)
+		require.EqualValues(t, spec.SpecVersion, c.SpecVersion)
+		require.Contains(t, c.Forks, "0x00000000")
+		require.Contains(t, c.Forks, "0x05000000")
+		require.NoError(t, c.Supports(spec.ReshareMessageType, fixtures.TestFork, 100))
+		require.EqualError(t, c.Supports(spec.ReshareMessageType, fixtures.TestFork, 101), "bulk size 101 above max 100")
+		require.EqualError(t, c.Supports(spec.InitMessageType, [4]byte{0xff}, 1), "fork ff000000 not supported")
+	})
+
+	t.Run("json round trip", func(t *testing.T) {
+		byts, err := json.Marshal(spec.CurrentCapabilities(0))
+		require.NoError(t, err)
+		decoded := &spec.Capabilities{}
+		require.NoError(t, json.Unmarshal(byts, decoded))
+		require.EqualValues(t, spec.CurrentCapabilities(0), decoded)
+	})
+
+	t.Run("committee intersection", func(t *testing.T) {
+		full := spec.CurrentCapabilities(0)
+		limited := spec.CurrentCapabilities(50)
+		limited.MessageTypes = []spec.MessageType{spec.ResignMessageType, spec.InitMessageType}
+		limited.ShareEncryptions = []spec.ShareEncryption{spec.ShareEncryptionRSAPKCS1v15}
+		limited.Forks = []string{"0x05000000"}
+		small := spec.CurrentCapabilities(20)
+
+		committee, err := spec.IntersectCapabilities(full, limited, small)
+		require.NoError(t, err)
+		require.EqualValues(t, []spec.MessageType{spec.InitMessageType, spec.ResignMessageType}, committee.MessageTypes)
+		require.EqualValues(t, []spec.ShareEncryption{spec.ShareEncryptionRSAPKCS1v15}, committee.ShareEncryptions)
+		require.EqualValues(t, []string{"0x05000000"}, committee.Forks)
+		require.EqualValues(t, 20, committee.MaxBulkSize)
+
+		require.NoError(t, committee.Supports(spec.InitMessageType, electra, 20))
+		require.EqualError(t, committee.Supports(spec.ReshareMessageType, electra, 1), "reshare ceremonies not supported")
+		require.EqualError(t, committee.Supports(spec.InitMessageType, fixtures.TestFork, 1), "fork 00000000 not supported")
+		// inputs are left as is
+		require.Len(t, full.MessageTypes, 3)
+	})
+
+	t.Run("unbounded bulk size", func(t *testing.T) {
+		committee, err := spec.IntersectCapabilities(spec.CurrentCapabilities(0), spec.CurrentCapabilities(0))
+		require.NoError(t, err)
+		require.EqualValues(t, 0, committee.MaxBulkSize)
+		require.NoError(t, committee.Supports(spec.InitMessageType, fixtures.TestFork, 10000))
+	})
+
+	t.Run("spec version mismatch", func(t *testing.T) {
+		other := spec.CurrentCapabilities(0)
+		other.SpecVersion = "v0.9.0"
+		_, err := spec.IntersectCapabilities(spec.CurrentCapabilities(0), other)
+		require.EqualError(t, err, "operator 1 runs spec v0.9.0, operator 0 runs "+spec.SpecVersion)
+
+		_, err = spec.IntersectCapabilities()
+		require.EqualError(t, err, "no capabilities")
+	})
+}
@@ -0,0 +1,74 @@
+package testing
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+
+	spec "github.com/bloxapp/dkg-spec"
+	"github.com/bloxapp/dkg-spec/testing/fixtures"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCID(t *testing.T) {
+	t.Run("empty", func(t *testing.T) {
+		require.EqualValues(t, "bafkreihdwdcefgh4dqkjv67uzcmw7ojee6xedzdetojuzjevtenxquvyku", spec.CID([]byte{}))
+	})
+
+	t.Run("hello world", func(t *testing.T) {
+		require.EqualValues(t, "bafkreifzjut3te2nhyekklss27nh3k72ysco7y32koao5eei66wof36n5e", spec.CID([]byte("hello world")))
+	})
+
+	t.Run("ssz deterministic", func(t *testing.T) {
+		cid1, err := spec.SSZCID(fixtures.Results4Operators()[0])
+		require.NoError(t, err)
+		cid2, err := spec.SSZCID(fixtures.Results4Operators()[0])
+		require.NoError(t, err)
+		require.EqualValues(t, cid1, cid2)
+
+		cid3, err := spec.SSZCID(fixtures.Results4Operators()[1])
+		require.NoError(t, err)
+		require.NotEqualValues(t, cid1, cid3)
+	})
+
+	t.Run("ssz matches encoding", func(t *testing.T) {
+		for _, result := range fixtures.Results13Operators() {
+			byts, err := result.MarshalSSZ()
+			require.NoError(t, err)
+			cid, err := spec.SSZCID(result)
+			require.NoError(t, err)
+			require.EqualValues(t, spec.CID(byts), cid)
+		}
+	})
+
+	t.Run("ssz concurrent", func(t *testing.T) {
+		expected, err := spec.SSZCID(fixtures.Results4Operators()[0])
+		require.NoError(t, err)
+
+		var wg sync.WaitGroup
+		errs := make(chan error, 32)
+		for i := 0; i < 32; i++ {
+			wg.Add(1)
+			go func(result *spec.Result) {
+				defer wg.Done()
+				cid, err := spec.SSZCID(result)
+				if err == nil && cid != expected {
+					err = fmt.Errorf("unexpected cid %s", cid)
+				}
+				errs <- err
+			}(fixtures.Results4Operators()[0])
+		}
+		wg.Wait()
+		close(errs)
+		for err := range errs {
+			require.NoError(t, err)
+		}
+	})
+
+	t.Run("proofs", func(t *testing.T) {
+		cid, err := spec.ProofsCID([]spec.CeremonyProofs{proofs4Operators()})
+		require.NoError(t, err)
+		require.Len(t, cid, 59)
+	})
+}
@@ -0,0 +1,154 @@
+package testing
+
+import (
+	"math/big"
+	"testing"
+
+	spec "github.com/bloxapp/dkg-spec"
+	"github.com/bloxapp/dkg-spec/ssvnetwork"
+	"github.com/bloxapp/dkg-spec/testing/fixtures"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+)
+
+type clusterTuple struct {
+	ValidatorCount  uint32
+	NetworkFeeIndex uint64
+	Index           uint64
+	Active          bool
+	Balance         *big.Int
+}
+
+func registration4Operators() *ssvnetwork.ValidatorEvent {
+	proofs := proofs4Operators()
+	shares := make([]byte, ssvnetwork.SharesSignatureLen)
+	for _, proof := range proofs {
+		shares = append(shares, proof.Proof.SharePubKey...)
+	}
+	for _, proof := range proofs {
+		shares = append(shares, proof.Proof.EncryptedShare...)
+	}
+	return &ssvnetwork.ValidatorEvent{
+		Owner:       fixtures.TestOwnerAddress,
+		OperatorIDs: []uint64{1, 2, 3, 4},
+		PublicKey:   proofs.ValidatorPubKey(),
+		Shares:      shares,
+	}
+}
+
+func TestVerifyProofsAgainstCluster(t *testing.T) {
+	t.Run("valid", func(t *testing.T) {
+		require.Empty(t, spec.VerifyProofsAgainstCluster(fixtures.GenerateOperators(4), proofs4Operators(), registration4Operators()))
+	})
+
+	t.Run("not registered", func(t *testing.T) {
+		mismatches := spec.VerifyProofsAgainstCluster(fixtures.GenerateOperators(4), proofs4Operators(), nil)
+		require.Len(t, mismatches, 1)
+		require.EqualValues(t, "registration", mismatches[0].Field)
+	})
+
+	t.Run("different owner", func(t *testing.T) {
+		registration := registration4Operators()
+		registration.Owner = common.Address{}
+		mismatches := spec.VerifyProofsAgainstCluster(fixtures.GenerateOperators(4), proofs4Operators(), registration)
+		require.NotEmpty(t, mismatches)
+		for _, mismatch := range mismatches {
+			require.EqualValues(t, "owner", mismatch.Field)
+		}
+	})
+
+	t.Run("different operators", func(t *testing.T) {
+		registration := registration4Operators()
+		registration.OperatorIDs = []uint64{1, 2, 3, 5}
+		mismatches := spec.VerifyProofsAgainstCluster(fixtures.GenerateOperators(4), proofs4Operators(), registration)
+		require.Len(t, mismatches, 1)
+		require.EqualValues(t, "operators: registered [1 2 3 5], proofs [1 2 3 4]", mismatches[0].String())
+	})
+
+	t.Run("unparseable shares", func(t *testing.T) {
+		registration := registration4Operators()
+		registration.Shares = registration.Shares[:len(registration.Shares)-1]
+		mismatches := spec.VerifyProofsAgainstCluster(fixtures.GenerateOperators(4), proofs4Operators(), registration)
+		require.Len(t, mismatches, 1)
+		require.EqualValues(t, "shares", mismatches[0].Field)
+	})
+
+	t.Run("different validator and shares", func(t *testing.T) {
+		proofs := proofs4Operators()
+		proofs[2] = &fixtures.TestOperator3Proof7Operators
+		mismatches := spec.VerifyProofsAgainstCluster(fixtures.GenerateOperators(4), proofs, registration4Operators())
+		fields := make([]string, 0)
+		for _, mismatch := range mismatches {
+			fields = append(fields, mismatch.Field)
+		}
+		require.EqualValues(t, []string{"validator", "share_pub", "encrypted_share"}, fields)
+	})
+
+	t.Run("fresh proofs", func(t *testing.T) {
+		shares := []string{
+			fixtures.TestValidator4OperatorsShare1,
+			fixtures.TestValidator4OperatorsShare2,
+			fixtures.TestValidator4OperatorsShare3,
+			fixtures.TestValidator4OperatorsShare4,
+		}
+		sks := []string{fixtures.TestOperator1SK, fixtures.TestOperator2SK, fixtures.TestOperator3SK, fixtures.TestOperator4SK}
+		proofs := make(spec.CeremonyProofs, len(shares))
+		for i := range shares {
+			result, err := spec.BuildResult(
+				uint64(i+1),
+				fixtures.TestRequestID,
+				fixtures.TestParamsHash(4),
+				nil,
+				fixtures.ShareSK(shares[i]),
+				fixtures.OperatorSK(sks[i]),
+				fixtures.TestOperator1Proof4Operators.Proof.ValidatorPubKey,
+				fixtures.TestOwnerAddress,
+				fixtures.TestWithdrawalCred,
+				fixtures.TestFork,
+				0,
+			)
+			require.NoError(t, err)
+			proofs[i] = &result.SignedProof
+		}
+
+		registration := registration4Operators()
+		registration.Shares = make([]byte, ssvnetwork.SharesSignatureLen)
+		for _, proof := range proofs {
+			registration.Shares = append(registration.Shares, proof.Proof.SharePubKey...)
+		}
+		for _, proof := range proofs {
+			registration.Shares = append(registration.Shares, proof.Proof.EncryptedShare...)
+		}
+		require.Empty(t, spec.VerifyProofsAgainstCluster(fixtures.GenerateOperators(4), proofs, registration))
+	})
+}
+
+func TestParseValidatorEvent(t *testing.T) {
+	registration := registration4Operators()
+	event := ssvnetwork.ABI.Events["ValidatorAdded"]
+	data, err := event.Inputs.NonIndexed().Pack(
+		registration.OperatorIDs,
+		registration.PublicKey,
+		registration.Shares,
+		clusterTuple{Balance: big.NewInt(0)},
+	)
+	require.NoError(t, err)
+
+	parsed, err := ssvnetwork.ParseValidatorEvent(types.Log{
+		Topics:      []common.Hash{event.ID, common.BytesToHash(registration.Owner.Bytes())},
+		Data:        data,
+		BlockNumber: 10,
+	})
+	require.NoError(t, err)
+	require.False(t, parsed.Removed)
+	require.EqualValues(t, registration.Owner, parsed.Owner)
+	require.EqualValues(t, registration.OperatorIDs, parsed.OperatorIDs)
+	require.EqualValues(t, registration.PublicKey, parsed.PublicKey)
+	require.EqualValues(t, registration.Shares, parsed.Shares)
+
+	removed := &ssvnetwork.ValidatorEvent{Removed: true, PublicKey: registration.PublicKey}
+	require.Nil(t, ssvnetwork.CurrentRegistration([]*ssvnetwork.ValidatorEvent{parsed, removed}, registration.PublicKey))
+	require.Equal(t, parsed, ssvnetwork.CurrentRegistration([]*ssvnetwork.ValidatorEvent{removed, parsed}, registration.PublicKey))
+}
@@ -0,0 +1,54 @@
+package testing
+
+import (
+	"testing"
+
+	spec "github.com/bloxapp/dkg-spec"
+	"github.com/bloxapp/dkg-spec/crypto"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCompatibility(t *testing.T) {
+	t.Run("current version", func(t *testing.T) {
+		c, err := spec.CompatibilityOf(spec.SpecVersion)
+		require.NoError(t, err)
+		require.EqualValues(t, []uint8{1, 2, 3, 4, spec.ProofVersion}, c.ProofVersions)
+		require.EqualValues(t, []uint8{1, spec.InitVersion}, c.InitVersions)
+		require.EqualValues(t, []int{4, 7, 10, 13}, c.ClusterSizes)
+		require.Len(t, c.Forks, len(crypto.Forks()))
+		require.EqualValues(t, [4]byte{0x00, 0x00, 0x00, 0x00}, c.Forks[0].Fork)
+		require.Equal(t, "mainnet phase0", c.Forks[0].Name)
+		for _, size := range c.ClusterSizes {
+			threshold, err := spec.ThresholdForCluster(make([]*spec.Operator, size))
+			require.NoError(t, err)
+			require.NotZero(t, threshold)
+		}
+	})
+
+	t.Run("supports", func(t *testing.T) {
+		c, err := spec.CompatibilityOf(spec.SpecVersion)
+		require.NoError(t, err)
+		require.NoError(t, c.Supports([4]byte{0x05, 0x00, 0x00, 0x00}, 7))
+		require.EqualError(t, c.Supports([4]byte{0xff, 0xff, 0xff, 0xff}, 4), "fork ffffffff not supported by spec "+spec.SpecVersion)
+		require.EqualError(t, c.Supports([4]byte{0x00, 0x00, 0x00, 0x00}, 5), "cluster size 5 not supported by spec "+spec.SpecVersion)
+	})
+
+	t.Run("registered fork", func(t *testing.T) {
+		defer crypto.ResetForks()
+		fork := [4]byte{0x10, 0x00, 0x00, 0x00}
+		require.NoError(t, crypto.RegisterFork(fork, crypto.ForkBehavior{
+			Name:                "shadow",
+			MaxEffectiveBalance: crypto.MaxEffectiveBalanceInGwei,
+		}))
+		c, err := spec.CompatibilityOf(spec.SpecVersion)
+		require.NoError(t, err)
+		require.NoError(t, c.Supports(fork, 4))
+	})
+
+	t.Run("unknown version", func(t *testing.T) {
+		_, err := spec.CompatibilityOf("v0.0.1")
+		require.EqualError(t, err, "unknown spec version v0.0.1")
+		require.Len(t, spec.CompatibilityMatrix(), 1)
+	})
+}
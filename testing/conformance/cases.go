@@ -0,0 +1,274 @@
+package conformance
+
+import (
+	"context"
+	"fmt"
+
+	spec "github.com/bloxapp/dkg-spec"
+	"github.com/bloxapp/dkg-spec/api"
+	"github.com/bloxapp/dkg-spec/testing/fixtures"
+)
+
+// Cases returns the cases applicable to target
+func Cases(target *Target) []*Case {
+	ret := initCases(target)
+	if target.Validator != nil {
+		ret = append(ret, reshareCases(target)...)
+		ret = append(ret, resignCases(target)...)
+	}
+	return ret
+}
+
+// cluster returns a valid cluster of n operators including the target, peers are fixture operators
+func cluster(target *Target, n int) []*spec.Operator {
+	ret := []*spec.Operator{target.Operator}
+	for _, op := range fixtures.GenerateOperators(13) {
+		if len(ret) == n {
+			break
+		}
+		if op.ID != target.Operator.ID {
+			ret = append(ret, op)
+		}
+	}
+	return spec.OrderOperators(ret)
+}
+
+func initCase(name string, mutate func(init *spec.Init)) *Case {
+	return &Case{
+		Name: name,
+		run: func(ctx context.Context, target *Target) (*spec.Result, [24]byte, error) {
+			init := &spec.Init{
+				Operators:             cluster(target, 4),
+				T:                     3,
+				WithdrawalCredentials: target.Owner[:],
+				Owner:                 target.Owner,
+			}
+			mutate(init)
+			requestID := spec.NewID()
+			signed, err := signInit(target, init, requestID)
+			if err != nil {
+				return nil, requestID, err
+			}
+			result, err := target.Transport.Init(ctx, &api.InitRequest{RequestID: requestID, SignedInit: signed})
+			return result, requestID, err
+		},
+	}
+}
+
+// signInit signs init for requestID with the target's initiator key
+func signInit(target *Target, init *spec.Init, requestID [24]byte) (*spec.SignedInit, error) {
+	sk := target.Initiator
+	if sk == nil {
+		sk = fixtures.OperatorSK(fixtures.TestOperator1SK)
+	}
+	return spec.SignInit(init, requestID, sk)
+}
+
+func initCases(target *Target) []*Case {
+	return []*Case{
+		initCase("init unordered operators", func(init *spec.Init) {
+			init.Operators[0], init.Operators[1] = init.Operators[1], init.Operators[0]
+		}),
+		initCase("init duplicate operators", func(init *spec.Init) {
+			init.Operators[1] = init.Operators[0]
+		}),
+		initCase("init invalid threshold", func(init *spec.Init) {
+			init.T = 2
+		}),
+		initCase("init invalid cluster size", func(init *spec.Init) {
+			init.Operators = cluster(target, 5)
+		}),
+		initCase("init unknown fork", func(init *spec.Init) {
+			init.Fork = [4]byte{0xff, 0xff, 0xff, 0xff}
+		}),
+		{
+			Name: "init signed for another request",
+			run: func(ctx context.Context, target *Target) (*spec.Result, [24]byte, error) {
+				requestID := spec.NewID()
+				signed, err := signInit(target, &spec.Init{
+					Operators:             cluster(target, 4),
+					T:                     3,
+					WithdrawalCredentials: target.Owner[:],
+					Owner:                 target.Owner,
+				}, spec.NewID())
+				if err != nil {
+					return nil, requestID, err
+				}
+				result, err := target.Transport.Init(ctx, &api.InitRequest{RequestID: requestID, SignedInit: signed})
+				return result, requestID, err
+			},
+		},
+	}
+}
+
+// proofIndex returns the index of the target in the validator's cluster
+func proofIndex(target *Target) int {
+	for i, op := range target.Validator.Operators {
+		if op.ID == target.Operator.ID {
+			return i
+		}
+	}
+	return -1
+}
+
+func reshareCase(name string, mutate func(reshare *spec.Reshare, proof *spec.SignedProof) *spec.SignedProof) *Case {
+	return &Case{
+		Name: name,
+		run: func(ctx context.Context, target *Target) (*spec.Result, [24]byte, error) {
+			validator := target.Validator
+			newOperators := cluster(target, 7)
+			if spec.EqualOperators(newOperators, validator.Operators) {
+				newOperators = cluster(target, 4)
+			}
+			oldT, _ := spec.ThresholdForCluster(validator.Operators)
+			newT, _ := spec.ThresholdForCluster(newOperators)
+			reshare := &spec.Reshare{
+				ValidatorPubKey:       validator.PubKey,
+				OldOperators:          validator.Operators,
+				NewOperators:          newOperators,
+				OldT:                  oldT,
+				NewT:                  newT,
+				Fork:                  validator.Fork,
+				WithdrawalCredentials: validator.WithdrawalCredentials,
+				Owner:                 target.Owner,
+			}
+			i := proofIndex(target)
+			if i < 0 {
+				return nil, [24]byte{}, fmt.Errorf("operator not in validator cluster")
+			}
+			proof := mutate(reshare, validator.Proofs[i])
+			signedReshare := &spec.SignedReshare{Reshare: *reshare}
+			sig, err := target.sign(signedReshare)
+			if err != nil {
+				return nil, [24]byte{}, err
+			}
+			signedReshare.Signature = sig
+			requestID := spec.NewID()
+			result, err := target.Transport.Reshare(ctx, &api.ReshareRequest{
+				RequestID:     requestID,
+				SignedReshare: signedReshare,
+				Proof:         proof,
+				Params:        target.Validator.Params,
+			})
+			return result, requestID, err
+		},
+	}
+}
+
+func reshareCases(target *Target) []*Case {
+	return []*Case{
+		reshareCase("reshare same operators", func(reshare *spec.Reshare, proof *spec.SignedProof) *spec.SignedProof {
+			reshare.NewOperators = reshare.OldOperators
+			reshare.NewT = reshare.OldT
+			return proof
+		}),
+		reshareCase("reshare invalid new threshold", func(reshare *spec.Reshare, proof *spec.SignedProof) *spec.SignedProof {
+			reshare.NewT--
+			return proof
+		}),
+		reshareCase("reshare unordered old operators", func(reshare *spec.Reshare, proof *spec.SignedProof) *spec.SignedProof {
+			old := append([]*spec.Operator{}, reshare.OldOperators...)
+			old[0], old[1] = old[1], old[0]
+			reshare.OldOperators = old
+			return proof
+		}),
+		reshareCase("reshare owner mismatch", func(reshare *spec.Reshare, proof *spec.SignedProof) *spec.SignedProof {
+			reshare.Owner[0] ^= 0xff
+			return proof
+		}),
+		reshareCase("reshare tampered proof", func(reshare *spec.Reshare, proof *spec.SignedProof) *spec.SignedProof {
+			return tamperedProof(proof)
+		}),
+	}
+}
+
+func resignCase(name string, valid bool, mutate func(target *Target, resign *spec.Resign, proof *spec.SignedProof) *spec.SignedProof) *Case {
+	resignF := func(target *Target) *spec.Resign {
+		return &spec.Resign{
+			ValidatorPubKey:       target.Validator.PubKey,
+			Fork:                  target.Validator.Fork,
+			WithdrawalCredentials: target.Validator.WithdrawalCredentials,
+			Owner:                 target.Owner,
+			Nonce:                 1,
+			Amount:                spec.DepositAmount,
+		}
+	}
+	ret := &Case{
+		Name:  name,
+		Valid: valid,
+		run: func(ctx context.Context, target *Target) (*spec.Result, [24]byte, error) {
+			i := proofIndex(target)
+			if i < 0 {
+				return nil, [24]byte{}, fmt.Errorf("operator not in validator cluster")
+			}
+			resign := resignF(target)
+			proof := mutate(target, resign, target.Validator.Proofs[i])
+			signedResign := &spec.SignedResign{Resign: *resign}
+			sig, err := target.sign(signedResign)
+			if err != nil {
+				return nil, [24]byte{}, err
+			}
+			signedResign.Signature = sig
+			requestID := spec.NewID()
+			result, err := target.Transport.Resign(ctx, &api.ResignRequest{
+				RequestID:    requestID,
+				SignedResign: signedResign,
+				Proof:        proof,
+				Params:       target.Validator.Params,
+			})
+			return result, requestID, err
+		},
+	}
+	if valid {
+		ret.check = func(target *Target, requestID [24]byte, result *spec.Result) error {
+			resign := resignF(target)
+			if result.OperatorID != target.Operator.ID {
+				return fmt.Errorf("unexpected operator ID %d", result.OperatorID)
+			}
+			return spec.ValidateResult(
+				target.Validator.Operators,
+				resign.Owner,
+				requestID,
+				resign.WithdrawalCredentials,
+				resign.ValidatorPubKey,
+				resign.Fork,
+				resign.Amount,
+				resign.Nonce,
+				result,
+			)
+		}
+	}
+	return ret
+}
+
+func resignCases(target *Target) []*Case {
+	return []*Case{
+		resignCase("resign valid", true, func(target *Target, resign *spec.Resign, proof *spec.SignedProof) *spec.SignedProof {
+			return proof
+		}),
+		resignCase("resign owner mismatch", false, func(target *Target, resign *spec.Resign, proof *spec.SignedProof) *spec.SignedProof {
+			resign.Owner[0] ^= 0xff
+			return proof
+		}),
+		resignCase("resign validator mismatch", false, func(target *Target, resign *spec.Resign, proof *spec.SignedProof) *spec.SignedProof {
+			resign.ValidatorPubKey = append([]byte{}, resign.ValidatorPubKey...)
+			resign.ValidatorPubKey[47] ^= 0xff
+			return proof
+		}),
+		resignCase("resign another operator's proof", false, func(target *Target, resign *spec.Resign, proof *spec.SignedProof) *spec.SignedProof {
+			i := proofIndex(target)
+			return target.Validator.Proofs[(i+1)%len(target.Validator.Proofs)]
+		}),
+		resignCase("resign tampered proof", false, func(target *Target, resign *spec.Resign, proof *spec.SignedProof) *spec.SignedProof {
+			return tamperedProof(proof)
+		}),
+	}
+}
+
+// tamperedProof returns a copy of proof with a different encrypted share, invalidating its signature
+func tamperedProof(proof *spec.SignedProof) *spec.SignedProof {
+	tampered := *proof.Proof
+	tampered.EncryptedShare = append([]byte{}, tampered.EncryptedShare...)
+	tampered.EncryptedShare[0] ^= 0xff
+	return &spec.SignedProof{Proof: &tampered, Signature: proof.Signature}
+}
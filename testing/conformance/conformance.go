@@ -0,0 +1,130 @@
+// Package conformance checks an operator implementation against the spec's validation rules: it sends crafted valid and
+// invalid requests through an api.Transport (api.Client for a remote endpoint) and asserts the operator accepts or rejects them.
+//
+// Most cases need a validator the operator holds a share of (see Target.Validator), as only its proofs are signed by the operator.
+// Messages are signed with Target.Sign, operators verifying owner signatures against a chain must be given a matching owner.
+package conformance
+
+import (
+	"context"
+	"crypto/rsa"
+	"fmt"
+	"strings"
+
+	spec "github.com/bloxapp/dkg-spec"
+	"github.com/bloxapp/dkg-spec/api"
+
+	ssz "github.com/ferranbt/fastssz"
+)
+
+// Target is the operator under test
+type Target struct {
+	Operator  *spec.Operator
+	Transport api.Transport
+	Owner     [20]byte
+	// Sign returns the owner's signature of msg, a zero signature is sent if nil
+	Sign func(msg ssz.HashRoot) ([]byte, error)
+	// Initiator signs init messages, a fixture key is used if nil
+	Initiator *rsa.PrivateKey
+	// Validator the operator holds a share of, cases requiring the operator's proof are skipped if nil
+	Validator *Validator
+}
+
+// Validator is an existing validator of Target.Owner the operator under test holds a share of
+type Validator struct {
+	PubKey []byte
+	// Operators of the validator's cluster, ordered by ID
+	Operators []*spec.Operator
+	// Proofs of the validator's ceremony, ordered as Operators
+	Proofs spec.CeremonyProofs
+	// Params of the validator's ceremony, nil if its proofs don't commit to them
+	Params                *spec.CeremonyParams
+	WithdrawalCredentials []byte
+	Fork                  [4]byte
+}
+
+// Case is a request sent to the operator under test with its expected outcome
+type Case struct {
+	Name string
+	// Valid is true if the operator must accept the request, false if it must reject it
+	Valid bool
+	// run sends the request, returning the operator's result and the request ID it was sent with
+	run func(ctx context.Context, target *Target) (*spec.Result, [24]byte, error)
+	// check validates the result of a valid case
+	check func(target *Target, requestID [24]byte, result *spec.Result) error
+}
+
+// Outcome is the outcome of a single case
+type Outcome struct {
+	Case string
+	// Err is nil if the operator conformed
+	Err error
+}
+
+// Report is the outcome of a conformance run
+type Report struct {
+	Outcomes []*Outcome
+}
+
+// Failed returns the outcomes of the cases the operator didn't conform to
+func (r *Report) Failed() []*Outcome {
+	var ret []*Outcome
+	for _, outcome := range r.Outcomes {
+		if outcome.Err != nil {
+			ret = append(ret, outcome)
+		}
+	}
+	return ret
+}
+
+// Err returns an error listing the failed cases, nil if the operator conformed to every case
+func (r *Report) Err() error {
+	failed := r.Failed()
+	if len(failed) == 0 {
+		return nil
+	}
+	msgs := make([]string, len(failed))
+	for i, outcome := range failed {
+		msgs[i] = fmt.Sprintf("%s: %v", outcome.Case, outcome.Err)
+	}
+	return fmt.Errorf("%d/%d cases failed: %s", len(failed), len(r.Outcomes), strings.Join(msgs, "; "))
+}
+
+// Run runs every case applicable to target
+func Run(ctx context.Context, target *Target) *Report {
+	ret := &Report{}
+	for _, c := range Cases(target) {
+		ret.Outcomes = append(ret.Outcomes, &Outcome{Case: c.Name, Err: c.Run(ctx, target)})
+	}
+	return ret
+}
+
+// Run sends the case's request to target, returns nil if the operator responded as expected
+func (c *Case) Run(ctx context.Context, target *Target) error {
+	result, requestID, err := c.run(ctx, target)
+	if !c.Valid {
+		if err == nil {
+			return fmt.Errorf("invalid request accepted")
+		}
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("valid request rejected: %v", err)
+	}
+	if result == nil {
+		return fmt.Errorf("no result")
+	}
+	if c.check != nil {
+		if err := c.check(target, requestID, result); err != nil {
+			return fmt.Errorf("invalid result: %v", err)
+		}
+	}
+	return nil
+}
+
+func (t *Target) sign(msg ssz.HashRoot) ([]byte, error) {
+	if t.Sign == nil {
+		return make([]byte, 65), nil
+	}
+	return t.Sign(msg)
+}
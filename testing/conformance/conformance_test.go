@@ -0,0 +1,97 @@
+package conformance
+
+import (
+	"context"
+	"testing"
+
+	spec "github.com/bloxapp/dkg-spec"
+	"github.com/bloxapp/dkg-spec/api"
+	"github.com/bloxapp/dkg-spec/testing/simulator"
+
+	"github.com/stretchr/testify/require"
+)
+
+// permissive accepts every request, returning the wrapped operator's result for a valid one
+type permissive struct {
+	api.Transport
+	result *spec.Result
+}
+
+func (p *permissive) Init(ctx context.Context, req *api.InitRequest) (*spec.Result, error) {
+	return p.result, nil
+}
+
+func (p *permissive) Reshare(ctx context.Context, req *api.ReshareRequest) (*spec.Result, error) {
+	return p.result, nil
+}
+
+func (p *permissive) Resign(ctx context.Context, req *api.ResignRequest) (*spec.Result, error) {
+	return p.Transport.Resign(ctx, req)
+}
+
+func target(t *testing.T) *Target {
+	sim, err := simulator.New(4)
+	require.NoError(t, err)
+	init, err := spec.NewInitBuilder().
+		Operators(sim.Cluster()...).
+		WithdrawalCredentials(sim.Owner[:]).
+		Owner(sim.Owner).
+		Build()
+	require.NoError(t, err)
+	ceremony, err := sim.Init(context.Background(), init)
+	require.NoError(t, err)
+
+	operator := sim.Operators[0]
+	return &Target{
+		Operator:  operator.Operator,
+		Transport: operator,
+		Owner:     sim.Owner,
+		Validator: &Validator{
+			PubKey:                ceremony.ValidatorPubKey.Serialize(),
+			Operators:             init.Operators,
+			Proofs:                ceremony.Proofs(),
+			Params:                ceremony.Params,
+			WithdrawalCredentials: init.WithdrawalCredentials,
+			Fork:                  init.Fork,
+		},
+	}
+}
+
+func TestRun(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("conforming operator", func(t *testing.T) {
+		target := target(t)
+		report := Run(ctx, target)
+		require.Len(t, report.Outcomes, len(Cases(target)))
+		require.NoError(t, report.Err())
+	})
+
+	t.Run("no validator", func(t *testing.T) {
+		target := target(t)
+		target.Validator = nil
+		report := Run(ctx, target)
+		require.Len(t, report.Outcomes, 6)
+		require.NoError(t, report.Err())
+	})
+
+	t.Run("permissive operator", func(t *testing.T) {
+		target := target(t)
+		target.Transport = &permissive{Transport: target.Transport, result: &spec.Result{}}
+		report := Run(ctx, target)
+		failed := report.Failed()
+		require.Len(t, failed, 11)
+		require.Equal(t, "init unordered operators", failed[0].Case)
+		require.EqualError(t, failed[0].Err, "invalid request accepted")
+		require.ErrorContains(t, report.Err(), "11/16 cases failed")
+	})
+
+	t.Run("mismatched validator proofs", func(t *testing.T) {
+		target := target(t)
+		target.Validator.Proofs = target.Validator.Proofs[1:]
+		report := Run(ctx, target)
+		failed := report.Failed()
+		require.Len(t, failed, 1)
+		require.Equal(t, "resign valid", failed[0].Case)
+	})
+}
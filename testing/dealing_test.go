@@ -0,0 +1,128 @@
+package testing
+
+import (
+	"fmt"
+	"testing"
+
+	spec "github.com/bloxapp/dkg-spec"
+	"github.com/bloxapp/dkg-spec/crypto"
+	"github.com/bloxapp/dkg-spec/crypto/bls"
+	"github.com/bloxapp/dkg-spec/testing/fixtures"
+
+	"github.com/stretchr/testify/require"
+)
+
+// dealtResults runs a joint dealing of init's operators, each dealing a random polynomial of degree T-1,
+// and returns the results carrying each operator's resulting share public key and dealing proof
+func dealtResults(t *testing.T, init *spec.Init) []*spec.Result {
+	polynomials := make([][]bls.SecretKey, len(init.Operators))
+	validatorPK := &bls.PublicKey{}
+	for i := range polynomials {
+		polynomials[i] = make([]bls.SecretKey, init.T)
+		for j := range polynomials[i] {
+			polynomials[i][j].SetByCSPRNG()
+		}
+		if i == 0 {
+			validatorPK = polynomials[i][0].GetPublicKey()
+		} else {
+			validatorPK.Add(polynomials[i][0].GetPublicKey())
+		}
+	}
+
+	ret := make([]*spec.Result, len(init.Operators))
+	for i, op := range init.Operators {
+		id := &bls.ID{}
+		require.NoError(t, id.SetDecString(fmt.Sprintf("%d", op.ID)))
+		share := &bls.SecretKey{}
+		for j := range polynomials {
+			subShare := &bls.SecretKey{}
+			require.NoError(t, subShare.Set(polynomials[j], id))
+			if j == 0 {
+				share = subShare
+			} else {
+				share.Add(subShare)
+			}
+		}
+		commitments, proof, err := spec.ProveDealing(fixtures.TestRequestID, op.ID, polynomials[i])
+		require.NoError(t, err)
+		ret[i] = &spec.Result{
+			OperatorID:                 op.ID,
+			RequestID:                  fixtures.TestRequestID,
+			DepositPartialSignature:    make([]byte, 96),
+			OwnerNoncePartialSignature: make([]byte, 96),
+			SignedProof: spec.SignedProof{
+				Proof: &spec.Proof{
+					ValidatorPubKey: validatorPK.Serialize(),
+					SharePubKey:     share.GetPublicKey().Serialize(),
+				},
+				Signature: make([]byte, 256),
+			},
+			DealingCommitments: commitments,
+			DealingProof:       proof,
+		}
+	}
+	return ret
+}
+
+func TestDealingAudit(t *testing.T) {
+	crypto.InitBLS()
+	init := &spec.Init{
+		Operators: fixtures.GenerateOperators(4),
+		T:         3,
+	}
+
+	t.Run("consistent", func(t *testing.T) {
+		results := dealtResults(t, init)
+		for _, result := range results {
+			require.NoError(t, spec.VerifyDealingProof(init.T, result))
+		}
+		require.NoError(t, spec.AuditDealings(init, results))
+	})
+
+	t.Run("ssz round trip", func(t *testing.T) {
+		result := dealtResults(t, init)[0]
+		byts, err := result.MarshalSSZ()
+		require.NoError(t, err)
+		decoded := &spec.Result{}
+		require.NoError(t, decoded.UnmarshalSSZ(byts))
+		require.EqualValues(t, result.DealingCommitments, decoded.DealingCommitments)
+		require.EqualValues(t, result.DealingProof, decoded.DealingProof)
+	})
+
+	t.Run("proof of another operator", func(t *testing.T) {
+		results := dealtResults(t, init)
+		results[1].DealingProof = results[0].DealingProof
+		err := spec.AuditDealings(init, results)
+		require.EqualError(t, err, "operator 2: invalid dealing proof")
+		require.EqualValues(t, spec.CodeInconsistentDealing, spec.ErrorCodeOf(err))
+	})
+
+	t.Run("wrong degree", func(t *testing.T) {
+		result := dealtResults(t, init)[0]
+		err := spec.VerifyDealingProof(init.T+1, result)
+		require.EqualError(t, err, "operator 1: 3 dealing commitments, expected 4")
+	})
+
+	t.Run("inconsistent share", func(t *testing.T) {
+		results := dealtResults(t, init)
+		results[2].SignedProof.Proof.SharePubKey = results[3].SignedProof.Proof.SharePubKey
+		err := spec.AuditDealings(init, results)
+		require.EqualError(t, err, "operator 3: share public key doesn't match the dealings")
+		require.EqualValues(t, spec.CodeInconsistentDealing, spec.ErrorCodeOf(err))
+	})
+
+	t.Run("validator key not dealt", func(t *testing.T) {
+		results := dealtResults(t, init)
+		other := dealtResults(t, init)
+		for _, result := range results {
+			result.SignedProof.Proof.ValidatorPubKey = other[0].SignedProof.Proof.ValidatorPubKey
+		}
+		err := spec.AuditDealings(init, results)
+		require.EqualError(t, err, "operator 1: validator public key isn't the sum of the dealt constant terms")
+	})
+
+	t.Run("missing result", func(t *testing.T) {
+		err := spec.AuditDealings(init, dealtResults(t, init)[:3])
+		require.EqualValues(t, spec.CodeResultsCountMismatch, spec.ErrorCodeOf(err))
+	})
+}
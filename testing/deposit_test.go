@@ -0,0 +1,158 @@
+package testing
+
+import (
+	"encoding/binary"
+	"fmt"
+	"testing"
+
+	spec "github.com/bloxapp/dkg-spec"
+	"github.com/bloxapp/dkg-spec/crypto"
+	"github.com/bloxapp/dkg-spec/depositcontract"
+	"github.com/bloxapp/dkg-spec/testing/fixtures"
+	"github.com/bloxapp/dkg-spec/testing/stubs"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+)
+
+type depositCheckerF func(validatorPK []byte) ([][]byte, error)
+
+func (f depositCheckerF) ExistingWithdrawalCredentials(validatorPK []byte) ([][]byte, error) {
+	return f(validatorPK)
+}
+
+func depositLog(t *testing.T, contract common.Address, validatorPK []byte, withdrawalCredentials []byte, index uint64) types.Log {
+	amount := make([]byte, 8)
+	binary.LittleEndian.PutUint64(amount, 32000000000)
+	indexBytes := make([]byte, 8)
+	binary.LittleEndian.PutUint64(indexBytes, index)
+
+	event := depositcontract.ABI.Events["DepositEvent"]
+	data, err := event.Inputs.Pack(validatorPK, withdrawalCredentials, amount, make([]byte, 96), indexBytes)
+	require.NoError(t, err)
+	return types.Log{
+		Address:     contract,
+		Topics:      []common.Hash{event.ID},
+		Data:        data,
+		BlockNumber: 100 + index,
+	}
+}
+
+func TestValidateNoConflictingDeposit(t *testing.T) {
+	validatorPK := fixtures.TestOperator1Proof4Operators.Proof.ValidatorPubKey
+	withdrawalCredentials := crypto.ETH1WithdrawalCredentials(fixtures.TestOwnerAddress[:])
+
+	t.Run("no deposits", func(t *testing.T) {
+		require.NoError(t, spec.ValidateNoConflictingDeposit(depositCheckerF(func([]byte) ([][]byte, error) {
+			return nil, nil
+		}), validatorPK, withdrawalCredentials))
+	})
+
+	t.Run("same credentials", func(t *testing.T) {
+		require.NoError(t, spec.ValidateNoConflictingDeposit(depositCheckerF(func([]byte) ([][]byte, error) {
+			return [][]byte{withdrawalCredentials}, nil
+		}), validatorPK, withdrawalCredentials))
+	})
+
+	t.Run("conflicting credentials", func(t *testing.T) {
+		require.EqualError(t, spec.ValidateNoConflictingDeposit(depositCheckerF(func([]byte) ([][]byte, error) {
+			return [][]byte{withdrawalCredentials, make([]byte, 32)}, nil
+		}), validatorPK, withdrawalCredentials), "validator already deposited with different withdrawal credentials")
+	})
+
+	t.Run("checker error", func(t *testing.T) {
+		require.EqualError(t, spec.ValidateNoConflictingDeposit(depositCheckerF(func([]byte) ([][]byte, error) {
+			return nil, fmt.Errorf("node unavailable")
+		}), validatorPK, withdrawalCredentials), "failed to fetch existing deposits: node unavailable")
+	})
+}
+
+func TestOperatorInitDepositCheck(t *testing.T) {
+	crypto.InitBLS()
+	init := &spec.Init{
+		Operators:             fixtures.GenerateOperators(4),
+		T:                     3,
+		WithdrawalCredentials: fixtures.TestOwnerAddress[:],
+		Fork:                  fixtures.TestFork,
+		Owner:                 fixtures.TestOwnerAddress,
+	}
+	operatorInit := func(deposited []byte) error {
+		_, err := spec.OperatorInit(init, fixtures.TestRequestID, 1, fixtures.OperatorSK(fixtures.TestOperator1SK), depositCheckerF(func([]byte) ([][]byte, error) {
+			return [][]byte{deposited}, nil
+		}))
+		return err
+	}
+
+	t.Run("deposit with the init's withdrawal address", func(t *testing.T) {
+		// the check passes, signing then fails as the DKG rounds producing the validator key are out of the spec's scope
+		err := operatorInit(crypto.ETH1WithdrawalCredentials(fixtures.TestOwnerAddress[:]))
+		require.EqualError(t, err, "invalid validator public key length")
+	})
+
+	t.Run("deposit with other credentials", func(t *testing.T) {
+		err := operatorInit(make([]byte, 32))
+		require.EqualValues(t, spec.CodeConflictingDeposit, spec.ErrorCodeOf(err))
+	})
+}
+
+func TestDepositContractChecker(t *testing.T) {
+	contract := common.HexToAddress("0x00000000219ab540356cBB839Cbe05303d7705Fa")
+	validatorPK := fixtures.TestOperator1Proof4Operators.Proof.ValidatorPubKey
+	otherPK := fixtures.TestOperator1Proof4Operators.Proof.SharePubKey
+	withdrawalCredentials := crypto.ETH1WithdrawalCredentials(fixtures.TestOwnerAddress[:])
+
+	client := &stubs.LogFilterer{Logs: []types.Log{
+		depositLog(t, contract, otherPK, make([]byte, 32), 1),
+		depositLog(t, contract, validatorPK, withdrawalCredentials, 2),
+		depositLog(t, common.Address{1}, validatorPK, make([]byte, 32), 3),
+	}}
+
+	event, err := depositcontract.ParseDepositEvent(client.Logs[1])
+	require.NoError(t, err)
+	require.EqualValues(t, validatorPK, event.PubKey)
+	require.EqualValues(t, withdrawalCredentials, event.WithdrawalCredentials)
+	require.EqualValues(t, 32000000000, event.Amount)
+	require.EqualValues(t, 2, event.Index)
+
+	checker := &depositcontract.Checker{Client: client, Contract: contract}
+	existing, err := checker.ExistingWithdrawalCredentials(validatorPK)
+	require.NoError(t, err)
+	require.EqualValues(t, [][]byte{withdrawalCredentials}, existing)
+	require.NoError(t, spec.ValidateNoConflictingDeposit(checker, validatorPK, withdrawalCredentials))
+	require.Error(t, spec.ValidateNoConflictingDeposit(checker, validatorPK, make([]byte, 32)))
+}
+
+type validatorCheckerF func(validatorPK []byte) (*spec.ChainValidator, error)
+
+func (f validatorCheckerF) Validator(validatorPK []byte) (*spec.ChainValidator, error) {
+	return f(validatorPK)
+}
+
+func TestValidateValidatorOnChain(t *testing.T) {
+	validatorPK := fixtures.TestOperator1Proof4Operators.Proof.ValidatorPubKey
+	withdrawalCredentials := crypto.ETH1WithdrawalCredentials(fixtures.TestOwnerAddress[:])
+	checker := func(status spec.ValidatorStatus) validatorCheckerF {
+		return func(pk []byte) (*spec.ChainValidator, error) {
+			if string(pk) != string(validatorPK) {
+				return nil, nil
+			}
+			return &spec.ChainValidator{Index: 7, Status: status, WithdrawalCredentials: withdrawalCredentials}, nil
+		}
+	}
+
+	validator, err := spec.ValidateValidatorOnChain(checker(spec.ValidatorActiveOngoing), validatorPK, withdrawalCredentials)
+	require.NoError(t, err)
+	require.EqualValues(t, 7, validator.Index)
+
+	_, err = spec.ValidateValidatorOnChain(checker(spec.ValidatorActiveOngoing), validatorPK, make([]byte, 32))
+	require.EqualError(t, err, "validator withdrawal credentials mismatch")
+	_, err = spec.ValidateValidatorOnChain(checker(spec.ValidatorActiveOngoing), make([]byte, 48), withdrawalCredentials)
+	require.EqualError(t, err, "validator not found on chain")
+	_, err = spec.ValidateValidatorOnChain(checker(spec.ValidatorExitedUnslashed), validatorPK, withdrawalCredentials)
+	require.EqualError(t, err, "validator is exiting or exited (exited_unslashed)")
+	_, err = spec.ValidateValidatorOnChain(validatorCheckerF(func([]byte) (*spec.ChainValidator, error) {
+		return nil, fmt.Errorf("node unavailable")
+	}), validatorPK, withdrawalCredentials)
+	require.EqualError(t, err, "failed to fetch validator: node unavailable")
+}
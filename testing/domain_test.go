@@ -0,0 +1,59 @@
+package testing
+
+import (
+	"errors"
+	"testing"
+
+	spec "github.com/bloxapp/dkg-spec"
+	"github.com/bloxapp/dkg-spec/crypto"
+	"github.com/bloxapp/dkg-spec/testing/fixtures"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDomains(t *testing.T) {
+	proof := fixtures.TestOperator1Proof4Operators.Proof
+
+	t.Run("distinct roots", func(t *testing.T) {
+		roots := map[[32]byte]spec.Domain{}
+		for _, domain := range []spec.Domain{spec.DomainOwnerNonce, spec.DomainProof, spec.DomainExit, spec.DomainTranscript, spec.DomainResult, spec.DomainPong, spec.DomainInit, spec.DomainDealing, spec.DomainPossession, spec.DomainReshare, spec.DomainResign} {
+			root, err := spec.ComputeSigningRoot(proof, domain)
+			require.NoError(t, err)
+			require.NotContains(t, roots, root)
+			roots[root] = domain
+		}
+		root, err := proof.HashTreeRoot()
+		require.NoError(t, err)
+		require.NotContains(t, roots, root)
+	})
+
+	t.Run("unknown domain", func(t *testing.T) {
+		_, err := spec.ComputeSigningRoot(proof, spec.Domain{1, 2, 3, 4})
+		require.EqualError(t, err, "unknown signing domain 01020304")
+		var validationErr *spec.ValidationError
+		require.True(t, errors.As(err, &validationErr))
+		require.EqualValues(t, spec.CodeInvalidDomain, validationErr.Code)
+	})
+
+	t.Run("validate signing root", func(t *testing.T) {
+		root, err := proof.SigningRoot()
+		require.NoError(t, err)
+		require.NoError(t, spec.ValidateSigningRoot(root[:], proof, spec.DomainProof))
+		require.EqualError(t, spec.ValidateSigningRoot(root[:], proof, spec.DomainResult), "signing root not produced under the result domain")
+
+		htr, err := proof.HashTreeRoot()
+		require.NoError(t, err)
+		require.EqualError(t, spec.ValidateSigningRoot(htr[:], proof, spec.DomainProof), "signing root not produced under the proof domain")
+	})
+
+	t.Run("proof signed under another domain", func(t *testing.T) {
+		root, err := spec.ComputeSigningRoot(proof, spec.DomainTranscript)
+		require.NoError(t, err)
+		sig, err := crypto.SignRSA(fixtures.OperatorSK(fixtures.TestOperator1SK), root[:])
+		require.NoError(t, err)
+		require.ErrorContains(t, spec.VerifyCeremonyProof(
+			fixtures.EncodedOperatorPK(fixtures.TestOperator1SK),
+			spec.SignedProof{Proof: proof, Signature: sig},
+		), "verification error")
+	})
+}
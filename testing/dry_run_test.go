@@ -0,0 +1,131 @@
+package testing
+
+import (
+	"encoding/json"
+	"testing"
+
+	spec "github.com/bloxapp/dkg-spec"
+	"github.com/bloxapp/dkg-spec/crypto"
+	"github.com/bloxapp/dkg-spec/eip1271"
+	"github.com/bloxapp/dkg-spec/testing/fixtures"
+	"github.com/bloxapp/dkg-spec/testing/stubs"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+// contractOwnerClient accepts any signature of owner as a valid EIP-1271 contract signature
+func contractOwnerClient(owner [20]byte) *stubs.Client {
+	return &stubs.Client{
+		CallContractF: func(call ethereum.CallMsg) ([]byte, error) {
+			ret := make([]byte, 32)
+			copy(ret[:4], eip1271.MagicValue[:])
+			return ret, nil
+		},
+		CodeAtMap: map[common.Address]bool{
+			owner: true,
+		},
+	}
+}
+
+func TestDryRun(t *testing.T) {
+	crypto.InitBLS()
+	withdrawalCredentials := fixtures.TestOwnerAddress[:]
+
+	t.Run("init", func(t *testing.T) {
+		init := &spec.Init{
+			Operators:             fixtures.GenerateOperators(4),
+			T:                     3,
+			WithdrawalCredentials: withdrawalCredentials,
+			Fork:                  fixtures.TestFork,
+			Owner:                 fixtures.TestOwnerAddress,
+		}
+		report := spec.DryRunOperatorInit(init)
+		require.True(t, report.Passed())
+		require.Len(t, report.Checks, 2)
+
+		init.T = 2
+		init.Fork = [4]byte{0xff}
+		report = spec.DryRunOperatorInit(init)
+		require.False(t, report.Passed())
+		require.EqualError(t, report.Err(), "dry run failed: init message: threshold set is invalid; deposit: unknown network")
+	})
+
+	t.Run("reshare", func(t *testing.T) {
+		reshare := fixtures.TestReshare4Operators
+		reshare.WithdrawalCredentials = withdrawalCredentials
+		signedReshare := &spec.SignedReshare{Reshare: reshare, Signature: make([]byte, 65)}
+		client := contractOwnerClient(fixtures.TestOwnerAddress)
+
+		report := spec.DryRunOperatorReshare(
+			signedReshare,
+			fixtures.GenerateOperators(4)[0],
+			&fixtures.TestOperator1Proof4Operators,
+			fixtures.TestParams(4),
+			client,
+			validatorCheckerF(func(pk []byte) (*spec.ChainValidator, error) {
+				return &spec.ChainValidator{Index: 1, Status: spec.ValidatorActiveOngoing, WithdrawalCredentials: crypto.ETH1WithdrawalCredentials(withdrawalCredentials)}, nil
+			}),
+		)
+		require.NoError(t, report.Err())
+		require.Len(t, report.Checks, 4)
+
+		report = spec.DryRunOperatorReshare(
+			signedReshare,
+			fixtures.GenerateOperators(4)[0],
+			&fixtures.TestOperator2Proof4Operators,
+			fixtures.TestParams(4),
+			client,
+			validatorCheckerF(func(pk []byte) (*spec.ChainValidator, error) {
+				return nil, nil
+			}),
+		)
+		require.False(t, report.Passed())
+		require.EqualValues(t, "", report.Checks[0].Error)
+		require.EqualValues(t, "reshare message", report.Checks[1].Name)
+		require.EqualValues(t, "crypto/rsa: verification error", report.Checks[1].Error)
+		require.EqualValues(t, "validator not found on chain", report.Checks[2].Error)
+		require.EqualValues(t, "", report.Checks[3].Error)
+
+		byts, err := json.Marshal(report)
+		require.NoError(t, err)
+		require.Contains(t, string(byts), `{"name":"validator on chain","error":"validator not found on chain"}`)
+	})
+
+	t.Run("resign", func(t *testing.T) {
+		signedResign := &spec.SignedResign{
+			Resign: spec.Resign{
+				ValidatorPubKey:       fixtures.TestOperator1Proof4Operators.Proof.ValidatorPubKey,
+				Fork:                  fixtures.TestFork,
+				WithdrawalCredentials: withdrawalCredentials,
+				Owner:                 fixtures.TestOwnerAddress,
+				Amount:                spec.DepositAmount,
+			},
+			Signature: make([]byte, 65),
+		}
+
+		report := spec.DryRunOperatorResign(
+			signedResign,
+			fixtures.GenerateOperators(4)[0],
+			&fixtures.TestOperator1Proof4Operators,
+			fixtures.TestParams(4),
+			contractOwnerClient(fixtures.TestOwnerAddress),
+			nil,
+		)
+		require.NoError(t, report.Err())
+		require.Len(t, report.Checks, 3)
+
+		report = spec.DryRunOperatorResign(
+			signedResign,
+			fixtures.GenerateOperators(4)[0],
+			&fixtures.TestOperator1Proof4Operators,
+			fixtures.TestParams(4),
+			contractOwnerClient([20]byte{}),
+			nil,
+		)
+		require.False(t, report.Passed())
+		require.EqualValues(t, "owner signature", report.Checks[0].Name)
+		require.NotEmpty(t, report.Checks[0].Error)
+	})
+}
@@ -0,0 +1,56 @@
+package testing
+
+import (
+	"testing"
+	"time"
+
+	spec "github.com/bloxapp/dkg-spec"
+	"github.com/bloxapp/dkg-spec/testing/fixtures"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSignedResponse(t *testing.T) {
+	operators := fixtures.GenerateOperators(4)
+	payload, err := fixtures.Results4Operators()[0].MarshalSSZ()
+	require.NoError(t, err)
+	now := time.Unix(1700000000, 0)
+
+	signed, err := spec.SignResponse(1, payload, now, fixtures.OperatorSK(fixtures.TestOperator1SK))
+	require.NoError(t, err)
+
+	t.Run("valid", func(t *testing.T) {
+		require.NoError(t, spec.VerifyResponse(operators, payload, signed, now.Add(time.Second), time.Minute))
+		require.NoError(t, spec.VerifyResponse(operators, payload, signed, now.Add(time.Hour), 0))
+	})
+
+	t.Run("ssz round trip", func(t *testing.T) {
+		byts, err := signed.MarshalSSZ()
+		require.NoError(t, err)
+		decoded := &spec.SignedResponseEnvelope{}
+		require.NoError(t, decoded.UnmarshalSSZ(byts))
+		require.NoError(t, spec.VerifyResponse(operators, payload, decoded, now, time.Minute))
+	})
+
+	t.Run("tampered payload", func(t *testing.T) {
+		tampered := append([]byte{}, payload...)
+		tampered[0] ^= 1
+		require.EqualError(t, spec.VerifyResponse(operators, tampered, signed, now, time.Minute), "invalid payload hash")
+	})
+
+	t.Run("expired", func(t *testing.T) {
+		require.EqualError(t, spec.VerifyResponse(operators, payload, signed, now.Add(time.Hour), time.Minute), "response expired")
+		require.EqualError(t, spec.VerifyResponse(operators, payload, signed, now.Add(-time.Hour), time.Minute), "response timestamp in the future")
+	})
+
+	t.Run("wrong operator", func(t *testing.T) {
+		impersonated := *signed
+		envelope := *signed.Envelope
+		envelope.OperatorID = 2
+		impersonated.Envelope = &envelope
+		require.EqualError(t, spec.VerifyResponse(operators, payload, &impersonated, now, time.Minute), "crypto/rsa: verification error")
+
+		envelope.OperatorID = 5
+		require.EqualError(t, spec.VerifyResponse(operators, payload, &impersonated, now, time.Minute), "operator not found")
+	})
+}
@@ -0,0 +1,69 @@
+package testing
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	spec "github.com/bloxapp/dkg-spec"
+	"github.com/bloxapp/dkg-spec/testing/fixtures"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestErrorCodes(t *testing.T) {
+	t.Run("validation failures", func(t *testing.T) {
+		init := &spec.Init{
+			Operators: fixtures.GenerateOperators(4),
+			T:         2,
+		}
+		err := spec.ValidateInitMessage(init)
+		require.EqualError(t, err, "threshold set is invalid")
+		require.Equal(t, spec.CodeInvalidThreshold, spec.ErrorCodeOf(err))
+
+		_, err = spec.ThresholdForCluster(make([]*spec.Operator, 5))
+		require.Equal(t, spec.CodeInvalidClusterSize, spec.ErrorCodeOf(err))
+
+		err = spec.ValidateCeremonyProof(
+			[20]byte{},
+			fixtures.ShareSK(fixtures.TestValidator4Operators).GetPublicKey().Serialize(),
+			fixtures.GenerateOperators(4)[0],
+			fixtures.TestOperator1Proof4Operators,
+		)
+		require.EqualError(t, err, "invalid owner address")
+		require.Equal(t, spec.CodeProofOwnerMismatch, spec.ErrorCodeOf(err))
+
+		err = spec.VerifyCeremonyProof(fixtures.GenerateOperators(4)[1].PubKey, fixtures.TestOperator1Proof4Operators)
+		require.Equal(t, spec.CodeInvalidProofSignature, spec.ErrorCodeOf(err))
+	})
+
+	t.Run("wrapped", func(t *testing.T) {
+		err := fmt.Errorf("ceremony 1: %w", spec.ValidateInitMessage(&spec.Init{
+			Operators: []*spec.Operator{{ID: 2}, {ID: 1}},
+		}))
+		require.Equal(t, spec.CodeOperatorsNotOrdered, spec.ErrorCodeOf(err))
+		require.Equal(t, spec.CodeUnknown, spec.ErrorCodeOf(fmt.Errorf("other")))
+		require.Equal(t, spec.CodeUnknown, spec.ErrorCodeOf(nil))
+	})
+
+	t.Run("names", func(t *testing.T) {
+		require.Equal(t, "invalid_threshold", spec.CodeInvalidThreshold.String())
+		require.Equal(t, "code_999", spec.ErrorCode(999).String())
+		code, err := spec.ParseErrorCode("proof_owner_mismatch")
+		require.NoError(t, err)
+		require.Equal(t, spec.CodeProofOwnerMismatch, code)
+		_, err = spec.ParseErrorCode("nope")
+		require.EqualError(t, err, "unknown error code nope")
+	})
+
+	t.Run("json", func(t *testing.T) {
+		byts, err := json.Marshal(spec.CodeConflictingDeposit)
+		require.NoError(t, err)
+		require.Equal(t, `"conflicting_deposit"`, string(byts))
+		var code spec.ErrorCode
+		require.NoError(t, json.Unmarshal(byts, &code))
+		require.Equal(t, spec.CodeConflictingDeposit, code)
+		require.NoError(t, json.Unmarshal([]byte(`"added_later"`), &code))
+		require.Equal(t, spec.CodeUnknown, code)
+	})
+}
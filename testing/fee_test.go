@@ -0,0 +1,89 @@
+package testing
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	spec "github.com/bloxapp/dkg-spec"
+	"github.com/bloxapp/dkg-spec/testing/fixtures"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFeeTerms(t *testing.T) {
+	token := common.HexToAddress("0x9D65fF81a3c488d585bBfb0Bfe3c7707c7917f54")
+	amount, _ := new(big.Int).SetString("5000000000000000000", 10)
+	fee, err := spec.NewFeeTerms(token, amount, [32]byte{0x01})
+	require.NoError(t, err)
+
+	init := &spec.Init{
+		Operators:             fixtures.GenerateOperators(4),
+		T:                     3,
+		WithdrawalCredentials: fixtures.TestOwnerAddress[:],
+		Fork:                  fixtures.TestFork,
+		Owner:                 fixtures.TestOwnerAddress,
+	}
+
+	t.Run("amount", func(t *testing.T) {
+		require.Equal(t, amount, fee.AmountBig())
+		require.False(t, fee.IsZero())
+		require.True(t, spec.FeeTerms{}.IsZero())
+
+		_, err := spec.NewFeeTerms(token, big.NewInt(-1), [32]byte{})
+		require.EqualError(t, err, "fee amount -1 out of range")
+		_, err = spec.NewFeeTerms(token, new(big.Int).Lsh(big.NewInt(1), 256), [32]byte{})
+		require.Error(t, err)
+	})
+
+	t.Run("bound to the signing root", func(t *testing.T) {
+		free, err := init.SigningRoot()
+		require.NoError(t, err)
+		paid := *init
+		paid.Fee = fee
+		root, err := paid.SigningRoot()
+		require.NoError(t, err)
+		require.NotEqual(t, free, root)
+
+		byts, err := paid.MarshalSSZ()
+		require.NoError(t, err)
+		decoded := &spec.Init{}
+		require.NoError(t, decoded.UnmarshalSSZ(byts))
+		require.Equal(t, fee, decoded.Fee)
+	})
+
+	t.Run("validate", func(t *testing.T) {
+		paid := *init
+		paid.Fee = fee
+		require.NoError(t, spec.ValidateFee(&paid, fee))
+		require.NoError(t, spec.ValidateFee(init, spec.FeeTerms{}))
+		require.NoError(t, spec.ValidateFee(&spec.Resign{}, spec.FeeTerms{}))
+
+		err := spec.ValidateFee(init, fee)
+		require.EqualValues(t, spec.CodeFeeMismatch, spec.ErrorCodeOf(err))
+		require.EqualError(t, err, "fee terms none differ from the agreed terms 5000000000000000000 of token "+token.Hex()+
+			" (reference 0x0100000000000000000000000000000000000000000000000000000000000000)")
+	})
+
+	t.Run("policy", func(t *testing.T) {
+		policy := spec.FeePolicy(func(ctx context.Context, owner [20]byte) (spec.FeeTerms, error) {
+			return fee, nil
+		})
+		reshare := fixtures.TestReshare4Operators
+		err := spec.ApplyPolicy(context.Background(), policy, &reshare)
+		require.EqualValues(t, spec.CodeFeeMismatch, spec.ErrorCodeOf(err))
+
+		reshare.Fee = fee
+		require.NoError(t, spec.ApplyPolicy(context.Background(), policy, &reshare))
+	})
+
+	t.Run("preview", func(t *testing.T) {
+		reshare := fixtures.TestReshare4Operators
+		reshare.Fee = fee
+		preview, err := spec.PreviewReshare(&reshare)
+		require.NoError(t, err)
+		last := preview.Fields[len(preview.Fields)-1]
+		require.Equal(t, spec.PreviewField{Label: "Fee", Value: fee.String()}, last)
+	})
+}
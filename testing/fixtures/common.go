@@ -8,9 +8,9 @@ import (
 
 	spec "github.com/bloxapp/dkg-spec"
 	"github.com/bloxapp/dkg-spec/crypto"
+	"github.com/bloxapp/dkg-spec/crypto/bls"
 
 	"github.com/ethereum/go-ethereum/common"
-	"github.com/herumi/bls-eth-go-binary/bls"
 )
 
 var (
@@ -125,3 +125,22 @@ func DecodeHexNoError(str string) []byte {
 	}
 	return ret
 }
+
+// TestParams returns the ceremony parameters of the fixture proofs of n operators
+func TestParams(n int) *spec.CeremonyParams {
+	operators := GenerateOperators(n)
+	t, err := spec.ThresholdForCluster(operators)
+	if err != nil {
+		panic(err)
+	}
+	return spec.NewCeremonyParams(operators, t, TestFork)
+}
+
+// TestParamsHash returns the ceremony parameters hash of the fixture proofs of n operators
+func TestParamsHash(n int) [32]byte {
+	ret, err := TestParams(n).HashTreeRoot()
+	if err != nil {
+		panic(err)
+	}
+	return ret
+}
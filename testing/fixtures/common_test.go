@@ -9,11 +9,9 @@ import (
 
 	spec "github.com/bloxapp/dkg-spec"
 	"github.com/bloxapp/dkg-spec/crypto"
+	"github.com/bloxapp/dkg-spec/crypto/bls"
 
 	"github.com/attestantio/go-eth2-client/spec/phase0"
-	"github.com/ethereum/go-ethereum/common"
-	eth_crypto "github.com/ethereum/go-ethereum/crypto"
-	"github.com/herumi/bls-eth-go-binary/bls"
 	"github.com/stretchr/testify/require"
 )
 
@@ -63,8 +61,7 @@ func TestSSS(t *testing.T) {
 }
 
 func TestSignNonce(t *testing.T) {
-	data := fmt.Sprintf("%s:%d", common.Address(TestOwnerAddress).String(), TestNonce)
-	hash := eth_crypto.Keccak256([]byte(data))
+	hash := spec.PartialNonceRoot(TestOwnerAddress, TestNonce)
 
 	sig := ShareSK(TestValidator13OperatorsShare1).SignByte(hash)
 	fmt.Printf("%x\n", sig.Serialize())
@@ -139,7 +136,7 @@ func TestProof(t *testing.T) {
 		Owner:           TestOwnerAddress,
 	}
 
-	r, _ := proof.HashTreeRoot()
+	r, _ := proof.SigningRoot()
 
 	sig, err := crypto.SignRSA(OperatorSK(TestOperator13SK), r[:])
 	require.NoError(t, err)
@@ -65,30 +65,30 @@ var (
 	TestValidator10OperatorsEncShare10 = "9381ab4cf226c56fa1f67693ebd480b37835fd613d54fe927696e5194045142dcc421cf6371b33e37b381a5ad7f315bbffebe3edd73a23087996875093950e7382f29fcfff6c15c1b3ddfc9b4a419843cff563901d686af0e1938a55b8d0883a67b29df320fc8b8b2191306485ea5112044b7d92f9353d817c8b92b7821bf0efbaaef2d0bbd9991f9f2f5e1ef10ddae85f8aab4c2a8cab46394bc8dda44806eb09b60d7b6068c31717fd6cba1a588613710b54278024221c3da16d9d1c0279729a713a43dc59293fe4ef71a1a4deec205df092cb9f069e1bbe17d298e9b0e62bb061f888d885ad40e27778910d3e133e81f8830feb4bac28ae84d0d495d3523a"
 
 	TestValidator13Operators           = "11ee4a9a6428299afea47c428132b403d07a15a570d2df104b826ad88cabe46d"
-	TestValidator13OperatorsShare1     = "5d29b414466a1457f0eada547b3b4cbcaf66d491b429f4fb4b6970510c482524"
-	TestValidator13OperatorsShare2     = "25f6852625456f417bfba9d32f206206a66d2d88e2c0298b2bdab8545381b065"
-	TestValidator13OperatorsShare3     = "5ae2a4f2e1bb15fe1afcb3f3bcdd37d08c24ed5620c53f93851483d79904e0bf"
-	TestValidator13OperatorsShare4     = "2a190ca0a25f2575eabf87c8ffcf9c9458ec6d9aaef22d4f2b073801cf6fbf2c"
-	TestValidator13OperatorsShare5     = "1b0daec98e5de881f4560e0315de8d4481c59cd0e77182fa5e8ceccec6aee9a6"
-	TestValidator13OperatorsShare6     = "26bbb20c3b3f7b1dfb714dadce1cbe5c95db19de5f894044de41c9f487d17335"
-	TestValidator13OperatorsShare7     = "42c13ef96d749c8c90b4b60bcce6c44a81649056a4e510d543d4f41adeafe051"
-	TestValidator13OperatorsShare8     = "5908a322a27123df72fcfd11eb750e7e04c23e1cb4d097389ff9cf9dd47b6ac1"
-	TestValidator13OperatorsShare9     = "40a0760bdde0b1db64794ee187e246e20cb400f8145f6f758c7410bab91e5aea"
-	TestValidator13OperatorsShare10    = "53af43900b5a7936b3903fb7a9170287e6a47e7e5aabe337f10e73533f4481a0"
-	TestValidator13OperatorsShare11    = "2600a9351c7ec805071987221185dfe41dda8e6609fc45297da406621febb500"
-	TestValidator13OperatorsShare12    = "3d5b6cbac1111137824151dbbd1e83c7f43b3f4dc190576c60beb8b2b67fa4c3"
-	TestValidator13OperatorsShare13    = "114a5dbe9a6ebc190cc2156d604286e69ff6f378207ea69307ab45aa0dc26696"
-	TestValidator13OperatorsEncShare1  = "1fa2d84f7baba258d51357cedc7dde2bcf02395bcc814e4673c114f110bacfb482a573c01b3a7c664e1aaae4d97636184bd9bcc2c3f34cbfbbb17eb8aef164c5c8d94491cd5f258e1a9d50544bcc2a6fd134666dba11b4e18f25c0f1243923c24e43b473b717e2fcaf9c2754920658093cfe2115a27f6d262e7d714003566d9bc7295da2323b168ea5404fa54d0e6c9bb601a82e657c60a437d5bddc69ba874de276e5a250c6915886c233b8fa3d5ca9607ac1a8589450cb4e63f93bf67e24d1c577bd176b53463c22e7b17539993e3e26627d7d74e15e3a983cfc99344d6afa0650dc384c773119777b7b67e1b74ada63a53f4566bd5949a4fc51b312f70cc9"
-	TestValidator13OperatorsEncShare2  = "108bb226620c828d6c0d1e5ff5cf1e7618f23f5d9667b34e8d664d18edc6c5b4dbc7a221b0c27e4148b49568a6b3504939d43905405cf928c0d4980fc536061c53fa68650e6bc37ced31b3cec49677cfe96052b2c754692feed6d9cc38c1e911278e9300aff6907228e6ad23a374a55b7e5c3788b9b08aa50d0e86aad284df118516065d9aca9ffbc79f46e17859edff61afc666b9e5c9ac472b7d28997edf4c510a708a131c54dddf5d86092a4a64223d8c714645a7a04f187c7e9c4beb36e11c00fbe2c55bbcf669eb34e396d91dca4917e1272330fd310705f73e9556439609b9d294311a1fccb90ba8718872a9f66e2d331957880a5dec7a2cf61214a163"
-	TestValidator13OperatorsEncShare3  = "aef1f7abf59c98ae00685685258433d76c981dde24c6bfb2a6f818d92c5ddd7d6bd14f4d90abe3bf697eeaab1483098600b82c6dbb459d8a289ad0112c064a427ff9a4b5b5a54aa23d42c810bd2ce2b21dd2f02578095eaf78534ddf152b58a60127ce01cb4f6e484a955a71f2303e8c03e76c97072d0f32f11c7af020556fc2fb3f15203cffc03db23358578815b34c714e2f5728f88d992f16b1c619a76094c35059b57826288159c7df1f37cd1c703747f06aa07aa6c75ddba6b5aa84d0ad29c3804ff0d4d24365f34cccb8d7fb0bead807cbf59e6415f9c879d797606b2e80cb224fd32f00a73d88de4e3ba7236ffc89e9931115e103d3a2c6a5f76a824c"
-	TestValidator13OperatorsEncShare4  = "1bae4bba445a3c75422f40da284f5e5c5f0d124e0bbe1e165fc571038cd7f24e3c0534f0e557a22feeb5cb91378b79bf99acc0de903aaf3c7f257f3c920b84dcd02557eb9868bce6dead1c714e8682385ee535919c40fb51ea6f85388c64be8e714d47d00edee5355f3dfd3ac319592891bce8685265f740618ffeda9a82d230889ad4b7343f845717db683bc38fcc5df99a729ba8c1fb5db9dfb7decf3e1b016256f39907ef565d594f2baf75069a4949b5d26b3c2f2c5298063beece22c738f4d3cbe16e3269b4eff59457c1c7bb965b0d87852014cfa9a0514b84df72f28626c4b63ee7d83bd0f42abf821b8b6e01e6eaed5538c5a07402e4de07b38630db"
-	TestValidator13OperatorsEncShare5  = "9f99fa23b7b8a33edd42f4f26c6a9f899a0807aa9fbdaff1f4857fe0a347b0dc6b0fc122e1cfa38094ed8ec81c29c780ea4d315e9f8af24318661009aef3ded4172a6dc742e489aa7989e3bd89e5cc4f55c1ebda6c070e7c3dbd1b58b57af6a3ea7b7eec7c58c0d4fedfa33e02beede1b91e976d17868125fbd6ee0dd002ed9b0ad39cadd7e7fd7a3fe068783c867b895f72ecbddac6e7b5035d922b074b4d73db2435f96a7ab1f19412066a9dfeedad9d34244dd82d87fc8dce3a37b2826896f690b35d516e3d4d148de4397789c148f110c36bc7ae553f1440b47dfedd35786263fe3f8a9d4783fffc311b8c4152a83d63f2ffef2ca9d8313ec5f5044bca99"
-	TestValidator13OperatorsEncShare6  = "9cb2f0dda0f22c74f96dc4fbf18df56c4b97d3616f8065b900cb19f559fa799ce2025abdfdd418a3162de1d896fbd805c87e3184f595d1ac5552cdca3b0a233bbfa0cef5435101f6c71ca53d3cc6208b36d7344cfb4fe8f35ea09c56dbc9c749a9d701135b8a412bd09bf9fde095cc3e233a2741b35e7ab050f5777580f83ee9f0458a6aac498b7d9f0fc42a50f989f113db4c7ce7e18547d465c8e1ff799b43cdf514f67aa69e39e599572d949e512ff3c5bb3c1696e48fae3dee27a0eaf43578dc445f804a118a12c14340bf0e85c6013d32ba07fac26d2c858fd5a07730ff0b1ecd95400b22aec2e37c99ecad031b4dd49c05084250d8a011b7bf1938e25e"
-	TestValidator13OperatorsEncShare7  = "058057483e282a5fc7bf3cb92b2abdf5498069636a4b4d245e8ea5816d48b38f61af83b084732cfe4547884e897a1176bd1f04a22a6552ecabe4e8f15c366590a9708c1e2ff93d03cdb5850ddc74f106871ae8dbd4e5ac20b0fead40440e3749b0fe8a129870feff8058d19e23192ba5e357320cddb784cee31dca899347b8601cf9ed7cd257e2c4c18eaee7c3c971737f2524a63f5de25b77e483b91abe064c07126a57b5a98945123d24c3b0ceb3832f2fcf10b2f8dc29de70943dcb121e20942d85e7e159ef9d8cafdb1a086c995eb09b2f01fe50f5e99a70325d85d02f1fb6a37f3f41b68da1d2f1930342f1741a56857334f6ff287bdd104a5b71249350"
-	TestValidator13OperatorsEncShare8  = "79d6fdccb7726e2d49f5211b194907dd1778355f083fd1bdce0860ad35d37f801594396db672c381a6b0c71ae6e43a509cf0cb8a6b881fa4aa6cf2a6872d7de173954a02af757c5ec6bfab9d752475dfc269982ef98ddf636207b4031cddd4783b98fc0533bd2309700853f72d7315f916dfa47c45298991afd2958f083ec4863ab57374cdf0d9659f86ad8523b0fc680ed72f7239d93a060624e06b6186748f441a48e5e7cb3a44a9cbf376e44d3063f54445f7529e958bb9ab774968e6db7a360a14598f70cef00bd2d2ff2477dfe9ea29b68b2ed92d1394ec0751030f8aef63786049e1dd98b8f2462f1e459544f9e5b1e78164fb979f8efc452400b66bf5"
-	TestValidator13OperatorsEncShare9  = "84c05a478d95ff939332dcdea9e588cc34e8364c4d62ed429250c29b12796f824c5fe768a955b13450da2c6c16f45a2959328947e5e79cfa29eb4a784f1acf9e64946a9ebf48cd6f63ee9a46d43cd0fb27195ec884ee6176d58b26f0ba0be8997a37fdd490cbea33f1fb8288872e81e6f7856d6cb2a230b7436efd6909a16b155a6014f1d339b74e00aa44a69c41c485683898e826e2410bb2fad735dd591d12f68be73ed8ce15d3372a521ec5aeb060cd979d6f0c8cebeea6aa617f387a8d51eb48373d9129a9de98067b08011b6dcc5722c6f8bc61969ab6d12ddbc63f6009c4d4a517c06336a09a168fef035f998ffc468585e1836a8c01239f74be0b42d8"
-	TestValidator13OperatorsEncShare10 = "523e5f21bdfe4c4382c2cf05614a93def6118790d2332178fdd8656866cd1ce0d950b3d6d69b83ed8529b343f21b417f47c64e43aa18961363c5c5242515d8072b26d2a00da009c5abb09c88f7869abcadd6a732357fd80e9d04a7110985c0737e02b0b81237ecec0dd53eb4b760272cf889412e75b08a51049447c27d448448568ff81933906b0c0a227a9d911aed2199b9f4b167884ceb44890ada37327b7bfc37eb86c632ec940da3a6e41d38ab6e036bcaa4963c5ae4ec8281b5aff854090becc100fdd342e3ceacfd69326a785bef038d2903f38cbb9300f46923ed70228233a4556d5d6f88441f14503f9c04f33bc56c55c46ba8e91912990b768e841f"
-	TestValidator13OperatorsEncShare11 = "6c2a97a1ebaf8d4ec4cf25ee93f2ee4d079f43f87096244be688acc6a88fe9287190e376131f7329b31bc9ca710cb6d71535f545d02ced1d59226b0c30d79987b8761616b9afb2bbdc82986999763b950e8e8bbd2dc20266cb3a09b832e50ed1a80cc65d1b78048a4696a47114241660d0233a22da6ee0f595e1959714f6a6bac23011e1ab2c9da55bd4a38519874ff2798f64709a8b69ecd297ebcbc9652ec2608de3ae84d7777bbe2a5f3a160b618825ba31cef869b5788a0444018fce49ab1db8eba57fd94d0dedf8845f0221cd28c8ec4cf80ec93a4655dc1707c89b978fba61a1b86dc6438d9107b031e9db78da3c9c3bf4cb2c9ef07d1115f9f05e9524"
-	TestValidator13OperatorsEncShare12 = "2ae07b9f40ea47caa7de042f23f5442c8877bbde468bdd3c53ad6700640aa2bfd30af263e07b90446e12b30a3fa2ac19ec181d4bf36ee0ed8363e372dffd3192cd05714ec8bdb9bfe1f3c63433263a0b0dbf05adaca8201350a5df9d4a0d794f1f9c1a8b722238344df94ec64498c78d58df1ccf55bd33ee23ee1bbd8baa6434e4cf34d7cfb07283491b4aa534ea6091b79aaa0495ad68a5af14d5ea45d99844796ba36b350a81e9d73b31b2dc29b5ed6465f40085dd7916d7c553d5e0a6570ce752867352ec85b68d56e6c5e2560ca6a8ed4e847fccff1c034574c571813d10522b917877be73ef8f6c97fccad524e0f0dd077508030d8aef9c68faf97a8c5f"
-	TestValidator13OperatorsEncShare13 = "975c7c8c3bc40453cf35a32003d0f84b9ce509fafc98974c9a265ee6747c37851d299040383f715edca8580fb2fff418f815a5616fb0a41bcfb8537f0f9596b6b5cb34a05ad1c0f99a7003b3e2053b901615786ee3e7fe6be3cc06cc9e68c0491a1b37caa4d0558a0161a461e104b03e42d3b39d50a987b26d81ff2b7bbd82dacf758b18077897bef99cc3e3a429d7777eeb2092437bbe5e84877367c5fa19a131262527bc7083d984dc2a136d6004c2ee0e8ba80b5c9fb9ea49116a0359118b6b3c66cf70aace1fde1f5d1af396a24e7337799bf1e8b61a92c09a41c5a9a83bac0165dfb61cb98f4d6f3eff4de93299947eb1d98c68b2d481e4e670b48f9ad0"
+	TestValidator13OperatorsShare1     = "1438acb23a5d9b4ea6bc3d6ad01fa09b420912e8b051cbb1818b9a7b81f4011f"
+	TestValidator13OperatorsShare2     = "685de46c32dead20561b862d976f747439d823482132bbab4df3e9737f02ea23"
+	TestValidator13OperatorsShare3     = "26d70370d77a17feeca348dde030f769141ad103cd16e6e6dfaf68ef5beb043e"
+	TestValidator13OperatorsShare4     = "40b4d22bbe5a3a746233a1cd4485b630bb03f60f72fa4cc55047e6dbf0e19e41"
+	TestValidator13OperatorsShare5     = "05befc2d375cab68fe4844d42bea74c4c66d06ee43f0adec7610bd2f48dd2a0c"
+	TestValidator13OperatorsShare6     = "2e2f256d5629c35cd9033d2070baa25871679069ffe3123f16cb0c7775689e47"
+	TestValidator13OperatorsShare7     = "1c0d594bb72b7bfcfc8ba94fd1dc050d477a1c7bce129991d2d4d1701943f52e"
+	TestValidator13OperatorsShare8     = "5dd85889086f63f738090c460b827fe47249f6a3e3f8e65234ae8f0d36a659ec"
+	TestValidator13OperatorsShare9     = "70cf52031c21cd49d3c206853a09cfb388dac2adc492ca5b64549077453332be"
+	TestValidator13OperatorsShare10    = "1ba175cb2b78582fdccbf1e137f17ffb2f6710a499077ae3119a102b0aefd32b"
+	TestValidator13OperatorsShare11    = "499643e5c434b180aa421d5627185b2b064fbfb4daf3bd5c3c65db6a2ac43e6e"
+	TestValidator13OperatorsShare12    = "7369857a8eafbe852a4bfbaaf55ced2b6653e10c3fc4740295615305d24dff1d"
+	TestValidator13OperatorsShare13    = "4abca68338101a5da548e5121717bac246851f5b8b8d7181455bf4546808b61b"
+	TestValidator13OperatorsEncShare1  = "5374666e6295f9bfcff0d2ecf81afbc42c1098599bab1fcaeb3785d8ce426c546e771f1f77c847e6759935a854c532953e33404d5929439bffc9615674206d4ca0b538a887b6f56a156c329e10f351bf49279d496dfc2c4312e6ef24ae4d5a5393890cef16014abbdd79e71d15bb3a26e1777db8df38bd5fb676e60a182c9366e0b3922d4282a95e63ad1f3a45d431dfbe107220144552ce4346bde57f0fc41dd09ee16e0135a0251598a2d4294cb25ef1201022ddfeea00c37ca8c65083d44e1d88bcae95d1af67b21410d3edbded425c0b4c3f5aa6b3199ccc1a8f6f6a796a56030769e3458bfc0f44bb2b1b998299c8e111c98625c56af24d4c087425745b"
+	TestValidator13OperatorsEncShare2  = "596d63271ff889d4de86ea37679b5447c1d60e04158198ce429ad531c8b2922c237bcb4446f10b0180e69a6c8a5a9c3591da0600a1f11ebe7687cb1a8cca4d843c3fe4f5325e2f58d10d6a35d1b7d164a1c9fdd799b4f1b8e1eb0e2c9a7e994e824b0226dce50bb29bd359e562cf0443aed49f451badb30ca12bafa07a0ac5cc5f0e08296d553a8f52aac6e7bab6629e464b133630f980afb48e35eed59e2ef150ae56023cbac81d43198fcc207a7589cfa2b1ced1e310c4395f3c9fcacbd7eceea15d692f34bcb6c1835a06d8040bb4a57b829105055ba7f363e9b900e7449d06f1a763f873e9bba9360f9e01e451f9d10740c59071b478178a0b91bbd6e7ec"
+	TestValidator13OperatorsEncShare3  = "5a5a70df5d246cb58fa9a77dcce4675f220c2687fe00b95adad893ec6e9e50fbbbddc399d892927836e35603ef9ce839cf2e95fc49ca05bc0a835f2aa83f1f6f49c72f16cdebfea9f1780438d84267afe9485ce0179f22a3e228085c3e86b9a1853c71fb3b6263c48f8e88b3872d43fcb3cedf44ba72f9b92d8a423ef4b68b3ba20515b035e9aeab606da6e566632d53f083bfd388bf301ba921fc0dd981b2dfcd065a06a2af6c53da6a5cdd4d5e2a762f7b4ba386c2124a2f6b0d8931651dafd45893a0bcb6fe676f7e6d2e7b34b427b15f08e73d57a21929ffe601d84bbae2ca825417567e9c6857d09d2a3e37fb982fce6f4e54869e646e0d2cc71944cb1c"
+	TestValidator13OperatorsEncShare4  = "3a177d1291621af1cb86cbbef19450c0c1ea7096359898c77892ffcb5ec7c9dd91bdf3265c4052e127ffa71c4bdf10859ffe6c27608f899c4ac44059df37b961203daf443686bdd60755043b1df3630a4720ff3dbb96a9b6ca883ccf9ef9f06e26f410cb2fb1c0542d94f6fb9fa65629363c73fcabf2f9efb54ba0ff135a22cfcea0e60feb5ea7583ab48b9acd6c72bf4ac854c3b2a3da3a31a65013834d29c4a7f20f6448f62998b4714fd9e8fac2cbb22ac9255ed0e9c83c28c23cdfd420b76b28616e412c2bb400d4f02cc9bf83793c54e76c2b27cabc6e4181b204a70905b2e17369e5d896dd5846099ec1875c0d94a89e2dd9a0f86410c0c0281cfccd65"
+	TestValidator13OperatorsEncShare5  = "a3db8dcf72565033c8e3533cb96a6dd9c7e3e55076551eb4cb4a3432e62d80f4c49257a77c3dce1ff414e6b82a880cbca84ec41e3158944aee80ad3728d045e889f444cae5ccdd2260749c5358ef3e77985ed889573717273e1a428dab9b339e563b32904fbca7cf0b8d146e60c72f9c9bd705f9d459532b77e47cd01e63e1c294bb2efe1da728f3b2ae396cfef2ddfba54bb8f2299e334df36e7ba0a023b848ba839b7e67218faaf741bbd87432da2dab9357b55085a523e68400db0bf3b4b282f18deb32822f6754ea7b501b06ae70c77ba6044aea8158bc21f3b1074cf425a9244717808ce17e15688ae430db2a75bd441510685c5f3ce2b41e13eb96f06a"
+	TestValidator13OperatorsEncShare6  = "2cb806e888154e7ce1eba96f20738498be8879a8d248c918f76481ff15ab53c7fb0398a370fb6acc4b2832711c9e55a478c76bde3701769c2c88b1ae6f3b7ec16213a630c4b2014680334b537a838d3172755f91c42310fb8c680730107a7fa1e3a51f18a996e0261c6b382db9a6b283fd92f773a189dbd9840df820b7260c8c764bab9b0aa300216449f1fb084f0dc4cb04d6a60ca693dbe5351be00e22ba61db8d9ab51c07dbb1ca4a0b3ec5ae68dbc9cce67820ff69c7554263d73b10db983ea0a34ab5eeb71e216e6515982614b6eb1ea5f3e0e44617307926fb60529fbbcbf8844c910157f97cab5ff271003ed26f66a30466961d1d2cd9a9a77431c28e"
+	TestValidator13OperatorsEncShare7  = "4ff0633021ed38bf318cdb0859b56ee3bf58e998506f9037291a6a8614f8b1bd8ff4d75ae1c7b867797a87288e75eeca1797f6c095d89fa2e287c99318e1510e2d4b1c35036cd70c789d6b61b41f957f03a849c007116c977b8054392f46b4f237ff0468c39e1c48b8713d42459a15ce6642cac0fde8125c4ec5e04659b4f87248e73b14622a55ce2bb9510513da30d1d0d625d43929d7d7fd5920dbf110afc66da9a5a41d2edaa944fe59017165b208f98dbf3d53af66f8bb1078721be5779300119436ab7d9a998b681201cac640af0ed24f47b18dee29e87db04416ad5464c1309ebc00c98f7f5809ff81d00b57c9618c3d1e0b9c9eae345bf4bff3d7ce43"
+	TestValidator13OperatorsEncShare8  = "582f1d9b125fd658b114f837086c209dea5a435c128b6d0f78eedb765186cede48b1d367926341baf58233b3bd84638cf9aadd4a9c1a5c585331e74eddd01793ca1096d546367fe0654ee62dd5154e6c4213396feb41fedbbc7f027ecff5c7e24598167cd5ecde7267b62e3870980c4176f963965b8dfb1c3ee408ebcdb0334dc3c3dc85d78ac5a8b3efce77a781493d9c8450077691dd42247117c0fb5cb7f7864acaae7e919c5f28ba6ddfb54a40fafd64b7a34ff9153fb65fcbc6240787b35847975d76e6c416ae472dea5d970c9c9c87906ba10be51477be5751f75350a22be380dfdecca1b5555d165e591d6f4e78d1408824fde5f9032d0e3dd875060d"
+	TestValidator13OperatorsEncShare9  = "9f28f52aa13b12684b3c20eec71a8c9019f4a13a8b09903dd8cd5b37aa5b6e568f9963216fc9bddc465db57e5fab2a39f6e16ea6c37f3da0aa634ac23fc3488488a4d6a0235e00413d44c042f0253e42e5fd75a2e451a2984932ae890eb481488f11299be4dd623a3ef6bd07bf2dfdeb9917620c4302d209963f75b2f2d61d517346bae094ba5a05f4815a76129f7d90cbbb7b5a39e6789fed9822ac5a68cf27db00eae02deb5d4718071a95d495ff03e5be19a0162f1094458d7d41534be14207af0e142feeb5586dbd0a4158bfe523c84f79618e01dd84559e484c7f78cc3f5e3eadc006235df9cb008169d27df76597a41f953b29d3b3b417a2c12ac7f5e2"
+	TestValidator13OperatorsEncShare10 = "4b92d10b4e2de3a8e9df8c0f6957ab872dea46930248095918cc1ed4bdc8cac99d83c690bc3a0c81bb8ee9b01bc05284012c16bc6992a4c80989aaebb0424869d16a4324188edcb2b5f2c0285f6be24691914f3f1077ded753cafb52aa7b64feafacdf8ea64dc5cfb7b2cee52a29410ea545bbd22dd4658ff1d14cf9553ece90e56c676f0b8cb636c0a8a1b2ff7f85ba8c20cba3d9517098e5f0e52219f5d981bfa847c6b127bff263b6a4e64727f943c4fbf1f484f0fe539be0313e24b4c992c891b60e91dd47cc1e087e999d9a0e43c8bfe1696f0f9df6937699e3abbfd2f66fd45f64349419278265d99d6c812602efebfa8ba66ecfe8628c283dad3871e9"
+	TestValidator13OperatorsEncShare11 = "61dc9bc542b4457820a0e273fba6c11b5dfd1a62fde3aabb2aadcb8f489bb22df5482cadb515fe69a6d9c267cfd7ab01f9dfa8a839496568411bb0233e60f1be2dfe54cbf9f000253147befe2b5791555243cc621c68ea52a484ef97cdf18f9b96d4b81049e392af8eb8c94e88edf0889c8e4d934f134abd6948c753f0de0b6d23816c12b8c15e32156349da794eff4f668c7adbf738f16e530ad14fe77c89dad3a6cb3127e54f0aa7ff27a67ae64ffcfd59ca015927ec37760c85f42205103f093a11c7541071f067eef565448e5905dc1768990523673fcf16fcb70a97ac3565af04828c32d8c46f332a1fc48ff09445fc902032a990d261b01887f8a09d3e"
+	TestValidator13OperatorsEncShare12 = "2b23970f39ef3bade3379b94a9183c694f9e33c8483b5aea01725a3f0a1a56a41f3c96cdc80262b2d962039a683a88cea4a60e0b67dc42c7a5039651d529299f374d8e7eca445470cf40cda3a07c4a3e4b8b9121f431b4303a85b0b2458378c8c92c72e5800a1e41bc8b60026f7de36107de43c4c2af6450c0d583535ceac948a841938a507d98b4e9a00f0f5452f81b1155edddca559f9b0c033568e004a6f175064c33eb9ecdceb8868bb5baa6ce4ec2965b9861d83c3d09428e7ce4f3565df896c52ae6c278a348dfe726ab3fcf37af84e33ca426683ca4d1d7bd471460dfed354d444124c751cc2524b7e29010c942d69996147e4ac4c96d5d5b09da8c6a"
+	TestValidator13OperatorsEncShare13 = "06ace160f4b6643b028a1daa9d3b7b888107ca2176d97a2e41f966b499ac0c25ee8317ad81bd5a1d365c2e3f60ce5ce843f1a69aefed9c802c46b3bcbe3bf9b68fbedd66ee6a441af5321298f226c17879e32640030e871976ee4b9460bd3b75e46844e549ee75433b5a9f1a8fb844e822891c6f218a2e65085bf9b03069ce6d0f8a2bee4c7b910d9941989c3a2e24ed186e7ec15cdcdd2633e095474bd557a33b917ddb15c8f6a1c37f9a909565c9f0e8122243b03acaa139ad8c21252a12ecbd94f87851f576b2d22f7232be969778145848c2a528bf364ea99bd48b84837a30a7dbbb1efae3c0f402b38d733c2eabe06c88e721cadaf2457479f381587bfd"
 )
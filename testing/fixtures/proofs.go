@@ -9,8 +9,10 @@ var (
 			EncryptedShare:  DecodeHexNoError(TestValidator4OperatorsEncShare1),
 			SharePubKey:     ShareSK(TestValidator4OperatorsShare1).GetPublicKey().Serialize(),
 			Owner:           TestOwnerAddress,
+			RequestID:       TestRequestID,
+			ParamsHash:      TestParamsHash(4),
 		},
-		Signature: DecodeHexNoError("53f81fbdd1240146d6b9d32ebe90145354f7bf528e21455abaca97dfa120984544d0068ce06b8cea4893fe1ea9d99754aaefde2c94dcfb53458331747a5464e2eaa3397b1211cd0946fa3d2fa9157350597bb1a19e7fe3b6709f0c8728ce9a0e0cad269cdc84cbd5b77e8965649ce7286b7da3c6ba4c6e323f242af53a58c0094eb9e715fa9899ebffd2a44c12b86b149f4a08a1ceadbbaa8031980a75ee04f11767983308bf45d8a16120688d4406729380a0e45af6d183e43deb8736167175fb5060840f03057b3ca8114258f4dd42d809a05c41015d4e25be61daa20f28844872a2c8b04743193a4dc7f6bc61e9b8d0efd748651fd76839a2a9576c3644f4"),
+		Signature: DecodeHexNoError("1ae544a58d3c6b200121d0a5adfb86b970bc3b53021d7a5a509bdb69d4a2cbf1bf94b387e5d2cd1575ab4d4d024adb10b177c49b4555b34c450986d1c6cf94b163c9963a707da55dc83848a7615adc4720a7c7f0e3572af55bcd0ded630ed474e7742199a3338aa4cfb5539ee25096892f759e0e4dd34141006c8f05828e0e240e94a85039263ded13c2a83b006558d28f006fc697afe5e320cda907763dd6431ce3f007ef3ef12e49293dfd7bb3a5ffb61809e4c9409240cecfc11c61f439224ab961ca49285b79f1433d22a838ac1a6437c28fca12ef3014e10d1bd0712cf158171dc827f8e4bf64dce526cf520abebed9965320f58e25f7ce65d98568ea22"),
 	}
 	TestOperator2Proof4Operators = spec.SignedProof{
 		Proof: &spec.Proof{
@@ -18,8 +20,10 @@ var (
 			EncryptedShare:  DecodeHexNoError(TestValidator4OperatorsEncShare2),
 			SharePubKey:     ShareSK(TestValidator4OperatorsShare2).GetPublicKey().Serialize(),
 			Owner:           TestOwnerAddress,
+			RequestID:       TestRequestID,
+			ParamsHash:      TestParamsHash(4),
 		},
-		Signature: DecodeHexNoError("a4baf0fa5e1759e6bf6eff78cb69a1490d7f707b1eda653a71ff6c3cc9890dae3c9eda30999bdcfdaf9154acaf262a6690fbc24104933c0c0d30ca03cffb5fa1ba9b191ecef8c3912a1d482b7df99d737a82225ee2b519bceca5cab9cb83db92e5697bb0bfe9d0f24c9dd8d051240dc19f9c9a2aacbf0f97b99fccca0e33aa7005e7231a120bf6a8660d79fd92343ab4581c1184e3fd50ea40823196f5d4d0ab02fd4012f7b903c9abd1ac2c2b478c49de2463eba6a8837d7effea191fb7a42d112e93f051e2abeb60a8d9277ccad00ee72e9aafa7ef893cb5397c46cf2dbeedd82f933057c9df19bd0e2f659b5cca72aac2805f255673bb2c6530522b6a7d64"),
+		Signature: DecodeHexNoError("b35e22b3d44c98b12d53fa06525be0b0538534648e54be1221294395419a1ed41c55b8d4ef44990fb3117514b98504a47ed3605fff76cabeb034baa749684e6c7d34125dd8de19e8f7a9784b38746cdccef05feb1648ee19eb1edf5f2e0324d164e8fb43f88682e93ddaeb0ad2c5d3a50b2101e192c19c3748880b0cbea3b20dc3b7f7469e57cd30a076ef2e608c9f0188c95d63a5fd2a3f6fe91d6a413c2a2d27fe9fe7ea5729e29d5f19c85520b95d2b840f80cf611b5a5793fad5a9e3236da94089f8219aa911d42687e961f5914bc0d683e017263267fd4779923b71db5824cade3641cb9ecc71a1274d682fd5668ef6b027d2481adca71f22260558d982"),
 	}
 	TestOperator3Proof4Operators = spec.SignedProof{
 		Proof: &spec.Proof{
@@ -27,8 +31,10 @@ var (
 			EncryptedShare:  DecodeHexNoError(TestValidator4OperatorsEncShare3),
 			SharePubKey:     ShareSK(TestValidator4OperatorsShare3).GetPublicKey().Serialize(),
 			Owner:           TestOwnerAddress,
+			RequestID:       TestRequestID,
+			ParamsHash:      TestParamsHash(4),
 		},
-		Signature: DecodeHexNoError("8aad5bc23a85bd8b7e241d3b65cb6d29c27528d5bebb57f8b73decfcb52572153679a05739b5e42711d478d23e4e058556fead4d891a62bf4d8ffb082a92021b4f446ef876300017936aac46b3cf734fc963deedc49e46117d5d7ec6a95c4dc8df00b3af92971c7e9b5c443368887f536aae078493d2bb48dbdd52b13c2c4e85a1276836e0742f01707e40102702f6ded7c604d1e3f20d86a8aa2f983059477376cc22377bd661d60b786c7687d2203eef0af15a6c8fe0079565cb553ef0b89ca4b014d7100f3b56c3f875dec0fa7497ae77b7dbaae68a6b0b4a2bb53064d9d0ec28b1e2086aa11beb1355073a15e7ffb04aca5644ef7dc7c5f8addb0ed1bba4"),
+		Signature: DecodeHexNoError("bb3a07941bf64e02a1d3544f3b4a2c4a57ea494bfb1628ffcb375ddf05dee09778b95807f738d97cd8e82a9e863d02055f67bd112c59faaa665a2984bab0e7a6815f26ee12e92aee57786eb945615bac52555c6fd1b588d22db3a1a80be2efbd82c9e358520a48e6a1fd8118642e650d8d9ad3091d4d76caeb5246981511cdbe65f992b34579d9295a9c40c5d0794aa91dfc4466d44225d0d29412d3b6ef55a1463a0b56cd6f532b0f6a7825039d7876782bf7ae2f67724b142ce6a9291fc4d60e4c91850395ae4deb2ef5b4d26fecd897d7c20a0538e0ebb1c2b1bd396af40d38a0afa3b214f5b7b91ef266c27f52d8927d4c4d548262f704768d9f69a56558"),
 	}
 	TestOperator4Proof4Operators = spec.SignedProof{
 		Proof: &spec.Proof{
@@ -36,8 +42,10 @@ var (
 			EncryptedShare:  DecodeHexNoError(TestValidator4OperatorsEncShare4),
 			SharePubKey:     ShareSK(TestValidator4OperatorsShare4).GetPublicKey().Serialize(),
 			Owner:           TestOwnerAddress,
+			RequestID:       TestRequestID,
+			ParamsHash:      TestParamsHash(4),
 		},
-		Signature: DecodeHexNoError("c1615a2ff332059035db2baed39fc3d970b5ae896f62aa7addadd5d4b1f186f7cd3aabe5b1f2d4f95ff0e962b463aa1b8f3af83f49b809e805c2b42c12c79d66ecd540b64391fc48bd43cb1bfa10b47ff33f625b26fe060cfb116b80b1c1543602f26c576d1a23f1d7b7a566ac04dac794e9366bcc0bc91dddc7523604042644f99b35423e7f133dd07231d5c11e6684f0b429a6e9de3188ad05984eef4584bd8a2cb40d96539fcba87420cd013fc0d2cdb5d1e57df6b54ef03bdc8def1e7b33d52b47dc13e8562a890e761eaa6977903d29ab9b2833eae5f64c0df6411cda154f11e8a05aa48ad34a5f7ca2536c8c25daaf2fca7f08795f474d49cff065638e"),
+		Signature: DecodeHexNoError("791714954ff3141aead5f503b73e6798ceb313e3920bb4570b4b9a9cee8ee355eab2620e1c5fa8a8f2566eaa117a9d5c6c18b40a808e416f5a8fefbfb9d4d130aae2e10ffcca8fe130fe7748f667d1f137e33d912f062687bbf4797eae765bd487f26a8842b40b9d88daf5223017a61172517a111fcdcf7767d46c0d362591b37f517d05abd0d3f220f6b98a6dc5f27710106c866365bea297cb51925fd48901036a33fa6e12ded04476b0bf21bb16b8691cee8d04a75bb57966b773db63a209bfc44a7ff16afaae91530edbf119ed6809468fe9ffa43d4255b4840ff562fb26082101939779f0dc9432d93f341a84236f75ed2d99a1e554decf555455076095"),
 	}
 )
 
@@ -48,8 +56,10 @@ var (
 			EncryptedShare:  DecodeHexNoError(TestValidator7OperatorsEncShare1),
 			SharePubKey:     ShareSK(TestValidator7OperatorsShare1).GetPublicKey().Serialize(),
 			Owner:           TestOwnerAddress,
+			RequestID:       TestRequestID,
+			ParamsHash:      TestParamsHash(7),
 		},
-		Signature: DecodeHexNoError("aa05e51db4e8f130037843247ff0495c38c8f30054a5fd19ed47cb83be8cf4f81aa1d0fd6941033f5764294d67354b8bd6a950b70236a008f9412a2265589af46c1dbfcdf2ec38c74e129503f5ec3c587b389ef82c77ff24b28f125e4a134b91b38aa6db87c4a5a52680cdfe2e980e3ff925bfd5fc87ba51bdbe78daa6706bd4404a1fd2cea96756a4a0d7c97c1af4018cecf669f3aea14dac6f72f49787646b147b84cb0694f031ab0095d41f830bc2786d5e1c9e7f021519218e60d37ba048cc9a0f6e35a407df0ef8204aa41c69de8a81ec4b6b23dbdc93c663a5d7028794814a7387d9efcfdaf072e9102a662151c2bde2b5541f1192817d1519d1949566"),
+		Signature: DecodeHexNoError("8890df1477dc4f9b6aaa534aa686fe7e060c8af16161a28bb641b87e20a458eeb10b2aad3514a820a392c6bee14a23f02e0871c7493e80024a4cff2082920f42e73e2f8f7863f74b070c1b9fc4ecdb70eb915b802f8c4c42740d491ba53767bc1ff02d344ed0ec9801c9b27469925050741e9a8bc46bed619e117f6da630eee55564d904b68a806d793028e6d7aa1959c9423bae5b9e12ded493791b079da950efcb798ac6021aef726cbc838ee89e9027c100174fc5b973f293053ff4b6b9774349168629a43359c55f15a48a8e7e4fbf7917c684d22135641de4aa8b2d75f690d3cabc9973b8561b5efcd0e609d502397689a6800013bf8ccfc236441311f0"),
 	}
 	TestOperator2Proof7Operators = spec.SignedProof{
 		Proof: &spec.Proof{
@@ -57,8 +67,10 @@ var (
 			EncryptedShare:  DecodeHexNoError(TestValidator7OperatorsEncShare2),
 			SharePubKey:     ShareSK(TestValidator7OperatorsShare2).GetPublicKey().Serialize(),
 			Owner:           TestOwnerAddress,
+			RequestID:       TestRequestID,
+			ParamsHash:      TestParamsHash(7),
 		},
-		Signature: DecodeHexNoError("209f900587526ef934558191cd4a6a949c8985709ceb8bdc2aeccc8319d752ea1ba3eb59a3364b8290e7b3289027417be2b67af6d363e24df00119080a98af7222d4fbb94e219bf72910cfbf9da64914948329ae639c4cd0842c5917618b62de4c9250a8eb84c7b334e52c0cf06e4f7325d59c46f305aeffd2cfd433fcf91756b3069b7c919a21b331011e7192dfc2f7acc30a79ef25ca50ffe91210e0af5c7741cbef99f0e62d3769db89c2a0c51a394d698d1c833dc45a7232d7b20df9fa9d8b1f245e9aed9fb66ee5aadad13c93a0f6b30369fed0bc1ac95b653cb495aa33a4406f8525fcbaecd9c8cf98fd42f775c9754d84c9d2721a114d9885e1b69571"),
+		Signature: DecodeHexNoError("c4fd5115fc2a9cb3a37469e6f874a680aab11b49283f3cf027ef6ea5358a3363032dec3c9640fa9c12918ecc001e9d4a4a84e4d63ec107be3c5052ef2cb589cd89f8cdae0058b1adbfa1e94950bea4b0c7584ab783db9d2d38ed20e1c6f30101f30db035da5104d8027a24221399d37745aba77a5d8d029d396904882e6521569fcb5957742e15cbacc74ac43ade3b5a68af4a712389e07830e9d5a4854bc6d7e59f4722aa6fce85a16efb5427f8bd908bf2c2eee97e39a527de43f6ec564d42dd223fa9baed2bcb33412db159a3f168fddd0a59f6521dbba251b621546a73ca08e8aacb7cc8ce1ff381bb2ed2c85e43ca328ae5f8157b14751de650858df6d7"),
 	}
 	TestOperator3Proof7Operators = spec.SignedProof{
 		Proof: &spec.Proof{
@@ -66,8 +78,10 @@ var (
 			EncryptedShare:  DecodeHexNoError(TestValidator7OperatorsEncShare3),
 			SharePubKey:     ShareSK(TestValidator7OperatorsShare3).GetPublicKey().Serialize(),
 			Owner:           TestOwnerAddress,
+			RequestID:       TestRequestID,
+			ParamsHash:      TestParamsHash(7),
 		},
-		Signature: DecodeHexNoError("93c8bc04fd0ae686c7d55eb632314e1bc4bfcd4a3f6d6594dc9c4e0b620ecfe75e2f21210d0ea92c2acd77211637feb0dc3cf7b6282903beb5970e6e5f87fba86a737bb91a1e1a99627dee9ab122e2bc8f3e9ed6df94bf4edb4830ce0110c0367371b28bd3243e2d54dd8ddd33d44ddf671c38b6c9c8919e4b772ed5b21bb67e04b9511b9cbf5579214c676090f4a0241a8fe224a535032ea9a84279d1326bc5abb0c0e2efee74e63faac0cc7c986ad7d5617d51716aa19982c090ae2a42dd6bbea903b1007590a8c5f7d6f07388cf260c34642e9b90603537f601e851bd38de0032a9c8c581628afbb0995004c3a79a24671de717ad5239690d0c69fef648dd"),
+		Signature: DecodeHexNoError("8bc0f403892af1c8cf18e38392623a916aef5bcf402afb5f493cdc4fc52904e78ad7cd9bad244cffe6c9d334bc7bf8da13f65cb2559c5be354b600848bfa6bc1bd277450a37bcbcac8cf4246fe080d50c29c832e9261048c9fe8d6d9b61aba3e4c0f6618c9e78dd743fb3b3c403a768a357800b186e7d5dbe3be996da41f9a04b17aeec6b46b240c149bda0f9add722da7b2e1fcea44f7c7885c080a0d45038d64c85aaf74e5953ac90bd671cc1d4db8ac04780a5636b65c1320d36313ac3c2fe98543747b70cbdfe1061935af95b7b39420bcb888bd3150b80b9a1f828197448baf08a1480fbecae61e6c0f393c043e45f0cc29b846aa80446beab31911f315"),
 	}
 	TestOperator4Proof7Operators = spec.SignedProof{
 		Proof: &spec.Proof{
@@ -75,8 +89,10 @@ var (
 			EncryptedShare:  DecodeHexNoError(TestValidator7OperatorsEncShare4),
 			SharePubKey:     ShareSK(TestValidator7OperatorsShare4).GetPublicKey().Serialize(),
 			Owner:           TestOwnerAddress,
+			RequestID:       TestRequestID,
+			ParamsHash:      TestParamsHash(7),
 		},
-		Signature: DecodeHexNoError("adb3f235494c75d5e8c3dc3aa839a1ea46e8720dcb75089528fbfd5910d939e05cd3a8be742aaeb40ccd7872d6d1f69bf89a64f9427bfd45b45455b7a2f285931f571c7cd4950b08eedafdaf28505cf5980b9fe365dd35edd805e9619d37a65e9f0af83f846938a3769fabbd9e6423f2f407d8f56327de47779c93b0ea5cc81dee8988cf8cd283fb24affe7db0c5f10d9696da4b042c2cbdd7f9bef30e80325a4b55edda75a9d64e4498d8639aaf4137a6211b6f8a93406a7232819927ea0f53a1f338eb976c40303d6d08e8597afad430893df7074abe8c9368ca9f855c867f139d0b46f55d4fd930be3ca145dfec5acc3ef010e30d1eaba390284f21e1b287"),
+		Signature: DecodeHexNoError("ad94450b5fb7990969efc2744c26d3f8242e7755b69dca957ae3e387da37ad4b89e4bd0e50bb3c7335e55c25cc58cef3917bbcc4de56e787059f0228c92375c46b7dfc310534b3e281b34d54c2b0a8cd4cf017aad45b0fb96d4b5f81c103ef0682840a2c79f3dc29baedd8d82824a1e3c5a9a4390bf4d16eac26f285af23fe55cd0b949b9db5ad3016bb30a72c726fd4c4df9f79bd101ed49754c116c683280eeee7a7c58db32d4a453ab4f6260689670e59baf4570199b7772ccbf52fb5a2515181776ecb82268bc4b7bc2a5984235472d8e2a87194b462a082f086be76797d4c6178825a097dbcbb002a80db8b1e8439f0dba7bd9be3a9e02d58643375dc15"),
 	}
 	TestOperator5Proof7Operators = spec.SignedProof{
 		Proof: &spec.Proof{
@@ -84,8 +100,10 @@ var (
 			EncryptedShare:  DecodeHexNoError(TestValidator7OperatorsEncShare5),
 			SharePubKey:     ShareSK(TestValidator7OperatorsShare5).GetPublicKey().Serialize(),
 			Owner:           TestOwnerAddress,
+			RequestID:       TestRequestID,
+			ParamsHash:      TestParamsHash(7),
 		},
-		Signature: DecodeHexNoError("5409adb7d6972c39c94b291c9a97a071f40f87c7d81b858ddf569e755c8dae7511380773bc6b36e128fe24d268bf153371584f15edb61f16ceb6a53c7b55736dcc7bb27660386342c8648dfff9076e4d7749493af1bd5b5fa0f567dacfb9ca4cbe8ecff4cc50c96e278425d71e795411a8a35142b23801e5142dc2539a5a0fb7fe1537e2e3c63f5ef356c5ba27e35073ad5fd400a4bbbab3e0f83a967d95d580ed391c372dc1e1b34097c2ffe7924c8ae70da5109488894d3041ed3c8f065884233b84008069e6152a89d95bb49a42f4d40f61709654c1d8662dd6e6e7c38ecbc9aa1143f52fcebe001d7bb182fab4a43982b0ecb8dfff0a3c64a41060e772c0"),
+		Signature: DecodeHexNoError("3d3d88140da62651e32137ef92554c705e9da14f53d8034c28085e4bf1ead6c6a160ff282022e8d0b973ea2beae4068a418420a502b1fab1431412361eb4b00e1d4e2d9c829e4bab75482bc96043f05ad9fe2a3e4e28034cb6af423e1b8e9b7bd64aa32f1a2ac115f2fef5756285bf1f8197b7d52d757f49ffaf0e5a5ecbd7dfb647f8b7bf853a79e0780030a2a8a0bdb2939b96e799fbc9da2f250251f4ad6ee59bcf290314b54b983a2898706e7a80b56fd79770b0a4a4251fa6b9a6ebb5a7c33bf55d2d094f2d307a4c793dab45f3f62fce70580202cc1a828c431e62e6d32d070ed3620c99b5d68955ddcc1f12856f5bd212b2254ee1d0d77c7d352c69c2"),
 	}
 	TestOperator6Proof7Operators = spec.SignedProof{
 		Proof: &spec.Proof{
@@ -93,8 +111,10 @@ var (
 			EncryptedShare:  DecodeHexNoError(TestValidator7OperatorsEncShare6),
 			SharePubKey:     ShareSK(TestValidator7OperatorsShare6).GetPublicKey().Serialize(),
 			Owner:           TestOwnerAddress,
+			RequestID:       TestRequestID,
+			ParamsHash:      TestParamsHash(7),
 		},
-		Signature: DecodeHexNoError("8386a9cf1d4f5fb843398e8b6666dba681399d3a5e127a9b2e383ce74385da5eae2976b51d48af57e7bbc4bb3ddf296c991153971f25f9a6845b222c9be3d2b8c44825cf5d0e9d0b11a48e429e4ccffcbfa319cff36b53f974ad8ebb455c81c50a1c29112caa4977edcdca4d55b7918f3fe05f14072c65cc67851ea427e36bdfe26621bf248f7ee73c5e460ad32a72c851a55bbce466a0ee03d121866cd3c5183906acd632b1503944d725c96d7d413190443affe7357a41a51d53322b1a7be9e849e3b89cd7f3484554289ece145826c5d9785095a3c2f90fe6be02d72a1bde0a20d8ae3c90c20dcf43caf75d2c48c2c6d834714b65545c87d78e434e8ae3df"),
+		Signature: DecodeHexNoError("8da13034c06c4cad829d977a420a0685b01b2dd3af448bccbc82e23e649dca0f55cdb4887a977e200a6f5560514c14f7fec1e3d8ebca6b1b935c8c8500988710442346002a0c7ad9cfbea563a4e0b7ef704423b43ecac8ac56d271ff709c3054ef698e2278ff7b42c1d934fa269ef65e4af74977b198ef19742c9e5d4caafef58308d31f568a4bda71118f3ff2cb0376d9ca37a3dfc792659330e189959cc416a784eb9174dc318edccb1bd559344e5cbe3991338d1bdf1a38b7eb1979076cbcbac415ca429dc601243698116bf22baf999520e7db8844f5f131e060fc4ad1c7b117c4d83fca64caf40163b77e1a1fa10912e9201988e8c2cbaaa08ff6cb108b"),
 	}
 	TestOperator7Proof7Operators = spec.SignedProof{
 		Proof: &spec.Proof{
@@ -102,8 +122,10 @@ var (
 			EncryptedShare:  DecodeHexNoError(TestValidator7OperatorsEncShare7),
 			SharePubKey:     ShareSK(TestValidator7OperatorsShare7).GetPublicKey().Serialize(),
 			Owner:           TestOwnerAddress,
+			RequestID:       TestRequestID,
+			ParamsHash:      TestParamsHash(7),
 		},
-		Signature: DecodeHexNoError("cedb8791bd457680bb9c0f1dfa5b4526d07199e090736ae82db4b6a0809b0b723a1655b6c84e765e0249746c4fd5a64b6698d3fb73819c9aa784208d35effae6e38e7a012e937bdf456e862b427e94fe9f233f6c37699c2e1b88c92873ec2b808254dd9501a7b7df3c5d0a0757cc3f0f6b96c9d78c00fdd3bf0314688ededea0f78dc512dcaf7a4c8c37236740dc635950ff14fb80186e03d06d32622051b44102d0665d684f8b67f0a21b6a6dbf51edbe9a6648c57e2af396b7dc97c2badc23b0c50ce30d38618b9a1cc31c7ae254c951de2154e5373a9030da9f8d70d3906bdc6e0144917bbf26f1af761df848787e795a89d34d2586d7710e594f1ef2b996"),
+		Signature: DecodeHexNoError("b597f8c44d9c017e7658f33b93b0da2047eeeb1dcc20ecef17601c3d6b871b25bc039664ef0275b4f0e6189aea1ba330e28f7d4c05fbdb60df62ee6fd5a23a87d6a4f21abfbc113d73c6281dcd332dac3b2a6d21441bb7650b005ff12ee23f7d86549e498468ec28f1eb5e2d6a08089ce505d80fc710155bac251f0810ef308776d9acf14c4232995561ec397f221ccdfcc95e0d0fd63fe2aed2e55088f61ac6260865faf725a98e25ebb53ae02e4c56ba16a445365e703728a50728360a1a35f34381bb2c4d05f588477669189b5d88eb28b334dce85de3417a4bc44a6ed112d93999c50e90a239b26369e662dbb3b6277a2594bce72e3bb03c8c8a0af65fb5"),
 	}
 )
 
@@ -114,8 +136,10 @@ var (
 			EncryptedShare:  DecodeHexNoError(TestValidator10OperatorsEncShare1),
 			SharePubKey:     ShareSK(TestValidator10OperatorsShare1).GetPublicKey().Serialize(),
 			Owner:           TestOwnerAddress,
+			RequestID:       TestRequestID,
+			ParamsHash:      TestParamsHash(10),
 		},
-		Signature: DecodeHexNoError("2dc181d957eba48a79c3e71a5cad5be1517f2154bde3f377601a0a13038fe29cab6c1c4f8235a8ea6f09ac3fd417a54440f2a45f3afebc28c7306fdefd882bcf96f004cfdab7b71dcc8a4db3485e0e38f1fc2e0292b212525a94bc33da0cf8f52abcd73bc1fc989e1d65ae24eb9eb47280dce9d879d91d850ccab20e068d4b2e40b0e8f6cb27676bd64974ed2d3b2b2a54012997b4485d27277b7fdcce1cf14ae71dde4b26ac033976deecf433f56f7e24c46cb3cd57696930f43e1c51cf393793c26de3391611613a426f6f700c73230672b562e608306c639a0f5c655777b5f7f10d0d82733593b0ad7f298f0143c8357af529fa5bc031ab0dba26de82d7ac"),
+		Signature: DecodeHexNoError("3e12d7128025f30fbd3fa100556200f097476bcd39789481c48b07f59c136b79751f2b26abb4612972aca1de1fad6d4f3a3d16ad401554eed5ebcedab0f58e8e74226c8104650a37bd53e04ff2fa9120572e4354670d0d40faa128e1acba701928c704a1c1a339aec9171587f1f9f2e44332f93b5b8c38063b28c5eccf66e8e4ab666af4802aafd3e8ae1b3f37de3c8279eafce5c79ca9687e7818bb8ad3f00464332fe8dda3befac5122f4f04602ae526774fd26f08db999feaef60dd7a2819b8b5d0599f85c4dc4d2597fd71da49c9fb78697d3a5d49fb2b6bc63d27ccf76035e73323a9ebe17112cc2a7f7a3ef468a9d783df9e3d960dd5e84399b906c793"),
 	}
 	TestOperator2Proof10Operators = spec.SignedProof{
 		Proof: &spec.Proof{
@@ -123,8 +147,10 @@ var (
 			EncryptedShare:  DecodeHexNoError(TestValidator10OperatorsEncShare2),
 			SharePubKey:     ShareSK(TestValidator10OperatorsShare2).GetPublicKey().Serialize(),
 			Owner:           TestOwnerAddress,
+			RequestID:       TestRequestID,
+			ParamsHash:      TestParamsHash(10),
 		},
-		Signature: DecodeHexNoError("491da79c178f20b911264279d662f62562a2bc932986228ce5b8e7a00bfd5db6a81b0c7cca706cd6ed3a83f1000bcba05c0302a7d7dbff6f9fd65e956921432a6eef49baf86b6798f5e999ed554707ad9364d7483024b0ea006b859729057f293de91f1da50db9b6fb44b9b757c856e33988acaa0fc8ed79ff7391c6b3fa58a768ffa498e3879c338ecdd12789accc666cc29b3fdc0f88d9348b10832f20f518123432deb6b74179a7ee12d1f46fed14123b9e95b152d92d566262a5a46539af1ac14d6d57b64481fb0754fef3fc31c82d0931c0e5718a3547d628774a55d6ad0b4a149b1dfbfe63c28e14bf1e2cf450929d5c03636b8abab5f469f0ec920a97"),
+		Signature: DecodeHexNoError("6c6c5732c18889e640508e77d675217b2d74b1e118a0f508b9bab21af001d1d0bf791bf0d96c071063699cc8f91a793c70d4e667d3c06bb699fb01de9a651e50c0575dc2471a78d07308a1f5e81185d08cf9176c0673eb35136cf34623b54b26adb69786701c70c1596a5a4018cf9e38ab4f6fe4822d0b877cf3fca0f8bf47eca0f9396a5737b99c2468312eecfc1b2737429490daecbc553eb7bd598e71e750ec91e778c0d694e87102a7949edf8a6dfbc307bfbc7cd25ba60704d37b49ad87f907551a7d4a4ba13cc16773b65dd922688258e066081c5cdf7b38b1bb48b008af1faac32ed6926057f4c580f926d938432a6ded95765aa40a66390eeacccf3d"),
 	}
 	TestOperator3Proof10Operators = spec.SignedProof{
 		Proof: &spec.Proof{
@@ -132,8 +158,10 @@ var (
 			EncryptedShare:  DecodeHexNoError(TestValidator10OperatorsEncShare3),
 			SharePubKey:     ShareSK(TestValidator10OperatorsShare3).GetPublicKey().Serialize(),
 			Owner:           TestOwnerAddress,
+			RequestID:       TestRequestID,
+			ParamsHash:      TestParamsHash(10),
 		},
-		Signature: DecodeHexNoError("3ffd039cb91b943b6fbbf0962bbb3cbfcd839241de586ea2b320c08d7b0d10e7db4bf7239919df260ea4d84c2a1199a072b4fc1be7f5f7b5947f72440e04f605bd9f00518643c786581b3d6d900b3b160a325cd6681d29fe207f5d6672f22e45f35805eb5a0058685bc5f84cbdc3cb5bcdf2cda37e9247c6177abfb9b34b4fcc59c7d4d94b19ac4762ef84298b9697d1eab18138cb9299c9d2d832c9d1f8926a9a4068e68d9a900ab3cee41beafc51e023e5c43e2f9710c43880dfade388534e09fd1483dbfb9118431485aa7c1bff7fb5845a3f653155fa356679a359285e6a6d1215cb2567866bde9b48f6d85f39de26273997f098a41f13aa67706bb28971"),
+		Signature: DecodeHexNoError("9d6fd8b22b7a7167685fb5fbe31531d63f1c4e71c9eca4c277cf814793e54f23a711eabbef915f290232913f8d398f4665a436b5b7d3643d542ae9e78b5b32317327c990fdce4189cb4c3818dbd37f6f9946a1f7c77dcbc211cabb82f289bda788b7de76dcd714704e8e933e71a20fb4a81f934e5dfe50b933622778dc3f4515500c99aac3d0ed32514de35db0e2d476a881b4201e5a6a2bbeca10a70da97307ec7ba16c9b19b78bc0291cd9831cb169ec24340e965430df542824523a8c3c44c84e34a6b9eb93c19b9960ed2403a10291a5d905fd65d41881ebe52f4a0f3b98c6b83e383868d23c24008b3a21fe0c53fe172db9f416b2813ffea1aab9ed2c27"),
 	}
 	TestOperator4Proof10Operators = spec.SignedProof{
 		Proof: &spec.Proof{
@@ -141,8 +169,10 @@ var (
 			EncryptedShare:  DecodeHexNoError(TestValidator10OperatorsEncShare4),
 			SharePubKey:     ShareSK(TestValidator10OperatorsShare4).GetPublicKey().Serialize(),
 			Owner:           TestOwnerAddress,
+			RequestID:       TestRequestID,
+			ParamsHash:      TestParamsHash(10),
 		},
-		Signature: DecodeHexNoError("9e68ec19c6b895598be287c7fe9a843faac8ee91710d6756e3aa0bd160d33ca9b3dd8bf5508217ac3d553ad5d39168e1362b8db263b56a6be8f797b7aa3ee63b1eebe1df17f8acaf0ee97edab90d1c307cccde1e89116d1e2ceb660ba23cce4237233f384a62242726a3eceb0307937561bb1ec605fce02c2005932ceff4776a304d24518e582cbcba3f8a6af5ef33a5efea0548861664f40e7d4d963c8ee364b00cfb683ae2e877872fbbb15199eb11ccb11a59c6597e1262eb9c94ac9c621765b8fbcf49a40107eb55dd37fd403236b5733d3647c9d1d3fe308883323ea90efbf528be6695fd1d90fda34edbbb252b4f827243474824e825dfcd52994b192f"),
+		Signature: DecodeHexNoError("79a1c25c482d4b69c564f4660fe9714525eb50ee04def684d6608f3a27e67e8abdc11bee633b9aff9cf586eeabcabb6dbde81671aa4508dbab9b2b1f44a691d8e7f4e012fd730a0866fc7039b59b0fe9ae4ccc9323d3fe6cf039f475448f3151ab200a8b1f3c1955a34d64e48ef04992e06d323f66fcc727a7476b4038624374f83dc2327285fd2afd5b7b1989eef74bc0cb66e0d069ed03b3b9312276a9302f75bcd108cc5618f714727c545a0b8fe67d052240e374975260d5cfde1192f9b11c387caf7c65c13e552d350980fa3717da79cb48479aba6e0c83541a0a95023f1bc01a5be81aadaa595d941876a0863e082e1d106eb65f71aa55c9c4b9aff3fa"),
 	}
 	TestOperator5Proof10Operators = spec.SignedProof{
 		Proof: &spec.Proof{
@@ -150,8 +180,10 @@ var (
 			EncryptedShare:  DecodeHexNoError(TestValidator10OperatorsEncShare5),
 			SharePubKey:     ShareSK(TestValidator10OperatorsShare5).GetPublicKey().Serialize(),
 			Owner:           TestOwnerAddress,
+			RequestID:       TestRequestID,
+			ParamsHash:      TestParamsHash(10),
 		},
-		Signature: DecodeHexNoError("1cf74d8af86aee4f3de8797dc99fb3aff3e73ff6be7560a83f66ae953e537207b7d1d36e41cf2fd492dc840b2c63f0ce2dc92322520ade21753b16bb2a9698e91136425f1af0ce218079bc9b747a92c0e7931b54394d2875b8f226bb80cc1e798e6b850228bc85059f954847c357ce53a27d52df20548ef96bae63b07e013989a03335287e39ca1e58398fd368cfc72f35d7efe256153584bf10a3a39939027b538303b24808e3360ea82f8b16159110277deee6c7801390f29647b8c58553bb89cece61d5c46e5b584f660b003eb44388270df653b1503d20ecaee734638e697b03bd3a071ba799eac9d29fbf9cc7f6e8b4dfeadcbcb463aa28d3e2bb362cde"),
+		Signature: DecodeHexNoError("354b72465f385be1e11a82624b7f9f47c4980969e549673b265a769f4adfcbc7080e314aa507a468654fbe07bd6a206c02386cea8016bd90881de6475ae31efedb6919e16def76c42b106131553901e9b714910e3e68e29da23eaff2c8d4094a31538d88c038329ce36ac798c926d78256189c2d639f119cf365e7e684eaeb405cc3574f19eed0b514e6a592686238c3bd7a602d125585c760b4bbef459fe2db6a23ad6b4f5c838ce4c6220af47fb25c797af894fac42917c961da227c2141f4f395471be23cf44c57c1f78e3c25b73d3e8f2be48749a45db729d173d3391755e556b04f22754e16485fe9949a03e196191afebc6bce68babec9e53106ea25ce"),
 	}
 	TestOperator6Proof10Operators = spec.SignedProof{
 		Proof: &spec.Proof{
@@ -159,8 +191,10 @@ var (
 			EncryptedShare:  DecodeHexNoError(TestValidator10OperatorsEncShare6),
 			SharePubKey:     ShareSK(TestValidator10OperatorsShare6).GetPublicKey().Serialize(),
 			Owner:           TestOwnerAddress,
+			RequestID:       TestRequestID,
+			ParamsHash:      TestParamsHash(10),
 		},
-		Signature: DecodeHexNoError("2a1760d2a9f247a598ba5fb8194dbb4e1d7961a786ef254c17fe11f4a2c3b96af8548ee99ed214cf7bd4074c6e9afbaab3814b4c15127f52f31460bb403283a025429e6f730c6033ae5b04e240b5a97104e5144ac329901c80f90777d892f5623a63c0badd3590c418f0b4a444d4aa9547195453ec37873ec4256c07aef0f825b7ec2b9918b9c36889c28eb01ee82437c7cdf28083c696fc228e5b00433a5cb7f94307684585ef9e03dd4c4a23874a1f98449d7804d0ec378f610ea717e744033c1fddaaaa60f7b103445efd2fc4312d96633c8c6ca9ab27c59293be2aa09d47fdc50e473fdb74513997a79b9117ecc71e19756414e544a75b74d1da81006b0d"),
+		Signature: DecodeHexNoError("bb6f23f5e477823b60d3372fa20453ec53fb20c4155e2ff6b70daea03e646220fbe94beaef86a81f177f7026bd9dd5ea21c29afda774a6b28b13c8c49ccd0bf010a1396cc283ccdce7aeb56323b8fbb4c4a77c0043904d91379105fb3da55c09960bb53fa90865e9ca0c9099fd0f0b34f3eff4f40396a1e3b8842d5f6a134b163566c7d5376b193adfd51ac1823c912f4f9ea0cf10b3d6dfbc9353379a5c24280fffa723b1ea49c563d01e9fb43cdd399b7ccb1b0762b33016a36676d31fdebce090351b38c5b247aac883f450d0f19aa1eec24146cc67dac43ae8e5e61a41ae7608117796cf69d0543e777f77fba6660a31367f13f1e7ab6f41117032a73c99"),
 	}
 	TestOperator7Proof10Operators = spec.SignedProof{
 		Proof: &spec.Proof{
@@ -168,8 +202,10 @@ var (
 			EncryptedShare:  DecodeHexNoError(TestValidator10OperatorsEncShare7),
 			SharePubKey:     ShareSK(TestValidator10OperatorsShare7).GetPublicKey().Serialize(),
 			Owner:           TestOwnerAddress,
+			RequestID:       TestRequestID,
+			ParamsHash:      TestParamsHash(10),
 		},
-		Signature: DecodeHexNoError("2c323e687486adc56f94207e5b8c72493712ad60dfa1a55d50b9142d8722b779257b3344ca55729013b9d4db6983518b91a40625fe18b6c434f71c6a97bef3df3e5b902c144f697f39f7521578b8f25875fe67f66e5f6e937e26fcddbf8fb908fda12cdfb899b65d4487e8f6215f40d01438dda375c9dfb191e5ea61fe5a59c0f3f884bfddbb87b6b451aa07be2eda2cdd7c8b92a851a89e43a84885893b02ecfae2b7129917dd63f1db0cea3749353dad87c74fe22f8a69b24b421b1b168103baac67bf2be96d7bd086632bd466edd21987c18309619c3df15985d298c49db18a4544aec2ce326261338164123578ab3ac26150d3cea8d298cc41dbc1851d92"),
+		Signature: DecodeHexNoError("6d6db0bb9f8980f44b0c149955322ac0bfa5d49cec0595102bf17b815623f40aa3b5e49f72c20da7f59d82e4db861b89d53e8d78db76bf787308447c93b82b70fdb3b6bde67dbfe9ab77bf064d79ab4f216b333ba4f9ae57c7039da1b6c15c4d21e28d7095add6c249fe4b41b0cb7d940e6f121a6450179d295447c68c633ff36bac852fcdc93bbb95cdefa6d41894662dd0d9da57cc427ae08b6c7d676bc0ab47c2521ad9c0c0d2f7ad9db144bf17b28512806dc9396c4f8c7164aa31a8a09d88c288d3fe6227ac2fdf404b4714b87f3a259a66abbac8f5f2e2ffe9313897ffecd32d1c90a42b8959bc4a4a5b1c96f692ad66548fda8937e4a532b0de81ef0d"),
 	}
 	TestOperator8Proof10Operators = spec.SignedProof{
 		Proof: &spec.Proof{
@@ -177,8 +213,10 @@ var (
 			EncryptedShare:  DecodeHexNoError(TestValidator10OperatorsEncShare8),
 			SharePubKey:     ShareSK(TestValidator10OperatorsShare8).GetPublicKey().Serialize(),
 			Owner:           TestOwnerAddress,
+			RequestID:       TestRequestID,
+			ParamsHash:      TestParamsHash(10),
 		},
-		Signature: DecodeHexNoError("249b9ac09ede6d456c4dbb5e509c4d289f56a92e7feb23ec86108d05b3f4563ae6a1fea79907829366013ecaff1bdab8f3d2ace7e1d21da41983ffea4da8df1c68d2ec5f3ab5075cbf344393726bc27125132ad43874d714fc0d78eb3fefe85127660bebf32e400c7705240eae7b7ffdd8728b8e30411e042e970f010be85f087e89d5b3830c413606e080f90500432c1b02bcc64b32fb8e37224623ced9cc62a952b8bb985213e505abef8b5d716372a647adf6ba2ce90bcb537241ddf93006986f771bd915a456e6d338f2b42b1f03d19b3dc16ce3b0c0c07834db167b1bade342a93627c372a071df3319e0aa3e9578f55d37329362aacfea0097c69e90ec"),
+		Signature: DecodeHexNoError("03fd93b3273e5c7ee8b5ac07158a2e12da609fdf3501b47e7c499e02e3647ab0f4b54c94330d072dcf41903af5f07613112c47d390e963bcda939ee9680a780072e2da5d779e3529783515ceac0c2c80fed40380cef6a68486b5e7843785e0ac05006ef7aa4fb6b6e2b9917a769f942083b41698be943543d403e1e9fd08bbff74c0d6bfad0f645e6beaf35e458783aada82c77d6e3c5515812320c533602f3b47f762eddacd17cbbe62ddc0594f047f2f1be9e2739c0e32daa2a0378f557335f3df39d1c013c04d58b53b4d0bba57ba25096867ae7a881d77bf567997e2f097899027d7dd660e3ce9ba770ca064e993e17a687f1fda50aecf684dc24e7f1541"),
 	}
 	TestOperator9Proof10Operators = spec.SignedProof{
 		Proof: &spec.Proof{
@@ -186,8 +224,10 @@ var (
 			EncryptedShare:  DecodeHexNoError(TestValidator10OperatorsEncShare9),
 			SharePubKey:     ShareSK(TestValidator10OperatorsShare9).GetPublicKey().Serialize(),
 			Owner:           TestOwnerAddress,
+			RequestID:       TestRequestID,
+			ParamsHash:      TestParamsHash(10),
 		},
-		Signature: DecodeHexNoError("6c5a8e69ac201928d5a9c71cd651012448a279ee65ed8c2b6ae9ab5baefdd93cb28e04188f3e2b160c57694ab60f13e0efc6c08c4b117ff72222767f03660854e79b8976226615862ffc87da4d58ab573decd556dc727a9f8edb153847e612c5bb856a007e1f549df5192aeefa018467acd70ec578683e1e39f954fb7d10b1adcbe500aff13ec0203c41ed42d7aae168eee9c6eac620c3303dcb5ab08f79f2130a5737ca3ef6355afe8b69d80fe3ada7e7908f4540a33bfe86a013d9cfbcb55fe9c7df361f0e2c161996889f3ae26275b1794afbe447eb0750aacaf06cb09c09344b74b4ac0140a930712c549d680224a43250bbc241a4227541aad7a036c136"),
+		Signature: DecodeHexNoError("8351b2465215ae952c53cf00825ac699b4e07e58b68f16fb92356e64ed191e437a4abc2bd6f9c808a33fb0f3d000fe480c4fd5cdfbaf9954fd21de12ddbf1f3f58d5a3a3b3bdafc2d20f490d6d614fe5517d1d5308716f5ececaa20a5217139c5a883dd21a1cdc7dc143d34b998c6f4a1ae7000108a9c86a48e5a6f11fab7b023b7e81685db7ef53f5d5def346d60c11a29664f6a7bb48c39afdcb317bda5c98dc84adbc2d3becd162455d21d581feedd70462880d549673c0126fe96c39f1af7ec0c04a2b8090512cc05468605536d9a059ef924152b2c6e3503fc492d5e971fb8b324289c945fe636568b0e02dae22b2080d723e2b65d3f5a47a5bdb0147f8"),
 	}
 	TestOperator10Proof10Operators = spec.SignedProof{
 		Proof: &spec.Proof{
@@ -195,8 +235,10 @@ var (
 			EncryptedShare:  DecodeHexNoError(TestValidator10OperatorsEncShare10),
 			SharePubKey:     ShareSK(TestValidator10OperatorsShare10).GetPublicKey().Serialize(),
 			Owner:           TestOwnerAddress,
+			RequestID:       TestRequestID,
+			ParamsHash:      TestParamsHash(10),
 		},
-		Signature: DecodeHexNoError("ca3b6e47f23313700903d6f203ebd5a322ebb7e180f67c61257217ca1bd92a495ead376d2c742fde9cd1d4a18fd351093c693a1c93ecb7c9f5d215e1541b418fcfcc65e9bc5026b8aa2c554dd10841f7a8c89cf9577c2131fb0377e896e822c1cec88d6e878d5828f77daca458085ca794b27082df0cd434fa3183cab2ee6eddd5ad7e95629e9bf4b2df243f196162ce3473d7915af081ce7f2f70054072c0c9b6288f08786f2d5e1610233d248a6933bd15540f9017be93dff3d8d29b174c04373895c830969a14fc122f87ef503b934f8fdb0643f4e6994ba5261abb205d803ddc359098976a90c5e4f8958a03203a907fd8688d32db0d992ffec955354c28"),
+		Signature: DecodeHexNoError("c772ede37f0a2c95345286bb9cacd06401b350577d0d88b5d57fa58b0b09986f528c70efc5612240109cf90a6e42279c11377a035bbe285001f8c395e76d483b41d57d61f4c776140db3050571754e6ff212d09087cc92634cb329b0992616151e89a403e188cb2afb2dd2272cae3f50b88c7c9e657d254293e8606301d8e1763d43cd376a3c8d255dd65329960796454ddb9a89df70579f3fa3325fc32a9aa5d8e36d4f7ad8239c67092800e2644402cff86af4dbe9e5d81cd4682c6358c48342ad4f85a1f99c4e7c703fc0a36371e05f185cd17cfafb9ea64c667d77f5d4b93ad57f77e4007d587e74208c9168dde4f7932f6573b30aa201b75bd27f3d0ffa"),
 	}
 )
 
@@ -207,8 +249,10 @@ var (
 			EncryptedShare:  DecodeHexNoError(TestValidator13OperatorsEncShare1),
 			SharePubKey:     ShareSK(TestValidator13OperatorsShare1).GetPublicKey().Serialize(),
 			Owner:           TestOwnerAddress,
+			RequestID:       TestRequestID,
+			ParamsHash:      TestParamsHash(13),
 		},
-		Signature: DecodeHexNoError("86d4173ee5714fd8a4450bd9c5a7779d24e8a5198b28b5bcbfd0762269ea4f56384636361bae888b14859b8d676e98945898c00b8758565bdddfe720095ac09627a85204596c418cb0c341f7454e9e5e5c45dbfa48539c7a3bbba9152a04eec8cbd9e72d4b7c6ed2d0f813c17c72c8eae8d9d381120c700359cf2bdbf1fd7d3e362bca32643ec614d04aea3ffb28fc82384ef9c8f46fa42c2cec7758ea19981e7d9e6ad02eb2470f7bacf8b7df5d075dbabdef02ffd04aa67a0bb04d7b05cc6a786b7bb6843bcaf2c6e76f82e279e6f2ac38d8ce3ebe0fdc64a2249073014f524a7110c38af523247078fffd10c89dce2eca4699f89b74cab0a02ed0cadec3dc"),
+		Signature: DecodeHexNoError("82deb0d48a4f9a7305c6ac0a4018c7b4e6fadc084b97347674a5b8377cd06a317e197b18efd38985f5688d70035867d0d1d5c806cf06932ac5dcfeb2f9c152a20c1e9238ec6b91bcb087be5dbb002a216c0cba89db93e5eb0f74a3cb340323586de55f874192e8efde82712a921e6db84e3f223b1294b7838392ab879e84e45d9ea29bc81bfd2bfc635c0f4d2d9816c43ff5c6fad9018e553f37c86b518516eb4f1373217271498ee4a11bc99a9deac14acc6e1921f32fccc46b55e6d60029003f8ee3b1e31e03b1c137392b73502b2d424bed6957e1ba90992398d7ab22be079e0ccf3e856094398c993772ddbc5549e3295c9a6a0d7b85695b1452b6dd15ee"),
 	}
 	TestOperator2Proof13Operators = spec.SignedProof{
 		Proof: &spec.Proof{
@@ -216,8 +260,10 @@ var (
 			EncryptedShare:  DecodeHexNoError(TestValidator13OperatorsEncShare2),
 			SharePubKey:     ShareSK(TestValidator13OperatorsShare2).GetPublicKey().Serialize(),
 			Owner:           TestOwnerAddress,
+			RequestID:       TestRequestID,
+			ParamsHash:      TestParamsHash(13),
 		},
-		Signature: DecodeHexNoError("15b358862adc5b7cdc0fae8b975d59d17fb35d8f3822ddc1821ba8578efc6de8663a82d5fe13b5c98c67981dbe917e664936b8d47812569c9f678f7799e2cdd030f55b6a0d3a527fdb3af01ed013fcf0adb4fd5fc13fc29f6057c851e1764dbd8cd3525b25a1efced96f91cfaae924446b2d3aeed464812badbbab134a5e67e523e94db35a00299be4114dac41d3e3c1494679b6998f761c9eb54187260a93d8e1425c8e728f9ea82df51ff30c91dd10e3903edce18f91473034246bb6d787a39357255068505d2dd112957c9b7d77bb88ca8f8fc792880dc5535861ba604451e12bf9aa8b29530e0e3b2173596f8a11ff738c6543c5c94594b1a383edef72e0"),
+		Signature: DecodeHexNoError("977a8be36c58906b0e6ee4c72207317956a4210bf2e626c94b75e0068cc6f900ade1abe14d46ed7a7a1a28310013b8700c694596410022022b9219ad3037ef0f798f97787168c37689eab7a85803d7dc40ab08d1bd2c2f45d9e988d25131aba2da5a32543cba4ef74077e7f61e824f9fd9e347773b49c61c4e890f188ca100e9f3d73c6eac4c943868d00e63d924ac8b11c879233afa0df1803d26ddbc50b68e8283a523ebd8b6cb0a652af5ba04343d93acee0a5702935278c1f19c236f1f9468800874fb979e58727efbaa5dd5cd446e62046bd7dd293fb76333e1245318a16103b8754ca5f802d1facc9bd7e219207733e37e9ea1f532fdcda07db56af166"),
 	}
 	TestOperator3Proof13Operators = spec.SignedProof{
 		Proof: &spec.Proof{
@@ -225,8 +271,10 @@ var (
 			EncryptedShare:  DecodeHexNoError(TestValidator13OperatorsEncShare3),
 			SharePubKey:     ShareSK(TestValidator13OperatorsShare3).GetPublicKey().Serialize(),
 			Owner:           TestOwnerAddress,
+			RequestID:       TestRequestID,
+			ParamsHash:      TestParamsHash(13),
 		},
-		Signature: DecodeHexNoError("3de2b06c545f664e31e27fe08e0e9c4229b68557637ecbdac6570c160e692b56f33ea763df902ba88e5eaabbfa1a32b2380f9229d5de1c28e997f745c7e1f573ac30969046e9fbdc4349ff4aa414f743c638f999861aef58539b22c4f79aba1c12fdd0f9b78bf466f07ef50cd99f2dd5141f1b6b297b1570e25fab7f3fbbb41d6f015352d06580f9eab1a3d2de3b6841cbd7a8e8a055ba1488523741f44ecf616f808e798ef43301cdce6c7eaeac34cb3bac01fc077c7788015dd99b8a8a19f89fe9b296ba2011492c31af8094c75808b97eb33f2f2e0068c76098e853ba6c1f26a39c30dcc8b7f640d22e04f09a28cc5ac3fcaa58af7596e13607400a5cfb08"),
+		Signature: DecodeHexNoError("d91661518b7ca7ee5b9ba39d7ac1cd6f01378fb8791b86562ab26b0efd917488aea0f3d2441e1a042e7fe7dc93c8f6fda755309e4926f9e4915320b7251af3fe199e8ca5ed1c4d401fef9c7009bed0a96359f0a1e8ed69e8f8b9d5d5a96d98c4a5ec6c5882568e201735a80cc97c5ce46717299beacd02f3006e7e43296983922764df16955c0e22dad41d31ec392fb2ffa417ef6b0d817c3a3be73b5cc175ef6fdecbcb7da4dee54ceadcd02ea74163fde3e2624e4b13cd29533965b78d7153d9fc34dbfeac991a531e8981aeff3a74518d3c087f4f05397d41bc3d56a17be39439ada4727c5a43c094865003f3793ac2de15b3790df396090509710d112a46"),
 	}
 	TestOperator4Proof13Operators = spec.SignedProof{
 		Proof: &spec.Proof{
@@ -234,8 +282,10 @@ var (
 			EncryptedShare:  DecodeHexNoError(TestValidator13OperatorsEncShare4),
 			SharePubKey:     ShareSK(TestValidator13OperatorsShare4).GetPublicKey().Serialize(),
 			Owner:           TestOwnerAddress,
+			RequestID:       TestRequestID,
+			ParamsHash:      TestParamsHash(13),
 		},
-		Signature: DecodeHexNoError("ad9c7fbcb60b2b6a3e563c3fcf61f1f8b35ef51b34dddf40d4c614a3300d3dfe8f1145661c345fb459023dcfef91f9b096e6044a5bdad374b4203f4a2cac240c9ee5083321f96382bb935afa11183ff21f4e00f9238efa4124950a5a22444361b97ce7a887f092dd6ac421cd66b742a1a34c7f244d45fa80bf864e23604d42f6c5086b89a199b8e14b9b22349d3b8c40f9129d28e826fe168231bdf3166d9259f895708ad2eb52a8e33d90d1588458d530e377655c686b5326a5b64dd97f45791e19a9cb409118b33147d0ec38f4fbe6d51ff46fbb9741a86bb8e25bf4a43a0bd82357b2ebcd69c844cd3e1fb495018fd7885b889c7c9a80e67684bea4d22c4b"),
+		Signature: DecodeHexNoError("13f4e6d97c66cfe7667af040cb563dbc2dba980b0d9f749d36c5c767c9ef9bd1071037432c7e1141cc0f4f8cc0442f57c20fb22913f829a44635cc19cbda1fc0b02dc6f8ae2b1867452178dbe5eac2913adb7cc56ce268addb1caf3a2b8c8d5e2bcfe00781741b7d3dfdc612c4e97f866d717973482714307790efd9537fe95484971fa458b79f26d76e919a5b5bff54001e2c99de896cee47033e403bfdf85ae61a0e5869221dc02b71a89ca4b25349143310b05be529ad6d07ccf55bb29d87142e357fbfb23537e30f390a5934f2d269016a0ec3c7795dd78e558105b60d939f8a7b5b204e539b6f0df154eeea2c7a4950c7464598a84317ad9766e0c327b4"),
 	}
 	TestOperator5Proof13Operators = spec.SignedProof{
 		Proof: &spec.Proof{
@@ -243,8 +293,10 @@ var (
 			EncryptedShare:  DecodeHexNoError(TestValidator13OperatorsEncShare5),
 			SharePubKey:     ShareSK(TestValidator13OperatorsShare5).GetPublicKey().Serialize(),
 			Owner:           TestOwnerAddress,
+			RequestID:       TestRequestID,
+			ParamsHash:      TestParamsHash(13),
 		},
-		Signature: DecodeHexNoError("9545532cd17186f51d0d84eab60874ae902654561fdf61a0d28bfc79ded38c932e0aaaff51fb8081e481122629ff42af3df78bf57aa73ae3d9dee1bfb5a7775aacc70eca177bca29e7462ace5cdb253e1bc1b6829b07f289d5befe882e1c06cd3c04622db5a9ef4b483758c106e745e807d0cf16d03cc0cc341e84e6c023d572cf2f0ea6a5b40b0c7bafc8f4c6af923e3944facf5b7e9d1bc35245f0d3403de8963fc46934458176202e13250ad7f6bfe732a99d062a20d5f565fd7fd31d7bd945b862a97249873da96bd9a04ca86504d1418da954cc64d99eb3f851c50ca9d7d9e13bdc35ee898b12db5f9b177d90debba946f57f348489dd278a8a5d71c8f4"),
+		Signature: DecodeHexNoError("038baea37e46817cea611a38cfb8d94cd5db9ca3a0836e4716b77c1ae5f799f15ab1324f86d5c1b8fe4117ca6821521acbd24b19bdfee0042c6ccaafafa1acb171092c48c440eb5bd8cc1c23d92891e15394698accd82b442c2d77cadf204a15362078b2d5bb1567a5c0d6f7aafa790fd68ea55201c7d8c32d162b3c5c343e27ad0d73a33fc1c4b2dc31c314a5ee83477f886424ee3a72b2920a7a789857ce24583984677ccc2fd60687a6efff240d5c89747935ca9db001418a096172d1c5023c6d28ba798a40a904a68c86a3404c67fda83328f317350798fb09611a476b574917a11211cf2bec893f19b6fdc16e1d48060f274c04894779877ed11989bff2"),
 	}
 	TestOperator6Proof13Operators = spec.SignedProof{
 		Proof: &spec.Proof{
@@ -252,8 +304,10 @@ var (
 			EncryptedShare:  DecodeHexNoError(TestValidator13OperatorsEncShare6),
 			SharePubKey:     ShareSK(TestValidator13OperatorsShare6).GetPublicKey().Serialize(),
 			Owner:           TestOwnerAddress,
+			RequestID:       TestRequestID,
+			ParamsHash:      TestParamsHash(13),
 		},
-		Signature: DecodeHexNoError("39d6e95012d71f5b6a2ffb8019bbf20213db0248c75a9ee28667994ec82cb845b4a68980c9c70b55eeac02c59928ad66efe42ca97668f32e8294af195fadf2e9706a515c426a03d8b81f9ee5a8703f0f4474a3cb6bc8d5674af6529bfabcf747bf89ca299bb3e063ef7e21cb8f13b99c50a3827842e1193853a27e6edd3b84b928cb493ce2c6db30b37cad77f4db28778286747e85fb4da2e02e0eaf4b65388f3b8f7e4e50560d28019de1d0a1b7d266e03bdeb68ce5c1077862619f3fa1541c9f32bbeefc6a284e10eacb6deea27c1656509f64dea8321cd6a6c1513569af5b7f092f3f3f1d132ace416906b29afd0cadda3e47932ccf57d88860f9dfd7a9d1"),
+		Signature: DecodeHexNoError("498f347c58e99c881519b63307f0f9185cad4bbd133324a87e2f3811e1c770a1b8c0bfbaf2d14529f70feb041017fb6d45538d268eb5873ee3132ee293445419b8b651dbd1d6ef9c7430d3b36570e6255a19c98723ff5e52d5ed9a9f9f2c8abe0b5905f14e7d6fea935ba782bfbb833381ce157533a943dab226849acab8b43dac13f4fc9abe3bb061dab68ee12d15f176b7f3b6d0a4eb2c0c0fbeb73990eae857880ba2ab94858533105d51d760b11804a4b780f42729bbc13aa8c593b8c4be653b80b3b55d1e43b98b75fd19daee5600c6ba106c5fd13f01b0413cd59893f060e452b2e38e57d801618f34f98ba6eba01df8aa335f818fb19cde0c07bdff52"),
 	}
 	TestOperator7Proof13Operators = spec.SignedProof{
 		Proof: &spec.Proof{
@@ -261,8 +315,10 @@ var (
 			EncryptedShare:  DecodeHexNoError(TestValidator13OperatorsEncShare7),
 			SharePubKey:     ShareSK(TestValidator13OperatorsShare7).GetPublicKey().Serialize(),
 			Owner:           TestOwnerAddress,
+			RequestID:       TestRequestID,
+			ParamsHash:      TestParamsHash(13),
 		},
-		Signature: DecodeHexNoError("be1fe662149e615964e01a4ae726cf0876542d3dcfb4bf8305fbedd84d350328e06d8788c00163af19e1028eaec576622d55bf2bad4afe13e1d8bcca3287a41a41fd75c3f2a7980bb0a6c021b2560ecb1a9950143a967d26dae18d99f728d5ed4c31c5aebdb0a47391fbf1f3a28687845f99c179f409071ca2eec5c61e7dafa79aa53e1d6bc9bb49b8d2f22d3d9ebd881c826d70850d6427b50dbb50f797f8804d07b91ce8cc481967bf918ef0858be7fbd72834b9cdb4ae56c1331140e427459da58bd41cbaffed7229b41388555dd82bd5d99e9db4e650ae940c3aa926ef99852c989a8bea604ddd2278d901e5c72ce268df12fe3ada70be40fef1c6781f37"),
+		Signature: DecodeHexNoError("0d07dcf2dc84c187b05ad7d810f0124a07259b553e14ee0c66eb630ee1de9aa6463599e41d95271d2c6a4f4bcf8f7dcd9d9fd2f8cb8cf464408d3c0d6d50ac432c2d8cb79fc89e117e1cfcc86321899f4cdf67f6863a217541efb3d51437933390889bbac8f25c60bde1dd2d77e8cef4ad7a2f2cb39a141a55242ce94357e4372dc4431f14a520d3fb2277b047af857677e4781d5706b294e82b99e29008aaa615f60d03d51d33f352d27d50934617a0a45812028d6126d737798efa4914b3b00fee864b56768e809933083628b04dd7581e3655a5ede6f1429fee3c2c7a034f9f3c168d32d6431684436eea902d128d06b33156bf6203c69ee29051b93c4cc0"),
 	}
 	TestOperator8Proof13Operators = spec.SignedProof{
 		Proof: &spec.Proof{
@@ -270,8 +326,10 @@ var (
 			EncryptedShare:  DecodeHexNoError(TestValidator13OperatorsEncShare8),
 			SharePubKey:     ShareSK(TestValidator13OperatorsShare8).GetPublicKey().Serialize(),
 			Owner:           TestOwnerAddress,
+			RequestID:       TestRequestID,
+			ParamsHash:      TestParamsHash(13),
 		},
-		Signature: DecodeHexNoError("b6c2a0e794ed3abf2f145e5cd22b0c9a7002fa37176a99938898abda4ddfeb21be709fa8d6babe2760dc69c0e4a7d73654b41525aef707e22c21c61fea32957ac0c849ad541960178056aca74807bb3f5b6337035bd9abbed93e22ebcc0e476e139c28a0e42853a73a67ac670e064edb0cce32388a3e8daaedc2699a03e7aacf9c2f7f42efd01ef9702663bb129992ae6d209f63a0929408b4df57dcb4644deb5bc34123ef81346a8e1db4c602f719945c670c7e76606a73367e3d20a834c293be39735b3cc4a6fcf5646006f0665eb671be6b684eade46ebedf4b51b70373279b3347b4114258e92cd381322fc100bcff0b5c3fe353633c291e2848ce168144"),
+		Signature: DecodeHexNoError("1fe98279d470c0b11483a42ff072b47cb89ddeb47b737431cbc3e3c7731c53761a13519e017bf3b2dfff85b3ed3ff47414d5edacd106f70f477b6e1ede7fbfbb7876b37f2e9731f0af528a5c65758f3ad31734ca75dce014ddfc01a80263cb030e0dee7bc3ba206ed1b0db8e617cba09e0d428da0eea99f52ac5b19f81b9dcb4a078f0baf8a6eb157febed05f56aa88541e46793cbe992bbef34bab388ac1354f45a4611c1814dd8c5f44580afd9d4b920276ab512aa100bce6c63dbc8bd9c880a54437b0012b98273157e3cfe5047e6a721095f3cb2e33babf8f0e2682b468d37219afefbbdfa454ba1726c52ba9cb67e4329b6e624f8f66446a8c54e1cbcfe"),
 	}
 	TestOperator9Proof13Operators = spec.SignedProof{
 		Proof: &spec.Proof{
@@ -279,8 +337,10 @@ var (
 			EncryptedShare:  DecodeHexNoError(TestValidator13OperatorsEncShare9),
 			SharePubKey:     ShareSK(TestValidator13OperatorsShare9).GetPublicKey().Serialize(),
 			Owner:           TestOwnerAddress,
+			RequestID:       TestRequestID,
+			ParamsHash:      TestParamsHash(13),
 		},
-		Signature: DecodeHexNoError("9bc15c753a9b53bce693d066e52d6c5efdba85193bdb925be840e4296e68d0db4d55763309bd0807df086a6b5be7f1220b77e2622633419c6b1ffafc54cd2a71f25c32e11882866d9a49003dce342806033ada5587c4da833acc1ba2315f1e54b754421ef2b1584826dc8d5b1e3857c04399fb9b1474a44dd1ec0fbecdbaadac8c8806b69d00fe5ff2957f8296b202c78e48d89349a580b726e01f6c15578ec7b4b9f29cab52f14393c2634cc12ac20378e73dff24f7f41e5f9f0c4c5631f861c0d4d4cb18f7f6a1ce460fcfbd446ef0de132b47ac609cba171737736c27332a6f8e7944bdfa2596710c9ba5c1215e1dfcaf172b035c61d0a9ed5af7d75876e6"),
+		Signature: DecodeHexNoError("6e091e13144ddc38c6fecd79f6253bc6238c74387c97c01332fc6682aee6c87879b6c4a4089af61b249f7913c33d973dcb62fbb20e7cbc75249a07fe9612b2efdc755ec110fa4926fd430b36b86ef07aa05e6e83b337fa48fa70160a437fb7d43a58df4658ae6c9fb23e804d19c385d67239b1a81ce08edccb60563504251a364c64150eb7ee649edbc790f397e50e5bfadc334023093fd30ee89afc54b2b92be750eabd82e6c435f83248f6dd31abfdd68433d4b21a0f280ffd33d015c51e1bbebf437fd78a8a295a04ca0dacfd5b544d74e922ef5e806b1ff2ced3f6bb57c548abcae42df2b33b7d0f1d7d0b82996dfaad702d8a1d58610c049a9b7027f57a"),
 	}
 	TestOperator10Proof13Operators = spec.SignedProof{
 		Proof: &spec.Proof{
@@ -288,8 +348,10 @@ var (
 			EncryptedShare:  DecodeHexNoError(TestValidator13OperatorsEncShare10),
 			SharePubKey:     ShareSK(TestValidator13OperatorsShare10).GetPublicKey().Serialize(),
 			Owner:           TestOwnerAddress,
+			RequestID:       TestRequestID,
+			ParamsHash:      TestParamsHash(13),
 		},
-		Signature: DecodeHexNoError("3272daee0b8cab333474e1ef94883a9e606aa1e281c725b5a4543c63bddcb6175cf712fed851640bbe95f511e9201b8e87078e44ddabe735182064350d7751ce05a50638924684f6406e1748fa9735d29d30447e40c13c787484d9c2255bb131b57c9009b246ae6e966af7df6bf1b7c6e04fb9ff1b0591397bb8965b8ca6987d56320668728291116f469f66f9e0fac5790ebd2f0f5c4a3ee2395d240e6843aec03531129c21fc9ab289565201a65a84ca00a43bfe141dc43253505522354fb534ac5791aae6a4a83cb406ace745d597a576bc86ac3bb24ac85faa637233139112faa9c2caae0e54b7ce1dbb6fe1aaaf805d3c34ea1426a0d2210b686a556bbd"),
+		Signature: DecodeHexNoError("46d15105a633d7f2c2ed6e574a48ac3da38b9a076be05058f32a7e4d1489305d71cd05c55ceeac8df4c50be2f9a9a95374d05c871d3817507c6ed364ccc1013140af35293b841d8e86ba5a33ac29bed4c788c8598cb59f2646f0516c3fd7c9e5f7d68bf738a59464734e5cd02d7409367fff0b0a60c68b1fb778ff786097b78f3c2d449d4e5643e4cec4367702543619016eebdf99b413f35b14fa71068b3c26de78422660f9ed876d603a6048c5fa41dcee74e496f23212a15b8e562c83f36d57838149e73f8a9c51bbb7f76201b10b9e445bfebbffb6c22237cebd2e78f0aa75894f59e88bba160b51f7d3120e6577a1f2598dc94b7691a98d22fcd90500ec"),
 	}
 	TestOperator11Proof13Operators = spec.SignedProof{
 		Proof: &spec.Proof{
@@ -297,8 +359,10 @@ var (
 			EncryptedShare:  DecodeHexNoError(TestValidator13OperatorsEncShare11),
 			SharePubKey:     ShareSK(TestValidator13OperatorsShare11).GetPublicKey().Serialize(),
 			Owner:           TestOwnerAddress,
+			RequestID:       TestRequestID,
+			ParamsHash:      TestParamsHash(13),
 		},
-		Signature: DecodeHexNoError("2b7883f2c20daa80d5969fff2013a52214a8f44ff50a588fa5ad14198c4392e299a5fe7bf0d2c840d2709a764a3c788d275442e58eabe791686c6841c22d6b0c41da5e9091ed60770b3e9c988c31c2e01b9011fa82b2a589347ac992566e840ab9da486d09e7c7b5f1ae0d68769123a502fdc7cd57f6968cea321d103a0eca3e7638ab5aae9b7dc9ce8f478f527b068371961173b48fa93605b61bb79c7cbb54482a2b72b3b882ceb565d1fe2440fcf1711a9351d91730d8bf0e8bb3002b406992a42d271da495472bbdbe4070c5d4c99acd389681f1e5f565cabd102f577cd9151976f287932d3ea69a5b5ff96cc3e3d58d2f7810b7c62f02ec35434c719fdc"),
+		Signature: DecodeHexNoError("3db88f48ec87e229a140d961e518e95a5aae440bd06f52a74241d6ee9e8729960e5bd554450c0aed0dd62e3bc152fd58820ba623ebfb17b27ab3992d7e39abdd48f9e44985b0bccae70bfbe54746c15662cab31935e6e1ae101ef21979dd2b7bd43f886544bcc9210bad617f050d7e02401d600c24894ae817748b68e14ad88f696fd39d802171f0081aa820d146fa333451fcd038020e2fd726ecd300b80877ae218119d8bcd702a08bbd8d14de6bf986ce787e8a19792eead9a80b0babae24c1e71c711f6d072c32d55776974bdaa53f99431316ca66d4470cdcb3d31a380ab320292cb6299d6b6c044dad30dba43fa3ab7669640acfded7689d0428e025dd"),
 	}
 	TestOperator12Proof13Operators = spec.SignedProof{
 		Proof: &spec.Proof{
@@ -306,8 +370,10 @@ var (
 			EncryptedShare:  DecodeHexNoError(TestValidator13OperatorsEncShare12),
 			SharePubKey:     ShareSK(TestValidator13OperatorsShare12).GetPublicKey().Serialize(),
 			Owner:           TestOwnerAddress,
+			RequestID:       TestRequestID,
+			ParamsHash:      TestParamsHash(13),
 		},
-		Signature: DecodeHexNoError("79393266993304da6f5e90176191c5b446613c603a32adbd0c3dee6b68ee7dbb085fe9a85ceff05456be827c87650c7cbb0e7f260b315ddc81fb4f3dca727f2d1e9a296c055754ac2c2da7392fe7478f7f3c3eace4b68b98d36e3cb476e00f3e46e58b23c5f55a2eb91ff754a5ea9eda5b4d31409e44a66ebbae13f601fd60199364ac7c44b3fb0e23a08ef062e224351c12ae68e585c546909b875cfaa0ee661943eff03ff4f37a144bda5fa1768232ed630a005f93a27eb3c858106244e51b625f893c5a842c8e52c216e51612c6e1b57f45edfecd646945445b706f24cd54dc4f9be9a89b38518ddf39a6f74408fd347d41e7dd3e2b000d1165bf5288a053"),
+		Signature: DecodeHexNoError("2ac5f47710f2e5aa0aa907caae6f94a538faae06a4d9df12831f21bec30dade6050e710b509fbc27a1adf365295343a6f316291cb65e08dff35908dca540e1bd07136c958667e3f3803bf8209203d8cc58d8642803c2233c48752e0b8f52b290b84b0619a79103a5de5262fdee11555671472bfa31b80e8dfc200157aa137e5247693f666bb260459e490409134c979a89af780c004882a3a748d1179519c541405ddfba13eaf5871a9a613c4d22b4baf0e3131133c3d16a58442054c931985f9a494af239ec3e8cad22259ad1dc607bbbfae2dd72b2bfedf40a77253be78914552678bb4ee5ef107fffb463ea2d3ccfca3651830e2dc695c054543ed1b0dacc"),
 	}
 	TestOperator13Proof13Operators = spec.SignedProof{
 		Proof: &spec.Proof{
@@ -315,7 +381,9 @@ var (
 			EncryptedShare:  DecodeHexNoError(TestValidator13OperatorsEncShare13),
 			SharePubKey:     ShareSK(TestValidator13OperatorsShare13).GetPublicKey().Serialize(),
 			Owner:           TestOwnerAddress,
+			RequestID:       TestRequestID,
+			ParamsHash:      TestParamsHash(13),
 		},
-		Signature: DecodeHexNoError("a9aa067e29f6b17f3d35d532e52c7aafb2a21f2835536a62968f6308cb4d9af117530f6dfbdd7fd95170470613f03cdfb47c680e2b8bbc313677c7f741f244a687d9d4e011e883bc9c88a9ad63423ca79160abe289f50b101843a479abf5ef5ffdc00f20575b23aa1324fc1b6f48be51eb2aa4bf26be0a0c841a937357f18c3cb8cb9f48e112c6cf4170aec341d71f9db32b5129b97a6be33335f94b299f765d828222eb7613f4730b9afc4444fe652eda7330c700639a90859f18097c21680ff114de930a15372c9929f4501e2028369e516c818aae92539324aeea24214a080def5b952bd3f9243a00efe44d0092f9f1299d135fedb4d28502ed67be416964"),
+		Signature: DecodeHexNoError("2b2fec5d9d58f6c4750cf4ff8e163894e9b6cf52448fd0369095ad80c3adb3d246ee931d6818cd41d4f24bce513c744b289d4fbc342bb4d3e1bfe5a702f8aa743cfde7e7359b831627ac4850f9cb98c0fc0a19b112772c5c476221a00cdb4e3964367628129f467203bcf25c5f3f813027effcc103afc20fb42d208ff870358ff9d81757c14f9482cf5f6b3023fe2c9a9bbec73e151430d595e8cb09c886f6c3583268645d14d0ce2d2aeaf553d652771a486e73ade1ac7c33d72d3d2023df55f5897fd8182bb0ddeccd8b829adb9ba0d2938da92410e03e9c5ab3dc3d817c0dbb9f2f3b0a0dfb465c5627ad35d336d847d9f4e66586cd53a711311a8edca3b6"),
 	}
 )
@@ -6,10 +6,10 @@ var (
 	TestOperator3DepositSignature4Operators = "88f8431c72eb2d7a984c19e2856b003ac8e5ab241360517c89ea8eb086c2513733c02cdcdf39c9c540c93a0552c901c310772a538b6d626e2cb9671bc60473f19c7656f301a54d28635713ef7d0fa3ead348e7d55eecd196734ea3c915af83dc"
 	TestOperator4DepositSignature4Operators = "8254424d4f85455ba4deed5bc5e96e51de3a2ca638f22d933d5e8e1f0473a8fd50cd9e005f90c622d8a04385e32d7b8811a094945bc68dbef510a6e085033c399404c1f073307e358ebd069ea91c2b88a8be93c94d970828e66fa28bc1d52947"
 
-	TestOperator1NonceSignature4Operators = "a89a75ca0084450385634d9b9b75ac8ddc42f4c7bbf14b44744ec0bbd69846ae1ede0ac878c76d5a48f1ced25539cf9d0092d2c1d5941bca95a7d74ce9c0453b7c5ca32e988aee33bedb785755b237f50d036961a2633187b3ca7ffbf704614f"
-	TestOperator2NonceSignature4Operators = "a48c48286681c57aff704d4bbd5da364d810bcbe2f2f9e9eb3d6e1cdb13c38b81c7964f27930aaa7a1adca4592a19c5404531f55f49379bb822410d780346311f04e611ff54dbad4511531b7c3dbdf1810b0e429f4dae61629f83c4492d754c2"
-	TestOperator3NonceSignature4Operators = "b1848701dd6f1b4e46fed883e71d0118bebfe89d5227a8408007207cba85e98c00d2a56f09f175513909e3f24b453b6a067b29a821448d7bf7557bb90462ead337ee5473081d1ef6a9c85fda64b3700146704f61b93baac00bd7ad2327c7ed77"
-	TestOperator4NonceSignature4Operators = "807f76aa49248b045603a84e41ffd190a1fb8c6de6e46aa45ee5f8f984fb9adfda5ca468c669da7d120ae5e674f9107812a69a9d3b7d46e57d6dd9856362c32ecefe39aa077b9e83151f19545cad531e743c85522bd6d703cbaa06fef4bb05cc"
+	TestOperator1NonceSignature4Operators = "917556a0f9e641ceb61eba84f665649374e27b843fb2e85591893a429e4749d3130c9e15ff7bfbd43ac6e3b3cd85537819a6a0ef35b471eebdbbeb705d9bc7a7405414c8cbc0cd94d622d19f6635fa8a4e0abe2f62d60987c3648c2af49a1c13"
+	TestOperator2NonceSignature4Operators = "96532e5596a7031f9c336724f3196ccaece3a04b91633c0b994227af7550f495250e987c7c4897b00d8371bab0469f280a9d39fe012fc73c6dc9ef60be958a65c95b945b67aafcaa1e7d5c9b3c58d9aba9b4b3809eefe25c0dc7cf81d3704e98"
+	TestOperator3NonceSignature4Operators = "8bb6d64a877456d830f86668267dd685ae4cfda40f57c0b9fa481e7580e2b6ad4f01f3b6263f315ee2a096ef7b2e6e0f0edb1f4a1793f8605a9ced9c3f2d0059adf43372fbaef4804868a74cd1332eb305ec191045eab0de802f655e81dc181e"
+	TestOperator4NonceSignature4Operators = "90d31fae45dd32ba3022f12df5f109b0c19a358a5695d332a25ee4112641862d5b4433b0e2ae76e68866c16ef8d8c6f615d52969cf2c2d2f5146123af5d620891aa565756b64f8a6217872a7b5534f4773d97548b1c55489be39a2b27d7eebc5"
 )
 
 var (
@@ -21,13 +21,13 @@ var (
 	TestOperator6DepositSignature7Operators = "b224da2ac756bd76abceef9985bcacac1ea566883c262cda1170eecc1e3040ad91e86956c0babfd9a87b621b4c5322bf111a15a882257fe3a12dbea0cd94cedf68bfa455f4341fa6aedc2ccb2d5dc4c357376c42df92b306b526705bcb10ff61"
 	TestOperator7DepositSignature7Operators = "a00a43bf16e780d25900d32656376c02196d3c2ddb378975305e1629dad90cf962ec7163c48330d83daf13e5403ca76c0b58bfe96bab8d3e6610cfaea678adbe98dde5879b10bc0559fea8649098f590915fc93f250867f3d710c7274784bfae"
 
-	TestOperator1NonceSignature7Operators = "aa5c49c9bd3f9a45a5f484401739ada6e8d63fa5f497a1f56e255d8ba1526abd10577499deb66147e936c6b51c279e7b0b27fa9e17aba2e1f49b8d2e9735236c665dc10f683fa0dbddab68d660482d0d9755c7067f8212d7c14d51b2a69a7457"
-	TestOperator2NonceSignature7Operators = "b9b725bcd9bc1afbf261a1a99bac77db8cbcd7a3c467d493900837a2285b59f84ed6f117b443505f2e4d18d9ba4b14c40896ccf92cc5d9edc56aedf80b4b7ee9ce46cda836440c8fb6306a971a070c6f9ef88829128a54e8ecfdf6e88cb1abec"
-	TestOperator3NonceSignature7Operators = "81ada8c2546c21480b39eee2e311d1c0adc23766ac71f400a776efa9828aff944c90c8c36fcb9088bc80c1eeb06fff3b02afe53620723424979f4f75f06936caf35ce92808ae768f40c13ca3e819d7a01df6596956516a95400185c524ad4ce6"
-	TestOperator4NonceSignature7Operators = "9142f0991a7c7ab1a6013decb30e65798a774d194c2f5b535ef0f0d619c8b0b2a88b4c6e1e409f6509347a14b1783888130c2c3cfc998f75280744fed84055d44fb34f5d8fba93d8f529370c475d3af8cb8abd7ba96d6c30ad100bd1caaa9c64"
-	TestOperator5NonceSignature7Operators = "a18950e0c0ab1ab3335cb4654f1d93c7e11d9c1a6d4a734fb01505c3cede6ea35264f2d69bd64f34f37c72811ef8b91303f4ed05e0e230133e69ca38daae25f258e5b41d2b5f84c9596464172c0d6ffbb20431f853f1f3efb7d06be2174cf218"
-	TestOperator6NonceSignature7Operators = "87bb3551febc8b794c55893a5f02a62105cd4a668b4be2982e33a7e1aead1cce2d2480f2a43b8cdadbe5457fddc510a80b2749d9c5a582e5f186d92a60d105e76e7f738450029ac404c6144f0d6fd4cdc72ae9d3ffd85317811cb64890ef1f0d"
-	TestOperator7NonceSignature7Operators = "b2645a1e87b38e6b35c80076b9e260cbd703deae45851fe3c4dd0f466bf0942feaae8fb460d7549104a97fc33c2586cc05353fef9a9d82b275f81cd39192421c26998e620ef5c83ee64ce2c92f5752eab077974b6c998965a129e1ce2dcef5f9"
+	TestOperator1NonceSignature7Operators = "ac3693607ecb5812b4943430a7fabf456b4f457d4e7bc1b22fa9e2cee9a88b644919b692d8c73c95b19bf1c75ce25885167b9e27daba9d071249a88d76599f50ae33c18af230f3bc029dfad662755a21d10b17f60e8145cc130c6742ba38bd4d"
+	TestOperator2NonceSignature7Operators = "a4a0226d29075c0f9aca03bb58597d338ccb8ac43d1b8a4deebd0f84d99232a0ccef8a4e3bd9d92207534f2f29a1cb2000b012c4831edd1b9c03e75c391e7b3351f397259d4213bf3edec8e228ec73781491f105ca1390c3adfbe01a8fc22fbf"
+	TestOperator3NonceSignature7Operators = "a7b4e9379dcf14bbf9c72ddd66ef3c37cafa304f09c3ef8094926fa2cd2e90d8ad83c5a31c56fcf37414d854f760dbe714e17a6f793335907f465807da2281484a09b73845a4a4b8a03a69d557174eddfac310f5d09d9e62c25a79ed600d1d9d"
+	TestOperator4NonceSignature7Operators = "84d9ae0aeec0fc5805a71025857cca0532a5001db4ba378143d58c2527179163e5d0119a7ddf0058ec1819444ca705cd137db71d1b11ed434d5dea21d7df5c85b3ece8c983a34e206bd3f7dddc9933088d47b56ebc961569e6bb27fe3f7194e1"
+	TestOperator5NonceSignature7Operators = "a164528ec7b30675e652680678f857d23bf916cb10defc39e22eda8f413ec37ac0d44c6618d901c1604e20e3719d99aa0b5344cc50dc786531adbc76dabeb250cc860a35b24bea0e238440b72859400d0a8e444b4b734145d76e89bdc63766d1"
+	TestOperator6NonceSignature7Operators = "a1f8416a7617cbf333908e11d88232897df8e3e61da4e14793d098b26b9adcd29a8fcdeb606c19514308a925210bbfdf00505c01e502d6cbb4868c443f31b242fbc4d705f76e2080384a724c4af1c7891ab525883faf43fb159ae91c74893a42"
+	TestOperator7NonceSignature7Operators = "809475557c4936c83cebcd0c3e49f712d46752b63744a0e3047e81891826f25b0601b03df4763a39071149e7e83832f216aa75e6fe3bec77654c7b69b4001a4a5d4ddf4ff0b309e1dc6a70c412968f041777a3e8d63fccf3707390fb079eace6"
 )
 
 var (
@@ -42,44 +42,44 @@ var (
 	TestOperator9DepositSignature10Operators  = "b1345b92cd45fa0ce96700e203dcf47bd489202e6df5e07c6f4e059ae8273e941fc8bd36a1d6360f75a120084c734cea041037504db69b80ffca015e845d3dd5c57244097ef888cd9a97ca32f8b01cf0dc6366b1da34a21dd175de6ed700476e"
 	TestOperator10DepositSignature10Operators = "b1636d482c8b99e12e2369813df35e8ed6459f2787a0b96473c8627af55109b894d466efafff05079e80dfcc96262cec0d139a2ddccfecf7a7bc4e02143d9bc79bd20ca08cb89412ebdf41a1d9c9e112821648cf435d61424c95f6693246c92e"
 
-	TestOperator1NonceSignature10Operators  = "a905cc5f3dd1e327733ad4c07fed706666c6f6ebc5d03c43cc2cbabe8f836192cf3c65419dcc169ecd12db85e5fc1232123da2b243821e775571d07f65720af0165c0eaf9b706ed1b624871be557e0afd27b331d5e7d1dc9d9fd07e1ba4cbbb0"
-	TestOperator2NonceSignature10Operators  = "a72de95626d7f1fa27f3a25ff32d146df4db6daac41bb39eedd091187024d94d887a787c11ac9a946c0c30036739572c101c5e64a865c052f077809ea490631ae0bbbb836a49640405d56e02bd8cb0f3cbf4aea5cb5d0af9f2e73bfd5d05c9ca"
-	TestOperator3NonceSignature10Operators  = "b9e5208f050b392cae1c76ecf02e8ffdefd5c85488353a267670f8b50eb895f27107a9c778f4313b817b8bd58d2cccbf10225be70c8a91e84a1fb50f6dd8732891f2e4189a9a10249a61369ec416085e31c05b29ec5b224c395647f4e84830ff"
-	TestOperator4NonceSignature10Operators  = "a05b362234144fe5208de14658037e33725c4bd2e3d1c74ca3d2c1d904311626022752ba4e98fc1fba55af110990d5f3166fa4da677b6c5daec244255b49c7ef30e1a91d3531e9fd8a36e3b31227290d706cc2c2bdd6e68095c78da36274475b"
-	TestOperator5NonceSignature10Operators  = "a00cbfcb48b788883fdad17bff4f2816579216e1dc172639242068c0c8fb62d3dd05f66515b926affeb9aba86a391727102195bebb18a2d9b35df19905c78f5bc836fe79a652ccc27ddcb0ea42ceb6e2fac3ef3c77d3fc4b7d099a21c8cddb6a"
-	TestOperator6NonceSignature10Operators  = "a4c3c41414bc5982610bf0103157e171a183423c290d4d719cc8f180a5f0e16777aee660cce16a06243d6c52d63ba6bd19fa5dc89832f0c5f7bc3de61824c7f0a00a91a8cfe1abaff43ba60a936538cb58e7e38be1a3ca3d9132f121e18f66e2"
-	TestOperator7NonceSignature10Operators  = "995dab7b1f4a0a06845b94acbe801f581f9a842b657adbcd8c686ceb0ccd4be36b1f7cbf86b07d8e797c5dd14e6ff975081daaf7d0ef0c8a666e3babe52d6bec4653f421f1155d54070036f15a6fdf038cfebce0be1e5561609093da8e8e8c6b"
-	TestOperator8NonceSignature10Operators  = "ab5640564f1b4620d1d7a0c40700347030092e3481352d14979024aa5847e063653c97d702cbe797e9e8808b274592b713e4f6af2b867671c6ebfdc216d13952b86ae4f4cc6f331fa078fda72e1bdea6aaa0ee97e4bf674f671fbb8462f32de4"
-	TestOperator9NonceSignature10Operators  = "b4d24b8ba1721da18e0fdc0470feb4ff66e51c43d35681915365ad3c6c601cfd619ddb91467bfe0dd497bb3724922f2518dec502766b54c3887c4245d59e69098d555e58dd6bae815734a5852a1e3ef742d44486a7e862fd590e2735b492a363"
-	TestOperator10NonceSignature10Operators = "b790c9112869d34f1bef57eb42432c9cd4ce8bef00f1ae9a5692b94f3064fc5f87e14356e25e2ea61a7847789983d592012e6aa7e7b4faf59e039a39c812a7d931c02dcdbe2bc554452f0fa201e59749802281611867dd711540cf30fa995bbc"
+	TestOperator1NonceSignature10Operators  = "b99340e579136d5896fc063c9225f26da7692186a2e859f2d7b50b1cef05ae9b5f549b69bbd31f4d08e5daa5d27f665608d27099c05462c04bc36d059eec0bf57ab21a8fcf00dfd6a6e4852d96784f570b9c8319d6f00ab310f46e8f91a125a7"
+	TestOperator2NonceSignature10Operators  = "ab77597d99bb6491d6cc42cdf9cfa7b8f63c882d58dc1ee405d594dd7e99460ab0386025621d01c0767cd01b098961ae0f17f78dec0f600a26756d3b75bb5422fd51e5d1466e009be0312194dfdcf7bad895276f8490c9467251c640268936c6"
+	TestOperator3NonceSignature10Operators  = "a57659815c849d3fceaa1b334fb615d3e3ef2a3b13a2391d82bad5f1c1c42cda491e3d7ffc52914812b9b79907ca8ce209c05f742eccfd014f139315e81717818792b6da8fde9c205cde84cb048f3c94e79cd13b07310f0290e130d6d72a9dbb"
+	TestOperator4NonceSignature10Operators  = "961facb26c5ad4db1d965d10344695147279c353b5a69ce37c09082bcded3c6de61b094cf4b2cafb1b59a7d61c37373a154ab53c600c6577f8b3ceb235a5430b796ec11adbf9986c2aa7181a32f34bd2a581a62bec61011c502766508d217383"
+	TestOperator5NonceSignature10Operators  = "9546794b2a9c1623dd975045fc5a92bd2bfe4e960259887cccbdb849e8df1a3aee29d4f76a0d9dd43b359ec85421087c089bcd5bd392299975d683ccde4e187c9bf74ef0e9fe9acb443217589d3f17cbbdca1c061260c5863adfe49550c28b6d"
+	TestOperator6NonceSignature10Operators  = "8a89f9ed22a29435d9ee0ebf9b47f796f0e8cf6ec935e482cd754911f444060cf0960bede176b20b5a31ed0b75de19510040489a405c1b06987158afd9e625706635ccc2b9f5636388fb04d2ee4fcf08a72a9dcc968b6c21dd40dccae9349cd4"
+	TestOperator7NonceSignature10Operators  = "ac87e859e020ca2d214f6d35f3cb66518d84ea72206dffc22b1171d6a5bc388e6ea82878e9d2efb62a717675f1f478460653b949fd5b7c7927d937224f49a46725323c47a71782b3deaf4f6409160be10e11557afed5dbe1e0742afb2da49e02"
+	TestOperator8NonceSignature10Operators  = "a5f949e4dbe710c14b1d88b3ded5f8c863fc99911e337ff9bf9a65f8cf2f88d3ddf6c7e942c2d7df6e3d5d8f3b1ddfbf09ef8060e6484c1a2993bc770ef3a2bdfb2a10f2a0cf9559b64a0a0ae0637ccbecff5662485b3d7e3c9d891c5d42ba45"
+	TestOperator9NonceSignature10Operators  = "908eecaf6ffa9d3d02e3bd5f22a038be0c7cd18a1ca37d3b2f3dd0746dd91e4cc79ff70bef0865e0e07a05d66b6ad3fc156a26a78c0a07574fdcefc1fd98ce7288f594ec33eb2e0336b2f0625a45811ccc3e28adf4539b631c6b244e4eb2080f"
+	TestOperator10NonceSignature10Operators = "a00fc244ea4c37f08cba5816fe7ed9752cc438a2242e21c416e078f5f12e10739bdce739a464a2236361f4b5af3328fd0ef24bf821268f29d545776bd0651111f56e4340e926f97d0f7c66f1bc9629b7e37d99a87b881acae41c8aaec088e13f"
 )
 
 var (
-	TestOperator1DepositSignature13Operators  = "9405b225c424fea4eaa58679378d51fe77856e28a28ed1dc4e1bdeb813d7e5e87521ccaf918e0a297c8e8cdd0244f39101ccbe932f517838804e3fb2df0ed5e17721fea2fbd7e85041e0121fe96400e5a222ae3bd4914941fa54f43e0dd9b132"
-	TestOperator2DepositSignature13Operators  = "b85da6db81c5e160320acffd004e7daaadf720a30efb3b46a1d444c8705d296f2758d7078fd5897ba2311dee6e2da030172b195878243c6dd2c9cc4f6b07fe64eff9dc1dc240a9da5606279475a9cd7f1a461f79ca3f22f54cfff8bdf5d74eee"
-	TestOperator3DepositSignature13Operators  = "b8d3499d4662adc311c2fd695214051d0738b9db6e1a94cde22fb36dd777e93f01226f3fdbb44c983e1fd44416bc2f5d16c989d7d9aab5a5b42f7d8e437ca5938e328585603c77e1894773941c691f096ec6bf328ea3b58ccebc1bf4fa906677"
-	TestOperator4DepositSignature13Operators  = "b5281a1361e9450fdd49a4189d1affe910fbdf594f32e923d7f4476c88531de11d1b9c7a3a5d738367fe78006b4b316a0a73b32f383e250c191cd7d71ef2f24de5764ffa2130a9c0e15bebe63eb6ae205ad9628c6bdfc7c0bcec6620accb3c97"
-	TestOperator5DepositSignature13Operators  = "a5e0b31332d359f5b06c3b132ffe0868ddf1661954c83ac5af08d3cb826d72207adb726351fa2175ef401d361a4a2bdb104500e707bae00f378f02e5a1cf4a55bcb2a995ce99796299f36462fa996338520d71e8727a05cdc00017387d4721db"
-	TestOperator6DepositSignature13Operators  = "a377db4e5a6245c78990ea874900029c5f34d060a95b57e82fdc0378407adabd7b4eb7970de289e58aa30d22d03bf397131e2b8623a63679c3607343555ab33828b24e68397b65a000628f3fbaa2f05e4815812d784729c75101c8736f876037"
-	TestOperator7DepositSignature13Operators  = "b1f6bee35d39b0ee8c217716164f9b68e21a0deb35f3e82dd6c5a8ba9b16f49c7472911a12b89de6d3234e5c72c1dcf812727c71419ba930ab8bea033e1abfce7c12a5db05f685b8f05ba2d358b1d4dd217d9eaa8f90fb6fd5c0e303f4e4b64f"
-	TestOperator8DepositSignature13Operators  = "9037863eab0d2cb845bd9117803d37948488078eeec9319b2f515664a9699959aa88764f93d35f36464070ca118cb64a12940ff8c7856b2ab6e3e802ab4d97df2ea6d0c6ee84bda271a08e18ad885bc4d5f39089a15b72e7409e36500387f331"
-	TestOperator9DepositSignature13Operators  = "891973e20f634ad183bad3a9221ef066318e701c370350d5e2b30d5e6003c31840e6d3a8c6e67d267ba085783d49f5b204a657d82ac7a44d2ee4914f837624ea9616bac2273095e5c1cc8b8fa351a8c5a58861eca5f160e905881136e284e9e4"
-	TestOperator10DepositSignature13Operators = "b7e8aab51bf21aa845fc84141a07c3d580449bfa1cea10dd740436f9a21061bb434e5a511c0005ca6e4162ad8ffaa3520fb88dcaf1def87fda452a3252c93af02eb8719b636b7ff44d4923b0ff86fca355022370544f5090e797c3310293c105"
-	TestOperator11DepositSignature13Operators = "8c847ef5da2e8192d93a219cc8c4b68e1863c675ce1fc757b0bb7a0b9dd0b607cec511852cbb3cfcf7769b612bc1c15e141229794a38c265bb5b01311c540b1f24cf81abca1234fce43ec76429d3c2c4cbbd31dad0a2b3df420434dc9190876b"
-	TestOperator12DepositSignature13Operators = "aa993018ef01387af96284ddcf382059bae0ed9deb4b75af989882125a9702fac93748c83c97d925729548ea8a9e4bdd18ec769c18c59ce9148f957b0973e83e7ef6b7e359a5b890eca4a4e221eed59caeecaeeb579b3a38b89260490aa57ea7"
-	TestOperator13DepositSignature13Operators = "8ceebe524ad811036ede70d1f2f8e702eebd2b388ed602e64fd8103970c824653c2eaca50532afffeb4fc82fe0765c2f101aa93015db6118a06e5c78f9cc97cf658f016dc2f4ace2c466d5c179c3e133c50ddd78d942036e3711bcefb87ca37a"
+	TestOperator1DepositSignature13Operators  = "b303e5018eac18e944212570abce8c06c322793b56ddfd587321db54160bee5486e395a7290ac502447faea47e3f9a3010404d822c2a3c7ba281e91a1bd8fa68e37e1b15e54fda5be118ed440156a9765b32af096b822af69df9ca7a9983b74b"
+	TestOperator2DepositSignature13Operators  = "8cfaaa2d64507885e6ce8b8cca438adc3d9ad9990c0b3c52a8ac12c55f15b65490ff91303eac4764ff1f45a063e08a8c038c8c517da895f055e187a56e12a3afc9bc0c63a01a966f764ab7c21cb0070db04485be35298698b2f9e3aaf60168f2"
+	TestOperator3DepositSignature13Operators  = "ab2b0aa76579044dfb97a5529c917980b4511c81b6b6f09bfdc10d138c2631fb2b0a588f3eca652c800527f2e3da8c3d0f5b7f4a7d060ecb3f9546ddc754ed6bc2435ab10ac7013aa773096011c38c15053c6432c4fc31f80b36a0ee36a71607"
+	TestOperator4DepositSignature13Operators  = "8e7b5cd171308e85dc89f5d29558109281820b6f8fcae57b2b4cb8da8de68916a9a0a46327c356377dfdc56d7362f0b503ba2bde235119fe034f971e671f63737fc950b99105ab924432f222a241172be0bb962613c99e260cc10bbb722cc83f"
+	TestOperator5DepositSignature13Operators  = "a0a986fd9ccd148cb6ee3768d9ca787c28921e9273e840c84ccdbd4396390acc737f75f070a664c5c2a53b15426cf36a0cd594fa12f605f4bf3fa3b28704492561124a5b23e18deedc6e61837c48a212753b845c2dd7dbe4536447c9e81fae4c"
+	TestOperator6DepositSignature13Operators  = "ab80c91d738b5754c95a6b0dd41409ad5a5d22bc7d46d4daec2eb95adf59326a9865c68c911eb22b57e425a6a42c6f9f079b25cf36e7f16371fa49f0c3f451966e7fa8be3c35f824bd9f918843174dcd62a6fca16a275c1110b42e7be4f892c7"
+	TestOperator7DepositSignature13Operators  = "a5c1329bbd993513f816a3fbee39d6cc519cb6e7b1844615c8327679e259b1556077cf3ed7e5c90ba50188f41f1f788019ebb939b96747291a6ffdf7c3a09d2c5c339a2a9417ee8725153df878cb6153a46e411fc0a3f534fd2efa982dae9e2a"
+	TestOperator8DepositSignature13Operators  = "93104e13aa3cd5d87a61271220a12a16bb16584252ed54274063faa99a1739de46b7e1c6edd2b376239f7aefda88e140066edcfa22ad45ef6eaa741ac6f1c2e6ccc76da270b9137b468ab11d8b99da80c96bd68bd485f483984680e2455d9238"
+	TestOperator9DepositSignature13Operators  = "aa17bddf92071711edc6fb1f68bcd84d3a174140c057f348e5db2fb6b609d7ead4a2ce362995be72de78687371fd211a05a069babc1796e3dea0b2cabbdc506d64f583c63ae572f97b91949dd5b77c071b83c8ab9eeb344846dd7c6e137a026b"
+	TestOperator10DepositSignature13Operators = "97ab9c36c8e3978496e184d810be2d84659f8dae294ca65858108ec84de057db9ceaff89bb29ca74267ec762c8142e5b0080ebbae3dbb41f230458afaa2f7aca057e75f03fbcaf58463a8794c89c0a6711bb73e620967d48a7af3320506e17d5"
+	TestOperator11DepositSignature13Operators = "a34dfaa41f116aceda98424cd7ec94b2373110d6e8cf8f39a7545abe221c94afa47a8c830f62ebdf1532e069ff9011460e1dc4902946ab784c03700bb09e0bbd30cbb3eb289b78664ae3fd128d369bb7d1d41abd54d525b3e0de13b764cd41b0"
+	TestOperator12DepositSignature13Operators = "a4b09f38bc1a23f5a8c87128c1b0fe84581c1e1a3fb9314e2b1e49f4afb9d4bfd080f228cc746943ab8a38e3e42e50760b3885ddaab56ade3eb6604946a37898bcc717eba8332406a77f272c5b6e3d19da454675d8653aa7aaff2e5c9333ac4c"
+	TestOperator13DepositSignature13Operators = "a0ae0880aebc0a115d3d8da5abbbb4e64dffc5e7c09ed3017b08e99536aa840d364fa7c331d32870f4a159d7d4b06895026ca2dd7ef77bfba5f18cac5603e624c4aa289a2cac8a0cd68212c2f2d0a281417cf8364002a03128abc0f0348a71a0"
 
-	TestOperator1NonceSignature13Operators  = "aa48c674fb0f121b677e390d80b36428080bb1e0c183fb61682a2571b17365773e7f9b4d48c598c8eda57e7f6690c8a5199b49190d04f2191c0cdc5ad3f581cacbb130ed6efeb0e997d9dca3b8317a0beca2925d06fde7c2ef1d00ea12f84427"
-	TestOperator2NonceSignature13Operators  = "b1d293f24303fb9c1b57c9c6d82ecb4790d64205c0b000c3b6ecaa624b98f4e4b80a5d8d7560e40e44e060b4ee4a81b70e7fab9413311fe5c58d360d6ce7a3d7fc0c974e5691bb619f02c4b6743f75140b6af2200a42816c222c8d1c43db0dea"
-	TestOperator3NonceSignature13Operators  = "80ca642509e1801ff410927d2df6d7363bfc9b4e9334afa379d4e9d6a3586825ae430da008c8013460ab4c1f2efea39d02d95206451bd556c53f5631748fb142e9fcc41ee9a6e7412d0ef3425f5a136f1b4f4606207f5119da22697365862c48"
-	TestOperator4NonceSignature13Operators  = "8455e0c746bbdebef217ea17e0f7bb29ce1be138299809af856399dc51edcd52cb34cf2cdad5220a9b9c97dcd44878a01610ce1792e186503c759bf4d5ea0a495a3def3bd2cb40184c8cb13bf4f635522fe68758abe62c638eed53ba996ec9a7"
-	TestOperator5NonceSignature13Operators  = "87a6c22b7c14c6a4189a3b42ce13efeddee6da3d67e3f5e0bf6521e22732d2302b39dbe1034770526649e9835137389402d2c6e98fd9de4cea28da53acef310a03e30392a652c2ef99089dcc8a4a1939c89770cf05ef90ca6c85a42ff796d574"
-	TestOperator6NonceSignature13Operators  = "a14ee009b225020af32088482a46933fb0fe8584035ea8b4aea0e6444c80baf6464d0eee706a7df7ac77fe633af565610d160583adf365a3737ad3db7c6a29c89ae36492a2c5eadc7c6e0b341aef3d47f6126e9c79fdd940233dd9965e709a64"
-	TestOperator7NonceSignature13Operators  = "a138967371c9dac845fcc76bae47029a68cd2b95f01aba6a5d5c2ab0c9258e90308b664242fb926e788f73415dd8b6a40173d039accc5c0a3380dfb246db19fb129575dcfb2e1044c7563fb52d793d6ed443dbb879ad277b56f9eab9a8fafa85"
-	TestOperator8NonceSignature13Operators  = "8ccc0e6be899ca12af4f232d5e3f9734e5fb2a40711cb6d6829940f7a93b83055a36008aa492a37bef5a2ad379df00c60c42cce58b4933beba9e955c26b3bbc69960f1e4b1d3eee3eff872515ed942ca104ca9c31d4fea8e4545edc56e807c3b"
-	TestOperator9NonceSignature13Operators  = "ada4fa4b904f30a6026b1072b2baf52f77a83a172e6ae11f52e3bc3fea850eccda48378a90c952258db4dd2b06a1e81109f2b9b1db0aa7551b6c2e415dc6792d58c77d94d3ae8d643bf74555259fc70de65721b2a32333d3dd1a84c2c783f0d7"
-	TestOperator10NonceSignature13Operators = "ab5ae505bdb740205feadbc0eb43ca69d0df7a33ffbbde4d0452d130a55d3a2860175350539ae685f731e7e00fd5adf60039da9856b6c874e492dceb4fa1d218780f5eb2ee1009d7011358b11de0982bc151f09a6ad39310eb36751250f493e2"
-	TestOperator11NonceSignature13Operators = "91c324d1572d5a8b053a52715ba15687975d75290d08da4d654bd828b6be41366654f4e16e1950c33bcc0dc32f2b80c4105b9be14d676229aee134739cc15fde45d2678e9678deffcdb832d723dade57fc33668cce6c5dfdda5a744c7057cd8d"
-	TestOperator12NonceSignature13Operators = "afb51afa0673a5d513b5e29a99a91d5fa91fc6368975c79f5625c842a38f5838936a948283e2985d6e88c03ed47557890f9e7d622eb5bef57280a685823996dc4432cc36c9dcb8455627b048cc3530cf61e73f150ae3ad47116a4a3ca37c95d9"
-	TestOperator13NonceSignature13Operators = "97e8ca9ac34b3841878df6774cb700ac2218fe8733409809909d2b022fb790ac89ee1a7faf235b93e69b06c93a97a83d09db2ee44b43048cabe5d37c4dbd90e602544e1620672eaf625623f0ff7c00cca324d6f086ec0cb66b301f8145bb02b1"
+	TestOperator1NonceSignature13Operators  = "818eb61582c8c935e530d581f6bd81ca9c8383dd5b0e08c27bb71fa2e0aa34c6e7087befd559184899bbfc4f02b4525c020c0569b6886dc7f45ad52990944c850a2c35a66cfe6561ede321baa1e682c5677b4687e529d819a7a0e6fe5adcfed6"
+	TestOperator2NonceSignature13Operators  = "af3a1e92e860373e1f9e879638ae2c04f0819f62c1a40b82f6a555222fcdc853dd522bb63f34a67c83c30dd5970688ce0d97543d26a744ed8ed82151c1043a36b5f20cbc6003d25be7cef85c7d980180490dcb70820855635f10b7c79a078de2"
+	TestOperator3NonceSignature13Operators  = "803e986d90a051604d47d1a2080a80da749638b82a4eff18bb41cadcb638b024294e813826c4bc47a1b8ea3149076c5e16e28fc6bee0d9271e518a5908496d8c6968b31d7ea28dd00b31e3f6b19e57ab5d2aba73b5a74d19f621f058f0025710"
+	TestOperator4NonceSignature13Operators  = "ae615b8744d505a0158dcec627a6165c68bafe858492e54d2a6adaed4469e301b94385ce2cd58bfab9eac461ee06c6b107782370c75c8a598ffc1ec6e26840251147d579ce4234586532022e4e9d4e6743169895f238c0b858bfae5faa1f42e3"
+	TestOperator5NonceSignature13Operators  = "ab26b3f1756ceee638141d306e352ff114fc5911fdc4939da22720c30031ca972110ca0a09013395771378a0581f45340f3eabcc42c32848770c32c262679829cee49b91acb12a1b247e560453b23ffe6b0e3d5a0af514b37f1491f34523d09c"
+	TestOperator6NonceSignature13Operators  = "aa7a1ade5882794bfe48456fbdeb2e3e21d8ea8fd02fd60046e62bf30a4923eca3ef24ce83eb0c4f9ca1defdf47bd399015a54061cc1165d520dd9d79f6b912fb629828470f639be045caee516f6f2b6fddbc3124acaba4dd8f7b7679a4c4330"
+	TestOperator7NonceSignature13Operators  = "a107f2454aa8331b86c3edf3fc2cfc06f3eba353cdb27c2ae68a777387a59aeb333ca5c683ad99bbc22c4709fa02d0291597d98509f442b2f3f9d041babb8fd7eaa0cf19bf9db78f09e540bfcc14a035fff42c3f72d6a732481d789cf6a1aa87"
+	TestOperator8NonceSignature13Operators  = "8f6d83395caceb71241cce6a455c1a908bceb17118aee7b7a609ae12249728d9df7f80ef0cc1ed377f4b742575e829fa060a3e5c8b0947ec96b6019d8cd024f6ea0793674bf47ea1f6316c9890dfca1a828eed13486ecac89165d6b7e9d860d0"
+	TestOperator9NonceSignature13Operators  = "a991e37518169f71b232879833af6e325731e9724fa4169371f34cc1e53fdc04279464afb5305eb3305bdc4dea92c9e608058d0be1d1492d3a6f06d5f79ebcea13201b70ad16f6ebbcb3a9384b7406b71ce8a1860709b6f74903e482a263381e"
+	TestOperator10NonceSignature13Operators = "8d7592f91546b17a0f8db4fef6c5b0049afb026b5489d9d721bc404866e5be455c4fd5812838b9af2e117a61cc60a97f03fc52fd095a4c4228c40a83737d52f56c043b4b08e2ded22d82a230a3f95ae74d25626b47fc3c754e14e2c25850935f"
+	TestOperator11NonceSignature13Operators = "aa01c2a5bcf1a6d798edec98d8c98f01b9817e7e709ccfec3c7bf651da3defff4008991fb9d0dddb1a4b619a35edff0d02ed41ff88a13f17b85bcaa368d8d9fc74513802f41009365695b784a70b57535019c4fb651f9ff4e73056ca66c4daf0"
+	TestOperator12NonceSignature13Operators = "aca13fd40deb81fdf3578b9b9f1d892ac247707a928629ca76ebc461291c253a92f43ec94e15a5c91131845149629a6408eb0ba396bef397a6711e1decf2ed9457da686b608d1a44150882aaa60101bb941c06460aee958ab96dc63065c200f1"
+	TestOperator13NonceSignature13Operators = "b9d83f3cb9ed4f031b13b4f283128f33d66c9fc1f931a5cb9231f255ecc8fe838fd9a81a1e9e6575e26a7e3ad1ba0b2317cdd69023e35cc5f2f9e8666cc4fe8f0f3f421e89240292b0122e0fb9f3a72316c5873cb33a929ec1ce7f22d20f3049"
 )
@@ -0,0 +1,76 @@
+package testing
+
+import (
+	"testing"
+
+	spec "github.com/bloxapp/dkg-spec"
+	"github.com/bloxapp/dkg-spec/crypto"
+	"github.com/bloxapp/dkg-spec/testing/fixtures"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	eth_crypto "github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHardwareWalletOwnerSignature(t *testing.T) {
+	sk, err := eth_crypto.GenerateKey()
+	require.NoError(t, err)
+	owner := eth_crypto.PubkeyToAddress(sk.PublicKey)
+	// signs msg's payload as a hardware wallet, returning V as 27 or 28
+	walletSign := func(t *testing.T, msg crypto.OwnerMessage) []byte {
+		payload, err := crypto.OwnerSigningPayload(msg)
+		require.NoError(t, err)
+		sig, err := eth_crypto.Sign(accounts.TextHash(payload), sk)
+		require.NoError(t, err)
+		sig[64] += 27
+		return sig
+	}
+
+	reshare := fixtures.TestReshare4Operators
+	reshare.Owner = owner
+	resign := &spec.Resign{
+		ValidatorPubKey:       reshare.ValidatorPubKey,
+		Fork:                  fixtures.TestFork,
+		WithdrawalCredentials: fixtures.TestWithdrawalCred[:32],
+		Owner:                 owner,
+		Nonce:                 1,
+		Amount:                spec.DepositAmount,
+	}
+
+	t.Run("personal message signature", func(t *testing.T) {
+		for _, msg := range []spec.CeremonyMessage{&reshare, resign} {
+			sig := walletSign(t, msg)
+			require.Error(t, spec.VerifyOwnerSignature(nil, msg, sig))
+
+			normalized, err := crypto.NormalizeOwnerSignature(sig)
+			require.NoError(t, err)
+			require.NoError(t, spec.VerifyOwnerSignature(nil, msg, normalized))
+		}
+
+		// the payload is the domain signing root, not the message's hash tree root
+		root, err := resign.HashTreeRoot()
+		require.NoError(t, err)
+		sig, err := eth_crypto.Sign(accounts.TextHash(root[:]), sk)
+		require.NoError(t, err)
+		require.Error(t, spec.VerifyOwnerSignature(nil, resign, sig))
+
+		other := *resign
+		other.Owner = [20]byte{}
+		normalized, err := crypto.NormalizeOwnerSignature(walletSign(t, &other))
+		require.NoError(t, err)
+		require.Error(t, spec.VerifyOwnerSignature(nil, &other, normalized))
+	})
+
+	t.Run("ledger response", func(t *testing.T) {
+		sig := walletSign(t, resign)
+		response := append([]byte{sig[64]}, sig[:64]...)
+		response = append(response, 0x90, 0x00) // status word
+
+		parsed, err := crypto.ParseLedgerSignature(response)
+		require.NoError(t, err)
+		require.NoError(t, spec.VerifyOwnerSignature(nil, resign, parsed))
+
+		_, err = crypto.ParseLedgerSignature(response[:64])
+		require.EqualError(t, err, "ledger response too short")
+	})
+}
@@ -0,0 +1,111 @@
+package testing
+
+import (
+	"runtime"
+	"testing"
+
+	spec "github.com/bloxapp/dkg-spec"
+	"github.com/bloxapp/dkg-spec/testing/fixtures"
+
+	"github.com/stretchr/testify/require"
+)
+
+func signedReshares(n int) []*spec.SignedReshare {
+	ret := make([]*spec.SignedReshare, 0, n)
+	for i := 0; i < n; i++ {
+		reshare := fixtures.TestReshare4Operators
+		reshare.Nonce = uint64(i)
+		ret = append(ret, &spec.SignedReshare{
+			Reshare:   reshare,
+			Signature: make([]byte, 65),
+		})
+	}
+	return ret
+}
+
+// withParallelHashThreshold sets spec.ParallelHashThreshold for the test, with at least 4 procs so parallel hashing kicks in on any machine
+func withParallelHashThreshold(t testing.TB, threshold int) {
+	prev := spec.ParallelHashThreshold
+	spec.ParallelHashThreshold = threshold
+	prevProcs := runtime.GOMAXPROCS(0)
+	if prevProcs < 4 {
+		runtime.GOMAXPROCS(4)
+	}
+	t.Cleanup(func() {
+		spec.ParallelHashThreshold = prev
+		runtime.GOMAXPROCS(prevProcs)
+	})
+}
+
+func TestHashTreeRoots(t *testing.T) {
+	msgs := signedReshares(100)
+	expected := make([][32]byte, 0, len(msgs))
+	for _, msg := range msgs {
+		root, err := msg.HashTreeRoot()
+		require.NoError(t, err)
+		expected = append(expected, root)
+	}
+
+	t.Run("sequential", func(t *testing.T) {
+		withParallelHashThreshold(t, 0)
+		roots, err := spec.HashTreeRoots(msgs)
+		require.NoError(t, err)
+		require.EqualValues(t, expected, roots)
+	})
+
+	t.Run("parallel", func(t *testing.T) {
+		withParallelHashThreshold(t, 1)
+		roots, err := spec.HashTreeRoots(msgs)
+		require.NoError(t, err)
+		require.EqualValues(t, expected, roots)
+	})
+
+	t.Run("parallel error", func(t *testing.T) {
+		withParallelHashThreshold(t, 1)
+		invalid := signedReshares(100)
+		invalid[57].Signature = make([]byte, 2000)
+		_, err := spec.HashTreeRoots(invalid)
+		require.Error(t, err)
+	})
+
+	t.Run("empty", func(t *testing.T) {
+		roots, err := spec.HashTreeRoots([]*spec.SignedReshare{})
+		require.NoError(t, err)
+		require.Empty(t, roots)
+	})
+
+	t.Run("bulk results root", func(t *testing.T) {
+		bulk := [][]*spec.Result{
+			fixtures.Results4Operators(),
+			fixtures.Results7Operators(),
+			fixtures.Results13Operators(),
+		}
+		withParallelHashThreshold(t, 0)
+		sequential, err := spec.BulkResultsRoot(bulk)
+		require.NoError(t, err)
+
+		spec.ParallelHashThreshold = 1
+		parallel, err := spec.BulkResultsRoot(bulk)
+		require.NoError(t, err)
+		require.EqualValues(t, sequential, parallel)
+	})
+}
+
+func BenchmarkHashTreeRoots(b *testing.B) {
+	msgs := signedReshares(10000)
+	for _, threshold := range []int{0, 256} {
+		name := "sequential"
+		if threshold > 0 {
+			name = "parallel"
+		}
+		b.Run(name, func(b *testing.B) {
+			withParallelHashThreshold(b, threshold)
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				if _, err := spec.HashTreeRoots(msgs); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,171 @@
+package testing
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	spec "github.com/bloxapp/dkg-spec"
+	"github.com/bloxapp/dkg-spec/testing/simulator"
+
+	"github.com/stretchr/testify/require"
+)
+
+// simulatedHistory returns the artifacts of a validator created by operators 1-4, reshared to operators 1-7 and then to
+// operators 1-6 and 8
+func simulatedHistory(t *testing.T) (*simulator.Simulator, []*spec.CeremonyArtifacts) {
+	sim, err := simulator.New(8)
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	init, err := spec.NewInitBuilder().
+		Operators(sim.Cluster(1, 2, 3, 4)...).
+		WithdrawalCredentials(sim.Owner[:]).
+		Owner(sim.Owner).
+		Build()
+	require.NoError(t, err)
+	initCeremony, err := sim.Init(ctx, init)
+	require.NoError(t, err)
+	ret := []*spec.CeremonyArtifacts{{
+		RequestID:  initCeremony.RequestID,
+		Proofs:     initCeremony.Proofs(),
+		Results:    initCeremony.Results,
+		Transcript: []byte("init"),
+		Init:       init,
+	}}
+
+	previous, previousCluster := initCeremony, init.Operators
+	for i, cluster := range [][]*spec.Operator{sim.Cluster(1, 2, 3, 4, 5, 6, 7), sim.Cluster(1, 2, 3, 4, 5, 6, 8)} {
+		requests, err := spec.NewReshareBuilder().
+			OldOperators(previousCluster...).
+			NewOperators(cluster...).
+			Proofs(previous.Proofs()).
+			WithdrawalCredentials(sim.Owner[:]).
+			Nonce(uint64(i + 1)).
+			Build()
+		require.NoError(t, err)
+		ceremony, err := sim.Reshare(ctx, requests[0].Reshare, previous.Proofs(), previous.Params)
+		require.NoError(t, err)
+		ret = append(ret, &spec.CeremonyArtifacts{
+			RequestID:     ceremony.RequestID,
+			Proofs:        ceremony.Proofs(),
+			Results:       ceremony.Results,
+			SignedReshare: ceremony.SignedReshare,
+			Lineage:       ceremony.Lineage,
+		})
+		previous, previousCluster = ceremony, cluster
+	}
+	return sim, ret
+}
+
+func TestValidatorHistory(t *testing.T) {
+	sim, ceremonies := simulatedHistory(t)
+	client := contractOwnerClient(sim.Owner)
+	history := func(t *testing.T, ceremonies []*spec.CeremonyArtifacts) *spec.ValidatorHistory {
+		ret, err := spec.NewValidatorHistory(ceremonies[0])
+		require.NoError(t, err)
+		for _, ceremony := range ceremonies[1:] {
+			require.NoError(t, ret.AddReshare(ceremony))
+		}
+		return ret
+	}
+
+	t.Run("valid", func(t *testing.T) {
+		h := history(t, ceremonies)
+		require.NoError(t, h.Verify(client))
+		require.EqualValues(t, ceremonies[0].Results[0].SignedProof.Proof.ValidatorPubKey, h.ValidatorPubKey())
+		require.Len(t, h.Ceremonies(), 3)
+	})
+
+	t.Run("archive round trip", func(t *testing.T) {
+		a, err := history(t, ceremonies).Archive()
+		require.NoError(t, err)
+		buf := &bytes.Buffer{}
+		require.NoError(t, spec.WriteArchive(buf, a))
+		read, err := spec.ReadArchive(buf)
+		require.NoError(t, err)
+
+		h, err := spec.ReadValidatorHistory(read)
+		require.NoError(t, err)
+		require.Len(t, h.Ceremonies(), 3)
+		for i, ceremony := range h.Ceremonies() {
+			require.EqualValues(t, ceremonies[i].RequestID, ceremony.RequestID)
+			require.EqualValues(t, ceremonies[i].Lineage, ceremony.Lineage)
+		}
+		require.EqualValues(t, []byte("init"), h.Ceremonies()[0].Transcript)
+		require.NoError(t, h.Verify(client))
+	})
+
+	t.Run("archive missing a reshare", func(t *testing.T) {
+		a := spec.NewArchive()
+		require.NoError(t, a.AddCeremony(ceremonies[0]))
+		require.NoError(t, a.AddCeremony(ceremonies[2]))
+		_, err := spec.ReadValidatorHistory(a)
+		require.EqualError(t, err, "1 ceremonies aren't linked to the history")
+		require.EqualValues(t, spec.CodeLineageMismatch, spec.ErrorCodeOf(err))
+	})
+
+	t.Run("archive without the validator's creation", func(t *testing.T) {
+		a := spec.NewArchive()
+		require.NoError(t, a.AddCeremony(ceremonies[1]))
+		_, err := spec.ReadValidatorHistory(a)
+		require.EqualError(t, err, "no ceremony created the validator")
+	})
+
+	t.Run("not a reshare", func(t *testing.T) {
+		h, err := spec.NewValidatorHistory(ceremonies[0])
+		require.NoError(t, err)
+		require.Error(t, h.AddReshare(ceremonies[0]))
+		_, err = spec.NewValidatorHistory(ceremonies[1])
+		require.Error(t, err)
+	})
+
+	t.Run("skipped reshare", func(t *testing.T) {
+		err := history(t, []*spec.CeremonyArtifacts{ceremonies[0], ceremonies[2]}).Verify(client)
+		require.ErrorContains(t, err, "prior proofs don't match the lineage")
+		require.EqualValues(t, spec.CodeLineageMismatch, spec.ErrorCodeOf(err))
+	})
+
+	t.Run("reshare not signed by the owner", func(t *testing.T) {
+		err := history(t, ceremonies).Verify(nil)
+		require.Error(t, err)
+		require.EqualValues(t, spec.CodeInvalidOwnerSignature, spec.ErrorCodeOf(err))
+	})
+
+	t.Run("lineage of another reshare", func(t *testing.T) {
+		tampered := *ceremonies[2]
+		lineage := *ceremonies[1].Lineage
+		lineage.RequestID = tampered.RequestID
+		tampered.Lineage = &lineage
+		err := history(t, []*spec.CeremonyArtifacts{ceremonies[0], ceremonies[1], &tampered}).Verify(client)
+		require.ErrorContains(t, err, "lineage of another reshare")
+		require.EqualValues(t, spec.CodeLineageMismatch, spec.ErrorCodeOf(err))
+	})
+
+	t.Run("lineage of another ceremony", func(t *testing.T) {
+		tampered := *ceremonies[1]
+		tampered.RequestID = ceremonies[2].RequestID
+		err := history(t, []*spec.CeremonyArtifacts{ceremonies[0], &tampered}).Verify(client)
+		require.ErrorContains(t, err, "lineage of another ceremony")
+		require.EqualValues(t, spec.CodeLineageMismatch, spec.ErrorCodeOf(err))
+	})
+
+	t.Run("tampered result", func(t *testing.T) {
+		tampered := *ceremonies[1]
+		tampered.Results = append([]*spec.Result{}, ceremonies[1].Results...)
+		result := *tampered.Results[2]
+		result.DepositPartialSignature = tampered.Results[3].DepositPartialSignature
+		tampered.Results[2] = &result
+		err := history(t, []*spec.CeremonyArtifacts{ceremonies[0], &tampered}).Verify(client)
+		require.Error(t, err)
+		require.EqualValues(t, spec.CodeInvalidPartialSignature, spec.ErrorCodeOf(err))
+	})
+
+	t.Run("proofs other than the results'", func(t *testing.T) {
+		tampered := *ceremonies[0]
+		tampered.Proofs = append(spec.CeremonyProofs{}, ceremonies[0].Proofs...)
+		tampered.Proofs[0], tampered.Proofs[1] = tampered.Proofs[1], tampered.Proofs[0]
+		err := history(t, []*spec.CeremonyArtifacts{&tampered}).Verify(client)
+		require.ErrorContains(t, err, "proof of operator 1 isn't its result's")
+	})
+}
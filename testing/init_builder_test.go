@@ -0,0 +1,150 @@
+package testing
+
+import (
+	"crypto/x509"
+	"encoding/pem"
+	"testing"
+	"time"
+
+	spec "github.com/bloxapp/dkg-spec"
+	"github.com/bloxapp/dkg-spec/crypto"
+	"github.com/bloxapp/dkg-spec/testing/fixtures"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestInitBuilder(t *testing.T) {
+	withdrawalAddress := fixtures.TestOwnerAddress[:]
+
+	t.Run("valid with defaults", func(t *testing.T) {
+		operators := fixtures.GenerateOperators(7)
+		init, err := spec.NewInitBuilder().
+			Operators(operators[3], operators[0], operators[6], operators[1], operators[5], operators[2], operators[4]).
+			WithdrawalCredentials(withdrawalAddress).
+			Owner(fixtures.TestOwnerAddress).
+			Build()
+		require.NoError(t, err)
+		require.True(t, spec.EqualOperators(operators, init.Operators))
+		require.EqualValues(t, 5, init.T)
+		require.EqualValues(t, [4]byte{}, init.Fork)
+		require.EqualValues(t, 0, init.Nonce)
+		require.NoError(t, spec.ValidateInitMessage(init))
+	})
+
+	t.Run("valid compounding", func(t *testing.T) {
+		init, err := spec.NewInitBuilder().
+			Operators(fixtures.GenerateOperators(4)...).
+			WithdrawalCredentials(crypto.CompoundingWithdrawalCredentials(withdrawalAddress)).
+			Fork([4]byte{0x05, 0x00, 0x00, 0x00}).
+			Owner(fixtures.TestOwnerAddress).
+			Nonce(3).
+			Build()
+		require.NoError(t, err)
+		require.EqualValues(t, 3, init.T)
+		require.EqualValues(t, 3, init.Nonce)
+	})
+
+	t.Run("schedule", func(t *testing.T) {
+		notBefore := time.Unix(1700000000, 0)
+		init, err := spec.NewInitBuilder().
+			Operators(fixtures.GenerateOperators(4)...).
+			WithdrawalCredentials(withdrawalAddress).
+			Owner(fixtures.TestOwnerAddress).
+			Schedule(notBefore, time.Time{}).
+			Build()
+		require.NoError(t, err)
+		require.EqualValues(t, notBefore.Unix(), init.NotBefore)
+		require.EqualValues(t, 0, init.NotAfter)
+
+		_, err = spec.NewInitBuilder().
+			Operators(fixtures.GenerateOperators(4)...).
+			WithdrawalCredentials(withdrawalAddress).
+			Owner(fixtures.TestOwnerAddress).
+			Schedule(notBefore, notBefore.Add(-time.Hour)).
+			Build()
+		require.EqualError(t, err, "ceremony window ends before it starts")
+	})
+
+	t.Run("compounding before electra", func(t *testing.T) {
+		_, err := spec.NewInitBuilder().
+			Operators(fixtures.GenerateOperators(4)...).
+			WithdrawalCredentials(crypto.CompoundingWithdrawalCredentials(withdrawalAddress)).
+			Owner(fixtures.TestOwnerAddress).
+			Build()
+		require.EqualError(t, err, "withdrawal credentials: compounding credentials not supported on mainnet phase0")
+	})
+
+	t.Run("invalid threshold", func(t *testing.T) {
+		_, err := spec.NewInitBuilder().
+			Operators(fixtures.GenerateOperators(4)...).
+			Threshold(2).
+			WithdrawalCredentials(withdrawalAddress).
+			Owner(fixtures.TestOwnerAddress).
+			Build()
+		require.EqualError(t, err, "threshold set is invalid")
+	})
+
+	t.Run("first error is kept", func(t *testing.T) {
+		_, err := spec.NewInitBuilder().
+			Operators(fixtures.GenerateOperators(5)[:5]...).
+			Fork([4]byte{0xff}).
+			Build()
+		require.EqualError(t, err, "operators: invalid cluster size")
+	})
+
+	t.Run("duplicate operators", func(t *testing.T) {
+		operators := fixtures.GenerateOperators(4)
+		_, err := spec.NewInitBuilder().
+			Operators(operators[0], operators[1], operators[2], operators[2]).
+			Build()
+		require.EqualError(t, err, "operators: duplicate operator IDs")
+	})
+
+	t.Run("invalid operator public key", func(t *testing.T) {
+		operators := fixtures.GenerateOperators(4)
+		_, err := spec.NewInitBuilder().
+			Operators(operators[0], operators[1], operators[2], &spec.Operator{ID: 5, PubKey: []byte("invalid")}).
+			Build()
+		require.ErrorContains(t, err, "operators: operator 5 has invalid public key")
+	})
+
+	t.Run("operator public key in PEM", func(t *testing.T) {
+		operators := fixtures.GenerateOperators(4)
+		pk, err := crypto.ParseRSAPublicKey(operators[3].PubKey)
+		require.NoError(t, err)
+		der, err := x509.MarshalPKIXPublicKey(pk)
+		require.NoError(t, err)
+		pemOperator := &spec.Operator{ID: operators[3].ID, PubKey: pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der})}
+		init, err := spec.NewInitBuilder().
+			Operators(operators[0], operators[1], operators[2], pemOperator).
+			WithdrawalCredentials(withdrawalAddress).
+			Owner(fixtures.TestOwnerAddress).
+			Build()
+		require.NoError(t, err)
+		require.True(t, spec.EqualOperators(operators, init.Operators))
+	})
+
+	t.Run("unknown fork", func(t *testing.T) {
+		_, err := spec.NewInitBuilder().Fork([4]byte{0xff}).Build()
+		require.EqualError(t, err, "fork: unknown network")
+	})
+
+	t.Run("invalid withdrawal credentials", func(t *testing.T) {
+		_, err := spec.NewInitBuilder().WithdrawalCredentials(make([]byte, 32)).Build()
+		require.EqualError(t, err, "withdrawal credentials: expected 20 bytes address or 32 bytes compounding credentials")
+	})
+
+	t.Run("missing fields", func(t *testing.T) {
+		_, err := spec.NewInitBuilder().Build()
+		require.EqualError(t, err, "operators: not set")
+
+		_, err = spec.NewInitBuilder().Operators(fixtures.GenerateOperators(4)...).Build()
+		require.EqualError(t, err, "withdrawal credentials: not set")
+
+		_, err = spec.NewInitBuilder().Operators(fixtures.GenerateOperators(4)...).WithdrawalCredentials(withdrawalAddress).Build()
+		require.EqualError(t, err, "owner: not set")
+
+		_, err = spec.NewInitBuilder().Owner([20]byte{}).Build()
+		require.EqualError(t, err, "owner: zero address")
+	})
+}
@@ -30,6 +30,20 @@ func TestThresholdForCluster(t *testing.T) {
 		require.NoError(t, err)
 		require.EqualValues(t, 9, threshold)
 	})
+	t.Run("formula", func(t *testing.T) {
+		for _, size := range spec.ClusterSizes {
+			threshold, err := spec.ThresholdForSize(size)
+			require.NoError(t, err)
+			// n = 3f+1, t = 2f+1
+			require.EqualValues(t, 2*(size-1)/3+1, threshold)
+			require.True(t, spec.ValidThresholdSet(threshold, fixtures.GenerateOperators(size)))
+			require.False(t, spec.ValidThresholdSet(threshold-1, fixtures.GenerateOperators(size)))
+		}
+		for _, size := range []int{0, 1, 3, 5, 16} {
+			_, err := spec.ThresholdForSize(size)
+			require.EqualError(t, err, "invalid cluster size")
+		}
+	})
 }
 
 func TestValidateInitMessage(t *testing.T) {
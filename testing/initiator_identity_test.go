@@ -0,0 +1,93 @@
+package testing
+
+import (
+	"testing"
+
+	spec "github.com/bloxapp/dkg-spec"
+	"github.com/bloxapp/dkg-spec/testing/fixtures"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSignedInit(t *testing.T) {
+	init := &spec.Init{
+		Operators:             fixtures.GenerateOperators(4),
+		T:                     3,
+		WithdrawalCredentials: fixtures.TestOwnerAddress[:],
+		Fork:                  fixtures.TestFork,
+		Owner:                 fixtures.TestOwnerAddress,
+	}
+	initiatorSK := fixtures.OperatorSK(fixtures.TestOperator1SK)
+	signed, err := spec.SignInit(init, fixtures.TestRequestID, initiatorSK)
+	require.NoError(t, err)
+
+	t.Run("valid", func(t *testing.T) {
+		require.NoError(t, spec.VerifySignedInit(signed))
+	})
+
+	t.Run("ssz round trip", func(t *testing.T) {
+		byts, err := signed.MarshalSSZ()
+		require.NoError(t, err)
+		decoded := &spec.SignedInit{}
+		require.NoError(t, decoded.UnmarshalSSZ(byts))
+		require.NoError(t, spec.VerifySignedInit(decoded))
+	})
+
+	t.Run("tampered init", func(t *testing.T) {
+		tampered := *signed
+		tampered.Init = &spec.Init{
+			Operators:             init.Operators,
+			T:                     init.T,
+			WithdrawalCredentials: init.WithdrawalCredentials,
+			Fork:                  init.Fork,
+			Owner:                 init.Owner,
+			Nonce:                 1,
+		}
+		err := spec.VerifySignedInit(&tampered)
+		require.Error(t, err)
+		require.EqualValues(t, spec.CodeInvalidInitiatorSignature, spec.ErrorCodeOf(err))
+	})
+
+	t.Run("other initiator", func(t *testing.T) {
+		tampered := *signed
+		tampered.InitiatorPubKey = fixtures.EncodedOperatorPK(fixtures.TestOperator2SK)
+		err := spec.VerifySignedInit(&tampered)
+		require.EqualValues(t, spec.CodeInvalidInitiatorSignature, spec.ErrorCodeOf(err))
+	})
+
+	t.Run("other request", func(t *testing.T) {
+		tampered := *signed
+		tampered.RequestID = [24]byte{0x01}
+		err := spec.VerifySignedInit(&tampered)
+		require.EqualValues(t, spec.CodeInvalidInitiatorSignature, spec.ErrorCodeOf(err))
+
+		err = (&spec.InitiatorPolicy{}).CheckSignedInit(signed, [24]byte{0x01})
+		require.EqualValues(t, spec.CodeRequestIDMismatch, spec.ErrorCodeOf(err))
+
+		_, err = spec.OperatorSignedInit(signed, [24]byte{0x01}, 1, fixtures.OperatorSK(fixtures.TestOperator1SK), nil)
+		require.EqualValues(t, spec.CodeRequestIDMismatch, spec.ErrorCodeOf(err))
+	})
+
+	t.Run("owner signature root differs", func(t *testing.T) {
+		root, err := signed.SigningRoot()
+		require.NoError(t, err)
+		ownerRoot, err := init.SigningRoot()
+		require.NoError(t, err)
+		require.NotEqual(t, ownerRoot, root)
+	})
+
+	t.Run("missing init", func(t *testing.T) {
+		require.EqualError(t, spec.VerifySignedInit(&spec.SignedInit{}), "missing init")
+	})
+
+	t.Run("initiator ID", func(t *testing.T) {
+		id, err := spec.InitiatorID(signed.InitiatorPubKey)
+		require.NoError(t, err)
+		other, err := spec.InitiatorID(fixtures.EncodedOperatorPK(fixtures.TestOperator2SK))
+		require.NoError(t, err)
+		require.NotEqual(t, id, other)
+
+		_, err = spec.InitiatorID([]byte("not a key"))
+		require.Error(t, err)
+	})
+}
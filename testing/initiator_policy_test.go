@@ -0,0 +1,95 @@
+package testing
+
+import (
+	"encoding/json"
+	"testing"
+
+	spec "github.com/bloxapp/dkg-spec"
+	"github.com/bloxapp/dkg-spec/testing/fixtures"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInitiatorPolicy(t *testing.T) {
+	init := &spec.Init{
+		Operators:             fixtures.GenerateOperators(4),
+		T:                     3,
+		WithdrawalCredentials: fixtures.TestOwnerAddress[:],
+		Fork:                  fixtures.TestFork,
+		Owner:                 fixtures.TestOwnerAddress,
+	}
+	signed, err := spec.SignInit(init, fixtures.TestRequestID, fixtures.OperatorSK(fixtures.TestOperator1SK))
+	require.NoError(t, err)
+	initiator, err := spec.InitiatorID(signed.InitiatorPubKey)
+	require.NoError(t, err)
+	other, err := spec.InitiatorID(fixtures.EncodedOperatorPK(fixtures.TestOperator2SK))
+	require.NoError(t, err)
+	otherOwner := common.Address{0x01}
+
+	t.Run("empty policy", func(t *testing.T) {
+		policy := &spec.InitiatorPolicy{}
+		require.NoError(t, policy.CheckSignedInit(signed, fixtures.TestRequestID))
+		require.NoError(t, policy.Check(nil, init))
+	})
+
+	t.Run("allowed initiator", func(t *testing.T) {
+		policy := &spec.InitiatorPolicy{AllowedInitiators: []common.Hash{initiator}}
+		require.NoError(t, policy.CheckSignedInit(signed, fixtures.TestRequestID))
+
+		err := policy.Check(nil, init)
+		require.EqualError(t, err, "unidentified initiator")
+		require.EqualValues(t, spec.CodeInitiatorNotAllowed, spec.ErrorCodeOf(err))
+	})
+
+	t.Run("initiator not allowed", func(t *testing.T) {
+		policy := &spec.InitiatorPolicy{AllowedInitiators: []common.Hash{other}}
+		err := policy.CheckSignedInit(signed, fixtures.TestRequestID)
+		require.EqualValues(t, spec.CodeInitiatorNotAllowed, spec.ErrorCodeOf(err))
+	})
+
+	t.Run("denied initiator", func(t *testing.T) {
+		policy := &spec.InitiatorPolicy{
+			AllowedInitiators: []common.Hash{initiator},
+			DeniedInitiators:  []common.Hash{initiator},
+		}
+		err := policy.CheckSignedInit(signed, fixtures.TestRequestID)
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "denied")
+		require.EqualValues(t, spec.CodeInitiatorNotAllowed, spec.ErrorCodeOf(err))
+	})
+
+	t.Run("owners", func(t *testing.T) {
+		policy := &spec.InitiatorPolicy{AllowedOwners: []common.Address{fixtures.TestOwnerAddress}}
+		require.NoError(t, policy.CheckSignedInit(signed, fixtures.TestRequestID))
+		require.NoError(t, policy.Check(nil, &fixtures.TestReshare4Operators))
+
+		policy = &spec.InitiatorPolicy{AllowedOwners: []common.Address{otherOwner}}
+		err := policy.Check(nil, init)
+		require.EqualValues(t, spec.CodeOwnerNotAllowed, spec.ErrorCodeOf(err))
+
+		policy = &spec.InitiatorPolicy{DeniedOwners: []common.Address{fixtures.TestOwnerAddress}}
+		err = policy.CheckSignedInit(signed, fixtures.TestRequestID)
+		require.EqualError(t, err, "owner "+common.Address(fixtures.TestOwnerAddress).Hex()+" denied")
+		require.EqualValues(t, spec.CodeOwnerNotAllowed, spec.ErrorCodeOf(err))
+	})
+
+	t.Run("invalid signature", func(t *testing.T) {
+		tampered := *signed
+		tampered.Signature = make([]byte, 256)
+		err := (&spec.InitiatorPolicy{}).CheckSignedInit(&tampered, fixtures.TestRequestID)
+		require.EqualValues(t, spec.CodeInvalidInitiatorSignature, spec.ErrorCodeOf(err))
+	})
+
+	t.Run("json", func(t *testing.T) {
+		policy := &spec.InitiatorPolicy{
+			AllowedInitiators: []common.Hash{initiator},
+			DeniedOwners:      []common.Address{otherOwner},
+		}
+		byts, err := json.Marshal(policy)
+		require.NoError(t, err)
+		decoded := &spec.InitiatorPolicy{}
+		require.NoError(t, json.Unmarshal(byts, decoded))
+		require.EqualValues(t, policy, decoded)
+	})
+}
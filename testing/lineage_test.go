@@ -0,0 +1,86 @@
+package testing
+
+import (
+	"testing"
+
+	spec "github.com/bloxapp/dkg-spec"
+	"github.com/bloxapp/dkg-spec/testing/fixtures"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLineage(t *testing.T) {
+	hop := proofChain(t)[0]
+	signedReshare := &spec.SignedReshare{Reshare: *hop.Reshare, Signature: []byte{1, 2, 3}}
+	lineage, err := spec.NewLineage(fixtures.TestRequestID, signedReshare, proofs4Operators(), hop.Proofs)
+	require.NoError(t, err)
+
+	t.Run("valid", func(t *testing.T) {
+		require.EqualValues(t, hop.Reshare.ValidatorPubKey, lineage.ValidatorPubKey)
+		require.NoError(t, spec.VerifyLineage(lineage, signedReshare, proofs4Operators(), fixtures.TestParams(4), hop.Proofs))
+	})
+
+	t.Run("ssz round trip", func(t *testing.T) {
+		byts, err := lineage.MarshalSSZ()
+		require.NoError(t, err)
+		decoded := &spec.Lineage{}
+		require.NoError(t, decoded.UnmarshalSSZ(byts))
+		require.EqualValues(t, lineage, decoded)
+	})
+
+	t.Run("proofs root", func(t *testing.T) {
+		root, err := proofs4Operators().HashTreeRoot()
+		require.NoError(t, err)
+		require.EqualValues(t, root, lineage.PriorProofsRoot)
+		reversed := spec.CeremonyProofs{proofs4Operators()[3], proofs4Operators()[2], proofs4Operators()[1], proofs4Operators()[0]}
+		other, err := reversed.HashTreeRoot()
+		require.NoError(t, err)
+		require.NotEqualValues(t, root, other)
+	})
+
+	t.Run("another reshare", func(t *testing.T) {
+		other := *signedReshare
+		other.Reshare.Nonce = 5
+		err := spec.VerifyLineage(lineage, &other, proofs4Operators(), fixtures.TestParams(4), hop.Proofs)
+		require.EqualError(t, err, "lineage of another reshare")
+		require.EqualValues(t, spec.CodeLineageMismatch, spec.ErrorCodeOf(err))
+	})
+
+	t.Run("another validator", func(t *testing.T) {
+		other := *lineage
+		other.ValidatorPubKey = fixtures.ShareSK(fixtures.TestValidator7Operators).GetPublicKey().Serialize()
+		err := spec.VerifyLineage(&other, signedReshare, proofs4Operators(), fixtures.TestParams(4), hop.Proofs)
+		require.EqualError(t, err, "lineage of another validator")
+		require.EqualValues(t, spec.CodeLineageMismatch, spec.ErrorCodeOf(err))
+	})
+
+	t.Run("other prior proofs", func(t *testing.T) {
+		prior := proofs4Operators()
+		prior[0], prior[1] = prior[1], prior[0]
+		err := spec.VerifyLineage(lineage, signedReshare, prior, fixtures.TestParams(4), hop.Proofs)
+		require.EqualError(t, err, "prior proofs don't match the lineage")
+		require.EqualValues(t, spec.CodeLineageMismatch, spec.ErrorCodeOf(err))
+	})
+
+	t.Run("other proofs", func(t *testing.T) {
+		err := spec.VerifyLineage(lineage, signedReshare, proofs4Operators(), fixtures.TestParams(4), hop.Proofs[1:])
+		require.EqualError(t, err, "proofs don't match the lineage")
+		require.EqualValues(t, spec.CodeLineageMismatch, spec.ErrorCodeOf(err))
+	})
+
+	t.Run("invalid hop", func(t *testing.T) {
+		prior := proofs4Operators()
+		prior[0], prior[1] = prior[1], prior[0]
+		invalid, err := spec.NewLineage(fixtures.TestRequestID, signedReshare, prior, hop.Proofs)
+		require.NoError(t, err)
+		require.ErrorContains(t, spec.VerifyLineage(invalid, signedReshare, prior, fixtures.TestParams(4), hop.Proofs), "initial ceremony: invalid proof for operator 1")
+	})
+
+	t.Run("proofs of another ceremony", func(t *testing.T) {
+		other, err := spec.NewLineage([24]byte{1}, signedReshare, proofs4Operators(), hop.Proofs)
+		require.NoError(t, err)
+		err = spec.VerifyLineage(other, signedReshare, proofs4Operators(), fixtures.TestParams(4), hop.Proofs)
+		require.EqualError(t, err, "proof of operator 1 issued in another ceremony")
+		require.EqualValues(t, spec.CodeRequestIDMismatch, spec.ErrorCodeOf(err))
+	})
+}
@@ -0,0 +1,87 @@
+package testing
+
+import (
+	"testing"
+
+	spec "github.com/bloxapp/dkg-spec"
+	"github.com/bloxapp/dkg-spec/testing/fixtures"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestBulkResultsRoot(t *testing.T) {
+	bulk := [][]*spec.Result{
+		fixtures.Results4Operators(),
+		fixtures.Results7Operators(),
+		fixtures.Results10Operators(),
+		fixtures.Results13Operators(),
+		fixtures.Results4Operators()[:3],
+	}
+
+	root, err := spec.BulkResultsRoot(bulk)
+	require.NoError(t, err)
+
+	t.Run("deterministic", func(t *testing.T) {
+		root2, err := spec.BulkResultsRoot(bulk)
+		require.NoError(t, err)
+		require.EqualValues(t, root, root2)
+	})
+
+	t.Run("valid inclusion proofs", func(t *testing.T) {
+		for i := range bulk {
+			proof, err := spec.BulkResultsProof(bulk, i)
+			require.NoError(t, err)
+			leaf, err := spec.ResultsLeaf(bulk[i])
+			require.NoError(t, err)
+			require.True(t, spec.VerifyBulkResultsProof(root, leaf, proof))
+		}
+	})
+
+	t.Run("wrong leaf", func(t *testing.T) {
+		proof, err := spec.BulkResultsProof(bulk, 0)
+		require.NoError(t, err)
+		leaf, err := spec.ResultsLeaf(bulk[1])
+		require.NoError(t, err)
+		require.False(t, spec.VerifyBulkResultsProof(root, leaf, proof))
+	})
+
+	t.Run("wrong count", func(t *testing.T) {
+		proof, err := spec.BulkResultsProof(bulk, 4)
+		require.NoError(t, err)
+		leaf, err := spec.ResultsLeaf(bulk[4])
+		require.NoError(t, err)
+		proof.Count = 6
+		require.False(t, spec.VerifyBulkResultsProof(root, leaf, proof))
+	})
+
+	t.Run("single validator", func(t *testing.T) {
+		single := bulk[:1]
+		root, err := spec.BulkResultsRoot(single)
+		require.NoError(t, err)
+		proof, err := spec.BulkResultsProof(single, 0)
+		require.NoError(t, err)
+		require.Empty(t, proof.Branch)
+		leaf, err := spec.ResultsLeaf(single[0])
+		require.NoError(t, err)
+		require.True(t, spec.VerifyBulkResultsProof(root, leaf, proof))
+	})
+
+	t.Run("index out of range", func(t *testing.T) {
+		_, err := spec.BulkResultsProof(bulk, 5)
+		require.EqualError(t, err, "index out of range")
+	})
+}
+
+func BenchmarkBulkResultsRoot(b *testing.B) {
+	bulk := make([][]*spec.Result, 0, 1000)
+	for i := 0; i < 1000; i++ {
+		bulk = append(bulk, fixtures.Results13Operators())
+	}
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := spec.BulkResultsRoot(bulk); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
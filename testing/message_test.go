@@ -0,0 +1,174 @@
+package testing
+
+import (
+	"testing"
+
+	spec "github.com/bloxapp/dkg-spec"
+	"github.com/bloxapp/dkg-spec/crypto"
+	"github.com/bloxapp/dkg-spec/testing/fixtures"
+
+	eth_crypto "github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCeremonyMessage(t *testing.T) {
+	init := &spec.Init{
+		Operators:             fixtures.GenerateOperators(4),
+		T:                     3,
+		WithdrawalCredentials: make([]byte, 32),
+		Fork:                  fixtures.TestFork,
+		Owner:                 fixtures.TestOwnerAddress,
+		Nonce:                 1,
+	}
+	reshare := fixtures.TestReshare4Operators
+	resign := &spec.Resign{
+		ValidatorPubKey:       fixtures.ShareSK(fixtures.TestValidator4Operators).GetPublicKey().Serialize(),
+		Fork:                  fixtures.TestFork,
+		WithdrawalCredentials: make([]byte, 32),
+		Owner:                 fixtures.TestOwnerAddress,
+		Nonce:                 2,
+		Amount:                spec.DepositAmount,
+	}
+
+	t.Run("generic access", func(t *testing.T) {
+		for _, test := range []struct {
+			msg   spec.CeremonyMessage
+			typ   spec.MessageType
+			nonce uint64
+			// domain of the owner's signature, none for inits
+			domain *spec.Domain
+		}{
+			{msg: init, typ: spec.InitMessageType, nonce: 1},
+			{msg: &reshare, typ: spec.ReshareMessageType, nonce: reshare.Nonce, domain: &spec.DomainReshare},
+			{msg: resign, typ: spec.ResignMessageType, nonce: 2, domain: &spec.DomainResign},
+		} {
+			t.Run(test.typ.String(), func(t *testing.T) {
+				require.EqualValues(t, test.typ, test.msg.Type())
+				require.EqualValues(t, fixtures.TestOwnerAddress, test.msg.GetOwner())
+				require.EqualValues(t, test.nonce, test.msg.GetNonce())
+				require.NoError(t, test.msg.Validate())
+
+				root, err := test.msg.SigningRoot()
+				require.NoError(t, err)
+				expected, err := test.msg.HashTreeRoot()
+				if test.domain != nil {
+					expected, err = spec.ComputeSigningRoot(test.msg, *test.domain)
+				}
+				require.NoError(t, err)
+				require.EqualValues(t, expected, root)
+
+				byts, err := test.msg.MarshalSSZ()
+				require.NoError(t, err)
+				decoded, err := spec.NewCeremonyMessage(test.msg.Type())
+				require.NoError(t, err)
+				require.NoError(t, decoded.UnmarshalSSZ(byts))
+				decodedRoot, err := decoded.SigningRoot()
+				require.NoError(t, err)
+				require.EqualValues(t, root, decodedRoot)
+			})
+		}
+	})
+
+	t.Run("invalid init", func(t *testing.T) {
+		invalid := *init
+		invalid.T = 2
+		require.EqualError(t, invalid.Validate(), "threshold set is invalid")
+	})
+
+	t.Run("invalid reshare", func(t *testing.T) {
+		invalid := reshare
+		invalid.NewOperators = invalid.OldOperators
+		require.EqualError(t, invalid.Validate(), "old and new operators are the same")
+
+		invalid = reshare
+		invalid.OldOperators = []*spec.Operator{invalid.OldOperators[1], invalid.OldOperators[0]}
+		require.EqualError(t, invalid.Validate(), "old operators are not unique and ordered")
+	})
+
+	t.Run("invalid resign", func(t *testing.T) {
+		invalid := *resign
+		invalid.ValidatorPubKey = invalid.ValidatorPubKey[:47]
+		require.EqualError(t, invalid.Validate(), "invalid validator public key length")
+	})
+
+	t.Run("unknown type", func(t *testing.T) {
+		_, err := spec.NewCeremonyMessage(spec.MessageType(0))
+		require.EqualError(t, err, "unknown message type 0")
+		require.EqualValues(t, "unknown(0)", spec.MessageType(0).String())
+	})
+}
+
+func TestOwnerSignature(t *testing.T) {
+	crypto.InitBLS()
+	sk, err := eth_crypto.GenerateKey()
+	require.NoError(t, err)
+	owner := eth_crypto.PubkeyToAddress(sk.PublicKey)
+	sign := func(t *testing.T, root [32]byte) []byte {
+		ret, err := eth_crypto.Sign(root[:], sk)
+		require.NoError(t, err)
+		return ret
+	}
+
+	reshare := fixtures.TestReshare4Operators
+	reshare.Owner = owner
+	reshare.WithdrawalCredentials = owner[:]
+	root, err := reshare.SigningRoot()
+	require.NoError(t, err)
+	signed := &spec.SignedReshare{Reshare: reshare, Signature: sign(t, root)}
+	require.NoError(t, spec.VerifyOwnerSignature(nil, &reshare, signed.Signature))
+
+	// operator 5 joins the cluster, verifying the owner's signature offline
+	deals := make([]*spec.ReshareDeal, 0)
+	for id, share := range map[uint64]string{
+		1: fixtures.TestValidator4OperatorsShare1,
+		2: fixtures.TestValidator4OperatorsShare2,
+		3: fixtures.TestValidator4OperatorsShare3,
+	} {
+		dealt, err := spec.DealReshare(&reshare, fixtures.TestRequestID, id, fixtures.ShareSK(share))
+		require.NoError(t, err)
+		deals = append(deals, dealt[3])
+	}
+	reshareWith := func(signed *spec.SignedReshare) error {
+		_, err := spec.OperatorReshare(
+			signed,
+			reshare.NewOperators[3],
+			nil,
+			nil,
+			deals,
+			fixtures.TestRequestID,
+			fixtures.OperatorSK(fixtures.TestOperator5SK),
+			nil,
+			nil,
+		)
+		return err
+	}
+	require.NoError(t, reshareWith(signed))
+
+	// the owner signs the reshare, not the signed reshare holding the signature
+	wrapperRoot, err := signed.HashTreeRoot()
+	require.NoError(t, err)
+	err = reshareWith(&spec.SignedReshare{Reshare: reshare, Signature: sign(t, wrapperRoot)})
+	require.EqualError(t, err, "invalid signed reshare signature")
+	require.EqualValues(t, spec.CodeInvalidOwnerSignature, spec.ErrorCodeOf(err))
+
+	resign := &spec.Resign{
+		ValidatorPubKey:       reshare.ValidatorPubKey,
+		Fork:                  fixtures.TestFork,
+		WithdrawalCredentials: owner[:],
+		Owner:                 owner,
+		Nonce:                 1,
+		Amount:                spec.DepositAmount,
+	}
+	root, err = resign.SigningRoot()
+	require.NoError(t, err)
+	report := spec.DryRunOperatorResign(
+		&spec.SignedResign{Resign: *resign, Signature: sign(t, root)},
+		fixtures.GenerateOperators(4)[0],
+		&fixtures.TestOperator1Proof4Operators,
+		fixtures.TestParams(4),
+		nil,
+		nil,
+	)
+	require.EqualValues(t, "owner signature", report.Checks[0].Name)
+	require.Empty(t, report.Checks[0].Error)
+}
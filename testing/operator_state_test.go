@@ -0,0 +1,123 @@
+package testing
+
+import (
+	"bytes"
+	"testing"
+
+	spec "github.com/bloxapp/dkg-spec"
+	"github.com/bloxapp/dkg-spec/crypto"
+	"github.com/bloxapp/dkg-spec/testing/fixtures"
+
+	"github.com/stretchr/testify/require"
+)
+
+// resultWithRequestID returns a copy of result issued in ceremony requestID
+func resultWithRequestID(result *spec.Result, requestID [24]byte) *spec.Result {
+	ret := *result
+	proof := *result.SignedProof.Proof
+	proof.RequestID = requestID
+	ret.RequestID = requestID
+	ret.SignedProof.Proof = &proof
+	return &ret
+}
+
+func TestOperatorState(t *testing.T) {
+	operator := fixtures.GenerateOperators(4)[0]
+	otherRequestID := [24]byte{0xff}
+	state := spec.NewOperatorState(operator)
+	require.NoError(t, state.AddResult(fixtures.Results4Operators()[0]))
+	require.NoError(t, state.AddResult(resultWithRequestID(fixtures.Results7Operators()[0], otherRequestID)))
+
+	t.Run("processed requests", func(t *testing.T) {
+		require.True(t, state.Processed(fixtures.TestRequestID))
+		require.True(t, state.Processed(otherRequestID))
+		require.False(t, state.Processed([24]byte{}))
+		require.EqualError(t, state.MarkProcessed(fixtures.TestRequestID), "request 0102030405060708090a0b0c0d0e0f101112131415161718 already processed")
+		require.EqualError(t, state.AddResult(fixtures.Results4Operators()[0]), "request 0102030405060708090a0b0c0d0e0f101112131415161718 already processed")
+	})
+
+	t.Run("stored shares", func(t *testing.T) {
+		validatorPK := fixtures.ShareSK(fixtures.TestValidator4Operators).GetPublicKey().Serialize()
+		share := state.Share(validatorPK)
+		require.NotNil(t, share)
+		require.EqualValues(t, fixtures.TestRequestID, share.RequestID)
+		root, err := fixtures.Results4Operators()[0].HashTreeRoot()
+		require.NoError(t, err)
+		require.EqualValues(t, root, share.ResultRoot)
+		require.Len(t, state.Shares, 2)
+		require.Nil(t, state.Share(fixtures.ShareSK(fixtures.TestValidator10Operators).GetPublicKey().Serialize()))
+	})
+
+	t.Run("reshared validator", func(t *testing.T) {
+		reshared := spec.NewOperatorState(operator)
+		require.NoError(t, reshared.AddResult(fixtures.Results4Operators()[0]))
+		result := resultWithRequestID(fixtures.Results4Operators()[0], otherRequestID)
+		require.NoError(t, reshared.AddResult(result))
+		require.Len(t, reshared.Shares, 1)
+		require.EqualValues(t, otherRequestID, reshared.Shares[0].RequestID)
+		require.Len(t, reshared.ProcessedRequestIDs, 2)
+	})
+
+	t.Run("result of another operator", func(t *testing.T) {
+		err := spec.NewOperatorState(operator).AddResult(fixtures.Results4Operators()[1])
+		require.EqualError(t, err, "result of operator 2")
+		require.EqualValues(t, spec.CodeOperatorNotFound, spec.ErrorCodeOf(err))
+	})
+
+	passphrase := []byte("passphrase")
+	exported := &bytes.Buffer{}
+	require.NoError(t, spec.ExportOperatorState(exported, state, passphrase))
+
+	t.Run("export and import", func(t *testing.T) {
+		require.NotContains(t, string(exported.Bytes()), string(fixtures.TestRequestID[:]))
+		imported, err := spec.ImportOperatorState(bytes.NewReader(exported.Bytes()), operator, passphrase)
+		require.NoError(t, err)
+		requireSameRoot(t, state, imported)
+		require.True(t, imported.Processed(otherRequestID))
+	})
+
+	t.Run("import on another operator", func(t *testing.T) {
+		_, err := spec.ImportOperatorState(bytes.NewReader(exported.Bytes()), fixtures.GenerateOperators(4)[1], passphrase)
+		require.EqualError(t, err, "state of operator 1")
+		require.EqualValues(t, spec.CodeOperatorKeyMismatch, spec.ErrorCodeOf(err))
+	})
+
+	t.Run("wrong passphrase", func(t *testing.T) {
+		_, err := spec.ImportOperatorState(bytes.NewReader(exported.Bytes()), operator, []byte("other passphrase"))
+		require.EqualError(t, err, "failed to decrypt operator state: cipher: message authentication failed")
+	})
+
+	t.Run("tampered parameters", func(t *testing.T) {
+		encrypted := &spec.EncryptedOperatorState{}
+		require.NoError(t, encrypted.UnmarshalSSZ(exported.Bytes()))
+		// scrypt N = 2^30
+		encrypted.KDF[1+crypto.KDFSaltLen] = 0x40
+		_, err := spec.DecryptOperatorState(encrypted, passphrase)
+		require.EqualError(t, err, "invalid scrypt parameters")
+	})
+
+	t.Run("unordered request IDs", func(t *testing.T) {
+		invalid := *state
+		invalid.ProcessedRequestIDs = [][]byte{state.ProcessedRequestIDs[1], state.ProcessedRequestIDs[0]}
+		err := invalid.Validate()
+		require.EqualError(t, err, "processed request IDs are not unique and ordered")
+		require.EqualValues(t, spec.CodeNonCanonicalEncoding, spec.ErrorCodeOf(err))
+	})
+
+	t.Run("share of an unprocessed request", func(t *testing.T) {
+		invalid := *state
+		invalid.ProcessedRequestIDs = state.ProcessedRequestIDs[:1]
+		require.ErrorContains(t, spec.ExportOperatorState(&bytes.Buffer{}, &invalid, passphrase), "produced in unprocessed request")
+	})
+
+	t.Run("unsupported version", func(t *testing.T) {
+		invalid := *state
+		invalid.Version = 2
+		require.EqualError(t, invalid.Validate(), "unsupported operator state version 2")
+	})
+
+	t.Run("invalid encoding", func(t *testing.T) {
+		_, err := spec.ImportOperatorState(bytes.NewReader([]byte{1, 2, 3}), operator, passphrase)
+		require.ErrorContains(t, err, "failed to decode encrypted operator state")
+	})
+}
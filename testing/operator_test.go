@@ -0,0 +1,89 @@
+package testing
+
+import (
+	"testing"
+
+	spec "github.com/bloxapp/dkg-spec"
+	"github.com/bloxapp/dkg-spec/testing/fixtures"
+
+	ssz "github.com/ferranbt/fastssz"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOperatorSSZ(t *testing.T) {
+	t.Run("operator round trip", func(t *testing.T) {
+		op := fixtures.GenerateOperators(4)[0]
+		op.Addr = []byte("127.0.0.1:3030")
+
+		byts, err := op.MarshalSSZ()
+		require.NoError(t, err)
+		decoded := &spec.Operator{}
+		require.NoError(t, decoded.UnmarshalSSZ(byts))
+		require.EqualValues(t, op, decoded)
+
+		root, err := op.HashTreeRoot()
+		require.NoError(t, err)
+		decodedRoot, err := decoded.HashTreeRoot()
+		require.NoError(t, err)
+		require.EqualValues(t, root, decodedRoot)
+	})
+
+	t.Run("operators round trip", func(t *testing.T) {
+		ops := spec.Operators(fixtures.GenerateOperators(13))
+		byts, err := ops.MarshalSSZ()
+		require.NoError(t, err)
+		require.Len(t, byts, ops.SizeSSZ())
+
+		decoded := spec.Operators{}
+		require.NoError(t, decoded.UnmarshalSSZ(byts))
+		require.Len(t, decoded, 13)
+		reencoded, err := decoded.MarshalSSZ()
+		require.NoError(t, err)
+		require.EqualValues(t, byts, reencoded)
+	})
+
+	t.Run("operators consistent with init", func(t *testing.T) {
+		init := &spec.Init{
+			Operators:             fixtures.GenerateOperators(7),
+			T:                     5,
+			WithdrawalCredentials: fixtures.TestWithdrawalCred[:32],
+			Fork:                  fixtures.TestFork,
+			Owner:                 fixtures.TestOwnerAddress,
+			Nonce:                 1,
+		}
+		operatorsRoot, err := spec.Operators(init.Operators).HashTreeRoot()
+		require.NoError(t, err)
+
+		// rebuild the init root with the operators list root as its first field
+		hh := ssz.NewHasher()
+		indx := hh.Index()
+		hh.PutBytes(operatorsRoot[:])
+		hh.PutUint64(init.T)
+		subIndx := hh.Index()
+		hh.Append(init.WithdrawalCredentials)
+		hh.MerkleizeWithMixin(subIndx, uint64(len(init.WithdrawalCredentials)), 1)
+		hh.PutBytes(init.Fork[:])
+		hh.PutBytes(init.Owner[:])
+		hh.PutUint64(init.Nonce)
+		feeRoot, err := init.Fee.HashTreeRoot()
+		require.NoError(t, err)
+		hh.PutBytes(feeRoot[:])
+		hh.PutUint64(init.NotBefore)
+		hh.PutUint64(init.NotAfter)
+		hh.Merkleize(indx)
+		expected, err := hh.HashRoot()
+		require.NoError(t, err)
+
+		root, err := init.HashTreeRoot()
+		require.NoError(t, err)
+		require.EqualValues(t, expected, root)
+	})
+
+	t.Run("too many operators", func(t *testing.T) {
+		ops := spec.Operators(append(fixtures.GenerateOperators(13), fixtures.GenerateOperators(4)[0]))
+		_, err := ops.MarshalSSZ()
+		require.Error(t, err)
+		_, err = ops.HashTreeRoot()
+		require.Error(t, err)
+	})
+}
@@ -0,0 +1,52 @@
+package testing
+
+import (
+	"testing"
+
+	spec "github.com/bloxapp/dkg-spec"
+	"github.com/bloxapp/dkg-spec/testing/fixtures"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCeremonyParams(t *testing.T) {
+	init := &spec.Init{
+		Operators:             fixtures.GenerateOperators(4),
+		T:                     3,
+		WithdrawalCredentials: fixtures.TestWithdrawalCred[:32],
+		Fork:                  fixtures.TestFork,
+		Owner:                 fixtures.TestOwnerAddress,
+	}
+
+	t.Run("init", func(t *testing.T) {
+		params := init.Params()
+		require.EqualValues(t, []uint64{1, 2, 3, 4}, params.OperatorIDs)
+		require.EqualValues(t, 3, params.T)
+		require.EqualValues(t, spec.DepositAmount, params.Amount)
+		require.NoError(t, spec.ValidateProofParams(fixtures.TestOperator1Proof4Operators.Proof, params))
+	})
+
+	t.Run("reshare", func(t *testing.T) {
+		reshare := fixtures.TestReshare4Operators
+		require.EqualValues(t, []uint64{1, 2, 3, 5}, reshare.NewParams().OperatorIDs)
+	})
+
+	t.Run("mismatch", func(t *testing.T) {
+		params := init.Params()
+		params.T = 4
+		err := spec.ValidateProofParams(fixtures.TestOperator1Proof4Operators.Proof, params)
+		require.EqualError(t, err, "proof issued under different ceremony parameters")
+
+		params = init.Params()
+		params.Amount = 2 * spec.DepositAmount
+		require.Error(t, spec.ValidateProofParams(fixtures.TestOperator1Proof4Operators.Proof, params))
+	})
+
+	t.Run("proof without params hash", func(t *testing.T) {
+		proof := *fixtures.TestOperator1Proof4Operators.Proof
+		proof.ParamsHash = [32]byte{}
+		params := init.Params()
+		params.T = 4
+		require.NoError(t, spec.ValidateProofParams(&proof, params))
+	})
+}
@@ -0,0 +1,92 @@
+package testing
+
+import (
+	"testing"
+
+	spec "github.com/bloxapp/dkg-spec"
+	"github.com/bloxapp/dkg-spec/testing/fixtures"
+
+	ssz "github.com/ferranbt/fastssz"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEncryptedPayload(t *testing.T) {
+	operators := fixtures.GenerateOperators(4)
+	signed := &spec.SignedReshare{Reshare: fixtures.TestReshare4Operators, Signature: make([]byte, 65)}
+	signed.Reshare.WithdrawalCredentials = fixtures.TestWithdrawalCred[:32]
+	payloads, err := spec.EncryptPayloads(operators, fixtures.TestRequestID, spec.ReshareMessageType, signed)
+	require.NoError(t, err)
+	require.Len(t, payloads, 4)
+
+	t.Run("decrypt", func(t *testing.T) {
+		decrypted := &spec.SignedReshare{}
+		require.NoError(t, spec.DecryptPayload(2, fixtures.OperatorSK(fixtures.TestOperator2SK), payloads[1], decrypted))
+		requireSameRoot(t, signed, decrypted)
+	})
+
+	t.Run("ssz round trip", func(t *testing.T) {
+		byts, err := payloads[0].MarshalSSZ()
+		require.NoError(t, err)
+		decoded := &spec.EncryptedPayload{}
+		require.NoError(t, decoded.UnmarshalSSZ(byts))
+		decrypted := &spec.SignedReshare{}
+		require.NoError(t, spec.DecryptPayload(1, fixtures.OperatorSK(fixtures.TestOperator1SK), decoded, decrypted))
+		requireSameRoot(t, signed, decrypted)
+	})
+
+	t.Run("init", func(t *testing.T) {
+		init := &spec.Init{
+			Operators:             operators,
+			T:                     3,
+			WithdrawalCredentials: fixtures.TestWithdrawalCred[:32],
+			Fork:                  fixtures.TestFork,
+			Owner:                 fixtures.TestOwnerAddress,
+			Nonce:                 fixtures.TestNonce,
+		}
+		payload, err := spec.EncryptPayload(operators[2], fixtures.TestRequestID, spec.InitMessageType, init)
+		require.NoError(t, err)
+		require.NotContains(t, string(payload.Ciphertext), string(fixtures.TestOwnerAddress[:]))
+		decrypted := &spec.Init{}
+		require.NoError(t, spec.DecryptPayload(3, fixtures.OperatorSK(fixtures.TestOperator3SK), payload, decrypted))
+		requireSameRoot(t, init, decrypted)
+	})
+
+	t.Run("another operator", func(t *testing.T) {
+		err := spec.DecryptPayload(2, fixtures.OperatorSK(fixtures.TestOperator2SK), payloads[0], &spec.SignedReshare{})
+		require.EqualError(t, err, "payload encrypted to operator 1")
+
+		redirected := *payloads[0]
+		redirected.OperatorID = 2
+		err = spec.DecryptPayload(2, fixtures.OperatorSK(fixtures.TestOperator2SK), &redirected, &spec.SignedReshare{})
+		require.ErrorContains(t, err, "failed to decrypt payload")
+	})
+
+	t.Run("bound to request and type", func(t *testing.T) {
+		replayed := *payloads[0]
+		replayed.RequestID = [24]byte{0xaa}
+		err := spec.DecryptPayload(1, fixtures.OperatorSK(fixtures.TestOperator1SK), &replayed, &spec.SignedReshare{})
+		require.ErrorContains(t, err, "failed to decrypt payload")
+
+		retyped := *payloads[0]
+		retyped.Type = uint8(spec.ResignMessageType)
+		err = spec.DecryptPayload(1, fixtures.OperatorSK(fixtures.TestOperator1SK), &retyped, &spec.SignedResign{})
+		require.ErrorContains(t, err, "failed to decrypt payload")
+
+		retyped.Type = 9
+		err = spec.DecryptPayload(1, fixtures.OperatorSK(fixtures.TestOperator1SK), &retyped, &spec.SignedResign{})
+		require.EqualError(t, err, "unknown message type 9")
+	})
+
+	t.Run("unknown type", func(t *testing.T) {
+		_, err := spec.EncryptPayload(operators[0], fixtures.TestRequestID, 0, signed)
+		require.EqualError(t, err, "unknown message type 0")
+	})
+}
+
+func requireSameRoot(t *testing.T, expected, actual ssz.HashRoot) {
+	expectedRoot, err := expected.HashTreeRoot()
+	require.NoError(t, err)
+	actualRoot, err := actual.HashTreeRoot()
+	require.NoError(t, err)
+	require.EqualValues(t, expectedRoot, actualRoot)
+}
@@ -0,0 +1,74 @@
+package testing
+
+import (
+	"testing"
+	"time"
+
+	spec "github.com/bloxapp/dkg-spec"
+	"github.com/bloxapp/dkg-spec/testing/fixtures"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPingPong(t *testing.T) {
+	operators := fixtures.GenerateOperators(4)
+	now := time.Unix(1700000000, 0)
+	ping, err := spec.NewPing(now)
+	require.NoError(t, err)
+
+	signed, err := spec.SignPong(2, ping, 3, 10, now.Add(time.Second), fixtures.OperatorSK(fixtures.TestOperator2SK))
+	require.NoError(t, err)
+
+	t.Run("valid", func(t *testing.T) {
+		require.NoError(t, spec.VerifyPong(operators, ping, signed, now.Add(time.Second), time.Minute))
+		require.EqualValues(t, spec.SpecVersion, signed.Pong.SpecVersion)
+		require.NoError(t, signed.Pong.Ready(fixtures.TestFork))
+	})
+
+	t.Run("ssz round trip", func(t *testing.T) {
+		byts, err := signed.MarshalSSZ()
+		require.NoError(t, err)
+		decoded := &spec.SignedPong{}
+		require.NoError(t, decoded.UnmarshalSSZ(byts))
+		require.NoError(t, spec.VerifyPong(operators, ping, decoded, now, time.Minute))
+	})
+
+	t.Run("another ping", func(t *testing.T) {
+		other, err := spec.NewPing(now)
+		require.NoError(t, err)
+		require.NotEqualValues(t, ping.Nonce, other.Nonce)
+		require.EqualError(t, spec.VerifyPong(operators, other, signed, now, time.Minute), "pong doesn't answer ping")
+	})
+
+	t.Run("expired", func(t *testing.T) {
+		require.EqualError(t, spec.VerifyPong(operators, ping, signed, now.Add(time.Hour), time.Minute), "pong expired")
+		require.EqualError(t, spec.VerifyPong(operators, ping, signed, now.Add(-time.Hour), time.Minute), "pong timestamp in the future")
+	})
+
+	t.Run("tampered load", func(t *testing.T) {
+		tampered := *signed
+		pong := *signed.Pong
+		pong.ActiveCeremonies = 0
+		tampered.Pong = &pong
+		require.EqualError(t, spec.VerifyPong(operators, ping, &tampered, now, time.Minute), "crypto/rsa: verification error")
+
+		pong.OperatorID = 5
+		require.EqualError(t, spec.VerifyPong(operators, ping, &tampered, now, time.Minute), "operator not found")
+	})
+
+	t.Run("not ready", func(t *testing.T) {
+		require.EqualError(t, signed.Pong.Ready([4]byte{0xff}), "operator 2 doesn't support fork ff000000")
+
+		busy, err := spec.SignPong(2, ping, 10, 10, now, fixtures.OperatorSK(fixtures.TestOperator2SK))
+		require.NoError(t, err)
+		require.EqualError(t, busy.Pong.Ready(fixtures.TestFork), "operator 2 at capacity, 10 of 10 ceremonies running")
+
+		unbounded, err := spec.SignPong(2, ping, 10, 0, now, fixtures.OperatorSK(fixtures.TestOperator2SK))
+		require.NoError(t, err)
+		require.NoError(t, unbounded.Pong.Ready(fixtures.TestFork))
+
+		outdated := *signed.Pong
+		outdated.SpecVersion = []byte("v0.9.0")
+		require.EqualError(t, outdated.Ready(fixtures.TestFork), "operator 2 runs spec v0.9.0, expected "+spec.SpecVersion)
+	})
+}
@@ -0,0 +1,75 @@
+package testing
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	spec "github.com/bloxapp/dkg-spec"
+	"github.com/bloxapp/dkg-spec/testing/fixtures"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPolicy(t *testing.T) {
+	ctx := context.Background()
+	init := &spec.Init{
+		Operators:             fixtures.GenerateOperators(4),
+		T:                     3,
+		WithdrawalCredentials: fixtures.TestOwnerAddress[:],
+		Fork:                  fixtures.TestFork,
+		Owner:                 fixtures.TestOwnerAddress,
+	}
+
+	t.Run("nil policy", func(t *testing.T) {
+		require.NoError(t, spec.ApplyPolicy(ctx, nil, init))
+	})
+
+	t.Run("uncoded rejection", func(t *testing.T) {
+		policy := func(ctx context.Context, message spec.CeremonyMessage) error {
+			return fmt.Errorf("owner not KYC'd")
+		}
+		err := spec.ApplyPolicy(ctx, policy, init)
+		require.EqualError(t, err, "owner not KYC'd")
+		require.EqualValues(t, spec.CodePolicyRejected, spec.ErrorCodeOf(err))
+	})
+
+	t.Run("forks", func(t *testing.T) {
+		require.NoError(t, spec.ApplyPolicy(ctx, spec.ForkPolicy(fixtures.TestFork), init))
+		require.NoError(t, spec.ApplyPolicy(ctx, spec.ForkPolicy(fixtures.TestFork), &fixtures.TestReshare4Operators))
+
+		err := spec.ApplyPolicy(ctx, spec.ForkPolicy([4]byte{0x01}), init)
+		require.EqualError(t, err, "fork 00000000 not accepted")
+		require.EqualValues(t, spec.CodePolicyRejected, spec.ErrorCodeOf(err))
+	})
+
+	t.Run("policies", func(t *testing.T) {
+		var called []int
+		policy := func(i int, err error) spec.PolicyFunc {
+			return func(ctx context.Context, message spec.CeremonyMessage) error {
+				called = append(called, i)
+				return err
+			}
+		}
+		require.NoError(t, spec.ApplyPolicy(ctx, spec.Policies(policy(1, nil), policy(2, nil)), init))
+		require.Equal(t, []int{1, 2}, called)
+
+		called = nil
+		err := spec.ApplyPolicy(ctx, spec.Policies(policy(1, fmt.Errorf("rejected")), policy(2, nil)), init)
+		require.EqualError(t, err, "rejected")
+		require.Equal(t, []int{1}, called)
+	})
+
+	t.Run("initiator policy", func(t *testing.T) {
+		signed, err := spec.SignInit(init, fixtures.TestRequestID, fixtures.OperatorSK(fixtures.TestOperator1SK))
+		require.NoError(t, err)
+		initiator, err := spec.InitiatorID(signed.InitiatorPubKey)
+		require.NoError(t, err)
+		policy := (&spec.InitiatorPolicy{AllowedInitiators: []common.Hash{initiator}}).PolicyFunc()
+
+		require.NoError(t, spec.ApplyPolicy(spec.ContextWithInitiator(ctx, signed.InitiatorPubKey), policy, init))
+		err = spec.ApplyPolicy(ctx, policy, init)
+		require.EqualValues(t, spec.CodeInitiatorNotAllowed, spec.ErrorCodeOf(err))
+	})
+}
@@ -0,0 +1,98 @@
+package testing
+
+import (
+	"testing"
+
+	spec "github.com/bloxapp/dkg-spec"
+	"github.com/bloxapp/dkg-spec/testing/fixtures"
+
+	"github.com/stretchr/testify/require"
+)
+
+func partialSignatures4Operators(t *testing.T, root []byte) []*spec.PartialSignature {
+	ret := make([]*spec.PartialSignature, 0)
+	for i, share := range []string{
+		fixtures.TestValidator4OperatorsShare1,
+		fixtures.TestValidator4OperatorsShare2,
+		fixtures.TestValidator4OperatorsShare3,
+		fixtures.TestValidator4OperatorsShare4,
+	} {
+		sk := fixtures.ShareSK(share)
+		proof, err := spec.ProvePossession(sk)
+		require.NoError(t, err)
+		ret = append(ret, &spec.PartialSignature{
+			OperatorID:      uint64(i + 1),
+			SharePubKey:     sk.GetPublicKey().Serialize(),
+			Signature:       sk.SignByte(root).Serialize(),
+			PossessionProof: proof,
+		})
+	}
+	return ret
+}
+
+func TestAggregatePartialSignatures(t *testing.T) {
+	root := make([]byte, 32)
+	root[0] = 1
+	validatorSK := fixtures.ShareSK(fixtures.TestValidator4Operators)
+	validatorPK := validatorSK.GetPublicKey().Serialize()
+
+	t.Run("valid", func(t *testing.T) {
+		sig, err := spec.AggregatePartialSignatures(validatorPK, root, partialSignatures4Operators(t, root)[1:])
+		require.NoError(t, err)
+		require.EqualValues(t, validatorSK.SignByte(root).Serialize(), sig.Serialize())
+	})
+
+	t.Run("possession", func(t *testing.T) {
+		partials := partialSignatures4Operators(t, root)
+		require.NoError(t, spec.VerifyPossession(partials[0].SharePubKey, partials[0].PossessionProof))
+		err := spec.VerifyPossession(partials[0].SharePubKey, partials[1].PossessionProof)
+		require.EqualValues(t, spec.CodeInvalidPossessionProof, spec.ErrorCodeOf(err))
+		// a signature over the key without the possession domain is no proof
+		sk := fixtures.ShareSK(fixtures.TestValidator4OperatorsShare1)
+		err = spec.VerifyPossession(partials[0].SharePubKey, sk.SignByte(partials[0].SharePubKey).Serialize())
+		require.EqualValues(t, spec.CodeInvalidPossessionProof, spec.ErrorCodeOf(err))
+	})
+
+	t.Run("missing proof of possession", func(t *testing.T) {
+		partials := partialSignatures4Operators(t, root)
+		partials[2].PossessionProof = partials[1].PossessionProof
+		_, err := spec.AggregatePartialSignatures(validatorPK, root, partials)
+		require.ErrorContains(t, err, "operator 3: invalid proof of possession")
+		require.EqualValues(t, spec.CodeInvalidPossessionProof, spec.ErrorCodeOf(err))
+	})
+
+	t.Run("duplicate operator", func(t *testing.T) {
+		partials := partialSignatures4Operators(t, root)
+		partials = append(partials, partials[1])
+		_, err := spec.AggregatePartialSignatures(validatorPK, root, partials)
+		require.EqualError(t, err, "duplicate contribution of operator 2")
+		require.EqualValues(t, spec.CodeDuplicateContribution, spec.ErrorCodeOf(err))
+	})
+
+	t.Run("duplicate share key", func(t *testing.T) {
+		partials := partialSignatures4Operators(t, root)
+		duplicate := *partials[0]
+		duplicate.OperatorID = 5
+		_, err := spec.AggregatePartialSignatures(validatorPK, root, append(partials, &duplicate))
+		require.EqualError(t, err, "operator 5 contributes the share key of operator 1")
+		require.EqualValues(t, spec.CodeDuplicateContribution, spec.ErrorCodeOf(err))
+	})
+
+	t.Run("invalid partial signature", func(t *testing.T) {
+		partials := partialSignatures4Operators(t, root)
+		partials[3].Signature = partials[2].Signature
+		_, err := spec.AggregatePartialSignatures(validatorPK, root, partials)
+		require.EqualValues(t, spec.CodeInvalidPartialSignature, spec.ErrorCodeOf(err))
+	})
+
+	t.Run("below threshold", func(t *testing.T) {
+		_, err := spec.AggregatePartialSignatures(validatorPK, root, partialSignatures4Operators(t, root)[2:])
+		require.EqualError(t, err, "invalid recovered validator pubkey")
+		require.EqualValues(t, spec.CodeRecoveredPubKeyMismatch, spec.ErrorCodeOf(err))
+	})
+
+	t.Run("no partials", func(t *testing.T) {
+		_, err := spec.AggregatePartialSignatures(validatorPK, root, nil)
+		require.EqualError(t, err, "no partial signatures")
+	})
+}
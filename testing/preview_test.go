@@ -0,0 +1,107 @@
+package testing
+
+import (
+	"encoding/hex"
+	"testing"
+
+	spec "github.com/bloxapp/dkg-spec"
+	"github.com/bloxapp/dkg-spec/testing/fixtures"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	eth_crypto "github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSigningPreview(t *testing.T) {
+	validatorPK := fixtures.TestOperator1Proof4Operators.Proof.ValidatorPubKey
+
+	t.Run("reshare", func(t *testing.T) {
+		reshare := &spec.Reshare{
+			ValidatorPubKey:       validatorPK,
+			OldOperators:          fixtures.GenerateOperators(4),
+			NewOperators:          fixtures.GenerateOperators(7),
+			OldT:                  3,
+			NewT:                  5,
+			Fork:                  fixtures.TestFork,
+			WithdrawalCredentials: fixtures.TestWithdrawalCred[:32],
+			Owner:                 fixtures.TestOwnerAddress,
+			Nonce:                 2,
+		}
+		preview, err := spec.PreviewReshare(reshare)
+		require.NoError(t, err)
+		root, err := reshare.SigningRoot()
+		require.NoError(t, err)
+		require.EqualValues(t, "0x"+hex.EncodeToString(root[:]), preview.SigningRoot)
+		require.EqualValues(t, "DKG reshare request\n"+
+			"Owner: 0x0102030405060708090a0B0c0d0e0f1011121314\n"+
+			"Nonce: 2\n"+
+			"Network: mainnet phase0 (fork 0x00000000)\n"+
+			"Validator: 0x"+hex.EncodeToString(validatorPK)+"\n"+
+			"Withdrawal credentials: 0x"+hex.EncodeToString(fixtures.TestWithdrawalCred[:32])+"\n"+
+			"Old operators: 1, 2, 3, 4 (threshold 3)\n"+
+			"New operators: 1, 2, 3, 4, 5, 6, 7 (threshold 5)\n"+
+			"Signing root: "+preview.SigningRoot, preview.Text())
+	})
+
+	t.Run("resign", func(t *testing.T) {
+		resign := &spec.Resign{
+			ValidatorPubKey:       validatorPK,
+			Fork:                  [4]byte{0xde, 0xad, 0xbe, 0xef},
+			WithdrawalCredentials: fixtures.TestWithdrawalCred[:32],
+			Owner:                 fixtures.TestOwnerAddress,
+			Nonce:                 1,
+			Amount:                spec.DepositAmount,
+		}
+		preview, err := spec.PreviewResign(resign)
+		require.NoError(t, err)
+		require.EqualValues(t, "DKG resign request", preview.Title)
+		require.EqualValues(t, []spec.PreviewField{
+			{Label: "Owner", Value: "0x0102030405060708090a0B0c0d0e0f1011121314"},
+			{Label: "Nonce", Value: "1"},
+			{Label: "Network", Value: "unknown (fork 0xdeadbeef)"},
+			{Label: "Validator", Value: "0x" + hex.EncodeToString(validatorPK)},
+			{Label: "Withdrawal credentials", Value: "0x" + hex.EncodeToString(fixtures.TestWithdrawalCred[:32])},
+		}, preview.Fields)
+	})
+}
+
+func TestSigningPreviewVerifies(t *testing.T) {
+	sk, err := eth_crypto.GenerateKey()
+	require.NoError(t, err)
+	owner := eth_crypto.PubkeyToAddress(sk.PublicKey)
+	// signPreview signs the root displayed by preview, as a wallet does
+	signPreview := func(t *testing.T, preview *spec.SigningPreview) []byte {
+		root, err := hexutil.Decode(preview.SigningRoot)
+		require.NoError(t, err)
+		ret, err := eth_crypto.Sign(root, sk)
+		require.NoError(t, err)
+		return ret
+	}
+
+	reshare := fixtures.TestReshare4Operators
+	reshare.Owner = owner
+	reshare.WithdrawalCredentials = fixtures.TestWithdrawalCred[:32]
+	preview, err := spec.PreviewReshare(&reshare)
+	require.NoError(t, err)
+	require.NoError(t, spec.VerifyOwnerSignature(nil, &reshare, signPreview(t, preview)))
+
+	resign := &spec.Resign{
+		ValidatorPubKey:       reshare.ValidatorPubKey,
+		Fork:                  fixtures.TestFork,
+		WithdrawalCredentials: fixtures.TestWithdrawalCred[:32],
+		Owner:                 owner,
+		Nonce:                 1,
+		Amount:                spec.DepositAmount,
+	}
+	preview, err = spec.PreviewResign(resign)
+	require.NoError(t, err)
+	report := spec.DryRunOperatorResign(
+		&spec.SignedResign{Resign: *resign, Signature: signPreview(t, preview)},
+		fixtures.GenerateOperators(4)[0],
+		&fixtures.TestOperator1Proof4Operators,
+		fixtures.TestParams(4),
+		nil,
+		nil,
+	)
+	require.Empty(t, report.Checks[0].Error)
+}
@@ -0,0 +1,162 @@
+package testing
+
+import (
+	"testing"
+
+	spec "github.com/bloxapp/dkg-spec"
+	"github.com/bloxapp/dkg-spec/crypto"
+	"github.com/bloxapp/dkg-spec/testing/fixtures"
+
+	"github.com/stretchr/testify/require"
+)
+
+var chainOperatorSKs = map[uint64]string{
+	1: fixtures.TestOperator1SK,
+	2: fixtures.TestOperator2SK,
+	3: fixtures.TestOperator3SK,
+	4: fixtures.TestOperator4SK,
+	5: fixtures.TestOperator5SK,
+	6: fixtures.TestOperator6SK,
+	7: fixtures.TestOperator7SK,
+	8: fixtures.TestOperator8SK,
+}
+
+// reshareProofs returns the proofs the new operators of reshare sign for the 4 operators validator
+func reshareProofs(t *testing.T, reshare *spec.Reshare) spec.CeremonyProofs {
+	paramsHash, err := reshare.NewParams().HashTreeRoot()
+	require.NoError(t, err)
+	ret := make(spec.CeremonyProofs, len(reshare.NewOperators))
+	for i, operator := range reshare.NewOperators {
+		proof := &spec.Proof{
+			ValidatorPubKey: reshare.ValidatorPubKey,
+			EncryptedShare:  fixtures.DecodeHexNoError(fixtures.TestValidator4OperatorsEncShare1),
+			SharePubKey:     fixtures.ShareSK(fixtures.TestValidator4OperatorsShare1).GetPublicKey().Serialize(),
+			Owner:           reshare.Owner,
+			RequestID:       fixtures.TestRequestID,
+			ParamsHash:      paramsHash,
+		}
+		root, err := proof.SigningRoot()
+		require.NoError(t, err)
+		sig, err := crypto.SignRSA(fixtures.OperatorSK(chainOperatorSKs[operator.ID]), root[:])
+		require.NoError(t, err)
+		ret[i] = &spec.SignedProof{Proof: proof, Signature: sig}
+	}
+	return ret
+}
+
+// proofChain returns the hops of resharing the 4 operators validator to operators 1-7, then to operators 1-6 and 8
+func proofChain(t *testing.T) []*spec.ProofChainHop {
+	first := fixtures.TestReshare4Operators
+	first.NewOperators = fixtures.GenerateOperators(7)
+	first.NewT = 5
+
+	second := first
+	second.OldOperators = first.NewOperators
+	second.OldT = first.NewT
+	second.NewOperators = append(fixtures.GenerateOperators(7)[:6], fixtures.GenerateOperators(10)[7])
+	second.Nonce = 2
+
+	return []*spec.ProofChainHop{
+		{Reshare: &first, Proofs: reshareProofs(t, &first)},
+		{Reshare: &second, Proofs: reshareProofs(t, &second)},
+	}
+}
+
+func TestVerifyProofChain(t *testing.T) {
+	operators := fixtures.GenerateOperators(4)
+
+	t.Run("valid", func(t *testing.T) {
+		require.NoError(t, spec.VerifyProofChain(operators, proofs4Operators(), fixtures.TestParams(4), proofChain(t)))
+	})
+
+	t.Run("no reshares", func(t *testing.T) {
+		require.NoError(t, spec.VerifyProofChain(operators, proofs4Operators(), fixtures.TestParams(4), nil))
+	})
+
+	t.Run("invalid initial proofs", func(t *testing.T) {
+		require.EqualError(t, spec.VerifyProofChain(fixtures.GenerateOperators(7), proofs4Operators(), fixtures.TestParams(4), proofChain(t)),
+			"initial ceremony: mismatch proofs count")
+	})
+
+	t.Run("reshare of another validator", func(t *testing.T) {
+		hops := proofChain(t)
+		reshare := *hops[1].Reshare
+		reshare.ValidatorPubKey = fixtures.ShareSK(fixtures.TestValidator7Operators).GetPublicKey().Serialize()
+		hops[1] = &spec.ProofChainHop{Reshare: &reshare, Proofs: reshareProofs(t, &reshare)}
+		err := spec.VerifyProofChain(operators, proofs4Operators(), fixtures.TestParams(4), hops)
+		require.EqualError(t, err, "reshare 1: reshare of another validator")
+		require.EqualValues(t, spec.CodeProofValidatorMismatch, spec.ErrorCodeOf(err))
+	})
+
+	t.Run("reshare of another owner", func(t *testing.T) {
+		hops := proofChain(t)
+		reshare := *hops[0].Reshare
+		reshare.Owner = [20]byte{1}
+		hops[0] = &spec.ProofChainHop{Reshare: &reshare, Proofs: reshareProofs(t, &reshare)}
+		err := spec.VerifyProofChain(operators, proofs4Operators(), fixtures.TestParams(4), hops)
+		require.EqualError(t, err, "reshare 0: reshare of another owner")
+		require.EqualValues(t, spec.CodeProofOwnerMismatch, spec.ErrorCodeOf(err))
+	})
+
+	t.Run("missing hop", func(t *testing.T) {
+		hops := proofChain(t)
+		err := spec.VerifyProofChain(operators, proofs4Operators(), fixtures.TestParams(4), hops[1:])
+		require.EqualError(t, err, "reshare 0: old operators aren't the previous operators")
+		require.EqualValues(t, spec.CodeOperatorKeyMismatch, spec.ErrorCodeOf(err))
+	})
+
+	t.Run("reshare to another fork", func(t *testing.T) {
+		hops := proofChain(t)
+		reshare := *hops[1].Reshare
+		reshare.Fork = [4]byte{0x05, 0x00, 0x00, 0x00}
+		hops[1] = &spec.ProofChainHop{Reshare: &reshare, Proofs: reshareProofs(t, &reshare)}
+		require.NoError(t, spec.VerifyProofChain(operators, proofs4Operators(), fixtures.TestParams(4), hops))
+	})
+
+	t.Run("old proofs of another fork", func(t *testing.T) {
+		hops := proofChain(t)
+		params := fixtures.TestParams(4)
+		params.Fork = [4]byte{0x05, 0x00, 0x00, 0x00}
+		err := spec.VerifyProofChain(operators, proofs4Operators(), params, hops)
+		require.EqualError(t, err, "reshare 0: old proof of operator 1: proof issued under different ceremony parameters")
+		require.EqualValues(t, spec.CodeProofParamsMismatch, spec.ErrorCodeOf(err))
+	})
+
+	t.Run("proofs under other parameters", func(t *testing.T) {
+		hops := proofChain(t)
+		reshare := *hops[1].Reshare
+		reshare.NewT = 6
+		hops[1] = &spec.ProofChainHop{Reshare: hops[1].Reshare, Proofs: reshareProofs(t, &reshare)}
+		err := spec.VerifyProofChain(operators, proofs4Operators(), fixtures.TestParams(4), hops)
+		require.EqualError(t, err, "reshare 1: proof of operator 1: proof issued under different ceremony parameters")
+		require.EqualValues(t, spec.CodeProofParamsMismatch, spec.ErrorCodeOf(err))
+	})
+
+	t.Run("proof signed by another operator", func(t *testing.T) {
+		hops := proofChain(t)
+		proofs := append(spec.CeremonyProofs{}, hops[0].Proofs...)
+		proofs[6] = proofs[5]
+		hops[0] = &spec.ProofChainHop{Reshare: hops[0].Reshare, Proofs: proofs}
+		err := spec.VerifyProofChain(operators, proofs4Operators(), fixtures.TestParams(4), hops)
+		require.ErrorContains(t, err, "reshare 0: invalid proof for operator 7")
+		require.EqualValues(t, spec.CodeInvalidProofSignature, spec.ErrorCodeOf(err))
+	})
+
+	t.Run("proof of another validator", func(t *testing.T) {
+		hops := proofChain(t)
+		proofs := append(spec.CeremonyProofs{}, hops[0].Proofs...)
+		proofs[0] = &fixtures.TestOperator1Proof7Operators
+		hops[0] = &spec.ProofChainHop{Reshare: hops[0].Reshare, Proofs: proofs}
+		err := spec.VerifyProofChain(operators, proofs4Operators(), fixtures.TestParams(4), hops)
+		require.EqualError(t, err, "reshare 0: invalid proof for operator 1: invalid proof validator pubkey")
+		require.EqualValues(t, spec.CodeProofValidatorMismatch, spec.ErrorCodeOf(err))
+	})
+
+	t.Run("missing proof", func(t *testing.T) {
+		hops := proofChain(t)
+		hops[1] = &spec.ProofChainHop{Reshare: hops[1].Reshare, Proofs: hops[1].Proofs[1:]}
+		err := spec.VerifyProofChain(operators, proofs4Operators(), fixtures.TestParams(4), hops)
+		require.EqualError(t, err, "reshare 1: 6 proofs for 7 new operators")
+		require.EqualValues(t, spec.CodeResultsCountMismatch, spec.ErrorCodeOf(err))
+	})
+}
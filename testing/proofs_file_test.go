@@ -0,0 +1,161 @@
+package testing
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"path/filepath"
+	"testing"
+
+	spec "github.com/bloxapp/dkg-spec"
+	"github.com/bloxapp/dkg-spec/testing/fixtures"
+
+	"github.com/stretchr/testify/require"
+)
+
+func proofs4Operators() spec.CeremonyProofs {
+	return spec.CeremonyProofs{
+		&fixtures.TestOperator1Proof4Operators,
+		&fixtures.TestOperator2Proof4Operators,
+		&fixtures.TestOperator3Proof4Operators,
+		&fixtures.TestOperator4Proof4Operators,
+	}
+}
+
+func TestProofsFile(t *testing.T) {
+	t.Run("single ceremony round trip", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "proofs.json")
+		require.NoError(t, spec.SaveProofs(path, []spec.CeremonyProofs{proofs4Operators()}))
+
+		loaded, err := spec.LoadProofs(path)
+		require.NoError(t, err)
+		require.Len(t, loaded, 1)
+		require.EqualValues(t, proofs4Operators(), loaded[0])
+		require.EqualValues(t, fixtures.ShareSK(fixtures.TestValidator4Operators).GetPublicKey().Serialize(), loaded[0].ValidatorPubKey())
+		require.EqualValues(t, fixtures.TestOwnerAddress, loaded[0].Owner())
+		require.NoError(t, spec.ValidateCeremonyProofs(fixtures.GenerateOperators(4), loaded[0]))
+	})
+
+	t.Run("bulk ceremony round trip", func(t *testing.T) {
+		buf := &bytes.Buffer{}
+		require.NoError(t, spec.WriteProofs(buf, []spec.CeremonyProofs{proofs4Operators(), proofs4Operators()}))
+
+		loaded, err := spec.ReadProofs(buf)
+		require.NoError(t, err)
+		require.Len(t, loaded, 2)
+		require.EqualValues(t, proofs4Operators(), loaded[1])
+	})
+
+	t.Run("ssv-dkg output", func(t *testing.T) {
+		buf := bytes.NewBufferString(`[{"proof":{"validator":"` +
+			"8f9d9e4bca8b9f00dd2c2c3b4b3b9a4f" + `","encrypted_share":"00","share_pub":"01","owner":"0102030405060708090a0b0c0d0e0f1011121314"},"signature":"02"}]`)
+		loaded, err := spec.ReadProofs(buf)
+		require.NoError(t, err)
+		require.Len(t, loaded, 1)
+		require.Len(t, loaded[0], 1)
+		require.EqualValues(t, fixtures.TestOwnerAddress, loaded[0].Owner())
+		require.EqualValues(t, []byte{2}, loaded[0][0].Signature)
+	})
+
+	t.Run("invalid json", func(t *testing.T) {
+		_, err := spec.ReadProofs(bytes.NewBufferString(`{"proof":1}`))
+		require.Error(t, err)
+	})
+}
+
+func TestValidateCeremonyProofs(t *testing.T) {
+	t.Run("mismatch count", func(t *testing.T) {
+		require.EqualError(t, spec.ValidateCeremonyProofs(fixtures.GenerateOperators(7), proofs4Operators()), "mismatch proofs count")
+	})
+
+	t.Run("wrong order", func(t *testing.T) {
+		proofs := proofs4Operators()
+		proofs[0], proofs[1] = proofs[1], proofs[0]
+		require.EqualError(t, spec.ValidateCeremonyProofs(fixtures.GenerateOperators(4), proofs), "invalid proof for operator 1: crypto/rsa: verification error")
+	})
+
+	t.Run("mixed validators", func(t *testing.T) {
+		proofs := proofs4Operators()
+		proofs[3] = &fixtures.TestOperator4Proof7Operators
+		require.EqualError(t, spec.ValidateCeremonyProofs(fixtures.GenerateOperators(4), proofs), "invalid proof for operator 4: invalid proof validator pubkey")
+	})
+}
+
+func TestProofJSON(t *testing.T) {
+	t.Run("encoding", func(t *testing.T) {
+		proof := &spec.SignedProof{
+			Proof: &spec.Proof{
+				ValidatorPubKey: []byte{1, 2},
+				EncryptedShare:  []byte{3},
+				SharePubKey:     []byte{0xab},
+				Owner:           [20]byte{0xff},
+			},
+			Signature: []byte{0xcd, 0xef},
+		}
+		byts, err := json.Marshal(proof)
+		require.NoError(t, err)
+		require.EqualValues(t, `{"proof":{"validator":"0102","encrypted_share":"03","share_pub":"ab","owner":"ff00000000000000000000000000000000000000","request_id":"000000000000000000000000000000000000000000000000","params_hash":"0000000000000000000000000000000000000000000000000000000000000000"},"signature":"cdef"}`, string(byts))
+
+		decoded := &spec.SignedProof{}
+		require.NoError(t, json.Unmarshal(byts, decoded))
+		require.EqualValues(t, proof, decoded)
+	})
+
+	t.Run("nil proof", func(t *testing.T) {
+		byts, err := json.Marshal(&spec.SignedProof{Signature: []byte{1}})
+		require.NoError(t, err)
+		require.EqualValues(t, `{"proof":null,"signature":"01"}`, string(byts))
+	})
+
+	t.Run("invalid hex", func(t *testing.T) {
+		require.Error(t, json.Unmarshal([]byte(`{"proof":null,"signature":"0g"}`), &spec.SignedProof{}))
+		require.Error(t, json.Unmarshal([]byte(`{"proof":null,"signature":"012"}`), &spec.SignedProof{}))
+		require.Error(t, json.Unmarshal([]byte(`{"proof":null,"signature":12}`), &spec.SignedProof{}))
+	})
+
+	t.Run("invalid owner", func(t *testing.T) {
+		require.EqualError(t, json.Unmarshal([]byte(`{"validator":"","encrypted_share":"","share_pub":"","owner":"ff"}`), &spec.Proof{}), "invalid owner length")
+	})
+
+	t.Run("request ID", func(t *testing.T) {
+		owner := `"owner":"ff00000000000000000000000000000000000000"`
+		require.EqualError(t, json.Unmarshal([]byte(`{"validator":"","encrypted_share":"","share_pub":"",`+owner+`,"request_id":"ff"}`), &spec.Proof{}), "invalid request ID length")
+		require.EqualError(t, json.Unmarshal([]byte(`{"validator":"","encrypted_share":"","share_pub":"",`+owner+`,"params_hash":"ff"}`), &spec.Proof{}), "invalid params hash length")
+
+		// proofs issued before request ID binding have none
+		proof := &spec.Proof{}
+		require.NoError(t, json.Unmarshal([]byte(`{"validator":"","encrypted_share":"","share_pub":"",`+owner+`}`), proof))
+		require.EqualValues(t, [24]byte{}, proof.RequestID)
+	})
+}
+
+func bulkProofs(n int) []spec.CeremonyProofs {
+	ret := make([]spec.CeremonyProofs, 0, n)
+	for i := 0; i < n; i++ {
+		ret = append(ret, proofs4Operators())
+	}
+	return ret
+}
+
+func BenchmarkWriteProofs(b *testing.B) {
+	proofs := bulkProofs(1000)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if err := spec.WriteProofs(io.Discard, proofs); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkReadProofs(b *testing.B) {
+	buf := &bytes.Buffer{}
+	require.NoError(b, spec.WriteProofs(buf, bulkProofs(1000)))
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := spec.ReadProofs(bytes.NewReader(buf.Bytes())); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
@@ -0,0 +1,259 @@
+// Package property exports generators of random but valid spec messages and invariant checkers, so downstream code can
+// property test itself against the spec. Generators draw from a caller provided *rand.Rand to keep failures reproducible.
+package property
+
+import (
+	"crypto/rsa"
+	"encoding/hex"
+	"fmt"
+	"math/rand"
+	"sort"
+
+	spec "github.com/bloxapp/dkg-spec"
+	"github.com/bloxapp/dkg-spec/crypto"
+	"github.com/bloxapp/dkg-spec/crypto/bls"
+	"github.com/bloxapp/dkg-spec/testing/fixtures"
+)
+
+// ClusterSizes are the cluster sizes the spec accepts
+var ClusterSizes = spec.ClusterSizes
+
+var operatorKeys = []string{
+	fixtures.TestOperator1SK,
+	fixtures.TestOperator2SK,
+	fixtures.TestOperator3SK,
+	fixtures.TestOperator4SK,
+	fixtures.TestOperator5SK,
+	fixtures.TestOperator6SK,
+	fixtures.TestOperator7SK,
+	fixtures.TestOperator8SK,
+	fixtures.TestOperator9SK,
+	fixtures.TestOperator10SK,
+	fixtures.TestOperator11SK,
+	fixtures.TestOperator12SK,
+	fixtures.TestOperator13SK,
+	fixtures.TestOperator14SK,
+}
+
+// Cluster is a generated operator set with the operators' RSA keys
+type Cluster struct {
+	// Operators ordered by ID
+	Operators []*spec.Operator
+	SKs       map[uint64]*rsa.PrivateKey
+}
+
+// T returns the cluster's threshold
+func (c *Cluster) T() uint64 {
+	t, _ := spec.ThresholdForCluster(c.Operators)
+	return t
+}
+
+// GenCluster returns a cluster of n operators with random unique IDs, keys are drawn from the fixtures' operator keys
+func GenCluster(r *rand.Rand, n int) *Cluster {
+	ids := make(map[uint64]bool, n)
+	for len(ids) < n {
+		ids[uint64(r.Int63n(10000)+1)] = true
+	}
+	ordered := make([]uint64, 0, n)
+	for id := range ids {
+		ordered = append(ordered, id)
+	}
+	sort.Slice(ordered, func(i, j int) bool { return ordered[i] < ordered[j] })
+
+	keys := r.Perm(len(operatorKeys))
+	ret := &Cluster{SKs: make(map[uint64]*rsa.PrivateKey, n)}
+	for i, id := range ordered {
+		sk := fixtures.OperatorSK(operatorKeys[keys[i%len(keys)]])
+		pk, err := crypto.EncodeRSAPublicKey(&sk.PublicKey)
+		if err != nil {
+			panic(err)
+		}
+		ret.Operators = append(ret.Operators, &spec.Operator{ID: id, PubKey: pk})
+		ret.SKs[id] = sk
+	}
+	return ret
+}
+
+// GenValidCluster returns a cluster of a random valid size
+func GenValidCluster(r *rand.Rand) *Cluster {
+	return GenCluster(r, ClusterSizes[r.Intn(len(ClusterSizes))])
+}
+
+// GenOwner returns a random non zero owner address
+func GenOwner(r *rand.Rand) [20]byte {
+	var ret [20]byte
+	r.Read(ret[:])
+	ret[0] |= 0x01
+	return ret
+}
+
+// GenInit returns a valid Init for a random cluster on a random known fork, with ETH1 or (on electra) compounding credentials
+func GenInit(r *rand.Rand) (*spec.Init, *Cluster) {
+	cluster := GenValidCluster(r)
+	fork, behavior := genFork(r)
+	withdrawalAddress := make([]byte, 20)
+	r.Read(withdrawalAddress)
+	withdrawalCredentials := withdrawalAddress
+	if behavior.Compounding && r.Intn(2) == 0 {
+		withdrawalCredentials = crypto.CompoundingWithdrawalCredentials(withdrawalAddress)
+	}
+	return &spec.Init{
+		Operators:             cluster.Operators,
+		T:                     cluster.T(),
+		WithdrawalCredentials: withdrawalCredentials,
+		Fork:                  fork,
+		Owner:                 GenOwner(r),
+		Nonce:                 uint64(r.Int63()),
+	}, cluster
+}
+
+// GenReshare returns a valid Reshare of validatorPK from old to a random new cluster of a valid size,
+// keeping a random subset of the old operators, possibly none
+func GenReshare(r *rand.Rand, old *Cluster, validatorPK []byte, owner [20]byte) (*spec.Reshare, *Cluster) {
+	cluster := genReshareCluster(r, old)
+	fork, _ := genFork(r)
+	withdrawalAddress := make([]byte, 20)
+	r.Read(withdrawalAddress)
+	return &spec.Reshare{
+		ValidatorPubKey:       validatorPK,
+		OldOperators:          old.Operators,
+		NewOperators:          cluster.Operators,
+		OldT:                  old.T(),
+		NewT:                  cluster.T(),
+		Fork:                  fork,
+		WithdrawalCredentials: withdrawalAddress,
+		Owner:                 owner,
+		Nonce:                 uint64(r.Int63()),
+	}, cluster
+}
+
+// genReshareCluster returns a new cluster keeping a random subset of old's operators, joined by operators with fresh IDs and unused keys
+func genReshareCluster(r *rand.Rand, old *Cluster) *Cluster {
+	used := make(map[string]bool, len(old.Operators))
+	for _, op := range old.Operators {
+		used[string(op.PubKey)] = true
+	}
+	var unused []*rsa.PrivateKey
+	for _, key := range operatorKeys {
+		sk := fixtures.OperatorSK(key)
+		pk, err := crypto.EncodeRSAPublicKey(&sk.PublicKey)
+		if err != nil {
+			panic(err)
+		}
+		if !used[string(pk)] {
+			unused = append(unused, sk)
+		}
+	}
+
+	for {
+		n := ClusterSizes[r.Intn(len(ClusterSizes))]
+		// every old operator may leave (see spec.ReshareOverlapLimits), as long as there are keys for the joining ones
+		minKept := 0
+		if k := n - len(unused); k > minKept {
+			minKept = k
+		}
+		maxKept := len(old.Operators)
+		if n < maxKept {
+			maxKept = n
+		}
+		if minKept > maxKept {
+			continue
+		}
+		kept := minKept + r.Intn(maxKept-minKept+1)
+		if kept == len(old.Operators) && kept == n {
+			continue
+		}
+
+		ret := &Cluster{SKs: make(map[uint64]*rsa.PrivateKey, n)}
+		ids := make(map[uint64]bool, n)
+		for _, i := range r.Perm(len(old.Operators))[:kept] {
+			op := old.Operators[i]
+			ret.Operators = append(ret.Operators, op)
+			ret.SKs[op.ID] = old.SKs[op.ID]
+			ids[op.ID] = true
+		}
+		for _, op := range old.Operators {
+			ids[op.ID] = true
+		}
+		for _, i := range r.Perm(len(unused))[:n-kept] {
+			id := uint64(r.Int63n(10000) + 1)
+			for ids[id] {
+				id = uint64(r.Int63n(10000) + 1)
+			}
+			ids[id] = true
+			pk, err := crypto.EncodeRSAPublicKey(&unused[i].PublicKey)
+			if err != nil {
+				panic(err)
+			}
+			ret.Operators = append(ret.Operators, &spec.Operator{ID: id, PubKey: pk})
+			ret.SKs[id] = unused[i]
+		}
+		sort.Slice(ret.Operators, func(i, j int) bool { return ret.Operators[i].ID < ret.Operators[j].ID })
+		return ret
+	}
+}
+
+// GenCeremonyProofs returns valid proofs of a ceremony for a random validator with cluster and owner, with the validator's secret.
+// Shares are RSA encrypted with the standard library's randomness, so proofs aren't reproducible byte for byte.
+func GenCeremonyProofs(r *rand.Rand, cluster *Cluster, owner [20]byte) (spec.CeremonyProofs, *bls.SecretKey) {
+	crypto.InitBLS()
+	poly := make([]bls.SecretKey, cluster.T())
+	for i := range poly {
+		genSecretKey(r, &poly[i])
+	}
+	validatorPK := poly[0].GetPublicKey().Serialize()
+
+	ret := make(spec.CeremonyProofs, 0, len(cluster.Operators))
+	for _, operator := range cluster.Operators {
+		id := bls.ID{}
+		if err := id.SetDecString(fmt.Sprintf("%d", operator.ID)); err != nil {
+			panic(err)
+		}
+		share := &bls.SecretKey{}
+		if err := share.Set(poly, &id); err != nil {
+			panic(err)
+		}
+		sk := cluster.SKs[operator.ID]
+		encryptedShare, err := spec.EncryptShare(&sk.PublicKey, share.Serialize(), validatorPK, owner)
+		if err != nil {
+			panic(err)
+		}
+		proof := &spec.Proof{
+			ValidatorPubKey: validatorPK,
+			EncryptedShare:  encryptedShare,
+			SharePubKey:     share.GetPublicKey().Serialize(),
+			Owner:           owner,
+		}
+		root, err := proof.SigningRoot()
+		if err != nil {
+			panic(err)
+		}
+		sig, err := crypto.SignRSA(sk, root[:])
+		if err != nil {
+			panic(err)
+		}
+		ret = append(ret, &spec.SignedProof{Proof: proof, Signature: sig})
+	}
+	return ret, &poly[0]
+}
+
+func genFork(r *rand.Rand) ([4]byte, crypto.ForkBehavior) {
+	forks := crypto.Forks()
+	ordered := make([][4]byte, 0, len(forks))
+	for fork := range forks {
+		ordered = append(ordered, fork)
+	}
+	sort.Slice(ordered, func(i, j int) bool { return hex.EncodeToString(ordered[i][:]) < hex.EncodeToString(ordered[j][:]) })
+	fork := ordered[r.Intn(len(ordered))]
+	return fork, forks[fork]
+}
+
+func genSecretKey(r *rand.Rand, sk *bls.SecretKey) {
+	var byts [32]byte
+	r.Read(byts[:])
+	// keeps the scalar below the curve order
+	byts[0] &= 0x3f
+	if err := sk.SetHexString(hex.EncodeToString(byts[:])); err != nil {
+		panic(err)
+	}
+}
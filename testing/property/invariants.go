@@ -0,0 +1,123 @@
+package property
+
+import (
+	"bytes"
+	"fmt"
+
+	spec "github.com/bloxapp/dkg-spec"
+)
+
+// CheckThreshold returns nil if the spec's threshold functions agree with the threshold model for t and operators:
+// clusters have n = 3f+1 operators (up to spec.MaxOperators) with threshold t = 2f+1, so any two threshold sets
+// share at least f+1 operators (at least one honest) and the cluster stays live with f operators offline.
+func CheckThreshold(t uint64, operators []*spec.Operator) error {
+	n := uint64(len(operators))
+	validSize := n >= 4 && n <= spec.MaxOperators && n%3 == 1
+	f := (n - 1) / 3
+
+	expected := validSize && t == 2*f+1
+	if valid := spec.ValidThresholdSet(t, operators); valid != expected {
+		return fmt.Errorf("ValidThresholdSet(%d) with %d operators is %v, expected %v", t, n, valid, expected)
+	}
+
+	threshold, err := spec.ThresholdForCluster(operators)
+	if (err == nil) != validSize {
+		return fmt.Errorf("ThresholdForCluster with %d operators returned error %v", n, err)
+	}
+	if err != nil {
+		return nil
+	}
+	if threshold != 2*f+1 {
+		return fmt.Errorf("ThresholdForCluster with %d operators is %d, expected %d", n, threshold, 2*f+1)
+	}
+	if 2*threshold < n+f+1 {
+		return fmt.Errorf("threshold sets of %d out of %d operators may not share an honest operator", threshold, n)
+	}
+	if n-threshold < f {
+		return fmt.Errorf("%d out of %d operators can't tolerate %d offline", threshold, n, f)
+	}
+	return nil
+}
+
+// CheckInit returns nil if spec.ValidateInitMessage agrees with the model on init
+func CheckInit(init *spec.Init) error {
+	if err := CheckThreshold(init.T, init.Operators); err != nil {
+		return err
+	}
+	expected := orderedUnique(init.Operators) && spec.ValidThresholdSet(init.T, init.Operators)
+	return agree("ValidateInitMessage", spec.ValidateInitMessage(init), expected)
+}
+
+// CheckReshare returns nil if Reshare.Validate agrees with the model on reshare:
+// both clusters ordered and unique with valid thresholds, the new cluster differs from the old one,
+// a key belongs to a single operator and, with spec.ReshareOverlapLimits, fewer than a threshold of operators leave or join
+func CheckReshare(reshare *spec.Reshare) error {
+	if err := CheckThreshold(reshare.OldT, reshare.OldOperators); err != nil {
+		return fmt.Errorf("old operators: %v", err)
+	}
+	if err := CheckThreshold(reshare.NewT, reshare.NewOperators); err != nil {
+		return fmt.Errorf("new operators: %v", err)
+	}
+	expected := orderedUnique(reshare.OldOperators) &&
+		orderedUnique(reshare.NewOperators) &&
+		!sameOperators(reshare.OldOperators, reshare.NewOperators) &&
+		spec.ValidThresholdSet(reshare.OldT, reshare.OldOperators) &&
+		spec.ValidThresholdSet(reshare.NewT, reshare.NewOperators) &&
+		validOverlap(reshare)
+	return agree("Reshare.Validate", reshare.Validate(), expected)
+}
+
+func agree(name string, err error, expectedValid bool) error {
+	if (err == nil) != expectedValid {
+		return fmt.Errorf("%s returned %v, expected valid %v", name, err, expectedValid)
+	}
+	return nil
+}
+
+func orderedUnique(operators []*spec.Operator) bool {
+	for i, op := range operators {
+		if op.ID == 0 || (i > 0 && op.ID <= operators[i-1].ID) {
+			return false
+		}
+	}
+	return true
+}
+
+func validOverlap(reshare *spec.Reshare) bool {
+	oldIDs := make(map[string]uint64, len(reshare.OldOperators))
+	kept := make(map[uint64]bool, len(reshare.OldOperators))
+	for _, op := range reshare.OldOperators {
+		oldIDs[string(op.PubKey)] = op.ID
+		kept[op.ID] = false
+	}
+	for _, op := range reshare.NewOperators {
+		if id, found := oldIDs[string(op.PubKey)]; found && id != op.ID {
+			return false
+		}
+		if _, found := kept[op.ID]; found {
+			kept[op.ID] = true
+		}
+	}
+	if !spec.ReshareOverlapLimits {
+		return true
+	}
+	shared := 0
+	for _, k := range kept {
+		if k {
+			shared++
+		}
+	}
+	return uint64(len(reshare.OldOperators)-shared) < reshare.OldT && uint64(len(reshare.NewOperators)-shared) < reshare.NewT
+}
+
+func sameOperators(a, b []*spec.Operator) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i].ID != b[i].ID || !bytes.Equal(a[i].PubKey, b[i].PubKey) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,61 @@
+package property
+
+import (
+	"math/rand"
+	"testing"
+
+	spec "github.com/bloxapp/dkg-spec"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestThreshold(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for n := 0; n <= spec.MaxOperators+2; n++ {
+		cluster := GenCluster(r, n)
+		for threshold := uint64(0); threshold <= uint64(n)+1; threshold++ {
+			require.NoError(t, CheckThreshold(threshold, cluster.Operators), "n %d t %d", n, threshold)
+		}
+	}
+}
+
+func TestGenInit(t *testing.T) {
+	r := rand.New(rand.NewSource(2))
+	for i := 0; i < 200; i++ {
+		init, _ := GenInit(r)
+		require.NoError(t, spec.ValidateInitMessage(init))
+		require.NoError(t, CheckInit(init))
+
+		// random mutations must be judged alike by the spec and the model
+		mutated := *init
+		mutated.T = uint64(r.Intn(len(init.Operators) + 2))
+		if r.Intn(2) == 0 {
+			mutated.Operators = append([]*spec.Operator{}, init.Operators...)
+			j := r.Intn(len(mutated.Operators))
+			mutated.Operators[j] = mutated.Operators[r.Intn(len(mutated.Operators))]
+		}
+		require.NoError(t, CheckInit(&mutated))
+	}
+}
+
+func TestGenReshare(t *testing.T) {
+	r := rand.New(rand.NewSource(3))
+	for i := 0; i < 10; i++ {
+		init, cluster := GenInit(r)
+		proofs, secret := GenCeremonyProofs(r, cluster, init.Owner)
+		require.NoError(t, spec.ValidateCeremonyProofs(cluster.Operators, proofs))
+		require.EqualValues(t, secret.GetPublicKey().Serialize(), proofs.ValidatorPubKey())
+
+		reshare, _ := GenReshare(r, cluster, proofs.ValidatorPubKey(), init.Owner)
+		require.NoError(t, CheckReshare(reshare))
+		for j, operator := range reshare.OldOperators {
+			require.NoError(t, spec.ValidateReshareMessage(reshare, operator, proofs[j], init.Params()))
+		}
+
+		same := *reshare
+		same.NewOperators = same.OldOperators
+		same.NewT = same.OldT
+		require.Error(t, same.Validate())
+		require.NoError(t, CheckReshare(&same))
+	}
+}
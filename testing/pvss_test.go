@@ -0,0 +1,87 @@
+package testing
+
+import (
+	"testing"
+
+	spec "github.com/bloxapp/dkg-spec"
+	"github.com/bloxapp/dkg-spec/crypto"
+	"github.com/bloxapp/dkg-spec/crypto/bls"
+	"github.com/bloxapp/dkg-spec/crypto/pvss"
+	"github.com/bloxapp/dkg-spec/testing/fixtures"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPVSSCeremony(t *testing.T) {
+	crypto.InitBLS()
+	init := &spec.Init{
+		Operators:             fixtures.GenerateOperators(4),
+		T:                     3,
+		WithdrawalCredentials: fixtures.TestOwnerAddress[:],
+		Fork:                  fixtures.TestFork,
+		Owner:                 fixtures.TestOwnerAddress,
+	}
+	keys := map[uint64][]byte{}
+	sks := map[uint64]*pvss.PrivateKey{}
+	for _, operator := range init.Operators {
+		sk, err := pvss.GenerateKey()
+		require.NoError(t, err)
+		sks[operator.ID] = sk
+		keys[operator.ID] = sk.PublicKey()
+	}
+	var dealings []*spec.PVSSDealing
+	for _, operator := range init.Operators {
+		dealing, err := spec.DealPVSS(init, fixtures.TestRequestID, operator.ID, keys)
+		require.NoError(t, err)
+		dealings = append(dealings, dealing)
+	}
+
+	outcome, err := spec.VerifyPVSSCeremony(init, fixtures.TestRequestID, keys, dealings)
+	require.NoError(t, err)
+
+	t.Run("shares match the outcome", func(t *testing.T) {
+		results := make([]*spec.Result, len(init.Operators))
+		for i, operator := range init.Operators {
+			byts, err := spec.CombinePVSSShares(init, fixtures.TestRequestID, operator.ID, sks[operator.ID], keys, dealings)
+			require.NoError(t, err)
+			share := &bls.SecretKey{}
+			require.NoError(t, share.Deserialize(byts))
+			results[i] = &spec.Result{
+				OperatorID: operator.ID,
+				SignedProof: spec.SignedProof{Proof: &spec.Proof{
+					ValidatorPubKey: outcome.ValidatorPubKey,
+					SharePubKey:     share.GetPublicKey().Serialize(),
+				}},
+			}
+		}
+		require.NoError(t, spec.ValidatePVSSResults(outcome, results))
+
+		results[1].SignedProof.Proof.SharePubKey = results[2].SignedProof.Proof.SharePubKey
+		err := spec.ValidatePVSSResults(outcome, results)
+		require.EqualError(t, err, "operator 2: share public key doesn't match the dealings")
+		require.EqualValues(t, spec.CodeInconsistentDealing, spec.ErrorCodeOf(err))
+	})
+
+	t.Run("dealing replayed from another operator", func(t *testing.T) {
+		replayed := append([]*spec.PVSSDealing{}, dealings...)
+		replayed[1] = &spec.PVSSDealing{OperatorID: 2, Transcript: dealings[0].Transcript}
+		_, err := spec.VerifyPVSSCeremony(init, fixtures.TestRequestID, keys, replayed)
+		require.EqualError(t, err, "dealing of operator 2: recipient 1: invalid proof")
+		require.EqualValues(t, spec.CodeInconsistentDealing, spec.ErrorCodeOf(err))
+	})
+
+	t.Run("dealing of another ceremony", func(t *testing.T) {
+		_, err := spec.VerifyPVSSCeremony(init, [24]byte{}, keys, dealings)
+		require.EqualValues(t, spec.CodeInconsistentDealing, spec.ErrorCodeOf(err))
+	})
+
+	t.Run("missing dealing", func(t *testing.T) {
+		_, err := spec.VerifyPVSSCeremony(init, fixtures.TestRequestID, keys, dealings[:3])
+		require.EqualError(t, err, "3 dealings, expected one by each of 4 operators")
+	})
+
+	t.Run("missing key", func(t *testing.T) {
+		_, err := spec.DealPVSS(init, fixtures.TestRequestID, 1, map[uint64][]byte{1: keys[1]})
+		require.EqualError(t, err, "missing PVSS key of operator 2")
+	})
+}
@@ -0,0 +1,59 @@
+package testing
+
+import (
+	"testing"
+
+	spec "github.com/bloxapp/dkg-spec"
+	"github.com/bloxapp/dkg-spec/crypto"
+	"github.com/bloxapp/dkg-spec/testing/fixtures"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestReencryptProof(t *testing.T) {
+	crypto.InitBLS()
+	oldSK := fixtures.OperatorSK(fixtures.TestOperator1SK)
+	oldPK := fixtures.EncodedOperatorPK(fixtures.TestOperator1SK)
+	newSK, _, err := crypto.GenerateRSAKeys()
+	require.NoError(t, err)
+	newPK, err := crypto.EncodeRSAPublicKey(&newSK.PublicKey)
+	require.NoError(t, err)
+	oldProof := fixtures.TestOperator1Proof4Operators
+
+	newProof, err := spec.ReencryptProof(&oldProof, oldSK, newSK)
+	require.NoError(t, err)
+
+	t.Run("valid", func(t *testing.T) {
+		require.NoError(t, spec.ValidateReencryptedProof(oldPK, newPK, oldProof, *newProof))
+		require.EqualValues(t, oldProof.Proof.ParamsHash, newProof.Proof.ParamsHash)
+
+		share, err := spec.DecryptShare(newSK, newProof.Proof)
+		require.NoError(t, err)
+		require.EqualValues(t, fixtures.ShareSK(fixtures.TestValidator4OperatorsShare1).Serialize(), share.Serialize())
+	})
+
+	t.Run("old key can't decrypt", func(t *testing.T) {
+		_, err := spec.DecryptShare(oldSK, newProof.Proof)
+		require.Error(t, err)
+	})
+
+	t.Run("proof not signed by old key", func(t *testing.T) {
+		_, err := spec.ReencryptProof(&fixtures.TestOperator2Proof4Operators, oldSK, newSK)
+		require.ErrorContains(t, err, "verification error")
+	})
+
+	t.Run("key not rotated", func(t *testing.T) {
+		require.EqualError(t, spec.ValidateReencryptedProof(oldPK, oldPK, oldProof, oldProof), "operator key not rotated")
+	})
+
+	t.Run("new proof not signed by new key", func(t *testing.T) {
+		err := spec.ValidateReencryptedProof(oldPK, newPK, oldProof, oldProof)
+		require.ErrorContains(t, err, "invalid new proof")
+	})
+
+	t.Run("different share", func(t *testing.T) {
+		other, err := spec.ReencryptProof(&fixtures.TestOperator2Proof4Operators, fixtures.OperatorSK(fixtures.TestOperator2SK), newSK)
+		require.NoError(t, err)
+		require.EqualError(t, spec.ValidateReencryptedProof(oldPK, newPK, oldProof, *other), "invalid proof share pubkey")
+	})
+}
@@ -0,0 +1,107 @@
+package testing
+
+import (
+	"testing"
+
+	spec "github.com/bloxapp/dkg-spec"
+	"github.com/bloxapp/dkg-spec/testing/fixtures"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestReshareBuilder(t *testing.T) {
+	proofs := spec.CeremonyProofs{
+		&fixtures.TestOperator1Proof4Operators,
+		&fixtures.TestOperator2Proof4Operators,
+		&fixtures.TestOperator3Proof4Operators,
+		&fixtures.TestOperator4Proof4Operators,
+	}
+	oldOperators := fixtures.GenerateOperators(4)
+	newOperators := fixtures.GenerateOperators(7)
+	withdrawalAddress := fixtures.TestOwnerAddress[:]
+
+	t.Run("valid", func(t *testing.T) {
+		requests, err := spec.NewReshareBuilder().
+			OldOperators(oldOperators[2], oldOperators[0], oldOperators[3], oldOperators[1]).
+			NewOperators(newOperators...).
+			Proofs(proofs).
+			WithdrawalCredentials(withdrawalAddress).
+			Nonce(5).
+			Build()
+		require.NoError(t, err)
+		require.Len(t, requests, 1)
+
+		reshare := requests[0].Reshare
+		require.EqualValues(t, proofs.ValidatorPubKey(), reshare.ValidatorPubKey)
+		require.EqualValues(t, fixtures.TestOwnerAddress, reshare.Owner)
+		require.True(t, spec.EqualOperators(oldOperators, reshare.OldOperators))
+		require.True(t, spec.EqualOperators(newOperators, reshare.NewOperators))
+		require.EqualValues(t, 3, reshare.OldT)
+		require.EqualValues(t, 5, reshare.NewT)
+		require.EqualValues(t, 5, reshare.Nonce)
+
+		require.Len(t, requests[0].Proofs, 4)
+		for op, proof := range requests[0].Proofs {
+			proof := proof
+			require.NoError(t, spec.ValidateReshareMessage(reshare, op, &proof, fixtures.TestParams(4)))
+		}
+	})
+
+	t.Run("proofs not matching operators", func(t *testing.T) {
+		_, err := spec.NewReshareBuilder().
+			OldOperators(oldOperators...).
+			NewOperators(newOperators...).
+			Proofs(spec.CeremonyProofs{proofs[1], proofs[0], proofs[2], proofs[3]}).
+			WithdrawalCredentials(withdrawalAddress).
+			Build()
+		require.ErrorContains(t, err, "ceremony 0: invalid proof for operator 1")
+	})
+
+	t.Run("duplicate validator", func(t *testing.T) {
+		_, err := spec.NewReshareBuilder().
+			OldOperators(oldOperators...).
+			NewOperators(newOperators...).
+			Proofs(proofs, proofs).
+			WithdrawalCredentials(withdrawalAddress).
+			Build()
+		require.EqualError(t, err, "ceremony 1: duplicate validator")
+	})
+
+	t.Run("same operators", func(t *testing.T) {
+		_, err := spec.NewReshareBuilder().
+			OldOperators(oldOperators...).
+			NewOperators(oldOperators...).
+			Proofs(proofs).
+			WithdrawalCredentials(withdrawalAddress).
+			Build()
+		require.EqualError(t, err, "ceremony 0: old and new operators are the same")
+	})
+
+	t.Run("invalid cluster", func(t *testing.T) {
+		_, err := spec.NewReshareBuilder().
+			OldOperators(oldOperators[:3]...).
+			NewOperators(newOperators...).
+			Build()
+		require.EqualError(t, err, "old operators: invalid cluster size")
+
+		_, err = spec.NewReshareBuilder().
+			OldOperators(oldOperators...).
+			NewOperators(oldOperators[0], oldOperators[0], oldOperators[1], oldOperators[2]).
+			Build()
+		require.EqualError(t, err, "new operators: duplicate operator IDs")
+	})
+
+	t.Run("missing fields", func(t *testing.T) {
+		_, err := spec.NewReshareBuilder().Build()
+		require.EqualError(t, err, "old operators: not set")
+
+		_, err = spec.NewReshareBuilder().OldOperators(oldOperators...).Build()
+		require.EqualError(t, err, "new operators: not set")
+
+		_, err = spec.NewReshareBuilder().OldOperators(oldOperators...).NewOperators(newOperators...).Build()
+		require.EqualError(t, err, "proofs: not set")
+
+		_, err = spec.NewReshareBuilder().OldOperators(oldOperators...).NewOperators(newOperators...).Proofs(proofs).Build()
+		require.EqualError(t, err, "withdrawal credentials: not set")
+	})
+}
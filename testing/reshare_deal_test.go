@@ -0,0 +1,260 @@
+package testing
+
+import (
+	"crypto/rsa"
+	"testing"
+
+	spec "github.com/bloxapp/dkg-spec"
+	"github.com/bloxapp/dkg-spec/crypto"
+	"github.com/bloxapp/dkg-spec/crypto/bls"
+	"github.com/bloxapp/dkg-spec/testing/fixtures"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestReshareDeals(t *testing.T) {
+	crypto.InitBLS()
+	reshare := fixtures.TestReshare4Operators
+	reshare.WithdrawalCredentials = fixtures.TestOwnerAddress[:]
+	oldShares := map[uint64]*bls.SecretKey{
+		1: fixtures.ShareSK(fixtures.TestValidator4OperatorsShare1),
+		2: fixtures.ShareSK(fixtures.TestValidator4OperatorsShare2),
+		3: fixtures.ShareSK(fixtures.TestValidator4OperatorsShare3),
+		4: fixtures.ShareSK(fixtures.TestValidator4OperatorsShare4),
+	}
+	newSKs := map[uint64]*rsa.PrivateKey{
+		1: fixtures.OperatorSK(fixtures.TestOperator1SK),
+		2: fixtures.OperatorSK(fixtures.TestOperator2SK),
+		3: fixtures.OperatorSK(fixtures.TestOperator3SK),
+		5: fixtures.OperatorSK(fixtures.TestOperator5SK),
+	}
+	requestID := fixtures.TestRequestID
+	deal := func(t *testing.T, id uint64) []*spec.ReshareDeal {
+		ret, err := spec.DealReshare(&reshare, requestID, id, oldShares[id])
+		require.NoError(t, err)
+		require.Len(t, ret, len(reshare.NewOperators))
+		return ret
+	}
+	// received returns the dealers' deals addressed to new operator id, decrypted with its key
+	received := func(t *testing.T, id uint64, dealers ...[]*spec.ReshareDeal) []*spec.DecryptedReshareDeal {
+		ret := make([]*spec.DecryptedReshareDeal, 0)
+		for _, dealt := range dealers {
+			for _, d := range dealt {
+				if d.RecipientID != id {
+					continue
+				}
+				decrypted, err := spec.DecryptReshareDeal(requestID, d, id, newSKs[id])
+				require.NoError(t, err)
+				ret = append(ret, decrypted)
+			}
+		}
+		return ret
+	}
+	dealers := [][]*spec.ReshareDeal{deal(t, 4), deal(t, 1), deal(t, 3)}
+	deals := received(t, 5, dealers...)
+
+	t.Run("new shares", func(t *testing.T) {
+		ids := make([]uint64, 0)
+		pks := make([]*bls.PublicKey, 0)
+		for _, op := range reshare.NewOperators {
+			share, err := spec.CombineReshareDeals(&reshare, op.ID, received(t, op.ID, dealers...))
+			require.NoError(t, err)
+			ids = append(ids, op.ID)
+			pks = append(pks, share.GetPublicKey())
+		}
+		// any NewT of the new shares recover the validator
+		for _, subset := range [][]int{{0, 1, 2}, {1, 2, 3}, {0, 2, 3}} {
+			subsetIDs := make([]uint64, 0)
+			subsetPKs := make([]*bls.PublicKey, 0)
+			for _, i := range subset {
+				subsetIDs = append(subsetIDs, ids[i])
+				subsetPKs = append(subsetPKs, pks[i])
+			}
+			pk, err := crypto.RecoverValidatorPublicKey(subsetIDs, subsetPKs)
+			require.NoError(t, err)
+			require.EqualValues(t, reshare.ValidatorPubKey, pk.Serialize())
+		}
+	})
+
+	t.Run("not enough deals", func(t *testing.T) {
+		_, err := spec.CombineReshareDeals(&reshare, 5, deals[:2])
+		require.EqualError(t, err, "not enough deals: 2 of 3")
+		require.EqualValues(t, spec.CodeThresholdUnreachable, spec.ErrorCodeOf(err))
+
+		// rejected before verifying the deals
+		tampered := *deals[0]
+		tampered.Share = oldShares[1]
+		_, err = spec.CombineReshareDeals(&reshare, 5, []*spec.DecryptedReshareDeal{&tampered, deals[1]})
+		require.EqualError(t, err, "not enough deals: 2 of 3")
+	})
+
+	t.Run("responders", func(t *testing.T) {
+		require.NoError(t, spec.ValidateReshareResponders(&reshare, []uint64{1, 3, 4}))
+		require.NoError(t, spec.ValidateReshareResponders(&reshare, []uint64{1, 2, 3, 4}))
+
+		err := spec.ValidateReshareResponders(&reshare, []uint64{1, 3})
+		require.EqualError(t, err, "old threshold unreachable: 2 of 3 old operators responding")
+		require.EqualValues(t, spec.CodeThresholdUnreachable, spec.ErrorCodeOf(err))
+		require.EqualError(t, spec.ValidateReshareResponders(&reshare, []uint64{1, 3, 3}), "duplicate responder 3")
+		require.EqualError(t, spec.ValidateReshareResponders(&reshare, []uint64{1, 3, 5}), "operator 5 not in old operators")
+	})
+
+	t.Run("threshold of old operators contribute", func(t *testing.T) {
+		// all old operators dealt, operator 2's deal arrived last
+		all := append([][]*spec.ReshareDeal{deal(t, 2)}, dealers...)
+		contributors, err := spec.SelectReshareContributors(&reshare, []uint64{4, 2, 1, 3})
+		require.NoError(t, err)
+		require.EqualValues(t, spec.ReshareContributors{1, 2, 3}, contributors)
+		require.EqualValues(t, []uint64{4}, contributors.Absent(&reshare))
+
+		ids := make([]uint64, 0)
+		pks := make([]*bls.PublicKey, 0)
+		for _, op := range reshare.NewOperators[1:] {
+			share, err := spec.CombineContributorDeals(&reshare, op.ID, contributors, received(t, op.ID, all...))
+			require.NoError(t, err)
+			ids = append(ids, op.ID)
+			pks = append(pks, share.GetPublicKey())
+		}
+		pk, err := crypto.RecoverValidatorPublicKey(ids, pks)
+		require.NoError(t, err)
+		require.EqualValues(t, reshare.ValidatorPubKey, pk.Serialize())
+
+		// new operators combining different contributors' deals don't hold shares of the same polynomial
+		first := reshare.NewOperators[0].ID
+		other, err := spec.CombineContributorDeals(&reshare, first, spec.ReshareContributors{2, 3, 4}, received(t, first, all...))
+		require.NoError(t, err)
+		pk, err = crypto.RecoverValidatorPublicKey(
+			[]uint64{first, ids[0], ids[1]},
+			[]*bls.PublicKey{other.GetPublicKey(), pks[0], pks[1]},
+		)
+		require.NoError(t, err)
+		require.NotEqualValues(t, reshare.ValidatorPubKey, pk.Serialize())
+	})
+
+	t.Run("missing contributor deal", func(t *testing.T) {
+		_, err := spec.CombineContributorDeals(&reshare, 5, spec.ReshareContributors{1, 2, 3}, deals)
+		require.EqualError(t, err, "missing deal of contributor 2")
+		require.EqualValues(t, spec.CodeThresholdUnreachable, spec.ErrorCodeOf(err))
+
+		_, err = spec.SelectReshareContributors(&reshare, []uint64{1, 4})
+		require.EqualError(t, err, "old threshold unreachable: 2 of 3 old operators responding")
+		_, err = spec.CombineContributorDeals(&reshare, 5, spec.ReshareContributors{1, 4}, deals)
+		require.EqualError(t, err, "old threshold unreachable: 2 of 3 old operators responding")
+	})
+
+	t.Run("duplicate deal", func(t *testing.T) {
+		_, err := spec.CombineReshareDeals(&reshare, 5, []*spec.DecryptedReshareDeal{deals[0], deals[1], deals[0]})
+		require.EqualError(t, err, "duplicate deal of operator 4")
+	})
+
+	t.Run("tampered share", func(t *testing.T) {
+		tampered := *deals[0]
+		tampered.Share = oldShares[1]
+		_, err := spec.CombineReshareDeals(&reshare, 5, []*spec.DecryptedReshareDeal{&tampered, deals[1], deals[2]})
+		require.EqualError(t, err, "deal of operator 4: share doesn't match commitments")
+	})
+
+	t.Run("wrong dealt share", func(t *testing.T) {
+		wrong, err := spec.DealReshare(&reshare, requestID, 2, oldShares[4])
+		require.NoError(t, err)
+		_, err = spec.CombineReshareDeals(&reshare, 5, append(received(t, 5, wrong), deals[1], deals[2]))
+		require.EqualError(t, err, "dealt shares don't recover the validator public key")
+	})
+
+	t.Run("not a new operator", func(t *testing.T) {
+		_, err := spec.CombineReshareDeals(&reshare, 4, deals)
+		require.EqualError(t, err, "operator 4 not in new operators")
+		_, err = spec.DealReshare(&reshare, requestID, 5, oldShares[1])
+		require.EqualError(t, err, "operator 5 not in old operators")
+	})
+
+	t.Run("confidential shares", func(t *testing.T) {
+		// operator 1's deal addressed to operator 5
+		dealt := dealers[1]
+		toOperator5 := dealt[3]
+		require.EqualValues(t, 5, toOperator5.RecipientID)
+		decrypted, err := spec.DecryptReshareDeal(requestID, toOperator5, 5, newSKs[5])
+		require.NoError(t, err)
+		require.NotContains(t, string(toOperator5.EncryptedShare), string(decrypted.Share.Serialize()))
+
+		// another new operator can't read operator 5's sub-share
+		_, err = spec.DecryptReshareDeal(requestID, toOperator5, 2, newSKs[2])
+		require.EqualError(t, err, "deal of operator 1: addressed to operator 5")
+		readdressed := *toOperator5
+		readdressed.RecipientID = 2
+		_, err = spec.DecryptReshareDeal(requestID, &readdressed, 2, newSKs[2])
+		require.Error(t, err)
+		_, err = spec.DecryptReshareDeal(requestID, toOperator5, 5, newSKs[2])
+		require.Error(t, err)
+
+		// nor can the deal be replayed in another ceremony or as another dealer's
+		_, err = spec.DecryptReshareDeal([24]byte{0xff}, toOperator5, 5, newSKs[5])
+		require.Error(t, err)
+		redealt := *toOperator5
+		redealt.OperatorID = 3
+		_, err = spec.DecryptReshareDeal(requestID, &redealt, 5, newSKs[5])
+		require.Error(t, err)
+	})
+
+	t.Run("operator reshare", func(t *testing.T) {
+		client := contractOwnerClient(reshare.Owner)
+		signed := &spec.SignedReshare{Reshare: reshare, Signature: make([]byte, 65)}
+		oldOperators := fixtures.GenerateOperators(4)
+		proofs := map[uint64]*spec.SignedProof{
+			1: &fixtures.TestOperator1Proof4Operators,
+			2: &fixtures.TestOperator2Proof4Operators,
+			3: &fixtures.TestOperator3Proof4Operators,
+		}
+		// operator 5 joins the cluster without a proof
+		joining := reshare.NewOperators[3]
+		deals := make([]*spec.ReshareDeal, 0)
+		for id, proof := range proofs {
+			dealt, err := spec.OperatorReshareDeal(signed, oldOperators[id-1], proof, fixtures.TestParams(4), oldShares[id], fixtures.TestRequestID, client)
+			require.NoError(t, err)
+			for _, d := range dealt {
+				if d.RecipientID == joining.ID {
+					deals = append(deals, d)
+				}
+			}
+		}
+		_, err := spec.OperatorReshareDeal(signed, oldOperators[0], proofs[1], fixtures.TestParams(4), oldShares[2], fixtures.TestRequestID, client)
+		require.EqualError(t, err, "share doesn't match proof")
+
+		result, err := spec.OperatorReshare(
+			signed,
+			joining,
+			nil,
+			nil,
+			deals,
+			fixtures.TestRequestID,
+			fixtures.OperatorSK(fixtures.TestOperator5SK),
+			client,
+			// the beacon chain holds the credentials deposited for reshare's withdrawal address
+			validatorCheckerF(func(pk []byte) (*spec.ChainValidator, error) {
+				return &spec.ChainValidator{
+					Index:                 9,
+					Status:                spec.ValidatorActiveOngoing,
+					WithdrawalCredentials: crypto.ETH1WithdrawalCredentials(reshare.WithdrawalCredentials),
+				}, nil
+			}),
+		)
+		require.NoError(t, err)
+		require.EqualValues(t, 9, result.ValidatorIndex)
+		require.NoError(t, spec.ValidateResult(
+			reshare.NewOperators,
+			reshare.Owner,
+			fixtures.TestRequestID,
+			reshare.WithdrawalCredentials,
+			reshare.ValidatorPubKey,
+			reshare.Fork,
+			spec.DepositAmount,
+			reshare.Nonce,
+			result,
+		))
+		require.Len(t, result.SignedProof.Proof.Commitments, int(reshare.NewT))
+		require.NoError(t, spec.VerifyProofCommitments(result.SignedProof.Proof, joining.ID))
+
+		_, err = spec.OperatorReshare(signed, oldOperators[0], nil, nil, deals, fixtures.TestRequestID, fixtures.OperatorSK(fixtures.TestOperator1SK), client, nil)
+		require.EqualError(t, err, "missing proof")
+	})
+}
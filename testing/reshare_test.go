@@ -1,6 +1,8 @@
 package testing
 
 import (
+	"crypto/x509"
+	"fmt"
 	"testing"
 
 	spec "github.com/bloxapp/dkg-spec"
@@ -18,24 +20,28 @@ func TestValidateReshare(t *testing.T) {
 			&fixtures.TestReshare4Operators,
 			fixtures.GenerateOperators(4)[0],
 			&fixtures.TestOperator1Proof4Operators,
+			fixtures.TestParams(4),
 		))
 
 		require.NoError(t, spec.ValidateReshareMessage(
 			&fixtures.TestReshare4Operators,
 			fixtures.GenerateOperators(4)[1],
 			&fixtures.TestOperator2Proof4Operators,
+			fixtures.TestParams(4),
 		))
 
 		require.NoError(t, spec.ValidateReshareMessage(
 			&fixtures.TestReshare4Operators,
 			fixtures.GenerateOperators(4)[2],
 			&fixtures.TestOperator3Proof4Operators,
+			fixtures.TestParams(4),
 		))
 
 		require.NoError(t, spec.ValidateReshareMessage(
 			&fixtures.TestReshare4Operators,
 			fixtures.GenerateOperators(4)[3],
 			&fixtures.TestOperator4Proof4Operators,
+			fixtures.TestParams(4),
 		))
 	})
 
@@ -44,42 +50,49 @@ func TestValidateReshare(t *testing.T) {
 			&fixtures.TestReshare7Operators,
 			fixtures.GenerateOperators(7)[0],
 			&fixtures.TestOperator1Proof7Operators,
+			fixtures.TestParams(7),
 		))
 
 		require.NoError(t, spec.ValidateReshareMessage(
 			&fixtures.TestReshare7Operators,
 			fixtures.GenerateOperators(7)[1],
 			&fixtures.TestOperator2Proof7Operators,
+			fixtures.TestParams(7),
 		))
 
 		require.NoError(t, spec.ValidateReshareMessage(
 			&fixtures.TestReshare7Operators,
 			fixtures.GenerateOperators(7)[2],
 			&fixtures.TestOperator3Proof7Operators,
+			fixtures.TestParams(7),
 		))
 
 		require.NoError(t, spec.ValidateReshareMessage(
 			&fixtures.TestReshare7Operators,
 			fixtures.GenerateOperators(7)[3],
 			&fixtures.TestOperator4Proof7Operators,
+			fixtures.TestParams(7),
 		))
 
 		require.NoError(t, spec.ValidateReshareMessage(
 			&fixtures.TestReshare7Operators,
 			fixtures.GenerateOperators(7)[4],
 			&fixtures.TestOperator5Proof7Operators,
+			fixtures.TestParams(7),
 		))
 
 		require.NoError(t, spec.ValidateReshareMessage(
 			&fixtures.TestReshare7Operators,
 			fixtures.GenerateOperators(7)[5],
 			&fixtures.TestOperator6Proof7Operators,
+			fixtures.TestParams(7),
 		))
 
 		require.NoError(t, spec.ValidateReshareMessage(
 			&fixtures.TestReshare7Operators,
 			fixtures.GenerateOperators(7)[6],
 			&fixtures.TestOperator7Proof7Operators,
+			fixtures.TestParams(7),
 		))
 	})
 
@@ -88,60 +101,70 @@ func TestValidateReshare(t *testing.T) {
 			&fixtures.TestReshare10Operators,
 			fixtures.GenerateOperators(10)[0],
 			&fixtures.TestOperator1Proof10Operators,
+			fixtures.TestParams(10),
 		))
 
 		require.NoError(t, spec.ValidateReshareMessage(
 			&fixtures.TestReshare10Operators,
 			fixtures.GenerateOperators(10)[1],
 			&fixtures.TestOperator2Proof10Operators,
+			fixtures.TestParams(10),
 		))
 
 		require.NoError(t, spec.ValidateReshareMessage(
 			&fixtures.TestReshare10Operators,
 			fixtures.GenerateOperators(10)[2],
 			&fixtures.TestOperator3Proof10Operators,
+			fixtures.TestParams(10),
 		))
 
 		require.NoError(t, spec.ValidateReshareMessage(
 			&fixtures.TestReshare10Operators,
 			fixtures.GenerateOperators(10)[3],
 			&fixtures.TestOperator4Proof10Operators,
+			fixtures.TestParams(10),
 		))
 
 		require.NoError(t, spec.ValidateReshareMessage(
 			&fixtures.TestReshare10Operators,
 			fixtures.GenerateOperators(10)[4],
 			&fixtures.TestOperator5Proof10Operators,
+			fixtures.TestParams(10),
 		))
 
 		require.NoError(t, spec.ValidateReshareMessage(
 			&fixtures.TestReshare10Operators,
 			fixtures.GenerateOperators(10)[5],
 			&fixtures.TestOperator6Proof10Operators,
+			fixtures.TestParams(10),
 		))
 
 		require.NoError(t, spec.ValidateReshareMessage(
 			&fixtures.TestReshare10Operators,
 			fixtures.GenerateOperators(10)[6],
 			&fixtures.TestOperator7Proof10Operators,
+			fixtures.TestParams(10),
 		))
 
 		require.NoError(t, spec.ValidateReshareMessage(
 			&fixtures.TestReshare10Operators,
 			fixtures.GenerateOperators(10)[7],
 			&fixtures.TestOperator8Proof10Operators,
+			fixtures.TestParams(10),
 		))
 
 		require.NoError(t, spec.ValidateReshareMessage(
 			&fixtures.TestReshare10Operators,
 			fixtures.GenerateOperators(10)[8],
 			&fixtures.TestOperator9Proof10Operators,
+			fixtures.TestParams(10),
 		))
 
 		require.NoError(t, spec.ValidateReshareMessage(
 			&fixtures.TestReshare10Operators,
 			fixtures.GenerateOperators(10)[9],
 			&fixtures.TestOperator10Proof10Operators,
+			fixtures.TestParams(10),
 		))
 	})
 
@@ -150,78 +173,91 @@ func TestValidateReshare(t *testing.T) {
 			&fixtures.TestReshare13Operators,
 			fixtures.GenerateOperators(13)[0],
 			&fixtures.TestOperator1Proof13Operators,
+			fixtures.TestParams(13),
 		))
 
 		require.NoError(t, spec.ValidateReshareMessage(
 			&fixtures.TestReshare13Operators,
 			fixtures.GenerateOperators(13)[1],
 			&fixtures.TestOperator2Proof13Operators,
+			fixtures.TestParams(13),
 		))
 
 		require.NoError(t, spec.ValidateReshareMessage(
 			&fixtures.TestReshare13Operators,
 			fixtures.GenerateOperators(13)[2],
 			&fixtures.TestOperator3Proof13Operators,
+			fixtures.TestParams(13),
 		))
 
 		require.NoError(t, spec.ValidateReshareMessage(
 			&fixtures.TestReshare13Operators,
 			fixtures.GenerateOperators(13)[3],
 			&fixtures.TestOperator4Proof13Operators,
+			fixtures.TestParams(13),
 		))
 
 		require.NoError(t, spec.ValidateReshareMessage(
 			&fixtures.TestReshare13Operators,
 			fixtures.GenerateOperators(13)[4],
 			&fixtures.TestOperator5Proof13Operators,
+			fixtures.TestParams(13),
 		))
 
 		require.NoError(t, spec.ValidateReshareMessage(
 			&fixtures.TestReshare13Operators,
 			fixtures.GenerateOperators(13)[5],
 			&fixtures.TestOperator6Proof13Operators,
+			fixtures.TestParams(13),
 		))
 
 		require.NoError(t, spec.ValidateReshareMessage(
 			&fixtures.TestReshare13Operators,
 			fixtures.GenerateOperators(13)[6],
 			&fixtures.TestOperator7Proof13Operators,
+			fixtures.TestParams(13),
 		))
 
 		require.NoError(t, spec.ValidateReshareMessage(
 			&fixtures.TestReshare13Operators,
 			fixtures.GenerateOperators(13)[7],
 			&fixtures.TestOperator8Proof13Operators,
+			fixtures.TestParams(13),
 		))
 
 		require.NoError(t, spec.ValidateReshareMessage(
 			&fixtures.TestReshare13Operators,
 			fixtures.GenerateOperators(13)[8],
 			&fixtures.TestOperator9Proof13Operators,
+			fixtures.TestParams(13),
 		))
 
 		require.NoError(t, spec.ValidateReshareMessage(
 			&fixtures.TestReshare13Operators,
 			fixtures.GenerateOperators(13)[9],
 			&fixtures.TestOperator10Proof13Operators,
+			fixtures.TestParams(13),
 		))
 
 		require.NoError(t, spec.ValidateReshareMessage(
 			&fixtures.TestReshare13Operators,
 			fixtures.GenerateOperators(13)[10],
 			&fixtures.TestOperator11Proof13Operators,
+			fixtures.TestParams(13),
 		))
 
 		require.NoError(t, spec.ValidateReshareMessage(
 			&fixtures.TestReshare13Operators,
 			fixtures.GenerateOperators(13)[11],
 			&fixtures.TestOperator12Proof13Operators,
+			fixtures.TestParams(13),
 		))
 
 		require.NoError(t, spec.ValidateReshareMessage(
 			&fixtures.TestReshare13Operators,
 			fixtures.GenerateOperators(13)[12],
 			&fixtures.TestOperator13Proof13Operators,
+			fixtures.TestParams(13),
 		))
 	})
 
@@ -246,6 +282,7 @@ func TestValidateReshare(t *testing.T) {
 			},
 			fixtures.GenerateOperators(4)[0],
 			&fixtures.TestOperator1Proof4Operators,
+			fixtures.TestParams(4),
 		))
 	})
 
@@ -262,6 +299,7 @@ func TestValidateReshare(t *testing.T) {
 			},
 			fixtures.GenerateOperators(7)[0],
 			&fixtures.TestOperator1Proof7Operators,
+			fixtures.TestParams(7),
 		))
 	})
 
@@ -287,6 +325,7 @@ func TestValidateReshare(t *testing.T) {
 			},
 			fixtures.GenerateOperators(4)[0],
 			&fixtures.TestOperator1Proof4Operators,
+			fixtures.TestParams(4),
 		), "old operators are not unique and ordered")
 	})
 
@@ -313,6 +352,7 @@ func TestValidateReshare(t *testing.T) {
 			},
 			fixtures.GenerateOperators(4)[0],
 			&fixtures.TestOperator2Proof4Operators,
+			fixtures.TestParams(4),
 		), "crypto/rsa: verification error")
 	})
 
@@ -339,6 +379,7 @@ func TestValidateReshare(t *testing.T) {
 			},
 			fixtures.GenerateOperators(4)[0],
 			&fixtures.TestOperator1Proof4Operators,
+			fixtures.TestParams(4),
 		), "new operators are not unique and ordered")
 	})
 
@@ -365,6 +406,7 @@ func TestValidateReshare(t *testing.T) {
 			},
 			fixtures.GenerateOperators(4)[0],
 			&fixtures.TestOperator1Proof4Operators,
+			fixtures.TestParams(4),
 		), "old and new operators are the same")
 	})
 
@@ -391,6 +433,7 @@ func TestValidateReshare(t *testing.T) {
 			},
 			fixtures.GenerateOperators(4)[0],
 			&fixtures.TestOperator1Proof4Operators,
+			fixtures.TestParams(4),
 		), "old threshold set is invalid")
 	})
 
@@ -417,6 +460,124 @@ func TestValidateReshare(t *testing.T) {
 			},
 			fixtures.GenerateOperators(4)[0],
 			&fixtures.TestOperator1Proof4Operators,
+			fixtures.TestParams(4),
 		), "new threshold set is invalid")
 	})
+
+	t.Run("proof of another cluster", func(t *testing.T) {
+		reshare := fixtures.TestReshare4Operators
+		reshare.OldOperators = []*spec.Operator{
+			fixtures.GenerateOperators(4)[0],
+			fixtures.GenerateOperators(4)[1],
+			fixtures.GenerateOperators(4)[2],
+			fixtures.GenerateOperators(7)[5],
+		}
+		reshare.NewOperators = fixtures.GenerateOperators(4)
+		err := spec.ValidateReshareMessage(&reshare, fixtures.GenerateOperators(4)[0], &fixtures.TestOperator1Proof4Operators, fixtures.TestParams(4))
+		require.EqualError(t, err, "proof issued under different ceremony parameters")
+		require.EqualValues(t, spec.CodeProofParamsMismatch, spec.ErrorCodeOf(err))
+	})
+
+	t.Run("reshare to another fork", func(t *testing.T) {
+		reshare := fixtures.TestReshare4Operators
+		reshare.Fork = [4]byte{0x05, 0x00, 0x00, 0x00}
+		require.NoError(t, spec.ValidateReshareMessage(
+			&reshare,
+			fixtures.GenerateOperators(4)[0],
+			&fixtures.TestOperator1Proof4Operators,
+			fixtures.TestParams(4),
+		))
+	})
+
+	t.Run("proof of another fork", func(t *testing.T) {
+		params := fixtures.TestParams(4)
+		params.Fork = [4]byte{0x05, 0x00, 0x00, 0x00}
+		err := spec.ValidateReshareMessage(
+			&fixtures.TestReshare4Operators,
+			fixtures.GenerateOperators(4)[0],
+			&fixtures.TestOperator1Proof4Operators,
+			params,
+		)
+		require.EqualError(t, err, "proof issued under different ceremony parameters")
+		require.EqualValues(t, spec.CodeProofParamsMismatch, spec.ErrorCodeOf(err))
+	})
+
+	t.Run("missing params", func(t *testing.T) {
+		err := spec.ValidateReshareMessage(&fixtures.TestReshare4Operators, fixtures.GenerateOperators(4)[0], &fixtures.TestOperator1Proof4Operators, nil)
+		require.EqualError(t, err, "missing the parameters of the proof's ceremony")
+		require.EqualValues(t, spec.CodeProofParamsMismatch, spec.ErrorCodeOf(err))
+	})
+
+	t.Run("full committee migration", func(t *testing.T) {
+		reshare := fixtures.TestReshare4Operators
+		reshare.NewOperators = fixtures.GenerateOperators(10)[4:8]
+		require.NoError(t, spec.ValidateReshareMessage(&reshare, fixtures.GenerateOperators(4)[0], &fixtures.TestOperator1Proof4Operators, fixtures.TestParams(4)))
+	})
+
+	t.Run("too many old operators leave", func(t *testing.T) {
+		spec.ReshareOverlapLimits = true
+		defer func() { spec.ReshareOverlapLimits = false }()
+		reshare := fixtures.TestReshare4Operators
+		reshare.NewOperators = []*spec.Operator{
+			fixtures.GenerateOperators(4)[0],
+			fixtures.GenerateOperators(7)[4],
+			fixtures.GenerateOperators(7)[5],
+			fixtures.GenerateOperators(7)[6],
+		}
+		err := spec.ValidateReshareMessage(&reshare, fixtures.GenerateOperators(4)[0], &fixtures.TestOperator1Proof4Operators, fixtures.TestParams(4))
+		require.EqualError(t, err, "3 old operators leave, at least the old threshold (3)")
+		require.EqualValues(t, spec.CodeReshareOverlap, spec.ErrorCodeOf(err))
+	})
+
+	t.Run("too many new operators join", func(t *testing.T) {
+		spec.ReshareOverlapLimits = true
+		defer func() { spec.ReshareOverlapLimits = false }()
+		reshare := fixtures.TestReshare4Operators
+		reshare.NewOperators = fixtures.GenerateOperators(10)
+		reshare.NewT = 7
+		err := spec.ValidateReshareMessage(&reshare, fixtures.GenerateOperators(4)[0], &fixtures.TestOperator1Proof4Operators, fixtures.TestParams(4))
+		require.NoError(t, err)
+
+		reshare.NewOperators = fixtures.GenerateOperators(13)
+		reshare.NewT = 9
+		err = spec.ValidateReshareMessage(&reshare, fixtures.GenerateOperators(4)[0], &fixtures.TestOperator1Proof4Operators, fixtures.TestParams(4))
+		require.EqualError(t, err, "9 new operators join, at least the new threshold (9)")
+		require.EqualValues(t, spec.CodeReshareOverlap, spec.ErrorCodeOf(err))
+	})
+
+	// withOperator2 returns reshare with operator 2 of the new operators replaced by operator
+	withOperator2 := func(operator *spec.Operator) spec.Reshare {
+		reshare := fixtures.TestReshare4Operators
+		reshare.NewOperators = append([]*spec.Operator{}, reshare.NewOperators...)
+		for i, op := range reshare.NewOperators {
+			if op.ID == 2 {
+				reshare.NewOperators[i] = operator
+			}
+		}
+		return reshare
+	}
+
+	t.Run("operator key rotated", func(t *testing.T) {
+		reshare := withOperator2(&spec.Operator{ID: 2, PubKey: fixtures.GenerateOperators(13)[12].PubKey})
+		require.NoError(t, spec.ValidateReshareMessage(&reshare, fixtures.GenerateOperators(4)[0], &fixtures.TestOperator1Proof4Operators, fixtures.TestParams(4)))
+	})
+
+	t.Run("operator key in another encoding", func(t *testing.T) {
+		pk, err := crypto.ParseRSAPublicKey(fixtures.GenerateOperators(4)[1].PubKey)
+		require.NoError(t, err)
+		der, err := x509.MarshalPKIXPublicKey(pk)
+		require.NoError(t, err)
+		reshare := withOperator2(&spec.Operator{ID: 2, PubKey: der})
+		require.NoError(t, spec.ValidateReshareMessage(&reshare, fixtures.GenerateOperators(4)[0], &fixtures.TestOperator1Proof4Operators, fixtures.TestParams(4)))
+	})
+
+	t.Run("operator key of another operator", func(t *testing.T) {
+		reshare := fixtures.TestReshare4Operators
+		reshare.NewOperators = append([]*spec.Operator{}, reshare.NewOperators...)
+		last := len(reshare.NewOperators) - 1
+		reshare.NewOperators[last] = &spec.Operator{ID: reshare.NewOperators[last].ID, PubKey: fixtures.GenerateOperators(4)[1].PubKey}
+		err := spec.ValidateReshareMessage(&reshare, fixtures.GenerateOperators(4)[0], &fixtures.TestOperator1Proof4Operators, fixtures.TestParams(4))
+		require.EqualError(t, err, fmt.Sprintf("operators 2 and %d have the same key", reshare.NewOperators[last].ID))
+		require.EqualValues(t, spec.CodeOperatorKeyMismatch, spec.ErrorCodeOf(err))
+	})
 }
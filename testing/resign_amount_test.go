@@ -0,0 +1,90 @@
+package testing
+
+import (
+	"testing"
+
+	spec "github.com/bloxapp/dkg-spec"
+	"github.com/bloxapp/dkg-spec/crypto"
+	"github.com/bloxapp/dkg-spec/testing/fixtures"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateResignAmount(t *testing.T) {
+	resign := func(amount uint64) *spec.Resign {
+		return &spec.Resign{
+			ValidatorPubKey:       fixtures.TestOperator1Proof4Operators.Proof.ValidatorPubKey,
+			Fork:                  fixtures.TestFork,
+			WithdrawalCredentials: fixtures.TestWithdrawalCred[:20],
+			Owner:                 fixtures.TestOwnerAddress,
+			Amount:                amount,
+		}
+	}
+	proof := fixtures.TestOperator1Proof4Operators.Proof
+	original := fixtures.TestParams(4)
+
+	t.Run("default policy", func(t *testing.T) {
+		require.NoError(t, spec.ValidateResignAmount(nil, resign(spec.DepositAmount), proof, original))
+
+		err := spec.ValidateResignAmount(nil, resign(spec.DepositAmount-1), proof, original)
+		require.EqualError(t, err, "amount 31999999999 lower than the original 32000000000")
+		require.EqualValues(t, spec.CodeAmountNotAllowed, spec.ErrorCodeOf(err))
+
+		err = spec.ValidateResignAmount(nil, resign(2*spec.DepositAmount), proof, original)
+		require.EqualError(t, err, "amount 64000000000 out of range [1000000000, 32000000000] for fork 00000000")
+		require.EqualValues(t, spec.CodeAmountNotAllowed, spec.ErrorCodeOf(err))
+	})
+
+	t.Run("compounding on electra", func(t *testing.T) {
+		electra := resign(64000000000)
+		electra.Fork = [4]byte{0x05, 0x00, 0x00, 0x00}
+		electra.WithdrawalCredentials = crypto.CompoundingWithdrawalCredentials(fixtures.TestWithdrawalCred[:20])
+		require.NoError(t, spec.ValidateResignAmount(nil, electra, proof, original))
+
+		electra.Amount = uint64(crypto.MaxEffectiveBalanceElectraInGwei) + 1
+		require.ErrorContains(t, spec.ValidateResignAmount(nil, electra, proof, original), "out of range")
+	})
+
+	t.Run("unchanged", func(t *testing.T) {
+		policy := spec.AmountPolicy{spec.AmountUnchanged}
+		require.NoError(t, spec.ValidateResignAmount(policy, resign(spec.DepositAmount), proof, original))
+		require.EqualError(t, spec.ValidateResignAmount(policy, resign(spec.MinDepositAmount), proof, original),
+			"amount 1000000000 differs from the original 32000000000")
+	})
+
+	t.Run("custom rule", func(t *testing.T) {
+		policy := spec.AmountPolicy{func(original, requested uint64, fork [4]byte, withdrawalCredentials []byte) error {
+			return nil
+		}}
+		require.NoError(t, spec.ValidateResignAmount(policy, resign(spec.MinDepositAmount), proof, original))
+	})
+
+	t.Run("unknown fork", func(t *testing.T) {
+		unknown := resign(spec.DepositAmount)
+		unknown.Fork = [4]byte{0xff}
+		require.EqualError(t, spec.ValidateResignAmount(nil, unknown, proof, original), "unknown network")
+	})
+
+	t.Run("ceremony params", func(t *testing.T) {
+		err := spec.ValidateResignAmount(nil, resign(spec.DepositAmount), proof, nil)
+		require.EqualError(t, err, "missing the parameters of the proof's ceremony")
+		require.EqualValues(t, spec.CodeProofParamsMismatch, spec.ErrorCodeOf(err))
+
+		err = spec.ValidateResignAmount(nil, resign(spec.DepositAmount), proof, fixtures.TestParams(7))
+		require.EqualValues(t, spec.CodeProofParamsMismatch, spec.ErrorCodeOf(err))
+
+		// a lower original amount is only trusted if the proof commits to it
+		lower := *original
+		lower.Amount = spec.MinDepositAmount
+		err = spec.ValidateResignAmount(nil, resign(spec.MinDepositAmount), proof, &lower)
+		require.EqualValues(t, spec.CodeProofParamsMismatch, spec.ErrorCodeOf(err))
+	})
+
+	t.Run("legacy proof", func(t *testing.T) {
+		legacy := *proof
+		legacy.ParamsHash = [32]byte{}
+		require.NoError(t, spec.ValidateResignAmount(nil, resign(spec.DepositAmount), &legacy, nil))
+		require.EqualError(t, spec.ValidateResignAmount(nil, resign(spec.MinDepositAmount), &legacy, nil),
+			"amount 1000000000 lower than the original 32000000000")
+	})
+}
@@ -0,0 +1,96 @@
+package testing
+
+import (
+	"testing"
+
+	spec "github.com/bloxapp/dkg-spec"
+	"github.com/bloxapp/dkg-spec/testing/stubs"
+
+	"github.com/stretchr/testify/require"
+)
+
+func validatorPKs(n int) [][]byte {
+	ret := make([][]byte, n)
+	for i := range ret {
+		ret[i] = make([]byte, 48)
+		ret[i][0] = byte(i)
+		ret[i][1] = byte(i >> 8)
+	}
+	return ret
+}
+
+func TestResignBuilder(t *testing.T) {
+	owner := [20]byte{1, 2, 3}
+	withdrawalAddress := owner[:]
+
+	t.Run("chunked with nonce provider", func(t *testing.T) {
+		pks := validatorPKs(250)
+		batches, err := spec.NewResignBuilder().
+			Validators(pks...).
+			Validators(pks[10], pks[20]).
+			WithdrawalCredentials(withdrawalAddress).
+			Owner(owner).
+			Nonce(1000).
+			NonceProvider(&stubs.NonceProvider{Nonces: map[[20]byte]uint64{owner: 7}}).
+			Build()
+		require.NoError(t, err)
+		require.Len(t, batches, 3)
+		require.Len(t, batches[0], spec.DefaultResignBatchSize)
+		require.Len(t, batches[1], spec.DefaultResignBatchSize)
+		require.Len(t, batches[2], 50)
+
+		i := 0
+		for _, batch := range batches {
+			for _, resign := range batch {
+				require.EqualValues(t, pks[i], resign.ValidatorPubKey)
+				require.EqualValues(t, 7+i, resign.Nonce)
+				require.EqualValues(t, owner, resign.Owner)
+				require.NoError(t, resign.Validate())
+				i++
+			}
+		}
+	})
+
+	t.Run("batch size", func(t *testing.T) {
+		batches, err := spec.NewResignBuilder().
+			Validators(validatorPKs(6)...).
+			WithdrawalCredentials(withdrawalAddress).
+			Owner(owner).
+			Nonce(3).
+			BatchSize(3).
+			Build()
+		require.NoError(t, err)
+		require.Len(t, batches, 2)
+		require.EqualValues(t, 3, batches[0][0].Nonce)
+		require.EqualValues(t, 8, batches[1][2].Nonce)
+
+		_, err = spec.NewResignBuilder().BatchSize(0).Build()
+		require.EqualError(t, err, "batch size: must be positive")
+	})
+
+	t.Run("nonce provider error", func(t *testing.T) {
+		_, err := spec.NewResignBuilder().
+			Validators(validatorPKs(1)...).
+			WithdrawalCredentials(withdrawalAddress).
+			Owner(owner).
+			NonceProvider(&stubs.NonceProvider{}).
+			Build()
+		require.EqualError(t, err, "failed to fetch owner nonce: unknown owner")
+	})
+
+	t.Run("invalid validator", func(t *testing.T) {
+		_, err := spec.NewResignBuilder().Validators(make([]byte, 47)).Build()
+		require.EqualError(t, err, "validators: invalid validator public key length")
+	})
+
+	t.Run("missing fields", func(t *testing.T) {
+		_, err := spec.NewResignBuilder().Build()
+		require.EqualError(t, err, "validators: not set")
+
+		_, err = spec.NewResignBuilder().Validators(validatorPKs(1)...).Build()
+		require.EqualError(t, err, "withdrawal credentials: not set")
+
+		_, err = spec.NewResignBuilder().Validators(validatorPKs(1)...).WithdrawalCredentials(withdrawalAddress).Build()
+		require.EqualError(t, err, "owner: not set")
+	})
+}
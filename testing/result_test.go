@@ -1,9 +1,13 @@
 package testing
 
 import (
+	"encoding/json"
+	"fmt"
 	"testing"
 
 	spec "github.com/bloxapp/dkg-spec"
+	"github.com/bloxapp/dkg-spec/crypto"
+	"github.com/bloxapp/dkg-spec/crypto/bls"
 	"github.com/bloxapp/dkg-spec/testing/fixtures"
 	"github.com/ethereum/go-ethereum/common"
 
@@ -15,6 +19,8 @@ func TestBuildResult(t *testing.T) {
 		result, err := spec.BuildResult(
 			1,
 			fixtures.TestRequestID,
+			fixtures.TestParamsHash(4),
+			nil,
 			fixtures.ShareSK(fixtures.TestValidator4OperatorsShare1),
 			fixtures.OperatorSK(fixtures.TestOperator1SK),
 			fixtures.ShareSK(fixtures.TestValidator4Operators).GetPublicKey().Serialize(),
@@ -31,6 +37,7 @@ func TestBuildResult(t *testing.T) {
 			fixtures.TestWithdrawalCred,
 			fixtures.ShareSK(fixtures.TestValidator4Operators).GetPublicKey().Serialize(),
 			fixtures.TestFork,
+			spec.DepositAmount,
 			fixtures.TestNonce,
 			result,
 		))
@@ -45,10 +52,12 @@ func TestValidateResults(t *testing.T) {
 			fixtures.TestWithdrawalCred,
 			fixtures.ShareSK(fixtures.TestValidator4Operators).GetPublicKey().Serialize(),
 			fixtures.TestFork,
+			spec.DepositAmount,
 			fixtures.TestOwnerAddress,
 			fixtures.TestNonce,
 			fixtures.TestRequestID,
 			3,
+			fixtures.TestParams(4),
 			fixtures.Results4Operators(),
 		)
 		require.NoError(t, err)
@@ -60,10 +69,12 @@ func TestValidateResults(t *testing.T) {
 			fixtures.TestWithdrawalCred,
 			fixtures.ShareSK(fixtures.TestValidator7Operators).GetPublicKey().Serialize(),
 			fixtures.TestFork,
+			spec.DepositAmount,
 			fixtures.TestOwnerAddress,
 			fixtures.TestNonce,
 			fixtures.TestRequestID,
 			5,
+			fixtures.TestParams(7),
 			fixtures.Results7Operators(),
 		)
 		require.NoError(t, err)
@@ -75,10 +86,12 @@ func TestValidateResults(t *testing.T) {
 			fixtures.TestWithdrawalCred,
 			fixtures.ShareSK(fixtures.TestValidator10Operators).GetPublicKey().Serialize(),
 			fixtures.TestFork,
+			spec.DepositAmount,
 			fixtures.TestOwnerAddress,
 			fixtures.TestNonce,
 			fixtures.TestRequestID,
 			7,
+			fixtures.TestParams(10),
 			fixtures.Results10Operators(),
 		)
 		require.NoError(t, err)
@@ -90,10 +103,12 @@ func TestValidateResults(t *testing.T) {
 			fixtures.TestWithdrawalCred,
 			fixtures.ShareSK(fixtures.TestValidator13Operators).GetPublicKey().Serialize(),
 			fixtures.TestFork,
+			spec.DepositAmount,
 			fixtures.TestOwnerAddress,
 			fixtures.TestNonce,
 			fixtures.TestRequestID,
 			9,
+			fixtures.TestParams(13),
 			fixtures.Results13Operators(),
 		)
 		require.NoError(t, err)
@@ -118,15 +133,35 @@ func TestValidateResults(t *testing.T) {
 			fixtures.TestWithdrawalCred,
 			fixtures.ShareSK(fixtures.TestValidator4Operators).GetPublicKey().Serialize(),
 			fixtures.TestFork,
+			spec.DepositAmount,
 			fixtures.TestOwnerAddress,
 			fixtures.TestNonce,
 			fixtures.TestRequestID,
 			3,
+			fixtures.TestParams(4),
 			res,
 		)
 		require.EqualError(t, err, "invalid recovered validator pubkey")
 	})
 
+	t.Run("threshold not matching cluster", func(t *testing.T) {
+		_, _, _, err := spec.ValidateResults(
+			fixtures.GenerateOperators(4),
+			fixtures.TestWithdrawalCred,
+			fixtures.ShareSK(fixtures.TestValidator4Operators).GetPublicKey().Serialize(),
+			fixtures.TestFork,
+			spec.DepositAmount,
+			fixtures.TestOwnerAddress,
+			fixtures.TestNonce,
+			fixtures.TestRequestID,
+			4,
+			fixtures.TestParams(4),
+			fixtures.Results4Operators(),
+		)
+		require.EqualError(t, err, "threshold set is invalid")
+		require.EqualValues(t, spec.CodeInvalidThreshold, spec.ErrorCodeOf(err))
+	})
+
 	t.Run("too many results", func(t *testing.T) {
 		res := fixtures.Results7Operators()
 		_, _, _, err := spec.ValidateResults(
@@ -134,10 +169,12 @@ func TestValidateResults(t *testing.T) {
 			fixtures.TestWithdrawalCred,
 			fixtures.ShareSK(fixtures.TestValidator4Operators).GetPublicKey().Serialize(),
 			fixtures.TestFork,
+			spec.DepositAmount,
 			fixtures.TestOwnerAddress,
 			fixtures.TestNonce,
 			fixtures.TestRequestID,
 			3,
+			fixtures.TestParams(4),
 			res,
 		)
 		require.EqualError(t, err, "mistmatch results count")
@@ -150,16 +187,18 @@ func TestValidateResults(t *testing.T) {
 			fixtures.TestWithdrawalCred,
 			fixtures.ShareSK(fixtures.TestValidator7Operators).GetPublicKey().Serialize(),
 			fixtures.TestFork,
+			spec.DepositAmount,
 			fixtures.TestOwnerAddress,
 			fixtures.TestNonce,
 			fixtures.TestRequestID,
-			3,
+			5,
+			fixtures.TestParams(7),
 			res,
 		)
 		require.EqualError(t, err, "mistmatch results count")
 	})
 
-	t.Run("invalid result", func(t *testing.T) {
+	t.Run("duplicate result", func(t *testing.T) {
 		res := fixtures.Results4Operators()[:3]
 		res = append(res, &spec.Result{
 			OperatorID:                 1,
@@ -173,13 +212,16 @@ func TestValidateResults(t *testing.T) {
 			fixtures.TestWithdrawalCred,
 			fixtures.ShareSK(fixtures.TestValidator4Operators).GetPublicKey().Serialize(),
 			fixtures.TestFork,
+			spec.DepositAmount,
 			fixtures.TestOwnerAddress,
 			fixtures.TestNonce,
 			fixtures.TestRequestID,
 			3,
+			fixtures.TestParams(4),
 			res,
 		)
-		require.EqualError(t, err, "failed to recover validator public key from results")
+		require.EqualError(t, err, "duplicate result of operator 1 for request 0102030405060708090a0b0c0d0e0f101112131415161718")
+		require.EqualValues(t, spec.CodeResultsCountMismatch, spec.ErrorCodeOf(err))
 	})
 }
 
@@ -192,6 +234,7 @@ func TestValidateResult(t *testing.T) {
 			fixtures.TestWithdrawalCred,
 			fixtures.ShareSK(fixtures.TestValidator4Operators).GetPublicKey().Serialize(),
 			fixtures.TestFork,
+			spec.DepositAmount,
 			fixtures.TestNonce,
 			&spec.Result{
 				OperatorID:                 1,
@@ -211,6 +254,7 @@ func TestValidateResult(t *testing.T) {
 			fixtures.TestWithdrawalCred,
 			fixtures.ShareSK(fixtures.TestValidator7Operators).GetPublicKey().Serialize(),
 			fixtures.TestFork,
+			spec.DepositAmount,
 			fixtures.TestNonce,
 			&spec.Result{
 				OperatorID:                 1,
@@ -230,6 +274,7 @@ func TestValidateResult(t *testing.T) {
 			fixtures.TestWithdrawalCred,
 			fixtures.ShareSK(fixtures.TestValidator10Operators).GetPublicKey().Serialize(),
 			fixtures.TestFork,
+			spec.DepositAmount,
 			fixtures.TestNonce,
 			&spec.Result{
 				OperatorID:                 1,
@@ -249,6 +294,7 @@ func TestValidateResult(t *testing.T) {
 			fixtures.TestWithdrawalCred,
 			fixtures.ShareSK(fixtures.TestValidator13Operators).GetPublicKey().Serialize(),
 			fixtures.TestFork,
+			spec.DepositAmount,
 			fixtures.TestNonce,
 			&spec.Result{
 				OperatorID:                 1,
@@ -268,6 +314,7 @@ func TestValidateResult(t *testing.T) {
 			fixtures.TestWithdrawalCred,
 			fixtures.ShareSK(fixtures.TestValidator4Operators).GetPublicKey().Serialize(),
 			fixtures.TestFork,
+			spec.DepositAmount,
 			fixtures.TestNonce,
 			&spec.Result{
 				OperatorID:                 5,
@@ -287,6 +334,7 @@ func TestValidateResult(t *testing.T) {
 			fixtures.TestWithdrawalCred,
 			fixtures.ShareSK(fixtures.TestValidator4Operators).GetPublicKey().Serialize(),
 			fixtures.TestFork,
+			spec.DepositAmount,
 			fixtures.TestNonce,
 			&spec.Result{
 				OperatorID:                 1,
@@ -306,6 +354,7 @@ func TestValidateResult(t *testing.T) {
 			fixtures.TestWithdrawalCred,
 			fixtures.ShareSK(fixtures.TestValidator4Operators).GetPublicKey().Serialize(),
 			fixtures.TestFork,
+			spec.DepositAmount,
 			fixtures.TestNonce,
 			&spec.Result{
 				OperatorID:                 1,
@@ -325,6 +374,7 @@ func TestValidateResult(t *testing.T) {
 			fixtures.TestWithdrawalCred,
 			fixtures.ShareSK(fixtures.TestValidator4Operators).GetPublicKey().Serialize(),
 			fixtures.TestFork,
+			spec.DepositAmount,
 			fixtures.TestNonce,
 			&spec.Result{
 				OperatorID:                 1,
@@ -344,6 +394,7 @@ func TestValidateResult(t *testing.T) {
 			fixtures.TestWithdrawalCred,
 			fixtures.ShareSK(fixtures.TestValidator4Operators).GetPublicKey().Serialize(),
 			fixtures.TestFork,
+			spec.DepositAmount,
 			fixtures.TestNonce,
 			&spec.Result{
 				OperatorID:                 1,
@@ -355,6 +406,7 @@ func TestValidateResult(t *testing.T) {
 						ValidatorPubKey: fixtures.ShareSK(fixtures.TestValidator4Operators).GetPublicKey().Serialize(),
 						Owner:           [20]byte{},
 						SharePubKey:     fixtures.ShareSK(fixtures.TestValidator4OperatorsShare1).GetPublicKey().Serialize(),
+						RequestID:       fixtures.TestRequestID,
 					},
 				},
 			},
@@ -369,6 +421,7 @@ func TestValidateResult(t *testing.T) {
 			fixtures.TestWithdrawalCred,
 			fixtures.ShareSK(fixtures.TestValidator4Operators).GetPublicKey().Serialize(),
 			fixtures.TestFork,
+			spec.DepositAmount,
 			fixtures.TestNonce,
 			&spec.Result{
 				OperatorID:                 1,
@@ -381,12 +434,37 @@ func TestValidateResult(t *testing.T) {
 						EncryptedShare:  fixtures.DecodeHexNoError(fixtures.TestValidator4OperatorsShare1),
 						Owner:           fixtures.TestOwnerAddress,
 						SharePubKey:     fixtures.ShareSK(fixtures.TestValidator4OperatorsShare1).GetPublicKey().Serialize(),
+						RequestID:       fixtures.TestRequestID,
 					},
 				},
 			},
 		), "crypto/rsa: verification error")
 	})
 
+	t.Run("invalid proof request ID", func(t *testing.T) {
+		// a valid proof of the same validator and owner issued in another ceremony
+		requestID := [24]byte{0xaa}
+		err := spec.ValidateResult(
+			fixtures.GenerateOperators(4),
+			fixtures.TestOwnerAddress,
+			requestID,
+			fixtures.TestWithdrawalCred,
+			fixtures.ShareSK(fixtures.TestValidator4Operators).GetPublicKey().Serialize(),
+			fixtures.TestFork,
+			spec.DepositAmount,
+			fixtures.TestNonce,
+			&spec.Result{
+				OperatorID:                 1,
+				RequestID:                  requestID,
+				DepositPartialSignature:    fixtures.DecodeHexNoError(fixtures.TestOperator1DepositSignature4Operators),
+				OwnerNoncePartialSignature: fixtures.DecodeHexNoError(fixtures.TestOperator1NonceSignature4Operators),
+				SignedProof:                fixtures.TestOperator1Proof4Operators,
+			},
+		)
+		require.EqualError(t, err, "invalid proof request ID")
+		require.EqualValues(t, spec.CodeRequestIDMismatch, spec.ErrorCodeOf(err))
+	})
+
 	t.Run("invalid validator pubkey", func(t *testing.T) {
 		require.ErrorContains(t, spec.ValidateResult(
 			fixtures.GenerateOperators(4),
@@ -395,6 +473,7 @@ func TestValidateResult(t *testing.T) {
 			fixtures.TestWithdrawalCred,
 			fixtures.ShareSK(fixtures.TestValidator7Operators).GetPublicKey().Serialize(),
 			fixtures.TestFork,
+			spec.DepositAmount,
 			fixtures.TestNonce,
 			&spec.Result{
 				OperatorID:                 1,
@@ -410,13 +489,239 @@ func TestValidateResult(t *testing.T) {
 func TestPartialNonceRoot(t *testing.T) {
 	t.Run("nonce 1", func(t *testing.T) {
 		require.EqualValues(t,
-			[]byte{0x74, 0x7b, 0x78, 0x3f, 0xcb, 0x76, 0x75, 0xa1, 0xe7, 0xc6, 0xcd, 0x19, 0xa6, 0x0, 0x8, 0x67, 0xf, 0x8b, 0xd3, 0x28, 0xca, 0x87, 0xef, 0x4e, 0xb5, 0x47, 0xfe, 0x7a, 0xc0, 0xfe, 0xdb, 0x4},
+			[]byte{0xf0, 0x1a, 0xec, 0xfb, 0xcb, 0x49, 0xf6, 0x60, 0x49, 0xf9, 0x17, 0xbc, 0xfb, 0x92, 0x49, 0x5f, 0x12, 0x7d, 0x2a, 0x81, 0x77, 0x6e, 0x72, 0x1d, 0xe9, 0x8e, 0xe8, 0x87, 0xe4, 0x1c, 0xd8, 0xd9},
 			spec.PartialNonceRoot(common.Address{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 19, 20}, 1))
 	})
 
 	t.Run("nonce 2", func(t *testing.T) {
 		require.EqualValues(t,
-			[]byte{0x90, 0x68, 0x19, 0x2a, 0xf8, 0x42, 0x31, 0x85, 0x91, 0xc6, 0x71, 0xe4, 0x3d, 0x2e, 0x99, 0x5b, 0x41, 0x87, 0x15, 0x99, 0xe3, 0xa3, 0x2e, 0xe0, 0xde, 0x88, 0x75, 0xa7, 0xac, 0xaf, 0x8, 0xe},
+			[]byte{0xe4, 0x94, 0x88, 0x74, 0x15, 0x76, 0xb8, 0x17, 0xb, 0x13, 0xd7, 0xda, 0x5f, 0xe8, 0x91, 0xa1, 0x28, 0x89, 0xce, 0xa0, 0x16, 0x3d, 0xe, 0x89, 0xdd, 0x3b, 0xb, 0xb7, 0x4c, 0x83, 0x6c, 0xf9},
 			spec.PartialNonceRoot(common.Address{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 19, 20}, 2))
 	})
+
+	t.Run("domain separated", func(t *testing.T) {
+		root, err := (&spec.OwnerNonce{
+			Domain: spec.DomainOwnerNonce,
+			Owner:  fixtures.TestOwnerAddress,
+			Nonce:  fixtures.TestNonce,
+		}).HashTreeRoot()
+		require.NoError(t, err)
+		require.EqualValues(t, root[:], spec.PartialNonceRoot(fixtures.TestOwnerAddress, fixtures.TestNonce))
+
+		other, err := (&spec.OwnerNonce{
+			Owner: fixtures.TestOwnerAddress,
+			Nonce: fixtures.TestNonce,
+		}).HashTreeRoot()
+		require.NoError(t, err)
+		require.NotEqualValues(t, other[:], spec.PartialNonceRoot(fixtures.TestOwnerAddress, fixtures.TestNonce))
+	})
+}
+
+func TestBatchVerifyPartialSignatures(t *testing.T) {
+	results := append(fixtures.Results4Operators(), fixtures.Results7Operators()...)
+	nonces := make([]uint64, len(results))
+	for i := range nonces {
+		nonces[i] = fixtures.TestNonce
+	}
+
+	t.Run("valid", func(t *testing.T) {
+		require.NoError(t, spec.BatchVerifyPartialSignatures(fixtures.TestWithdrawalCred, fixtures.TestFork, spec.DepositAmount, fixtures.TestOwnerAddress, nonces, results))
+	})
+
+	t.Run("invalid signature", func(t *testing.T) {
+		invalid := append([]*spec.Result{}, results...)
+		result := *invalid[5]
+		result.OwnerNoncePartialSignature = invalid[6].OwnerNoncePartialSignature
+		invalid[5] = &result
+		err := spec.BatchVerifyPartialSignatures(fixtures.TestWithdrawalCred, fixtures.TestFork, spec.DepositAmount, fixtures.TestOwnerAddress, nonces, invalid)
+		require.ErrorContains(t, err, "operator 2 of request")
+		require.EqualValues(t, spec.CodeInvalidPartialSignature, spec.ErrorCodeOf(err))
+	})
+
+	t.Run("wrong nonce", func(t *testing.T) {
+		wrong := append([]uint64{}, nonces...)
+		wrong[len(wrong)-1] = 1
+		err := spec.BatchVerifyPartialSignatures(fixtures.TestWithdrawalCred, fixtures.TestFork, spec.DepositAmount, fixtures.TestOwnerAddress, wrong, results)
+		require.ErrorContains(t, err, "operator 7 of request")
+	})
+
+	t.Run("nonces count", func(t *testing.T) {
+		require.EqualError(t, spec.BatchVerifyPartialSignatures(fixtures.TestWithdrawalCred, fixtures.TestFork, spec.DepositAmount, fixtures.TestOwnerAddress, nonces[1:], results), "10 nonces for 11 results")
+	})
+}
+
+func TestVerifyShareInterpolation(t *testing.T) {
+	validatorPK := fixtures.ShareSK(fixtures.TestValidator4Operators).GetPublicKey().Serialize()
+
+	t.Run("valid", func(t *testing.T) {
+		require.NoError(t, spec.VerifyShareInterpolation(validatorPK, 3, fixtures.Results4Operators()))
+		require.NoError(t, spec.VerifyShareInterpolation(validatorPK, 3, fixtures.Results4Operators()[1:]))
+	})
+
+	t.Run("errors cancelling out", func(t *testing.T) {
+		// with lagrange coefficients 4 and -1 at 0, shifting share 1 by d and share 4 by 4d keeps the recovered key
+		d := &bls.SecretKey{}
+		d.SetByCSPRNG()
+		shift := func(result *spec.Result, share string, times int) *spec.Result {
+			sk := fixtures.ShareSK(share)
+			for i := 0; i < times; i++ {
+				sk.Add(d)
+			}
+			proof := *result.SignedProof.Proof
+			proof.SharePubKey = sk.GetPublicKey().Serialize()
+			ret := *result
+			ret.SignedProof = spec.SignedProof{Proof: &proof, Signature: result.SignedProof.Signature}
+			return &ret
+		}
+		results := fixtures.Results4Operators()
+		results[0] = shift(results[0], fixtures.TestValidator4OperatorsShare1, 1)
+		results[3] = shift(results[3], fixtures.TestValidator4OperatorsShare4, 4)
+
+		pk, err := spec.RecoverValidatorPKFromResults(results)
+		require.NoError(t, err)
+		require.EqualValues(t, validatorPK, pk)
+
+		err = spec.VerifyShareInterpolation(validatorPK, 3, results)
+		require.EqualError(t, err, "share public keys of operators [1 2] and 3 don't interpolate to the validator pubkey")
+		require.EqualValues(t, spec.CodeRecoveredPubKeyMismatch, spec.ErrorCodeOf(err))
+
+		_, _, _, err = spec.ValidateResults(
+			fixtures.GenerateOperators(4),
+			fixtures.TestWithdrawalCred,
+			validatorPK,
+			fixtures.TestFork,
+			spec.DepositAmount,
+			fixtures.TestOwnerAddress,
+			fixtures.TestNonce,
+			fixtures.TestRequestID,
+			3,
+			fixtures.TestParams(4),
+			results,
+		)
+		require.EqualValues(t, spec.CodeRecoveredPubKeyMismatch, spec.ErrorCodeOf(err))
+	})
+
+	t.Run("not enough results", func(t *testing.T) {
+		err := spec.VerifyShareInterpolation(validatorPK, 3, fixtures.Results4Operators()[2:])
+		require.EqualError(t, err, "2 results, threshold is 3")
+	})
+}
+
+func TestProofCommitments(t *testing.T) {
+	operators := fixtures.GenerateOperators(4)
+	operatorSKs := []string{fixtures.TestOperator1SK, fixtures.TestOperator2SK, fixtures.TestOperator3SK, fixtures.TestOperator4SK}
+	poly := make([]bls.SecretKey, 3)
+	commitments := make([][]byte, len(poly))
+	for i := range poly {
+		poly[i].SetByCSPRNG()
+		commitments[i] = poly[i].GetPublicKey().Serialize()
+	}
+	validatorPK := commitments[0]
+	build := func(t *testing.T, operatorID uint64, commitments [][]byte) *spec.Result {
+		id := bls.ID{}
+		require.NoError(t, id.SetDecString(fmt.Sprintf("%d", operatorID)))
+		share := &bls.SecretKey{}
+		require.NoError(t, share.Set(poly, &id))
+		result, err := spec.BuildResult(
+			operatorID,
+			fixtures.TestRequestID,
+			fixtures.TestParamsHash(4),
+			commitments,
+			share,
+			fixtures.OperatorSK(operatorSKs[operatorID-1]),
+			validatorPK,
+			fixtures.TestOwnerAddress,
+			fixtures.TestWithdrawalCred,
+			fixtures.TestFork,
+			fixtures.TestNonce,
+		)
+		require.NoError(t, err)
+		return result
+	}
+	validate := func(results []*spec.Result) error {
+		_, _, _, err := spec.ValidateResults(operators, fixtures.TestWithdrawalCred, validatorPK, fixtures.TestFork, spec.DepositAmount, fixtures.TestOwnerAddress, fixtures.TestNonce, fixtures.TestRequestID, 3, fixtures.TestParams(4), results)
+		return err
+	}
+
+	t.Run("valid", func(t *testing.T) {
+		results := make([]*spec.Result, len(operators))
+		for i, operator := range operators {
+			results[i] = build(t, operator.ID, commitments)
+			require.NoError(t, spec.VerifyProofCommitments(results[i].SignedProof.Proof, operator.ID))
+		}
+		require.NoError(t, validate(results))
+
+		// proofs survive JSON
+		byts, err := json.Marshal(results[0].SignedProof.Proof)
+		require.NoError(t, err)
+		decoded := &spec.Proof{}
+		require.NoError(t, json.Unmarshal(byts, decoded))
+		require.EqualValues(t, results[0].SignedProof.Proof, decoded)
+	})
+
+	t.Run("reencrypted", func(t *testing.T) {
+		proof := build(t, 1, commitments).SignedProof
+		newSK, _, err := crypto.GenerateRSAKeys()
+		require.NoError(t, err)
+		newPK, err := crypto.EncodeRSAPublicKey(&newSK.PublicKey)
+		require.NoError(t, err)
+		reencrypted, err := spec.ReencryptProof(&proof, fixtures.OperatorSK(fixtures.TestOperator1SK), newSK)
+		require.NoError(t, err)
+		require.EqualValues(t, proof.Proof.ParamsHash, reencrypted.Proof.ParamsHash)
+		require.EqualValues(t, commitments, reencrypted.Proof.Commitments)
+		require.NoError(t, spec.VerifyProofCommitments(reencrypted.Proof, 1))
+		require.NoError(t, spec.ValidateReencryptedProof(operators[0].PubKey, newPK, proof, *reencrypted))
+
+		// a re-encrypted proof dropping the commitments or parameters doesn't replace the old one
+		stripped := *reencrypted.Proof
+		stripped.Commitments = nil
+		sig, err := crypto.SignRSA(newSK, signingRoot(t, &stripped))
+		require.NoError(t, err)
+		err = spec.ValidateReencryptedProof(operators[0].PubKey, newPK, proof, spec.SignedProof{Proof: &stripped, Signature: sig})
+		require.EqualError(t, err, "invalid proof commitments")
+
+		stripped = *reencrypted.Proof
+		stripped.ParamsHash = [32]byte{}
+		sig, err = crypto.SignRSA(newSK, signingRoot(t, &stripped))
+		require.NoError(t, err)
+		err = spec.ValidateReencryptedProof(operators[0].PubKey, newPK, proof, spec.SignedProof{Proof: &stripped, Signature: sig})
+		require.EqualError(t, err, "invalid proof params hash")
+		require.EqualValues(t, spec.CodeProofParamsMismatch, spec.ErrorCodeOf(err))
+	})
+
+	t.Run("other operator's share", func(t *testing.T) {
+		err := spec.VerifyProofCommitments(build(t, 1, commitments).SignedProof.Proof, 2)
+		require.EqualError(t, err, "share public key of operator 2 doesn't match the commitments")
+		require.EqualValues(t, spec.CodeInconsistentDealing, spec.ErrorCodeOf(err))
+	})
+
+	t.Run("no commitments", func(t *testing.T) {
+		err := spec.VerifyProofCommitments(build(t, 1, nil).SignedProof.Proof, 1)
+		require.EqualError(t, err, "proof carries no commitments")
+	})
+
+	t.Run("differing commitments", func(t *testing.T) {
+		other := append([][]byte{}, commitments...)
+		other[2] = commitments[1]
+		results := []*spec.Result{build(t, 1, commitments), build(t, 2, other), build(t, 3, commitments), build(t, 4, commitments)}
+		require.EqualError(t, validate(results), "operator 2: commitments differ from operator 1's")
+
+		results[1] = build(t, 2, nil)
+		require.EqualError(t, validate(results), "operator 2: 0 commitments, operator 1's proof has 3")
+	})
+
+	t.Run("wrong degree", func(t *testing.T) {
+		results := make([]*spec.Result, len(operators))
+		for i, operator := range operators {
+			results[i] = build(t, operator.ID, commitments[:2])
+		}
+		require.EqualError(t, validate(results), "operator 1: 2 commitments, expected 3")
+	})
+}
+
+// signingRoot returns the root proof is signed over
+func signingRoot(t *testing.T, proof *spec.Proof) []byte {
+	root, err := proof.SigningRoot()
+	require.NoError(t, err)
+	return root[:]
 }
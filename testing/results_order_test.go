@@ -0,0 +1,51 @@
+package testing
+
+import (
+	"testing"
+
+	spec "github.com/bloxapp/dkg-spec"
+	"github.com/bloxapp/dkg-spec/testing/fixtures"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCanonicalResults(t *testing.T) {
+	t.Run("ordered by request and operator", func(t *testing.T) {
+		results := fixtures.Results4Operators()
+		other := *results[0]
+		other.RequestID = [24]byte{0x00, 0xff}
+		shuffled := []*spec.Result{results[2], results[0], &other, results[3], results[1]}
+		require.False(t, spec.IsCanonicalResults(shuffled))
+
+		canonical, err := spec.CanonicalResults(shuffled)
+		require.NoError(t, err)
+		require.EqualValues(t, append([]*spec.Result{&other}, results...), canonical)
+		require.True(t, spec.IsCanonicalResults(canonical))
+		// input is left as is
+		require.Same(t, results[2], shuffled[0])
+	})
+
+	t.Run("reproducible root", func(t *testing.T) {
+		results := fixtures.Results4Operators()
+		expected, err := spec.ResultsLeaf(results)
+		require.NoError(t, err)
+
+		canonical, err := spec.CanonicalResults([]*spec.Result{results[3], results[1], results[2], results[0]})
+		require.NoError(t, err)
+		root, err := spec.ResultsLeaf(canonical)
+		require.NoError(t, err)
+		require.EqualValues(t, expected, root)
+	})
+
+	t.Run("duplicate", func(t *testing.T) {
+		results := fixtures.Results4Operators()
+		_, err := spec.CanonicalResults(append(results, results[2]))
+		require.EqualError(t, err, "duplicate result of operator 3 for request 0102030405060708090a0b0c0d0e0f101112131415161718")
+		require.False(t, spec.IsCanonicalResults(append(results, results[3])))
+	})
+
+	t.Run("empty result", func(t *testing.T) {
+		_, err := spec.CanonicalResults([]*spec.Result{fixtures.Results4Operators()[0], nil})
+		require.EqualError(t, err, "empty result")
+	})
+}
@@ -0,0 +1,69 @@
+package testing
+
+import (
+	"testing"
+	"time"
+
+	spec "github.com/bloxapp/dkg-spec"
+	"github.com/bloxapp/dkg-spec/crypto"
+	"github.com/bloxapp/dkg-spec/testing/fixtures"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSchedule(t *testing.T) {
+	notBefore := time.Unix(1700000000, 0)
+	notAfter := notBefore.Add(time.Hour)
+	newInit := func() *spec.Init {
+		return &spec.Init{
+			Operators:             fixtures.GenerateOperators(4),
+			T:                     3,
+			WithdrawalCredentials: fixtures.TestOwnerAddress[:],
+			Fork:                  fixtures.TestFork,
+			Owner:                 fixtures.TestOwnerAddress,
+			NotBefore:             uint64(notBefore.Unix()),
+			NotAfter:              uint64(notAfter.Unix()),
+		}
+	}
+
+	t.Run("within window", func(t *testing.T) {
+		init := newInit()
+		require.NoError(t, spec.ValidateInitMessage(init))
+		require.NoError(t, spec.ValidateSchedule(init, notBefore))
+		require.NoError(t, spec.ValidateSchedule(init, notAfter))
+	})
+
+	t.Run("too early", func(t *testing.T) {
+		err := spec.ValidateSchedule(newInit(), notBefore.Add(-time.Second))
+		require.EqualError(t, err, "ceremony not accepted before 2023-11-14T22:13:20Z")
+		require.EqualValues(t, spec.CodeOutsideSchedule, spec.ErrorCodeOf(err))
+	})
+
+	t.Run("too late", func(t *testing.T) {
+		err := spec.ValidateSchedule(newInit(), notAfter.Add(time.Second))
+		require.EqualError(t, err, "ceremony not accepted after 2023-11-14T23:13:20Z")
+		require.EqualValues(t, spec.CodeOutsideSchedule, spec.ErrorCodeOf(err))
+	})
+
+	t.Run("open bounds", func(t *testing.T) {
+		init := newInit()
+		init.NotBefore = 0
+		require.NoError(t, spec.ValidateSchedule(init, time.Unix(0, 0)))
+		init.NotAfter = 0
+		require.NoError(t, spec.ValidateSchedule(init, notAfter.Add(time.Hour)))
+	})
+
+	t.Run("window ends before it starts", func(t *testing.T) {
+		init := newInit()
+		init.NotBefore, init.NotAfter = init.NotAfter, init.NotBefore
+		err := spec.ValidateInitMessage(init)
+		require.EqualError(t, err, "ceremony window ends before it starts")
+		require.EqualValues(t, spec.CodeInvalidSchedule, spec.ErrorCodeOf(err))
+	})
+
+	t.Run("operator rejects expired init", func(t *testing.T) {
+		crypto.InitBLS()
+		_, err := spec.OperatorInit(newInit(), fixtures.TestRequestID, 1, fixtures.OperatorSK(fixtures.TestOperator1SK), nil)
+		require.EqualValues(t, spec.CodeOutsideSchedule, spec.ErrorCodeOf(err))
+	})
+}
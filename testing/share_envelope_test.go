@@ -0,0 +1,165 @@
+package testing
+
+import (
+	"testing"
+
+	spec "github.com/bloxapp/dkg-spec"
+	"github.com/bloxapp/dkg-spec/ssvnetwork"
+	"github.com/bloxapp/dkg-spec/testing/fixtures"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestShareEnvelope(t *testing.T) {
+	sk := fixtures.OperatorSK(fixtures.TestOperator1SK)
+	share := fixtures.ShareSK(fixtures.TestValidator4OperatorsShare1).Serialize()
+	proof := fixtures.TestOperator1Proof4Operators.Proof
+	label := spec.ShareEncryptionLabel(proof.ValidatorPubKey, proof.Owner)
+
+	t.Run("legacy proof", func(t *testing.T) {
+		envelope, err := spec.DecodeShareEnvelope(fixtures.TestOperator1Proof4Operators.Proof.EncryptedShare)
+		require.NoError(t, err)
+		require.EqualValues(t, spec.ShareEnvelopeLegacy, envelope.Version)
+		require.EqualValues(t, spec.ShareEncryptionRSAPKCS1v15, envelope.Algorithm)
+
+		decrypted, err := envelope.Decrypt(sk, label)
+		require.NoError(t, err)
+		require.EqualValues(t, share, decrypted)
+
+		encoded, err := envelope.Encode()
+		require.NoError(t, err)
+		require.EqualValues(t, fixtures.TestOperator1Proof4Operators.Proof.EncryptedShare, encoded)
+	})
+
+	t.Run("v1 round trip", func(t *testing.T) {
+		envelope, err := spec.EncryptShareEnvelope(&sk.PublicKey, share, label, spec.ShareEnvelopeV1, spec.ShareEncryptionRSAPKCS1v15)
+		require.NoError(t, err)
+		encoded, err := envelope.Encode()
+		require.NoError(t, err)
+		require.EqualValues(t, spec.ShareEnvelopeMagic[:], encoded[:3])
+		require.LessOrEqual(t, len(encoded), 512)
+
+		decoded, err := spec.DecodeShareEnvelope(encoded)
+		require.NoError(t, err)
+		require.EqualValues(t, envelope, decoded)
+		decrypted, err := decoded.Decrypt(sk, label)
+		require.NoError(t, err)
+		require.EqualValues(t, share, decrypted)
+	})
+
+	t.Run("v2 round trip", func(t *testing.T) {
+		envelope, err := spec.EncryptShareEnvelope(&sk.PublicKey, share, label, spec.ShareEnvelopeV2, spec.ShareEncryptionRSAOAEPAESGCM)
+		require.NoError(t, err)
+		encoded, err := envelope.Encode()
+		require.NoError(t, err)
+		require.LessOrEqual(t, len(encoded), 512)
+
+		decoded, err := spec.DecodeShareEnvelope(encoded)
+		require.NoError(t, err)
+		require.EqualValues(t, envelope, decoded)
+		decrypted, err := decoded.Decrypt(sk, label)
+		require.NoError(t, err)
+		require.EqualValues(t, share, decrypted)
+
+		_, err = spec.EncryptShareEnvelope(&sk.PublicKey, share, label, spec.ShareEnvelopeV2, spec.ShareEncryptionRSAPKCS1v15)
+		require.EqualError(t, err, "unsupported share encryption 1")
+	})
+
+	t.Run("v2 bound to validator and owner", func(t *testing.T) {
+		envelope, err := spec.EncryptShareEnvelope(&sk.PublicKey, share, label, spec.ShareEnvelopeV2, spec.ShareEncryptionRSAOAEPAESGCM)
+		require.NoError(t, err)
+		encoded, err := envelope.Encode()
+		require.NoError(t, err)
+
+		otherOwner := proof.Owner
+		otherOwner[0] ^= 1
+		_, err = envelope.Decrypt(sk, spec.ShareEncryptionLabel(proof.ValidatorPubKey, otherOwner))
+		require.Error(t, err)
+		_, err = envelope.Decrypt(sk, spec.ShareEncryptionLabel(fixtures.TestOperator1Proof7Operators.Proof.ValidatorPubKey, proof.Owner))
+		require.Error(t, err)
+
+		// transplanted into another validator's proof
+		transplanted := *fixtures.TestOperator1Proof7Operators.Proof
+		transplanted.EncryptedShare = encoded
+		_, err = spec.DecryptShare(sk, &transplanted)
+		require.Error(t, err)
+	})
+
+	t.Run("result proofs", func(t *testing.T) {
+		build := func() *spec.Proof {
+			result, err := spec.BuildResult(
+				1,
+				fixtures.TestRequestID,
+				[32]byte{},
+				nil,
+				fixtures.ShareSK(fixtures.TestValidator4OperatorsShare1),
+				sk,
+				proof.ValidatorPubKey,
+				proof.Owner,
+				fixtures.TestWithdrawalCred,
+				fixtures.TestFork,
+				0,
+			)
+			require.NoError(t, err)
+			return result.SignedProof.Proof
+		}
+
+		// shares are registered as is on the SSV contract by default
+		legacy := build()
+		require.Len(t, legacy.EncryptedShare, ssvnetwork.EncryptedShareLen)
+		envelope, err := spec.DecodeShareEnvelope(legacy.EncryptedShare)
+		require.NoError(t, err)
+		require.EqualValues(t, spec.ShareEnvelopeLegacy, envelope.Version)
+		decrypted, err := spec.DecryptShare(sk, legacy)
+		require.NoError(t, err)
+		require.EqualValues(t, share, decrypted.Serialize())
+
+		spec.ProofShareEnvelope = spec.ShareEnvelopeV2
+		defer func() { spec.ProofShareEnvelope = spec.ShareEnvelopeLegacy }()
+		labelled := build()
+		envelope, err = spec.DecodeShareEnvelope(labelled.EncryptedShare)
+		require.NoError(t, err)
+		require.EqualValues(t, spec.ShareEnvelopeV2, envelope.Version)
+		require.EqualValues(t, spec.ShareEncryptionRSAOAEPAESGCM, envelope.Algorithm)
+
+		decrypted, err = spec.DecryptShare(sk, labelled)
+		require.NoError(t, err)
+		require.EqualValues(t, share, decrypted.Serialize())
+
+		transplanted := *fixtures.TestOperator1Proof7Operators.Proof
+		transplanted.EncryptedShare = labelled.EncryptedShare
+		_, err = spec.DecryptShare(sk, &transplanted)
+		require.Error(t, err)
+	})
+
+	t.Run("unsupported version", func(t *testing.T) {
+		_, err := spec.DecodeShareEnvelope(append(spec.ShareEnvelopeMagic[:], 9, 1, 1))
+		require.EqualError(t, err, "unsupported share envelope version 9")
+		_, err = spec.EncryptShareEnvelope(&sk.PublicKey, share, label, 9, spec.ShareEncryptionRSAPKCS1v15)
+		require.EqualError(t, err, "unsupported share envelope version 9")
+	})
+
+	t.Run("unsupported algorithm", func(t *testing.T) {
+		_, err := spec.DecodeShareEnvelope(append(spec.ShareEnvelopeMagic[:], 1, 9, 1))
+		require.EqualError(t, err, "unsupported share encryption 9")
+	})
+
+	t.Run("truncated", func(t *testing.T) {
+		_, err := spec.DecodeShareEnvelope(append(spec.ShareEnvelopeMagic[:], 1))
+		require.EqualError(t, err, "share envelope too short")
+		_, err = spec.DecodeShareEnvelope(append(spec.ShareEnvelopeMagic[:], 1, 1))
+		require.EqualError(t, err, "empty share ciphertext")
+		_, err = spec.DecodeShareEnvelope(nil)
+		require.EqualError(t, err, "empty share ciphertext")
+	})
+
+	t.Run("ambiguous legacy ciphertext", func(t *testing.T) {
+		envelope := &spec.ShareEnvelope{
+			Version:    spec.ShareEnvelopeLegacy,
+			Algorithm:  spec.ShareEncryptionRSAPKCS1v15,
+			Ciphertext: append(spec.ShareEnvelopeMagic[:], make([]byte, 253)...),
+		}
+		_, err := envelope.Encode()
+		require.EqualError(t, err, "legacy share ciphertext is ambiguous with a versioned envelope")
+	})
+}
@@ -0,0 +1,166 @@
+package testing
+
+import (
+	"fmt"
+	"testing"
+
+	spec "github.com/bloxapp/dkg-spec"
+	"github.com/bloxapp/dkg-spec/crypto"
+	"github.com/bloxapp/dkg-spec/crypto/bls"
+	"github.com/bloxapp/dkg-spec/testing/fixtures"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestOperatorResignShares(t *testing.T) {
+	operators := fixtures.GenerateOperators(4)
+	resign := &spec.SignedResign{
+		Resign: spec.Resign{
+			ValidatorPubKey:       fixtures.TestOperator1Proof4Operators.Proof.ValidatorPubKey,
+			Fork:                  fixtures.TestFork,
+			WithdrawalCredentials: fixtures.TestWithdrawalCred[:32],
+			Owner:                 fixtures.TestOwnerAddress,
+			Nonce:                 1,
+			Amount:                spec.DepositAmount,
+		},
+		Signature: make([]byte, 65),
+	}
+	shareOf := func(share string) spec.ShareLookup {
+		return func(validatorPK []byte) (*bls.SecretKey, error) {
+			return fixtures.ShareSK(share), nil
+		}
+	}
+
+	t.Run("valid", func(t *testing.T) {
+		requestID := [24]byte{0xaa}
+		result, err := spec.OperatorResign(
+			resign,
+			operators[0],
+			&fixtures.TestOperator1Proof4Operators,
+			fixtures.TestParams(4),
+			requestID,
+			shareOf(fixtures.TestValidator4OperatorsShare1),
+			fixtures.OperatorSK(fixtures.TestOperator1SK),
+			contractOwnerClient(fixtures.TestOwnerAddress),
+			nil,
+		)
+		require.NoError(t, err)
+		// the ceremony proof is re-issued for the re-sign request
+		expected := *fixtures.TestOperator1Proof4Operators.Proof
+		expected.RequestID = requestID
+		require.EqualValues(t, &expected, result.SignedProof.Proof)
+		require.NoError(t, spec.ValidateResult(
+			operators,
+			resign.Resign.Owner,
+			requestID,
+			resign.Resign.WithdrawalCredentials,
+			resign.Resign.ValidatorPubKey,
+			resign.Resign.Fork,
+			resign.Resign.Amount,
+			resign.Resign.Nonce,
+			result,
+		))
+	})
+
+	t.Run("validator on chain", func(t *testing.T) {
+		addressResign := &spec.SignedResign{Resign: resign.Resign, Signature: resign.Signature}
+		addressResign.Resign.WithdrawalCredentials = fixtures.TestOwnerAddress[:]
+		checker := func(withdrawalCredentials []byte) validatorCheckerF {
+			return func(pk []byte) (*spec.ChainValidator, error) {
+				return &spec.ChainValidator{Index: 11, Status: spec.ValidatorActiveOngoing, WithdrawalCredentials: withdrawalCredentials}, nil
+			}
+		}
+		resignWith := func(checker spec.ValidatorChecker) (*spec.Result, error) {
+			return spec.OperatorResign(
+				addressResign,
+				operators[0],
+				&fixtures.TestOperator1Proof4Operators,
+				fixtures.TestParams(4),
+				fixtures.TestRequestID,
+				shareOf(fixtures.TestValidator4OperatorsShare1),
+				fixtures.OperatorSK(fixtures.TestOperator1SK),
+				contractOwnerClient(fixtures.TestOwnerAddress),
+				checker,
+			)
+		}
+
+		// the beacon chain holds the credentials deposited for the withdrawal address
+		result, err := resignWith(checker(crypto.ETH1WithdrawalCredentials(fixtures.TestOwnerAddress[:])))
+		require.NoError(t, err)
+		require.EqualValues(t, 11, result.ValidatorIndex)
+
+		_, err = resignWith(checker(fixtures.TestOwnerAddress[:]))
+		require.EqualError(t, err, "validator withdrawal credentials mismatch")
+		require.EqualValues(t, spec.CodeWithdrawalCredentialsMismatch, spec.ErrorCodeOf(err))
+	})
+
+	t.Run("amount", func(t *testing.T) {
+		resignFor := func(amount uint64) (*spec.Result, error) {
+			amountResign := &spec.SignedResign{Resign: resign.Resign, Signature: resign.Signature}
+			amountResign.Resign.Fork = [4]byte{0x05, 0x00, 0x00, 0x00}
+			amountResign.Resign.WithdrawalCredentials = crypto.CompoundingWithdrawalCredentials(fixtures.TestWithdrawalCred[:20])
+			amountResign.Resign.Amount = amount
+			return spec.OperatorResign(
+				amountResign,
+				operators[0],
+				&fixtures.TestOperator1Proof4Operators,
+				fixtures.TestParams(4),
+				fixtures.TestRequestID,
+				shareOf(fixtures.TestValidator4OperatorsShare1),
+				fixtures.OperatorSK(fixtures.TestOperator1SK),
+				contractOwnerClient(fixtures.TestOwnerAddress),
+				nil,
+			)
+		}
+
+		// the partial deposit signature signs the deposit data of the requested amount
+		result, err := resignFor(64000000000)
+		require.NoError(t, err)
+		root, err := crypto.DepositDataRootForFork(
+			[4]byte{0x05, 0x00, 0x00, 0x00},
+			resign.Resign.ValidatorPubKey,
+			crypto.CompoundingWithdrawalCredentials(fixtures.TestWithdrawalCred[:20]),
+			64000000000,
+		)
+		require.NoError(t, err)
+		sig := &bls.Sign{}
+		require.NoError(t, sig.Deserialize(result.DepositPartialSignature))
+		require.True(t, sig.VerifyByte(fixtures.ShareSK(fixtures.TestValidator4OperatorsShare1).GetPublicKey(), root[:]))
+
+		_, err = resignFor(spec.DepositAmount - 1)
+		require.EqualError(t, err, "amount 31999999999 lower than the original 32000000000")
+		require.EqualValues(t, spec.CodeAmountNotAllowed, spec.ErrorCodeOf(err))
+	})
+
+	t.Run("share doesn't match proof", func(t *testing.T) {
+		_, err := spec.OperatorResign(
+			resign,
+			operators[0],
+			&fixtures.TestOperator1Proof4Operators,
+			fixtures.TestParams(4),
+			fixtures.TestRequestID,
+			shareOf(fixtures.TestValidator4OperatorsShare2),
+			fixtures.OperatorSK(fixtures.TestOperator1SK),
+			contractOwnerClient(fixtures.TestOwnerAddress),
+			nil,
+		)
+		require.EqualError(t, err, "share doesn't match proof")
+	})
+
+	t.Run("unknown share", func(t *testing.T) {
+		_, err := spec.OperatorResign(
+			resign,
+			operators[0],
+			&fixtures.TestOperator1Proof4Operators,
+			fixtures.TestParams(4),
+			fixtures.TestRequestID,
+			spec.ShareLookup(func(validatorPK []byte) (*bls.SecretKey, error) {
+				return nil, fmt.Errorf("unknown validator")
+			}),
+			fixtures.OperatorSK(fixtures.TestOperator1SK),
+			contractOwnerClient(fixtures.TestOwnerAddress),
+			nil,
+		)
+		require.EqualError(t, err, "unknown validator")
+	})
+}
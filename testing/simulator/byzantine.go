@@ -0,0 +1,146 @@
+package simulator
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	spec "github.com/bloxapp/dkg-spec"
+	"github.com/bloxapp/dkg-spec/api"
+	"github.com/bloxapp/dkg-spec/crypto/bls"
+)
+
+// Behavior is how a byzantine operator tampers with its results
+type Behavior int
+
+const (
+	// Honest returns results untouched
+	Honest Behavior = iota
+	// BadShare returns a consistent, properly signed result for a random share instead of the dealt one
+	BadShare
+	// WrongPartialSignatures signs the wrong roots with the dealt share
+	WrongPartialSignatures
+	// StaleProof returns the proof of the operator's previous ceremony with the current partial signatures
+	StaleProof
+	// ReplayedResult returns the operator's previous result as is
+	ReplayedResult
+)
+
+func (b Behavior) String() string {
+	switch b {
+	case Honest:
+		return "honest"
+	case BadShare:
+		return "bad share"
+	case WrongPartialSignatures:
+		return "wrong partial signatures"
+	case StaleProof:
+		return "stale proof"
+	case ReplayedResult:
+		return "replayed result"
+	default:
+		return fmt.Sprintf("unknown(%d)", int(b))
+	}
+}
+
+// Byzantine wraps an in-process operator, tampering with its results according to Behavior.
+// StaleProof and ReplayedResult behave honestly until the operator took part in a ceremony.
+type Byzantine struct {
+	Operator *Operator
+	Behavior Behavior
+
+	mtx      sync.Mutex
+	previous *spec.Result
+}
+
+var _ api.Transport = (*Byzantine)(nil)
+
+// SetBehavior makes operatorID byzantine, Honest restores the in-process operator
+func (s *Simulator) SetBehavior(operatorID uint64, behavior Behavior) error {
+	for _, operator := range s.Operators {
+		if operator.Operator.ID != operatorID {
+			continue
+		}
+		if behavior == Honest {
+			s.Transports[operatorID] = operator
+		} else {
+			s.Transports[operatorID] = &Byzantine{Operator: operator, Behavior: behavior}
+		}
+		return nil
+	}
+	return fmt.Errorf("unknown operator %d", operatorID)
+}
+
+func (b *Byzantine) Init(ctx context.Context, req *api.InitRequest) (*spec.Result, error) {
+	result, err := b.Operator.Init(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	init := req.SignedInit.Init
+	return b.tamper(result, init.WithdrawalCredentials, init.Fork, init.Nonce)
+}
+
+func (b *Byzantine) Reshare(ctx context.Context, req *api.ReshareRequest) (*spec.Result, error) {
+	result, err := b.Operator.Reshare(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	reshare := req.SignedReshare.Reshare
+	return b.tamper(result, reshare.WithdrawalCredentials, reshare.Fork, reshare.Nonce)
+}
+
+func (b *Byzantine) Resign(ctx context.Context, req *api.ResignRequest) (*spec.Result, error) {
+	result, err := b.Operator.Resign(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	resign := req.SignedResign.Resign
+	return b.tamper(result, resign.WithdrawalCredentials, resign.Fork, resign.Nonce)
+}
+
+func (b *Byzantine) tamper(result *spec.Result, withdrawalCredentials []byte, fork [4]byte, nonce uint64) (*spec.Result, error) {
+	b.mtx.Lock()
+	defer b.mtx.Unlock()
+	previous := b.previous
+	b.previous = result
+
+	proof := result.SignedProof.Proof
+	switch b.Behavior {
+	case BadShare:
+		share := &bls.SecretKey{}
+		share.SetByCSPRNG()
+		return spec.BuildResult(
+			result.OperatorID,
+			result.RequestID,
+			proof.ParamsHash,
+			proof.Commitments,
+			share,
+			b.Operator.SK,
+			proof.ValidatorPubKey,
+			proof.Owner,
+			withdrawalCredentials,
+			fork,
+			nonce,
+		)
+	case WrongPartialSignatures:
+		share := b.Operator.Share(proof.ValidatorPubKey)
+		tampered := *result
+		tampered.DepositPartialSignature = share.SignByte([]byte("wrong deposit root")).Serialize()
+		tampered.OwnerNoncePartialSignature = share.SignByte([]byte("wrong owner nonce root")).Serialize()
+		return &tampered, nil
+	case StaleProof:
+		if previous == nil {
+			return result, nil
+		}
+		tampered := *result
+		tampered.SignedProof = previous.SignedProof
+		return &tampered, nil
+	case ReplayedResult:
+		if previous == nil {
+			return result, nil
+		}
+		return previous, nil
+	default:
+		return result, nil
+	}
+}
@@ -0,0 +1,76 @@
+package simulator
+
+import (
+	"context"
+	"testing"
+
+	spec "github.com/bloxapp/dkg-spec"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestByzantine(t *testing.T) {
+	sim, err := NewSeeded(7, []byte("byzantine"))
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	newInit := func(t *testing.T) *spec.Init {
+		init, err := spec.NewInitBuilder().
+			Operators(sim.Cluster(1, 2, 3, 4)...).
+			WithdrawalCredentials(sim.Owner[:]).
+			Owner(sim.Owner).
+			Build()
+		require.NoError(t, err)
+		return init
+	}
+	requireFaulty := func(t *testing.T, err error, ids ...uint64) {
+		faulty := &spec.FaultyOperatorsError{}
+		require.ErrorAs(t, err, &faulty)
+		require.EqualValues(t, ids, faulty.OperatorIDs())
+	}
+
+	t.Run("bad share", func(t *testing.T) {
+		require.NoError(t, sim.SetBehavior(2, BadShare))
+		defer func() { require.NoError(t, sim.SetBehavior(2, Honest)) }()
+
+		_, err := sim.Init(ctx, newInit(t))
+		require.EqualError(t, err, "invalid recovered validator pubkey")
+	})
+
+	t.Run("wrong partial signatures", func(t *testing.T) {
+		require.NoError(t, sim.SetBehavior(3, WrongPartialSignatures))
+		defer func() { require.NoError(t, sim.SetBehavior(3, Honest)) }()
+
+		_, err := sim.Init(ctx, newInit(t))
+		requireFaulty(t, err, 3)
+	})
+
+	t.Run("stale proof and replayed result", func(t *testing.T) {
+		require.NoError(t, sim.SetBehavior(1, StaleProof))
+		require.NoError(t, sim.SetBehavior(4, ReplayedResult))
+		defer func() {
+			require.NoError(t, sim.SetBehavior(1, Honest))
+			require.NoError(t, sim.SetBehavior(4, Honest))
+		}()
+
+		// nothing to replay yet
+		ceremony, err := sim.Init(ctx, newInit(t))
+		require.NoError(t, err)
+
+		init := newInit(t)
+		requests, err := spec.NewReshareBuilder().
+			OldOperators(init.Operators...).
+			NewOperators(sim.Cluster()...).
+			Proofs(ceremony.Proofs()).
+			WithdrawalCredentials(sim.Owner[:]).
+			Nonce(1).
+			Build()
+		require.NoError(t, err)
+		_, err = sim.Reshare(ctx, requests[0].Reshare, ceremony.Proofs(), ceremony.Params)
+		requireFaulty(t, err, 1, 4)
+	})
+
+	t.Run("unknown operator", func(t *testing.T) {
+		require.EqualError(t, sim.SetBehavior(8, BadShare), "unknown operator 8")
+	})
+}
@@ -0,0 +1,89 @@
+package simulator
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"sync"
+
+	"github.com/bloxapp/dkg-spec/crypto/bls"
+)
+
+// dealer stands in for the DKG protocol rounds the spec leaves out: it samples each ceremony's secret polynomial
+// and hands every operator its share. Reshares keep the validator's secret as the polynomial's constant term.
+type dealer struct {
+	mtx sync.Mutex
+	// rand secrets are sampled from, the CSPRNG if nil
+	rand       io.Reader
+	ceremonies map[[24]byte][]bls.SecretKey
+	secrets    map[string]*bls.SecretKey
+}
+
+func newDealer(rand io.Reader) *dealer {
+	return &dealer{
+		rand:       rand,
+		ceremonies: make(map[[24]byte][]bls.SecretKey),
+		secrets:    make(map[string]*bls.SecretKey),
+	}
+}
+
+// share returns operatorID's share of requestID's polynomial, the validator public key and the commitments to the polynomial.
+// The polynomial is sampled on first use, for a new validator if validatorPK is nil or resharing validatorPK's secret otherwise.
+func (d *dealer) share(requestID [24]byte, validatorPK []byte, t uint64, operatorID uint64) (*bls.SecretKey, []byte, [][]byte, error) {
+	d.mtx.Lock()
+	defer d.mtx.Unlock()
+
+	poly, found := d.ceremonies[requestID]
+	if !found {
+		secret := &bls.SecretKey{}
+		if validatorPK == nil {
+			if err := d.sample(secret); err != nil {
+				return nil, nil, nil, err
+			}
+			d.secrets[hex.EncodeToString(secret.GetPublicKey().Serialize())] = secret
+		} else if secret, found = d.secrets[hex.EncodeToString(validatorPK)]; !found {
+			return nil, nil, nil, fmt.Errorf("unknown validator")
+		}
+		poly = make([]bls.SecretKey, t)
+		poly[0] = *secret
+		for i := 1; i < len(poly); i++ {
+			if err := d.sample(&poly[i]); err != nil {
+				return nil, nil, nil, err
+			}
+		}
+		d.ceremonies[requestID] = poly
+	}
+	pk := poly[0].GetPublicKey().Serialize()
+	if validatorPK != nil && !bytes.Equal(validatorPK, pk) {
+		return nil, nil, nil, fmt.Errorf("request ID already used for another validator")
+	}
+
+	id := bls.ID{}
+	if err := id.SetDecString(fmt.Sprintf("%d", operatorID)); err != nil {
+		return nil, nil, nil, err
+	}
+	share := &bls.SecretKey{}
+	if err := share.Set(poly, &id); err != nil {
+		return nil, nil, nil, err
+	}
+	commitments := make([][]byte, len(poly))
+	for i := range poly {
+		commitments[i] = poly[i].GetPublicKey().Serialize()
+	}
+	return share, pk, commitments, nil
+}
+
+func (d *dealer) sample(sk *bls.SecretKey) error {
+	if d.rand == nil {
+		sk.SetByCSPRNG()
+		return nil
+	}
+	var byts [32]byte
+	if _, err := io.ReadFull(d.rand, byts[:]); err != nil {
+		return err
+	}
+	// keeps the scalar below the curve order
+	byts[0] &= 0x3f
+	return sk.SetHexString(hex.EncodeToString(byts[:]))
+}
@@ -0,0 +1,122 @@
+package simulator
+
+import (
+	"context"
+	"crypto/rsa"
+	"encoding/hex"
+	"fmt"
+	"sync"
+
+	spec "github.com/bloxapp/dkg-spec"
+	"github.com/bloxapp/dkg-spec/api"
+	"github.com/bloxapp/dkg-spec/crypto/bls"
+	"github.com/bloxapp/dkg-spec/eip1271"
+)
+
+// Operator is an in-process operator implementing api.Transport.
+// It validates requests as the spec requires, gets its share from the simulator's dealer and keeps it for later re-sign requests.
+type Operator struct {
+	Operator *spec.Operator
+	SK       *rsa.PrivateKey
+
+	client eip1271.ETHClient
+	dealer *dealer
+	mtx    sync.Mutex
+	shares map[string]*bls.SecretKey
+}
+
+var _ api.Transport = (*Operator)(nil)
+
+// Share returns the operator's share of validatorPK, nil if it holds none
+func (o *Operator) Share(validatorPK []byte) *bls.SecretKey {
+	o.mtx.Lock()
+	defer o.mtx.Unlock()
+	return o.shares[hex.EncodeToString(validatorPK)]
+}
+
+func (o *Operator) Init(ctx context.Context, req *api.InitRequest) (*spec.Result, error) {
+	if req.SignedInit == nil {
+		return nil, fmt.Errorf("missing signed init")
+	}
+	if err := spec.VerifySignedInit(req.SignedInit); err != nil {
+		return nil, err
+	}
+	if req.SignedInit.RequestID != req.RequestID {
+		return nil, fmt.Errorf("init signed for request %x", req.SignedInit.RequestID)
+	}
+	init := req.SignedInit.Init
+	if err := spec.ValidateInitMessage(init); err != nil {
+		return nil, err
+	}
+	if spec.GetOperator(init.Operators, o.Operator.ID) == nil {
+		return nil, fmt.Errorf("operator not in cluster")
+	}
+
+	share, validatorPK, commitments, err := o.dealer.share(req.RequestID, nil, init.T, o.Operator.ID)
+	if err != nil {
+		return nil, err
+	}
+	return o.buildResult(req.RequestID, init.Params(), commitments, share, validatorPK, init.Owner, init.WithdrawalCredentials, init.Fork, init.Nonce)
+}
+
+func (o *Operator) Reshare(ctx context.Context, req *api.ReshareRequest) (*spec.Result, error) {
+	reshare := &req.SignedReshare.Reshare
+	if err := spec.VerifyOwnerSignature(o.client, reshare, req.SignedReshare.Signature); err != nil {
+		return nil, err
+	}
+	// operators joining the cluster have no proof of their own, any old operator's proof is accepted
+	var err error
+	for _, operator := range reshare.OldOperators {
+		if err = spec.ValidateReshareMessage(reshare, operator, req.Proof, req.Params); err == nil {
+			break
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	if spec.GetOperator(reshare.NewOperators, o.Operator.ID) == nil {
+		return nil, fmt.Errorf("operator not in new cluster")
+	}
+
+	share, _, commitments, err := o.dealer.share(req.RequestID, reshare.ValidatorPubKey, reshare.NewT, o.Operator.ID)
+	if err != nil {
+		return nil, err
+	}
+	return o.buildResult(req.RequestID, reshare.NewParams(), commitments, share, reshare.ValidatorPubKey, reshare.Owner, reshare.WithdrawalCredentials, reshare.Fork, reshare.Nonce)
+}
+
+func (o *Operator) Resign(ctx context.Context, req *api.ResignRequest) (*spec.Result, error) {
+	shares := spec.ShareLookup(func(validatorPK []byte) (*bls.SecretKey, error) {
+		share := o.Share(validatorPK)
+		if share == nil {
+			return nil, fmt.Errorf("unknown validator")
+		}
+		return share, nil
+	})
+	return spec.OperatorResign(req.SignedResign, o.Operator, req.Proof, req.Params, req.RequestID, shares, o.SK, o.client, nil)
+}
+
+func (o *Operator) buildResult(
+	requestID [24]byte,
+	params *spec.CeremonyParams,
+	commitments [][]byte,
+	share *bls.SecretKey,
+	validatorPK []byte,
+	owner [20]byte,
+	withdrawalCredentials []byte,
+	fork [4]byte,
+	nonce uint64,
+) (*spec.Result, error) {
+	paramsHash, err := params.HashTreeRoot()
+	if err != nil {
+		return nil, err
+	}
+	result, err := spec.BuildResult(o.Operator.ID, requestID, paramsHash, commitments, share, o.SK, validatorPK, owner, withdrawalCredentials, fork, nonce)
+	if err != nil {
+		return nil, err
+	}
+	o.mtx.Lock()
+	defer o.mtx.Unlock()
+	o.shares[hex.EncodeToString(validatorPK)] = share
+	return result, nil
+}
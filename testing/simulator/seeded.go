@@ -0,0 +1,110 @@
+package simulator
+
+import (
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/bloxapp/dkg-spec/testing/fixtures"
+)
+
+var seededOperatorKeys = []string{
+	fixtures.TestOperator1SK,
+	fixtures.TestOperator2SK,
+	fixtures.TestOperator3SK,
+	fixtures.TestOperator4SK,
+	fixtures.TestOperator5SK,
+	fixtures.TestOperator6SK,
+	fixtures.TestOperator7SK,
+	fixtures.TestOperator8SK,
+	fixtures.TestOperator9SK,
+	fixtures.TestOperator10SK,
+	fixtures.TestOperator11SK,
+	fixtures.TestOperator12SK,
+	fixtures.TestOperator13SK,
+	fixtures.TestOperator14SK,
+}
+
+// NewSeeded returns a simulator whose ceremonies are reproducible: operators use the fixtures' RSA keys (up to 14 operators)
+// while validator secrets, share polynomials and request IDs are derived from seed.
+// RSA encrypted shares and RSA signatures are randomized by the standard library regardless, Transcript leaves them out.
+// Nothing in the simulated flow reads a clock, so there's no clock to fix.
+func NewSeeded(n int, seed []byte) (*Simulator, error) {
+	if n > len(seededOperatorKeys) {
+		return nil, fmt.Errorf("at most %d seeded operators", len(seededOperatorKeys))
+	}
+	sks := make([]*rsa.PrivateKey, n)
+	for i := range sks {
+		sks[i] = fixtures.OperatorSK(seededOperatorKeys[i])
+	}
+	ids := &seededReader{seed: append([]byte("request id"), seed...)}
+	return newSimulator(sks, newDealer(&seededReader{seed: append([]byte("dealer"), seed...)}), func() [24]byte {
+		var ret [24]byte
+		_, _ = ids.Read(ret[:])
+		return ret
+	})
+}
+
+// seededReader is an endless stream of sha256(seed || counter) blocks
+type seededReader struct {
+	seed    []byte
+	counter uint64
+	buf     []byte
+}
+
+func (r *seededReader) Read(p []byte) (int, error) {
+	n := 0
+	for n < len(p) {
+		if len(r.buf) == 0 {
+			block := sha256.Sum256(binary.BigEndian.AppendUint64(append([]byte{}, r.seed...), r.counter))
+			r.counter++
+			r.buf = block[:]
+		}
+		copied := copy(p[n:], r.buf)
+		r.buf = r.buf[copied:]
+		n += copied
+	}
+	return n, nil
+}
+
+// Transcript is the reproducible part of a ceremony, every field is hex encoded
+type Transcript struct {
+	RequestID           string              `json:"request_id"`
+	ValidatorPubKey     string              `json:"validator_pub_key"`
+	Results             []*TranscriptResult `json:"results"`
+	DepositDataRoot     string              `json:"deposit_data_root"`
+	OwnerNonceSignature string              `json:"owner_nonce_signature"`
+}
+
+// TranscriptResult is the reproducible part of a result, leaving out the RSA encrypted share and proof signature
+type TranscriptResult struct {
+	OperatorID                 uint64 `json:"operator_id"`
+	SharePubKey                string `json:"share_pub_key"`
+	DepositPartialSignature    string `json:"deposit_partial_signature"`
+	OwnerNoncePartialSignature string `json:"owner_nonce_partial_signature"`
+}
+
+// Transcript returns the reproducible part of the ceremony, e.g. for golden output tests
+func (c *Ceremony) Transcript() (*Transcript, error) {
+	root, err := c.DepositData.HashTreeRoot()
+	if err != nil {
+		return nil, err
+	}
+	ret := &Transcript{
+		RequestID:           hex.EncodeToString(c.RequestID[:]),
+		ValidatorPubKey:     hex.EncodeToString(c.ValidatorPubKey.Serialize()),
+		DepositDataRoot:     hex.EncodeToString(root[:]),
+		OwnerNonceSignature: hex.EncodeToString(c.OwnerNonceSignature.Serialize()),
+	}
+	for _, result := range c.Results {
+		ret.Results = append(ret.Results, &TranscriptResult{
+			OperatorID:                 result.OperatorID,
+			SharePubKey:                hex.EncodeToString(result.SignedProof.Proof.SharePubKey),
+			DepositPartialSignature:    hex.EncodeToString(result.DepositPartialSignature),
+			OwnerNoncePartialSignature: hex.EncodeToString(result.OwnerNoncePartialSignature),
+		})
+	}
+	return ret, nil
+}
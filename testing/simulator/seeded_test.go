@@ -0,0 +1,79 @@
+package simulator
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"testing"
+
+	spec "github.com/bloxapp/dkg-spec"
+
+	"github.com/stretchr/testify/require"
+)
+
+// seededFlow runs init on operators 1-4, reshares to 1-7 and re-signs, returning the transcripts
+func seededFlow(t *testing.T, seed []byte) []*Transcript {
+	sim, err := NewSeeded(7, seed)
+	require.NoError(t, err)
+	ctx := context.Background()
+	withdrawalCredentials := sim.Owner[:]
+
+	init, err := spec.NewInitBuilder().
+		Operators(sim.Cluster(1, 2, 3, 4)...).
+		WithdrawalCredentials(withdrawalCredentials).
+		Owner(sim.Owner).
+		Build()
+	require.NoError(t, err)
+	initCeremony, err := sim.Init(ctx, init)
+	require.NoError(t, err)
+
+	requests, err := spec.NewReshareBuilder().
+		OldOperators(init.Operators...).
+		NewOperators(sim.Cluster()...).
+		Proofs(initCeremony.Proofs()).
+		WithdrawalCredentials(withdrawalCredentials).
+		Nonce(1).
+		Build()
+	require.NoError(t, err)
+	reshareCeremony, err := sim.Reshare(ctx, requests[0].Reshare, initCeremony.Proofs(), initCeremony.Params)
+	require.NoError(t, err)
+
+	resignCeremony, err := sim.Resign(ctx, &spec.Resign{
+		ValidatorPubKey:       initCeremony.ValidatorPubKey.Serialize(),
+		WithdrawalCredentials: withdrawalCredentials,
+		Owner:                 sim.Owner,
+		Nonce:                 2,
+		Amount:                spec.DepositAmount,
+	}, sim.Cluster(), reshareCeremony.Proofs(), reshareCeremony.Params)
+	require.NoError(t, err)
+
+	var ret []*Transcript
+	for _, ceremony := range []*Ceremony{initCeremony, reshareCeremony, resignCeremony} {
+		transcript, err := ceremony.Transcript()
+		require.NoError(t, err)
+		ret = append(ret, transcript)
+	}
+	return ret
+}
+
+func TestSeeded(t *testing.T) {
+	transcripts := seededFlow(t, []byte("seed"))
+
+	t.Run("reproducible", func(t *testing.T) {
+		require.EqualValues(t, transcripts, seededFlow(t, []byte("seed")))
+		require.NotEqualValues(t, transcripts, seededFlow(t, []byte("other seed")))
+	})
+
+	t.Run("golden", func(t *testing.T) {
+		byts, err := json.Marshal(transcripts)
+		require.NoError(t, err)
+		digest := sha256.Sum256(byts)
+		require.EqualValues(t, "d7b79f6e2e65a370422ef993ef5698ab0c158d86bee6fbd31ebe44f74f96afa1", hex.EncodeToString(digest[:]))
+	})
+
+	t.Run("too many operators", func(t *testing.T) {
+		_, err := NewSeeded(15, nil)
+		require.EqualError(t, err, "at most 14 seeded operators")
+	})
+}
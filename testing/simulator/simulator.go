@@ -0,0 +1,330 @@
+// Package simulator runs init, reshare and re-sign ceremonies end to end against N in-process operators with generated keys,
+// for integration tests and demos. Requests reach operators through api.Transport, so simulated operators can be swapped for
+// real ones (api.Client) and vice versa.
+//
+// The DKG protocol rounds are out of the spec's scope, a trusted dealer shared by the simulated operators stands in for them.
+// The simulated chain treats the owner as a contract accepting any signature, so signed messages need no owner key.
+package simulator
+
+import (
+	"context"
+	"crypto/rsa"
+	"fmt"
+
+	spec "github.com/bloxapp/dkg-spec"
+	"github.com/bloxapp/dkg-spec/api"
+	"github.com/bloxapp/dkg-spec/crypto"
+	"github.com/bloxapp/dkg-spec/crypto/bls"
+	"github.com/bloxapp/dkg-spec/eip1271"
+	"github.com/bloxapp/dkg-spec/testing/stubs"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// DefaultOwner is the owner address of simulated ceremonies
+var DefaultOwner = [20]byte{0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0x0d, 0x0e, 0x0f, 0x10, 0x11, 0x12, 0x13, 0x14}
+
+// Simulator holds N in-process operators with IDs 1 to N
+type Simulator struct {
+	Owner [20]byte
+	// Initiator signs the simulated ceremonies' init messages
+	Initiator *rsa.PrivateKey
+	Operators []*Operator
+	// Transports reach operators by ID, defaulting to the in-process operators
+	Transports map[uint64]api.Transport
+
+	newID func() [24]byte
+}
+
+// Ceremony is the outcome of a simulated ceremony, aggregated and verified with spec.Aggregate
+type Ceremony struct {
+	RequestID [24]byte
+	// Results of the ceremony operators, ordered as the operators
+	Results             []*spec.Result
+	ValidatorPubKey     *bls.PublicKey
+	DepositData         *phase0.DepositData
+	OwnerNonceSignature *bls.Sign
+	// SignedReshare and the Lineage it emitted, set for reshare ceremonies
+	SignedReshare *spec.SignedReshare
+	Lineage       *spec.Lineage
+	// Params of the ceremony the proofs were issued in
+	Params *spec.CeremonyParams
+}
+
+// Proofs returns the ceremony proofs, ordered as the ceremony operators
+func (c *Ceremony) Proofs() spec.CeremonyProofs {
+	ret := make(spec.CeremonyProofs, len(c.Results))
+	for i, result := range c.Results {
+		proof := result.SignedProof
+		ret[i] = &proof
+	}
+	return ret
+}
+
+// New returns a simulator with n operators with generated RSA keys
+func New(n int) (*Simulator, error) {
+	sks := make([]*rsa.PrivateKey, n)
+	for i := range sks {
+		sk, _, err := crypto.GenerateRSAKeys()
+		if err != nil {
+			return nil, err
+		}
+		sks[i] = sk
+	}
+	return newSimulator(sks, newDealer(nil), spec.NewID)
+}
+
+func newSimulator(sks []*rsa.PrivateKey, d *dealer, newID func() [24]byte) (*Simulator, error) {
+	crypto.InitBLS()
+	initiator, _, err := crypto.GenerateRSAKeys()
+	if err != nil {
+		return nil, err
+	}
+	ret := &Simulator{
+		Owner:      DefaultOwner,
+		Initiator:  initiator,
+		Transports: make(map[uint64]api.Transport, len(sks)),
+		newID:      newID,
+	}
+	client := ownerClient(ret.Owner)
+	for i, sk := range sks {
+		pk, err := crypto.EncodeRSAPublicKey(&sk.PublicKey)
+		if err != nil {
+			return nil, err
+		}
+		operator := &Operator{
+			Operator: &spec.Operator{ID: uint64(i + 1), PubKey: pk},
+			SK:       sk,
+			client:   client,
+			dealer:   d,
+			shares:   make(map[string]*bls.SecretKey),
+		}
+		ret.Operators = append(ret.Operators, operator)
+		ret.Transports[operator.Operator.ID] = operator
+	}
+	return ret, nil
+}
+
+// Cluster returns the operators with ids, every operator if none is given
+func (s *Simulator) Cluster(ids ...uint64) []*spec.Operator {
+	ret := make([]*spec.Operator, 0, len(s.Operators))
+	for _, operator := range s.Operators {
+		if len(ids) == 0 {
+			ret = append(ret, operator.Operator)
+			continue
+		}
+		for _, id := range ids {
+			if operator.Operator.ID == id {
+				ret = append(ret, operator.Operator)
+				break
+			}
+		}
+	}
+	return ret
+}
+
+// Init runs a DKG ceremony for init
+func (s *Simulator) Init(ctx context.Context, init *spec.Init) (*Ceremony, error) {
+	requestID := s.newID()
+	signed, err := spec.SignInit(init, requestID, s.Initiator)
+	if err != nil {
+		return nil, err
+	}
+	results := make([]*spec.Result, 0, len(init.Operators))
+	for _, operator := range init.Operators {
+		transport, err := s.transport(operator.ID)
+		if err != nil {
+			return nil, err
+		}
+		result, err := transport.Init(ctx, &api.InitRequest{RequestID: requestID, SignedInit: signed})
+		if err != nil {
+			return nil, fmt.Errorf("operator %d: %v", operator.ID, err)
+		}
+		results = append(results, result)
+	}
+	if len(results) == 0 || results[0].SignedProof.Proof == nil {
+		return nil, fmt.Errorf("no validator public key")
+	}
+	ret, err := aggregate(
+		init.Operators,
+		init.WithdrawalCredentials,
+		results[0].SignedProof.Proof.ValidatorPubKey,
+		init.Fork,
+		spec.DepositAmount,
+		init.Owner,
+		init.Nonce,
+		requestID,
+		results,
+	)
+	if err != nil {
+		return nil, err
+	}
+	ret.Params = init.Params()
+	return ret, nil
+}
+
+// Reshare runs a reshare ceremony, proofs are the validator's ceremony proofs ordered as the old operators and params the
+// parameters of the ceremony they were issued in (see Ceremony.Params).
+// Operators staying in the cluster get their own proof, joining operators get the first old operator's.
+func (s *Simulator) Reshare(
+	ctx context.Context,
+	reshare *spec.Reshare,
+	proofs spec.CeremonyProofs,
+	params *spec.CeremonyParams,
+) (*Ceremony, error) {
+	if len(proofs) != len(reshare.OldOperators) {
+		return nil, fmt.Errorf("mismatch proofs count")
+	}
+	requestID := s.newID()
+	signedReshare := &spec.SignedReshare{Reshare: *reshare, Signature: make([]byte, 65)}
+	results := make([]*spec.Result, 0, len(reshare.NewOperators))
+	for _, operator := range reshare.NewOperators {
+		proof := proofs[0]
+		for i, old := range reshare.OldOperators {
+			if old.ID == operator.ID {
+				proof = proofs[i]
+			}
+		}
+		transport, err := s.transport(operator.ID)
+		if err != nil {
+			return nil, err
+		}
+		result, err := transport.Reshare(ctx, &api.ReshareRequest{
+			RequestID:     requestID,
+			SignedReshare: signedReshare,
+			Proof:         proof,
+			Params:        params,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("operator %d: %v", operator.ID, err)
+		}
+		results = append(results, result)
+	}
+	ret, err := aggregate(
+		reshare.NewOperators,
+		reshare.WithdrawalCredentials,
+		reshare.ValidatorPubKey,
+		reshare.Fork,
+		spec.DepositAmount,
+		reshare.Owner,
+		reshare.Nonce,
+		requestID,
+		results,
+	)
+	if err != nil {
+		return nil, err
+	}
+	ret.SignedReshare = signedReshare
+	ret.Params = reshare.NewParams()
+	if ret.Lineage, err = spec.NewLineage(requestID, signedReshare, proofs, ret.Proofs()); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// Resign runs a re-sign ceremony with operators, proofs are the validator's ceremony proofs ordered as operators and params
+// the parameters of the ceremony they were issued in
+func (s *Simulator) Resign(
+	ctx context.Context,
+	resign *spec.Resign,
+	operators []*spec.Operator,
+	proofs spec.CeremonyProofs,
+	params *spec.CeremonyParams,
+) (*Ceremony, error) {
+	if len(proofs) != len(operators) {
+		return nil, fmt.Errorf("mismatch proofs count")
+	}
+	requestID := s.newID()
+	signedResign := &spec.SignedResign{Resign: *resign, Signature: make([]byte, 65)}
+	results := make([]*spec.Result, 0, len(operators))
+	for i, operator := range operators {
+		transport, err := s.transport(operator.ID)
+		if err != nil {
+			return nil, err
+		}
+		result, err := transport.Resign(ctx, &api.ResignRequest{
+			RequestID:    requestID,
+			SignedResign: signedResign,
+			Proof:        proofs[i],
+			Params:       params,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("operator %d: %v", operator.ID, err)
+		}
+		results = append(results, result)
+	}
+	ret, err := aggregate(
+		operators,
+		resign.WithdrawalCredentials,
+		resign.ValidatorPubKey,
+		resign.Fork,
+		resign.Amount,
+		resign.Owner,
+		resign.Nonce,
+		requestID,
+		results,
+	)
+	if err != nil {
+		return nil, err
+	}
+	ret.Params = params
+	return ret, nil
+}
+
+func (s *Simulator) transport(operatorID uint64) (api.Transport, error) {
+	transport, found := s.Transports[operatorID]
+	if !found {
+		return nil, fmt.Errorf("unknown operator %d", operatorID)
+	}
+	return transport, nil
+}
+
+func aggregate(
+	operators []*spec.Operator,
+	withdrawalCredentials []byte,
+	validatorPK []byte,
+	fork [4]byte,
+	amount uint64,
+	owner [20]byte,
+	nonce uint64,
+	requestID [24]byte,
+	results []*spec.Result,
+) (*Ceremony, error) {
+	pk, depositData, ownerNonceSig, err := spec.Aggregate(
+		operators,
+		withdrawalCredentials,
+		validatorPK,
+		fork,
+		amount,
+		owner,
+		nonce,
+		requestID,
+		results,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &Ceremony{
+		RequestID:           requestID,
+		Results:             results,
+		ValidatorPubKey:     pk,
+		DepositData:         depositData,
+		OwnerNonceSignature: ownerNonceSig,
+	}, nil
+}
+
+// ownerClient simulates a chain on which owner is a contract accepting any signature
+func ownerClient(owner [20]byte) eip1271.ETHClient {
+	return &stubs.Client{
+		CallContractF: func(call ethereum.CallMsg) ([]byte, error) {
+			ret := make([]byte, 32)
+			copy(ret[:4], eip1271.MagicValue[:])
+			return ret, nil
+		},
+		CodeAtMap: map[common.Address]bool{
+			owner: true,
+		},
+	}
+}
@@ -0,0 +1,101 @@
+package simulator
+
+import (
+	"context"
+	"testing"
+
+	spec "github.com/bloxapp/dkg-spec"
+	"github.com/bloxapp/dkg-spec/crypto"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSimulator(t *testing.T) {
+	sim, err := New(7)
+	require.NoError(t, err)
+	ctx := context.Background()
+	withdrawalCredentials := sim.Owner[:]
+
+	oldCluster := sim.Cluster(1, 2, 3, 4)
+	init, err := spec.NewInitBuilder().
+		Operators(oldCluster...).
+		WithdrawalCredentials(withdrawalCredentials).
+		Owner(sim.Owner).
+		Build()
+	require.NoError(t, err)
+	initCeremony, err := sim.Init(ctx, init)
+	require.NoError(t, err)
+	validatorPK := initCeremony.ValidatorPubKey.Serialize()
+	require.NoError(t, spec.ValidateCeremonyProofs(oldCluster, initCeremony.Proofs()))
+	for _, operator := range sim.Operators[:4] {
+		require.NotNil(t, operator.Share(validatorPK))
+	}
+
+	newCluster := sim.Cluster()
+	requests, err := spec.NewReshareBuilder().
+		OldOperators(oldCluster...).
+		NewOperators(newCluster...).
+		Proofs(initCeremony.Proofs()).
+		WithdrawalCredentials(withdrawalCredentials).
+		Nonce(1).
+		Build()
+	require.NoError(t, err)
+	reshareCeremony, err := sim.Reshare(ctx, requests[0].Reshare, initCeremony.Proofs(), initCeremony.Params)
+	require.NoError(t, err)
+	require.EqualValues(t, validatorPK, reshareCeremony.ValidatorPubKey.Serialize())
+	require.NoError(t, spec.ValidateCeremonyProofs(newCluster, reshareCeremony.Proofs()))
+
+	resign := &spec.Resign{
+		ValidatorPubKey:       validatorPK,
+		WithdrawalCredentials: withdrawalCredentials,
+		Owner:                 sim.Owner,
+		Nonce:                 2,
+		Amount:                spec.DepositAmount,
+	}
+	resignCeremony, err := sim.Resign(ctx, resign, newCluster, reshareCeremony.Proofs(), reshareCeremony.Params)
+	require.NoError(t, err)
+	require.EqualValues(t, validatorPK, resignCeremony.ValidatorPubKey.Serialize())
+	require.EqualValues(t, reshareCeremony.DepositData, resignCeremony.DepositData)
+
+	t.Run("resign with a new amount", func(t *testing.T) {
+		compounding := *resign
+		compounding.Fork = [4]byte{0x05, 0x00, 0x00, 0x00}
+		compounding.WithdrawalCredentials = crypto.CompoundingWithdrawalCredentials(withdrawalCredentials)
+		compounding.Nonce = 3
+		compounding.Amount = 64000000000
+		ceremony, err := sim.Resign(ctx, &compounding, newCluster, reshareCeremony.Proofs(), reshareCeremony.Params)
+		require.NoError(t, err)
+		require.EqualValues(t, 64000000000, ceremony.DepositData.Amount)
+		require.EqualValues(t, compounding.WithdrawalCredentials, ceremony.DepositData.WithdrawalCredentials)
+		_, _, _, err = spec.ValidateResults(
+			newCluster,
+			compounding.WithdrawalCredentials,
+			validatorPK,
+			compounding.Fork,
+			compounding.Amount,
+			sim.Owner,
+			compounding.Nonce,
+			ceremony.RequestID,
+			int(reshareCeremony.Params.T),
+			reshareCeremony.Params,
+			ceremony.Results,
+		)
+		require.NoError(t, err)
+
+		compounding.Amount = spec.DepositAmount - 1
+		_, err = sim.Resign(ctx, &compounding, newCluster, reshareCeremony.Proofs(), reshareCeremony.Params)
+		require.ErrorContains(t, err, "amount 31999999999 lower than the original 32000000000")
+	})
+
+	t.Run("resign with old cluster", func(t *testing.T) {
+		_, err := sim.Resign(ctx, resign, oldCluster, reshareCeremony.Proofs()[:4], reshareCeremony.Params)
+		require.EqualError(t, err, "invalid recovered validator pubkey")
+	})
+
+	t.Run("unknown operator", func(t *testing.T) {
+		invalid := *init
+		invalid.Operators = append(append([]*spec.Operator{}, init.Operators[:3]...), &spec.Operator{ID: 8})
+		_, err := sim.Init(ctx, &invalid)
+		require.EqualError(t, err, "unknown operator 8")
+	})
+}
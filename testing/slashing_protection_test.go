@@ -0,0 +1,50 @@
+package testing
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"testing"
+
+	spec "github.com/bloxapp/dkg-spec"
+	"github.com/bloxapp/dkg-spec/testing/fixtures"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSlashingProtection(t *testing.T) {
+	t.Run("ceremonies", func(t *testing.T) {
+		interchange, err := spec.CeremoniesSlashingProtection(fixtures.TestFork, []spec.CeremonyProofs{proofs4Operators()})
+		require.NoError(t, err)
+
+		byts, err := json.Marshal(interchange)
+		require.NoError(t, err)
+		pk := hex.EncodeToString(fixtures.TestOperator1Proof4Operators.Proof.ValidatorPubKey)
+		require.JSONEq(t, `{
+			"metadata": {
+				"interchange_format_version": "5",
+				"genesis_validators_root": "0x4b363db94e286120d76eb905340fdd4e54bfe9f06bf33ff6cf5ad27f511bfe95"
+			},
+			"data": [{
+				"pubkey": "0x`+pk+`",
+				"signed_blocks": [{"slot": "0"}],
+				"signed_attestations": [{"source_epoch": "0", "target_epoch": "0"}]
+			}]
+		}`, string(byts))
+	})
+
+	t.Run("holesky", func(t *testing.T) {
+		interchange, err := spec.NewSlashingProtection([4]byte{0x01, 0x01, 0x70, 0x00}, fixtures.TestOperator1Proof4Operators.Proof.ValidatorPubKey)
+		require.NoError(t, err)
+		require.EqualValues(t, "0x9143aa7c615a7f7115e2b6aac319c03529df8242ae705fba9df39b79c59fa8b1", interchange.Metadata.GenesisValidatorsRoot)
+	})
+
+	t.Run("unknown network", func(t *testing.T) {
+		_, err := spec.NewSlashingProtection([4]byte{9, 9, 9, 9})
+		require.EqualError(t, err, "unknown network")
+	})
+
+	t.Run("invalid pubkey", func(t *testing.T) {
+		_, err := spec.NewSlashingProtection(fixtures.TestFork, make([]byte, 47))
+		require.EqualError(t, err, "invalid validator pubkey length")
+	})
+}
@@ -0,0 +1,54 @@
+package testing
+
+import (
+	"testing"
+
+	spec "github.com/bloxapp/dkg-spec"
+	"github.com/bloxapp/dkg-spec/testing/fixtures"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSSZList(t *testing.T) {
+	results := fixtures.Results4Operators()[:2]
+
+	t.Run("marshal", func(t *testing.T) {
+		byts, err := spec.MarshalSSZList(results, 13)
+		require.NoError(t, err)
+		require.Len(t, byts, spec.SizeSSZList(results))
+
+		// a list of variable size messages starts with an offset per message
+		first, err := results[0].MarshalSSZ()
+		require.NoError(t, err)
+		require.EqualValues(t, first, byts[8:8+len(first)])
+
+		_, err = spec.MarshalSSZList(results, 1)
+		require.Error(t, err)
+	})
+
+	t.Run("hash tree root", func(t *testing.T) {
+		ops := fixtures.GenerateOperators(4)
+		root, err := spec.HashTreeRootList(ops, spec.MaxOperators)
+		require.NoError(t, err)
+		expected, err := spec.Operators(ops).HashTreeRoot()
+		require.NoError(t, err)
+		require.EqualValues(t, expected, root)
+
+		other, err := spec.HashTreeRootList(ops[:3], spec.MaxOperators)
+		require.NoError(t, err)
+		require.NotEqualValues(t, root, other)
+
+		_, err = spec.HashTreeRootList(ops, 3)
+		require.Error(t, err)
+	})
+
+	t.Run("to ssz list", func(t *testing.T) {
+		list := spec.ToSSZList(results)
+		require.Len(t, list, 2)
+		roots, err := spec.HashTreeRoots(list)
+		require.NoError(t, err)
+		expected, err := spec.HashTreeRoots(results)
+		require.NoError(t, err)
+		require.EqualValues(t, expected, roots)
+	})
+}
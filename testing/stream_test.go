@@ -0,0 +1,74 @@
+package testing
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"testing"
+
+	spec "github.com/bloxapp/dkg-spec"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSignedResharesStream(t *testing.T) {
+	msgs := signedReshares(1000)
+	buf := &bytes.Buffer{}
+	require.NoError(t, spec.EncodeSignedReshares(buf, msgs))
+
+	t.Run("round trip", func(t *testing.T) {
+		decoded := make([]*spec.SignedReshare, 0)
+		require.NoError(t, spec.DecodeSignedReshares(bytes.NewReader(buf.Bytes()), func(msg *spec.SignedReshare) error {
+			decoded = append(decoded, msg)
+			return nil
+		}))
+		require.Len(t, decoded, len(msgs))
+		for i := range msgs {
+			expected, err := msgs[i].HashTreeRoot()
+			require.NoError(t, err)
+			root, err := decoded[i].HashTreeRoot()
+			require.NoError(t, err)
+			require.EqualValues(t, expected, root)
+		}
+	})
+
+	t.Run("empty", func(t *testing.T) {
+		require.NoError(t, spec.DecodeSignedReshares(bytes.NewReader(nil), func(msg *spec.SignedReshare) error {
+			return fmt.Errorf("unexpected message")
+		}))
+	})
+
+	t.Run("truncated", func(t *testing.T) {
+		truncated := buf.Bytes()[:buf.Len()-10]
+		err := spec.DecodeSignedReshares(bytes.NewReader(truncated), func(msg *spec.SignedReshare) error {
+			return nil
+		})
+		require.ErrorIs(t, err, io.ErrUnexpectedEOF)
+	})
+
+	t.Run("callback error", func(t *testing.T) {
+		count := 0
+		err := spec.DecodeSignedReshares(bytes.NewReader(buf.Bytes()), func(msg *spec.SignedReshare) error {
+			count++
+			if count == 3 {
+				return fmt.Errorf("stop")
+			}
+			return nil
+		})
+		require.EqualError(t, err, "stop")
+		require.EqualValues(t, 3, count)
+	})
+
+	t.Run("message too large", func(t *testing.T) {
+		prefix := binary.LittleEndian.AppendUint32(nil, spec.MaxStreamMessageSize+1)
+		err := spec.NewStreamDecoder(bytes.NewReader(prefix)).Decode(&spec.SignedReshare{})
+		require.EqualError(t, err, "message too large")
+	})
+
+	t.Run("invalid message", func(t *testing.T) {
+		stream := append(binary.LittleEndian.AppendUint32(nil, 3), 1, 2, 3)
+		err := spec.NewStreamDecoder(bytes.NewReader(stream)).Decode(&spec.SignedReshare{})
+		require.Error(t, err)
+	})
+}
@@ -0,0 +1,30 @@
+package stubs
+
+import (
+	"context"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// LogFilterer returns Logs emitted by the queried addresses
+type LogFilterer struct {
+	Logs []types.Log
+}
+
+func (f *LogFilterer) FilterLogs(ctx context.Context, q ethereum.FilterQuery) ([]types.Log, error) {
+	var ret []types.Log
+	for _, log := range f.Logs {
+		for _, address := range q.Addresses {
+			if log.Address == address {
+				ret = append(ret, log)
+				break
+			}
+		}
+	}
+	return ret, nil
+}
+
+func (f *LogFilterer) SubscribeFilterLogs(ctx context.Context, q ethereum.FilterQuery, ch chan<- types.Log) (ethereum.Subscription, error) {
+	panic("implement")
+}
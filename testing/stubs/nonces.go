@@ -0,0 +1,16 @@
+package stubs
+
+import "fmt"
+
+// NonceProvider returns the nonces in Nonces, erroring on unknown owners
+type NonceProvider struct {
+	Nonces map[[20]byte]uint64
+}
+
+func (p *NonceProvider) NextNonce(owner [20]byte) (uint64, error) {
+	nonce, found := p.Nonces[owner]
+	if !found {
+		return 0, fmt.Errorf("unknown owner")
+	}
+	return nonce, nil
+}
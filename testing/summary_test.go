@@ -0,0 +1,87 @@
+package testing
+
+import (
+	"testing"
+
+	spec "github.com/bloxapp/dkg-spec"
+	"github.com/bloxapp/dkg-spec/crypto"
+	"github.com/bloxapp/dkg-spec/testing/fixtures"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCeremonySummary(t *testing.T) {
+	sk, _, err := crypto.GenerateRSAKeys()
+	require.NoError(t, err)
+	pk, err := crypto.EncodeRSAPublicKey(&sk.PublicKey)
+	require.NoError(t, err)
+	validatorPK := fixtures.ShareSK(fixtures.TestValidator4Operators).GetPublicKey().Serialize()
+	summary := spec.NewCeremonySummary(
+		fixtures.TestRequestID,
+		validatorPK,
+		fixtures.TestOwnerAddress,
+		fixtures.TestNonce,
+		fixtures.Results4Operators(),
+	)
+	signed, err := spec.SignCeremonySummary(summary, sk)
+	require.NoError(t, err)
+
+	t.Run("valid", func(t *testing.T) {
+		require.NoError(t, spec.ValidateCeremonySummary(pk, fixtures.GenerateOperators(4), fixtures.TestWithdrawalCred, fixtures.TestFork, spec.DepositAmount, fixtures.TestParams(4), signed))
+	})
+
+	t.Run("ssz round trip", func(t *testing.T) {
+		byts, err := signed.MarshalSSZ()
+		require.NoError(t, err)
+		decoded := &spec.SignedCeremonySummary{}
+		require.NoError(t, decoded.UnmarshalSSZ(byts))
+		require.NoError(t, spec.VerifyCeremonySummary(pk, decoded))
+	})
+
+	t.Run("signed by another initiator", func(t *testing.T) {
+		require.EqualError(t, spec.VerifyCeremonySummary(fixtures.EncodedOperatorPK(fixtures.TestOperator1SK), signed), "crypto/rsa: verification error")
+	})
+
+	t.Run("tampered results", func(t *testing.T) {
+		tamperedSummary := *summary
+		tamperedSummary.Results = append([]*spec.Result{}, summary.Results...)
+		tamperedSummary.Results[0], tamperedSummary.Results[1] = tamperedSummary.Results[1], tamperedSummary.Results[0]
+		tampered := &spec.SignedCeremonySummary{
+			Summary:   &tamperedSummary,
+			Signature: signed.Signature,
+		}
+		require.EqualError(t, spec.VerifyCeremonySummary(pk, tampered), "crypto/rsa: verification error")
+	})
+
+	t.Run("results in response order", func(t *testing.T) {
+		results := fixtures.Results4Operators()
+		results[0], results[3] = results[3], results[0]
+		reordered := spec.NewCeremonySummary(fixtures.TestRequestID, validatorPK, fixtures.TestOwnerAddress, fixtures.TestNonce, results)
+		require.EqualValues(t, summary, reordered)
+		require.NoError(t, spec.VerifyCeremonySummary(pk, &spec.SignedCeremonySummary{Summary: reordered, Signature: signed.Signature}))
+	})
+
+	t.Run("invalid results", func(t *testing.T) {
+		invalid, err := spec.SignCeremonySummary(spec.NewCeremonySummary(
+			fixtures.TestRequestID,
+			validatorPK,
+			fixtures.TestOwnerAddress,
+			fixtures.TestNonce+1,
+			fixtures.Results4Operators(),
+		), sk)
+		require.NoError(t, err)
+		require.ErrorContains(t, spec.ValidateCeremonySummary(pk, fixtures.GenerateOperators(4), fixtures.TestWithdrawalCred, fixtures.TestFork, spec.DepositAmount, fixtures.TestParams(4), invalid), "failed to verify nonce partial signatures")
+	})
+
+	t.Run("missing result", func(t *testing.T) {
+		incomplete, err := spec.SignCeremonySummary(spec.NewCeremonySummary(
+			fixtures.TestRequestID,
+			validatorPK,
+			fixtures.TestOwnerAddress,
+			fixtures.TestNonce,
+			fixtures.Results4Operators()[:3],
+		), sk)
+		require.NoError(t, err)
+		require.EqualError(t, spec.ValidateCeremonySummary(pk, fixtures.GenerateOperators(4), fixtures.TestWithdrawalCred, fixtures.TestFork, spec.DepositAmount, fixtures.TestParams(4), incomplete), "mistmatch results count")
+	})
+}
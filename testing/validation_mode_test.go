@@ -0,0 +1,102 @@
+package testing
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	spec "github.com/bloxapp/dkg-spec"
+	"github.com/bloxapp/dkg-spec/testing/fixtures"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDecodeJSON(t *testing.T) {
+	init := &spec.Init{
+		Operators:             fixtures.GenerateOperators(4),
+		T:                     3,
+		WithdrawalCredentials: fixtures.TestOwnerAddress[:],
+		Fork:                  fixtures.TestFork,
+		Owner:                 fixtures.TestOwnerAddress,
+	}
+	canonical, err := json.Marshal(init)
+	require.NoError(t, err)
+
+	unordered := *init
+	unordered.Operators = []*spec.Operator{init.Operators[2], init.Operators[0], init.Operators[3], init.Operators[1]}
+	unorderedJSON, err := json.Marshal(&unordered)
+	require.NoError(t, err)
+
+	t.Run("canonical", func(t *testing.T) {
+		for _, mode := range []spec.ValidationMode{spec.ValidationLenient, spec.ValidationStrict} {
+			decoded := &spec.Init{}
+			require.NoError(t, spec.DecodeJSON(canonical, decoded, mode))
+			requireJSONEq(t, canonical, decoded)
+		}
+	})
+
+	t.Run("unordered operators", func(t *testing.T) {
+		decoded := &spec.Init{}
+		require.NoError(t, spec.DecodeJSON(unorderedJSON, decoded, spec.ValidationLenient))
+		requireJSONEq(t, canonical, decoded)
+		require.NoError(t, spec.ValidateInitMessage(decoded))
+
+		err := spec.DecodeJSON(unorderedJSON, &spec.Init{}, spec.ValidationStrict)
+		require.EqualError(t, err, "operators are not unique and ordered")
+		require.Equal(t, spec.CodeOperatorsNotOrdered, spec.ErrorCodeOf(err))
+	})
+
+	t.Run("unknown field", func(t *testing.T) {
+		extra := strings.Replace(string(canonical), `"T":3`, `"T":3,"Amount":1`, 1)
+		require.NoError(t, spec.DecodeJSON([]byte(extra), &spec.Init{}, spec.ValidationLenient))
+
+		err := spec.DecodeJSON([]byte(extra), &spec.Init{}, spec.ValidationStrict)
+		require.EqualError(t, err, "unknown field Amount")
+		require.Equal(t, spec.CodeUnknownField, spec.ErrorCodeOf(err))
+
+		nested := strings.Replace(string(canonical), `"id":1`, `"id":1,"port":3030`, 1)
+		err = spec.DecodeJSON([]byte(nested), &spec.Init{}, spec.ValidationStrict)
+		require.EqualError(t, err, "unknown field Operators[0].port")
+	})
+
+	t.Run("non-canonical hex", func(t *testing.T) {
+		proof, err := json.Marshal(&fixtures.TestOperator1Proof4Operators)
+		require.NoError(t, err)
+		signature := fixtures.TestOperator1Proof4Operators.Signature
+		for _, altered := range []string{
+			strings.Replace(string(proof), `"signature":"`, `"signature":"0x`, 1),
+			strings.Replace(string(proof), `"signature":"`+hex.EncodeToString(signature), `"signature":"`+strings.ToUpper(hex.EncodeToString(signature)), 1),
+		} {
+			decoded := &spec.SignedProof{}
+			require.NoError(t, spec.DecodeJSON([]byte(altered), decoded, spec.ValidationLenient))
+			require.EqualValues(t, signature, decoded.Signature)
+
+			err := spec.DecodeJSON([]byte(altered), &spec.SignedProof{}, spec.ValidationStrict)
+			require.EqualError(t, err, "non-canonical value at signature")
+			require.Equal(t, spec.CodeNonCanonicalEncoding, spec.ErrorCodeOf(err))
+		}
+	})
+
+	t.Run("signed reshare", func(t *testing.T) {
+		signed := &spec.SignedReshare{Reshare: fixtures.TestReshare4Operators, Signature: make([]byte, 65)}
+		signed.Reshare.NewOperators = []*spec.Operator{
+			signed.Reshare.NewOperators[1],
+			signed.Reshare.NewOperators[0],
+			signed.Reshare.NewOperators[2],
+			signed.Reshare.NewOperators[3],
+		}
+		byts, err := json.Marshal(signed)
+		require.NoError(t, err)
+		require.EqualError(t, spec.DecodeJSON(byts, &spec.SignedReshare{}, spec.ValidationStrict), "new operators are not unique and ordered")
+		decoded := &spec.SignedReshare{}
+		require.NoError(t, spec.DecodeJSON(byts, decoded, spec.ValidationLenient))
+		require.True(t, spec.UniqueAndOrderedOperators(decoded.Reshare.NewOperators))
+	})
+}
+
+func requireJSONEq(t *testing.T, expected []byte, v interface{}) {
+	byts, err := json.Marshal(v)
+	require.NoError(t, err)
+	require.JSONEq(t, string(expected), string(byts))
+}
@@ -0,0 +1,371 @@
+package testing
+
+import (
+	"testing"
+
+	spec "github.com/bloxapp/dkg-spec"
+	"github.com/bloxapp/dkg-spec/crypto"
+	"github.com/bloxapp/dkg-spec/testing/fixtures"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestVersionedProof(t *testing.T) {
+	t.Run("round trip", func(t *testing.T) {
+		versioned, err := spec.NewVersionedProof(fixtures.TestOperator1Proof4Operators.Proof)
+		require.NoError(t, err)
+		require.EqualValues(t, spec.ProofVersion, versioned.Version)
+
+		byts, err := versioned.MarshalSSZ()
+		require.NoError(t, err)
+		decoded := &spec.VersionedProof{}
+		require.NoError(t, decoded.UnmarshalSSZ(byts))
+
+		proof, err := decoded.Decode()
+		require.NoError(t, err)
+		// SSZ decodes the fixture's nil commitments as empty
+		requireSameRoot(t, fixtures.TestOperator1Proof4Operators.Proof, proof)
+	})
+
+	t.Run("largest proof", func(t *testing.T) {
+		proof := &spec.Proof{
+			ValidatorPubKey: make([]byte, 48),
+			EncryptedShare:  make([]byte, 512),
+			SharePubKey:     make([]byte, 48),
+			Commitments:     make([][]byte, 13),
+		}
+		for i := range proof.Commitments {
+			proof.Commitments[i] = make([]byte, 48)
+		}
+		versioned, err := spec.NewVersionedProof(proof)
+		require.NoError(t, err)
+		byts, err := versioned.MarshalSSZ()
+		require.NoError(t, err)
+		decoded := &spec.VersionedProof{}
+		require.NoError(t, decoded.UnmarshalSSZ(byts))
+		decodedProof, err := decoded.Decode()
+		require.NoError(t, err)
+		require.EqualValues(t, proof, decodedProof)
+	})
+
+	t.Run("signing root unchanged", func(t *testing.T) {
+		versioned, err := spec.NewVersionedProof(fixtures.TestOperator1Proof4Operators.Proof)
+		require.NoError(t, err)
+		root, err := versioned.SigningRoot()
+		require.NoError(t, err)
+		expected, err := fixtures.TestOperator1Proof4Operators.Proof.SigningRoot()
+		require.NoError(t, err)
+		require.EqualValues(t, expected, root)
+
+		// existing signatures verify against the versioned root
+		require.NoError(t, spec.VerifyCeremonyProof(fixtures.GenerateOperators(4)[0].PubKey, fixtures.TestOperator1Proof4Operators))
+	})
+
+	t.Run("unsupported version", func(t *testing.T) {
+		versioned, err := spec.NewVersionedProof(fixtures.TestOperator1Proof4Operators.Proof)
+		require.NoError(t, err)
+		versioned.Version = 6
+		_, err = versioned.Decode()
+		require.EqualError(t, err, "unsupported proof version 6")
+	})
+
+	proofV3 := &spec.ProofV3{
+		ValidatorPubKey: fixtures.TestOperator1Proof4Operators.Proof.ValidatorPubKey,
+		EncryptedShare:  fixtures.TestOperator1Proof4Operators.Proof.EncryptedShare,
+		SharePubKey:     fixtures.TestOperator1Proof4Operators.Proof.SharePubKey,
+		Owner:           fixtures.TestOperator1Proof4Operators.Proof.Owner,
+		RequestID:       fixtures.TestOperator1Proof4Operators.Proof.RequestID,
+		ParamsHash:      fixtures.TestOperator1Proof4Operators.Proof.ParamsHash,
+	}
+
+	t.Run("version 4", func(t *testing.T) {
+		// proof signed before proofs carried their ceremony's commitments
+		signature := fixtures.DecodeHexNoError("4f94541225ee55d019b20e75545d642e58367eb44d190c7598dfbfcf5dab66f241a81c11d447701a25f338c1c0c2bc10f5e079ccb0655f806e4d2ea74e6c7c8b45469e4e58cc58d41b65f5dced5d607b7477f98f4821dcab367facfa266bcb44f720f3534635ee837892285829a44b217e114703477a4024c7e821db9aa52efcefb3b28b1adcd794f2e5d7e6b929b1536a20ebce3ae18ae67c2c2406727ce918816748825940cd7a5a16b8c23b16010cc9c7e393983c01c87dc2b8a723c01d6ee13f2f5246db11c0f2813c84669d29fbdae4fb37278c49af9d33d255a5001dfe52a7d8305d1ce4f4bfc5e2f11fe55b175296c006f73eb8a260246f635d0c45d2")
+		byts, err := proofV3.MarshalSSZ()
+		require.NoError(t, err)
+		versioned := &spec.VersionedProof{Version: 4, Proof: byts}
+
+		decoded, err := versioned.Decode()
+		require.NoError(t, err)
+		require.EqualValues(t, fixtures.TestOperator1Proof4Operators.Proof, decoded)
+
+		root, err := versioned.SigningRoot()
+		require.NoError(t, err)
+		pk, err := crypto.ParseRSAPublicKey(fixtures.GenerateOperators(4)[0].PubKey)
+		require.NoError(t, err)
+		require.NoError(t, crypto.VerifyRSA(pk, root[:], signature))
+
+		// the decoded proof is verified against its version's signing root
+		require.NoError(t, spec.VerifyCeremonyProof(fixtures.GenerateOperators(4)[0].PubKey, spec.SignedProof{Proof: decoded, Signature: signature}))
+		decoded.Commitments = [][]byte{decoded.ValidatorPubKey}
+		err = spec.VerifyCeremonyProof(fixtures.GenerateOperators(4)[0].PubKey, spec.SignedProof{Proof: decoded, Signature: signature})
+		require.EqualValues(t, spec.CodeInvalidProofSignature, spec.ErrorCodeOf(err))
+	})
+
+	t.Run("version 3", func(t *testing.T) {
+		// proof signed before proofs were signed under DomainProof
+		byts, err := proofV3.MarshalSSZ()
+		require.NoError(t, err)
+		versioned := &spec.VersionedProof{Version: 3, Proof: byts}
+
+		decoded, err := versioned.Decode()
+		require.NoError(t, err)
+		require.EqualValues(t, fixtures.TestOperator1Proof4Operators.Proof, decoded)
+
+		root, err := versioned.SigningRoot()
+		require.NoError(t, err)
+		expected, err := proofV3.HashTreeRoot()
+		require.NoError(t, err)
+		require.EqualValues(t, expected, root)
+	})
+
+	t.Run("version 2", func(t *testing.T) {
+		proof := &spec.ProofV2{
+			ValidatorPubKey: fixtures.TestOperator1Proof4Operators.Proof.ValidatorPubKey,
+			EncryptedShare:  fixtures.TestOperator1Proof4Operators.Proof.EncryptedShare,
+			SharePubKey:     fixtures.TestOperator1Proof4Operators.Proof.SharePubKey,
+			Owner:           fixtures.TestOperator1Proof4Operators.Proof.Owner,
+			RequestID:       fixtures.TestRequestID,
+		}
+		byts, err := proof.MarshalSSZ()
+		require.NoError(t, err)
+		versioned := &spec.VersionedProof{Version: 2, Proof: byts}
+
+		decoded, err := versioned.Decode()
+		require.NoError(t, err)
+		require.EqualValues(t, fixtures.TestRequestID, decoded.RequestID)
+		require.EqualValues(t, [32]byte{}, decoded.ParamsHash)
+
+		root, err := versioned.SigningRoot()
+		require.NoError(t, err)
+		expected, err := proof.HashTreeRoot()
+		require.NoError(t, err)
+		require.EqualValues(t, expected, root)
+	})
+
+	t.Run("version 1", func(t *testing.T) {
+		// proof signed before proofs were bound to their request ID
+		proof := &spec.ProofV1{
+			ValidatorPubKey: fixtures.TestOperator1Proof4Operators.Proof.ValidatorPubKey,
+			EncryptedShare:  fixtures.TestOperator1Proof4Operators.Proof.EncryptedShare,
+			SharePubKey:     fixtures.TestOperator1Proof4Operators.Proof.SharePubKey,
+			Owner:           fixtures.TestOperator1Proof4Operators.Proof.Owner,
+		}
+		signature := fixtures.DecodeHexNoError("53f81fbdd1240146d6b9d32ebe90145354f7bf528e21455abaca97dfa120984544d0068ce06b8cea4893fe1ea9d99754aaefde2c94dcfb53458331747a5464e2eaa3397b1211cd0946fa3d2fa9157350597bb1a19e7fe3b6709f0c8728ce9a0e0cad269cdc84cbd5b77e8965649ce7286b7da3c6ba4c6e323f242af53a58c0094eb9e715fa9899ebffd2a44c12b86b149f4a08a1ceadbbaa8031980a75ee04f11767983308bf45d8a16120688d4406729380a0e45af6d183e43deb8736167175fb5060840f03057b3ca8114258f4dd42d809a05c41015d4e25be61daa20f28844872a2c8b04743193a4dc7f6bc61e9b8d0efd748651fd76839a2a9576c3644f4")
+		byts, err := proof.MarshalSSZ()
+		require.NoError(t, err)
+		versioned := &spec.VersionedProof{Version: 1, Proof: byts}
+
+		decoded, err := versioned.Decode()
+		require.NoError(t, err)
+		require.EqualValues(t, [24]byte{}, decoded.RequestID)
+		require.EqualValues(t, proof.ValidatorPubKey, decoded.ValidatorPubKey)
+
+		root, err := versioned.SigningRoot()
+		require.NoError(t, err)
+		pk, err := crypto.ParseRSAPublicKey(fixtures.GenerateOperators(4)[0].PubKey)
+		require.NoError(t, err)
+		require.NoError(t, crypto.VerifyRSA(pk, root[:], signature))
+
+		require.NoError(t, spec.VerifyCeremonyProof(fixtures.GenerateOperators(4)[0].PubKey, spec.SignedProof{Proof: decoded, Signature: signature}))
+		// a version 1 signature doesn't bind the request ID a later proof claims
+		decoded.RequestID = fixtures.TestRequestID
+		err = spec.VerifyCeremonyProof(fixtures.GenerateOperators(4)[0].PubKey, spec.SignedProof{Proof: decoded, Signature: signature})
+		require.EqualValues(t, spec.CodeInvalidProofSignature, spec.ErrorCodeOf(err))
+	})
+}
+
+func TestVersionedInit(t *testing.T) {
+	init := &spec.Init{
+		Operators:             fixtures.GenerateOperators(13),
+		T:                     9,
+		WithdrawalCredentials: fixtures.TestWithdrawalCred[:32],
+		Fork:                  fixtures.TestFork,
+		Owner:                 fixtures.TestOwnerAddress,
+		Nonce:                 1,
+		Fee:                   spec.FeeTerms{Amount: [32]byte{31: 0x01}},
+		NotBefore:             1700000000,
+		NotAfter:              1700003600,
+	}
+	versioned, err := spec.NewVersionedInit(init)
+	require.NoError(t, err)
+	byts, err := versioned.MarshalSSZ()
+	require.NoError(t, err)
+	decoded := &spec.VersionedInit{}
+	require.NoError(t, decoded.UnmarshalSSZ(byts))
+
+	decodedInit, err := decoded.Decode()
+	require.NoError(t, err)
+	require.EqualValues(t, init.Fee, decodedInit.Fee)
+	require.EqualValues(t, init.NotAfter, decodedInit.NotAfter)
+	root, err := decoded.SigningRoot()
+	require.NoError(t, err)
+	expected, err := init.SigningRoot()
+	require.NoError(t, err)
+	require.EqualValues(t, expected, root)
+
+	decoded.Version = 0
+	_, err = decoded.Decode()
+	require.EqualError(t, err, "unsupported init version 0")
+
+	t.Run("version 1", func(t *testing.T) {
+		// init issued before inits carried fee terms and a ceremony window
+		initV1 := &spec.InitV1{
+			Operators:             init.Operators,
+			T:                     init.T,
+			WithdrawalCredentials: init.WithdrawalCredentials,
+			Fork:                  init.Fork,
+			Owner:                 init.Owner,
+			Nonce:                 init.Nonce,
+		}
+		byts, err := initV1.MarshalSSZ()
+		require.NoError(t, err)
+		versioned := &spec.VersionedInit{Version: 1, Init: byts}
+
+		decoded, err := versioned.Decode()
+		require.NoError(t, err)
+		require.True(t, decoded.Fee.IsZero())
+		require.Zero(t, decoded.NotBefore)
+		require.Zero(t, decoded.NotAfter)
+		legacy := *init
+		legacy.Fee = spec.FeeTerms{}
+		legacy.NotBefore, legacy.NotAfter = 0, 0
+		legacyRoot, err := legacy.HashTreeRoot()
+		require.NoError(t, err)
+		decodedRoot, err := decoded.HashTreeRoot()
+		require.NoError(t, err)
+		require.EqualValues(t, legacyRoot, decodedRoot)
+
+		// re-encoding the decoded init as version 1 gives back the legacy bytes
+		reencoded, err := (&spec.InitV1{
+			Operators:             decoded.Operators,
+			T:                     decoded.T,
+			WithdrawalCredentials: decoded.WithdrawalCredentials,
+			Fork:                  decoded.Fork,
+			Owner:                 decoded.Owner,
+			Nonce:                 decoded.Nonce,
+		}).MarshalSSZ()
+		require.NoError(t, err)
+		require.EqualValues(t, byts, reencoded)
+
+		root, err := versioned.SigningRoot()
+		require.NoError(t, err)
+		expected, err := initV1.HashTreeRoot()
+		require.NoError(t, err)
+		require.EqualValues(t, expected, root)
+
+		// version 1 encodings don't decode as the current version
+		_, err = (&spec.VersionedInit{Version: spec.InitVersion, Init: byts}).Decode()
+		require.Error(t, err)
+	})
+}
+
+func TestVersionedReshare(t *testing.T) {
+	reshare := fixtures.TestReshare13Operators
+	reshare.WithdrawalCredentials = fixtures.TestWithdrawalCred[:32]
+
+	versioned, err := spec.NewVersionedReshare(&reshare)
+	require.NoError(t, err)
+	byts, err := versioned.MarshalSSZ()
+	require.NoError(t, err)
+	decoded := &spec.VersionedReshare{}
+	require.NoError(t, decoded.UnmarshalSSZ(byts))
+
+	root, err := decoded.SigningRoot()
+	require.NoError(t, err)
+	expected, err := spec.ComputeSigningRoot(&reshare, spec.DomainReshare)
+	require.NoError(t, err)
+	require.EqualValues(t, expected, root)
+
+	decoded.Version = 0
+	_, err = decoded.Decode()
+	require.EqualError(t, err, "unsupported reshare version 0")
+
+	t.Run("version 1", func(t *testing.T) {
+		// reshare signed before reshares carried fee terms
+		reshareV1 := &spec.ReshareV1{
+			ValidatorPubKey:       reshare.ValidatorPubKey,
+			OldOperators:          reshare.OldOperators,
+			NewOperators:          reshare.NewOperators,
+			OldT:                  reshare.OldT,
+			NewT:                  reshare.NewT,
+			Fork:                  reshare.Fork,
+			WithdrawalCredentials: reshare.WithdrawalCredentials,
+			Owner:                 reshare.Owner,
+			Nonce:                 reshare.Nonce,
+		}
+		byts, err := reshareV1.MarshalSSZ()
+		require.NoError(t, err)
+		versioned := &spec.VersionedReshare{Version: 1, Reshare: byts}
+
+		decoded, err := versioned.Decode()
+		require.NoError(t, err)
+		require.True(t, decoded.Fee.IsZero())
+		decodedRoot, err := decoded.HashTreeRoot()
+		require.NoError(t, err)
+		reshareRoot, err := reshare.HashTreeRoot()
+		require.NoError(t, err)
+		require.EqualValues(t, reshareRoot, decodedRoot)
+
+		root, err := versioned.SigningRoot()
+		require.NoError(t, err)
+		expected, err := reshareV1.HashTreeRoot()
+		require.NoError(t, err)
+		require.EqualValues(t, expected, root)
+
+		// version 1 encodings don't decode as the current version
+		_, err = (&spec.VersionedReshare{Version: spec.ReshareVersion, Reshare: byts}).Decode()
+		require.Error(t, err)
+	})
+}
+
+func TestVersionedResign(t *testing.T) {
+	resign := &spec.Resign{
+		ValidatorPubKey:       fixtures.ShareSK(fixtures.TestValidator4Operators).GetPublicKey().Serialize(),
+		Fork:                  fixtures.TestFork,
+		WithdrawalCredentials: fixtures.TestWithdrawalCred[:32],
+		Owner:                 fixtures.TestOwnerAddress,
+		Nonce:                 1,
+		Amount:                spec.DepositAmount,
+	}
+	versioned, err := spec.NewVersionedResign(resign)
+	require.NoError(t, err)
+
+	decoded, err := versioned.Decode()
+	require.NoError(t, err)
+	require.EqualValues(t, resign, decoded)
+
+	root, err := versioned.SigningRoot()
+	require.NoError(t, err)
+	expected, err := spec.ComputeSigningRoot(resign, spec.DomainResign)
+	require.NoError(t, err)
+	require.EqualValues(t, expected, root)
+
+	t.Run("version 1", func(t *testing.T) {
+		// resign signed before resigns carried their deposit amount
+		resignV1 := &spec.ResignV1{
+			ValidatorPubKey:       resign.ValidatorPubKey,
+			Fork:                  resign.Fork,
+			WithdrawalCredentials: resign.WithdrawalCredentials,
+			Owner:                 resign.Owner,
+			Nonce:                 resign.Nonce,
+		}
+		byts, err := resignV1.MarshalSSZ()
+		require.NoError(t, err)
+		versioned := &spec.VersionedResign{Version: 1, Resign: byts}
+
+		decoded, err := versioned.Decode()
+		require.NoError(t, err)
+		require.EqualValues(t, resign, decoded)
+
+		root, err := versioned.SigningRoot()
+		require.NoError(t, err)
+		expected, err := resignV1.HashTreeRoot()
+		require.NoError(t, err)
+		require.EqualValues(t, expected, root)
+
+		// version 1 encodings don't decode as the current version
+		_, err = (&spec.VersionedResign{Version: spec.ResignVersion, Resign: byts}).Decode()
+		require.Error(t, err)
+	})
+}
@@ -0,0 +1,75 @@
+//go:build !verifyonly
+
+package spec
+
+import (
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// Phase is a step of an operator ceremony
+type Phase string
+
+const (
+	// PhaseOwnerSignature verifies the owner's signature over a reshare or re-sign message
+	PhaseOwnerSignature Phase = "owner_signature"
+	// PhaseValidation validates the message and the operator's ceremony proof
+	PhaseValidation Phase = "validation"
+	// PhaseChainCheck looks up existing deposits or the validator's beacon chain registration
+	PhaseChainCheck Phase = "chain_check"
+	// PhaseSigning signs the deposit data, owner nonce and the new ceremony proof
+	PhaseSigning Phase = "signing"
+)
+
+// PhaseTiming is the duration of a ceremony phase
+type PhaseTiming struct {
+	Phase    Phase
+	Duration time.Duration
+}
+
+// TimingHook is called once a phase of the ceremony of requestID ended, successfully or not
+type TimingHook func(requestID [24]byte, timing PhaseTiming)
+
+var timingHook atomic.Value
+
+// SetTimingHook makes OperatorInit, OperatorReshare and OperatorResign report their phase timings to hook, nil disables reporting
+func SetTimingHook(hook TimingHook) {
+	timingHook.Store(hook)
+}
+
+// observePhase reports the phase started at start to the timing hook, if set
+func observePhase(requestID [24]byte, phase Phase, start time.Time) {
+	hook, _ := timingHook.Load().(TimingHook)
+	if hook == nil {
+		return
+	}
+	hook(requestID, PhaseTiming{Phase: phase, Duration: time.Since(start)})
+}
+
+// TimingRecorder collects phase timings per ceremony, e.g. SetTimingHook(recorder.Hook).
+// Timings are kept until taken, so every observed ceremony must eventually be taken.
+type TimingRecorder struct {
+	mtx     sync.Mutex
+	timings map[[24]byte][]PhaseTiming
+}
+
+func NewTimingRecorder() *TimingRecorder {
+	return &TimingRecorder{timings: make(map[[24]byte][]PhaseTiming)}
+}
+
+// Hook records timing for requestID
+func (r *TimingRecorder) Hook(requestID [24]byte, timing PhaseTiming) {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+	r.timings[requestID] = append(r.timings[requestID], timing)
+}
+
+// Take returns and forgets the timings of requestID, in the order phases ended
+func (r *TimingRecorder) Take(requestID [24]byte) []PhaseTiming {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+	ret := r.timings[requestID]
+	delete(r.timings, requestID)
+	return ret
+}
@@ -20,6 +20,51 @@ type Init struct {
 	Owner [20]byte `ssz-size:"20"`
 	// Owner nonce
 	Nonce uint64
+	// Fee terms agreed with the operators, zero if the ceremony is free
+	Fee FeeTerms
+	// NotBefore is the unix time (seconds) operators start accepting the ceremony at, 0 if unbounded
+	NotBefore uint64
+	// NotAfter is the unix time (seconds) operators stop accepting the ceremony after, 0 if unbounded
+	NotAfter uint64
+}
+
+// InitV1 is Init version 1, issued before inits carried fee terms and a ceremony window
+type InitV1 struct {
+	Operators             []*Operator `ssz-max:"13"`
+	T                     uint64
+	WithdrawalCredentials []byte   `ssz-max:"32"`
+	Fork                  [4]byte  `ssz-size:"4"`
+	Owner                 [20]byte `ssz-size:"20"`
+	Nonce                 uint64
+}
+
+// FeeTerms bind a ceremony to a payment agreement with its operators
+type FeeTerms struct {
+	// Token is the ERC-20 token address the fee is paid in, zero for ether
+	Token [20]byte `ssz-size:"20"`
+	// Amount is the big-endian uint256 fee amount, in the token's base units
+	Amount [32]byte `ssz-size:"32"`
+	// PaymentReference identifies the payment agreement, e.g. an invoice hash or a payment transaction hash
+	PaymentReference [32]byte `ssz-size:"32"`
+}
+
+// SignedInit is an Init signed by the initiator, identifying it to the operators
+type SignedInit struct {
+	Init *Init
+	// RequestID of the ceremony the init starts
+	RequestID [24]byte `ssz-size:"24"`
+	// InitiatorPubKey is the initiator's base64 encoded PEM RSA public key
+	InitiatorPubKey []byte `ssz-max:"2048"`
+	// Signature is the initiator's RSA signature over the InitiatorMessage
+	Signature []byte `ssz-size:"256"`
+}
+
+// InitiatorMessage is what the initiator signs in a SignedInit: the init bound to its ceremony and initiator, so the
+// signature can't be replayed for another request nor claimed by another initiator
+type InitiatorMessage struct {
+	Init            *Init
+	RequestID       [24]byte `ssz-size:"24"`
+	InitiatorPubKey []byte   `ssz-max:"2048"`
 }
 
 type Reshare struct {
@@ -41,6 +86,21 @@ type Reshare struct {
 	Owner [20]byte `ssz-size:"20"`
 	// Owner nonce
 	Nonce uint64
+	// Fee terms agreed with the operators, zero if the ceremony is free
+	Fee FeeTerms
+}
+
+// ReshareV1 is Reshare version 1, issued before reshares carried fee terms
+type ReshareV1 struct {
+	ValidatorPubKey       []byte      `ssz-size:"48"`
+	OldOperators          []*Operator `ssz-max:"13"`
+	NewOperators          []*Operator `ssz-max:"13"`
+	OldT                  uint64
+	NewT                  uint64
+	Fork                  [4]byte  `ssz-size:"4"`
+	WithdrawalCredentials []byte   `ssz-max:"32"`
+	Owner                 [20]byte `ssz-size:"20"`
+	Nonce                 uint64
 }
 
 type SignedReshare struct {
@@ -60,6 +120,17 @@ type Resign struct {
 	Owner [20]byte `ssz-size:"20"`
 	// Owner nonce
 	Nonce uint64
+	// Amount in gwei of the deposit data to sign, see AmountPolicy
+	Amount uint64
+}
+
+// ResignV1 is Resign version 1, issued before resigns carried their deposit amount
+type ResignV1 struct {
+	ValidatorPubKey       []byte   `ssz-size:"48"`
+	Fork                  [4]byte  `ssz-size:"4"`
+	WithdrawalCredentials []byte   `ssz-max:"32"`
+	Owner                 [20]byte `ssz-size:"20"`
+	Nonce                 uint64
 }
 
 type SignedResign struct {
@@ -80,18 +151,144 @@ type Result struct {
 	OwnerNoncePartialSignature []byte `ssz-size:"96"`
 	// Signed proof for the ceremony
 	SignedProof SignedProof
+	// ValidatorIndex on the beacon chain, set on reshare/resign if the operator looked the validator up, 0 otherwise (not used for signing)
+	ValidatorIndex uint64
+	// DealingCommitments to the coefficients of the polynomial the operator dealt in a DKG, empty for reshare and resign
+	DealingCommitments [][]byte `json:"DealingCommitments,omitempty" ssz-max:"13" ssz-size:"?,48"`
+	// DealingProof is the signature over the dealing by the polynomial's constant term, empty for reshare and resign
+	DealingProof []byte `json:"DealingProof,omitempty" ssz-max:"96"`
+}
+
+// Dealing is an operator's commitment to the polynomial it dealt in a DKG ceremony, signed with the polynomial's constant term
+// to prove the operator knows it
+type Dealing struct {
+	RequestID   [24]byte `ssz-size:"24"`
+	OperatorID  uint64
+	Commitments [][]byte `ssz-max:"13" ssz-size:"?,48"`
+}
+
+// Possession is what a BLS key signs to prove its holder knows the secret key, see ProvePossession
+type Possession struct {
+	PubKey []byte `ssz-size:"48"`
 }
 
 // Proof for a DKG ceremony
 type Proof struct {
 	// ValidatorPubKey the resulting public key corresponding to the shared private key
 	ValidatorPubKey []byte `ssz-size:"48"`
-	// EncryptedShare standard SSV encrypted share
+	// EncryptedShare is the share encrypted to the operator, see DecodeShareEnvelope
 	EncryptedShare []byte `ssz-max:"512"`
 	// SharePubKey is the share's BLS pubkey
 	SharePubKey []byte `ssz-size:"48"`
 	// Owner address
 	Owner [20]byte `ssz-size:"20"`
+	// RequestID of the ceremony the proof was issued in
+	RequestID [24]byte `ssz-size:"24"`
+	// ParamsHash is the hash tree root of the CeremonyParams the share was produced under
+	ParamsHash [32]byte `ssz-size:"32"`
+	// Commitments to the coefficients of the ceremony's public polynomial, Commitments[0] being ValidatorPubKey: SharePubKey
+	// is the polynomial evaluated at the operator's ID. Empty if the operator didn't commit to it.
+	Commitments [][]byte `ssz-max:"13" ssz-size:"?,48"`
+}
+
+// ProofV3 is Proof versions 3 and 4, issued before proofs carried their ceremony's public polynomial
+type ProofV3 struct {
+	ValidatorPubKey []byte   `ssz-size:"48"`
+	EncryptedShare  []byte   `ssz-max:"512"`
+	SharePubKey     []byte   `ssz-size:"48"`
+	Owner           [20]byte `ssz-size:"20"`
+	RequestID       [24]byte `ssz-size:"24"`
+	ParamsHash      [32]byte `ssz-size:"32"`
+}
+
+// ProofV2 is Proof version 2, issued before proofs committed to their ceremony's parameters
+type ProofV2 struct {
+	ValidatorPubKey []byte   `ssz-size:"48"`
+	EncryptedShare  []byte   `ssz-max:"512"`
+	SharePubKey     []byte   `ssz-size:"48"`
+	Owner           [20]byte `ssz-size:"20"`
+	RequestID       [24]byte `ssz-size:"24"`
+}
+
+// ProofV1 is Proof version 1, issued before proofs were bound to their ceremony's request ID
+type ProofV1 struct {
+	ValidatorPubKey []byte   `ssz-size:"48"`
+	EncryptedShare  []byte   `ssz-max:"512"`
+	SharePubKey     []byte   `ssz-size:"48"`
+	Owner           [20]byte `ssz-size:"20"`
+}
+
+// Lineage links a reshare ceremony's proofs to the proofs it reshared and to the signed reshare authorizing it, so the
+// custody history of a validator can be audited back to its first ceremony, see NewLineage
+type Lineage struct {
+	ValidatorPubKey []byte `ssz-size:"48"`
+	// RequestID of the reshare ceremony
+	RequestID [24]byte `ssz-size:"24"`
+	// PriorProofsRoot is the hash tree root of the old operators' proofs, see CeremonyProofs.HashTreeRoot
+	PriorProofsRoot [32]byte `ssz-size:"32"`
+	// SignedReshareRoot is the hash tree root of the SignedReshare authorizing the ceremony
+	SignedReshareRoot [32]byte `ssz-size:"32"`
+	// ProofsRoot is the hash tree root of the new operators' proofs
+	ProofsRoot [32]byte `ssz-size:"32"`
+}
+
+// OperatorState is an operator's ceremony relevant state, exported to migrate the operator to another host (see
+// ExportOperatorState). It holds no secrets: the identity key and the shares are referenced, not exported.
+type OperatorState struct {
+	// Version of the state encoding, see OperatorStateVersion
+	Version uint64
+	// Operator the state is of, its PubKey references the identity key
+	Operator *Operator
+	// ProcessedRequestIDs of every ceremony the operator took part in, ordered, so no ceremony is run twice
+	ProcessedRequestIDs [][]byte `ssz-max:"1048576" ssz-size:"?,24"`
+	// Shares the operator stores, ordered by validator public key
+	Shares []*StoredShare `ssz-max:"1048576"`
+}
+
+// EncryptedOperatorState is an OperatorState encrypted at rest with a passphrase derived key, see EncryptOperatorState
+type EncryptedOperatorState struct {
+	// KDF is the binary encoding of the crypto.KDFParams the key is derived from the passphrase with
+	KDF []byte `ssz-max:"64"`
+	// Ciphertext is the SSZ encoded OperatorState sealed with AES-256-GCM, see crypto.Seal
+	Ciphertext []byte `ssz-max:"268435456"`
+}
+
+// StoredShare references a validator's share stored by an operator and the result it returned for it
+type StoredShare struct {
+	ValidatorPubKey []byte `ssz-size:"48"`
+	SharePubKey     []byte `ssz-size:"48"`
+	// RequestID of the latest ceremony the operator returned a result for the share in
+	RequestID [24]byte `ssz-size:"24"`
+	// ResultRoot is the hash tree root of the operator's Result of the ceremony
+	ResultRoot [32]byte `ssz-size:"32"`
+}
+
+// CeremonyParams are the parameters of the ceremony producing a share, see Proof.ParamsHash
+type CeremonyParams struct {
+	// OperatorIDs of the cluster, ordered
+	OperatorIDs []uint64 `ssz-max:"13"`
+	// T is the cluster's threshold
+	T uint64
+	// Fork the deposit data was signed for
+	Fork [4]byte `ssz-size:"4"`
+	// Amount of the deposit data in gwei
+	Amount uint64
+}
+
+// OwnerNonce is the message signed by the validator to bind it to its owner's nonce, see PartialNonceRoot
+type OwnerNonce struct {
+	// Domain separates the root from other signed messages, see DomainOwnerNonce
+	Domain [4]byte `ssz-size:"4"`
+	// Owner address
+	Owner [20]byte `ssz-size:"20"`
+	// Owner nonce
+	Nonce uint64
+}
+
+// SigningData is a message's hash tree root mixed with its domain, see ComputeSigningRoot
+type SigningData struct {
+	ObjectRoot [32]byte `ssz-size:"32"`
+	Domain     [4]byte  `ssz-size:"4"`
 }
 
 type SignedProof struct {
@@ -99,3 +296,107 @@ type SignedProof struct {
 	// Signature is an RSA signature over proof
 	Signature []byte `ssz-size:"256"`
 }
+
+// VersionedProof wraps an SSZ encoded Proof with its explicit version.
+// Every change to Proof gets a new version so hash roots of existing versions never change.
+type VersionedProof struct {
+	Version uint8
+	// Proof is the SSZ encoded Proof of Version, at most 180 fixed bytes, a 512 bytes encrypted share and 13 commitments
+	Proof []byte `ssz-max:"1316"`
+}
+
+// VersionedInit wraps an SSZ encoded Init with its explicit version
+type VersionedInit struct {
+	Version uint8
+	// Init is the SSZ encoded Init of Version
+	Init []byte `ssz-max:"131072"`
+}
+
+// VersionedReshare wraps an SSZ encoded Reshare with its explicit version
+type VersionedReshare struct {
+	Version uint8
+	// Reshare is the SSZ encoded Reshare of Version
+	Reshare []byte `ssz-max:"262144"`
+}
+
+// VersionedResign wraps an SSZ encoded Resign with its explicit version
+type VersionedResign struct {
+	Version uint8
+	// Resign is the SSZ encoded Resign of Version
+	Resign []byte `ssz-max:"256"`
+}
+
+// ResponseEnvelope authenticates a payload sent by an operator to the initiator
+type ResponseEnvelope struct {
+	// OperatorID of the sending operator
+	OperatorID uint64
+	// Timestamp unix seconds at which the response was signed
+	Timestamp uint64
+	// PayloadHash is the sha256 of the raw payload bytes
+	PayloadHash [32]byte `ssz-size:"32"`
+}
+
+type SignedResponseEnvelope struct {
+	Envelope *ResponseEnvelope
+	// Signature is an RSA signature over the envelope
+	Signature []byte `ssz-size:"256"`
+}
+
+// CeremonySummary is what the initiator presented to the owner at the end of a ceremony
+type CeremonySummary struct {
+	RequestID       [24]byte `ssz-size:"24"`
+	ValidatorPubKey []byte   `ssz-size:"48"`
+	Owner           [20]byte `ssz-size:"20"`
+	Nonce           uint64
+	// Results collected from the operators, carrying their signed proofs
+	Results []*Result `ssz-max:"13"`
+}
+
+type SignedCeremonySummary struct {
+	Summary *CeremonySummary
+	// Signature is the initiator's RSA signature over the summary
+	Signature []byte `ssz-size:"256"`
+}
+
+// Ping probes an operator's readiness, e.g. before launching a bulk ceremony
+type Ping struct {
+	// Nonce is a random value the operator echoes, binding its Pong to the Ping
+	Nonce [32]byte `ssz-size:"32"`
+	// Timestamp unix seconds at which the initiator sent the ping
+	Timestamp uint64
+}
+
+// Pong is an operator's answer to a Ping
+type Pong struct {
+	OperatorID uint64
+	// PingNonce is the nonce of the answered Ping
+	PingNonce [32]byte `ssz-size:"32"`
+	// Timestamp unix seconds at which the operator answered
+	Timestamp uint64
+	// SpecVersion the operator implements
+	SpecVersion []byte `ssz-max:"32"`
+	// Forks the operator signs deposit data for
+	Forks [][]byte `ssz-max:"64" ssz-size:"?,4"`
+	// ActiveCeremonies is the number of ceremonies the operator is currently running
+	ActiveCeremonies uint64
+	// MaxCeremonies is the number of ceremonies the operator runs concurrently, 0 if unbounded
+	MaxCeremonies uint64
+}
+
+type SignedPong struct {
+	Pong *Pong
+	// Signature is the operator's RSA signature over the pong
+	Signature []byte `ssz-size:"256"`
+}
+
+// EncryptedPayload is a ceremony message encrypted to a single operator, so intermediaries relaying it don't learn the ceremony's
+// parameters (owner, validator, nonce)
+type EncryptedPayload struct {
+	// OperatorID of the operator the payload is encrypted to
+	OperatorID uint64
+	RequestID  [24]byte `ssz-size:"24"`
+	// Type of the encrypted message, see MessageType
+	Type uint8
+	// Ciphertext is the hybrid encryption of the SSZ encoded message, bound to the fields above
+	Ciphertext []byte `ssz-max:"524288"`
+}
@@ -1,5 +1,5 @@
 // Code generated by fastssz. DO NOT EDIT.
-// Hash: 2f36feafba4c71a45e6387f13c2941a6c8462b6e76e556b3f267f416f1525ebf
+// Hash: bf88af993fd4f35184415277f65a590c124fcd71e2eb3493f20ebd89849cf9a3
 // Version: 0.1.3
 package spec
 
@@ -165,7 +165,7 @@ func (i *Init) MarshalSSZ() ([]byte, error) {
 // MarshalSSZTo ssz marshals the Init object to a target array
 func (i *Init) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 	dst = buf
-	offset := int(48)
+	offset := int(148)
 
 	// Offset (0) 'Operators'
 	dst = ssz.WriteOffset(dst, offset)
@@ -190,6 +190,17 @@ func (i *Init) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 	// Field (5) 'Nonce'
 	dst = ssz.MarshalUint64(dst, i.Nonce)
 
+	// Field (6) 'Fee'
+	if dst, err = i.Fee.MarshalSSZTo(dst); err != nil {
+		return
+	}
+
+	// Field (7) 'NotBefore'
+	dst = ssz.MarshalUint64(dst, i.NotBefore)
+
+	// Field (8) 'NotAfter'
+	dst = ssz.MarshalUint64(dst, i.NotAfter)
+
 	// Field (0) 'Operators'
 	if size := len(i.Operators); size > 13 {
 		err = ssz.ErrListTooBigFn("Init.Operators", size, 13)
@@ -222,7 +233,7 @@ func (i *Init) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 func (i *Init) UnmarshalSSZ(buf []byte) error {
 	var err error
 	size := uint64(len(buf))
-	if size < 48 {
+	if size < 148 {
 		return ssz.ErrSize
 	}
 
@@ -234,7 +245,7 @@ func (i *Init) UnmarshalSSZ(buf []byte) error {
 		return ssz.ErrOffset
 	}
 
-	if o0 < 48 {
+	if o0 < 148 {
 		return ssz.ErrInvalidVariableOffset
 	}
 
@@ -255,6 +266,17 @@ func (i *Init) UnmarshalSSZ(buf []byte) error {
 	// Field (5) 'Nonce'
 	i.Nonce = ssz.UnmarshallUint64(buf[40:48])
 
+	// Field (6) 'Fee'
+	if err = i.Fee.UnmarshalSSZ(buf[48:132]); err != nil {
+		return err
+	}
+
+	// Field (7) 'NotBefore'
+	i.NotBefore = ssz.UnmarshallUint64(buf[132:140])
+
+	// Field (8) 'NotAfter'
+	i.NotAfter = ssz.UnmarshallUint64(buf[140:148])
+
 	// Field (0) 'Operators'
 	{
 		buf = tail[o0:o2]
@@ -293,7 +315,7 @@ func (i *Init) UnmarshalSSZ(buf []byte) error {
 
 // SizeSSZ returns the ssz encoded size in bytes for the Init object
 func (i *Init) SizeSSZ() (size int) {
-	size = 48
+	size = 148
 
 	// Field (0) 'Operators'
 	for ii := 0; ii < len(i.Operators); ii++ {
@@ -356,6 +378,17 @@ func (i *Init) HashTreeRootWith(hh ssz.HashWalker) (err error) {
 	// Field (5) 'Nonce'
 	hh.PutUint64(i.Nonce)
 
+	// Field (6) 'Fee'
+	if err = i.Fee.HashTreeRootWith(hh); err != nil {
+		return
+	}
+
+	// Field (7) 'NotBefore'
+	hh.PutUint64(i.NotBefore)
+
+	// Field (8) 'NotAfter'
+	hh.PutUint64(i.NotAfter)
+
 	hh.Merkleize(indx)
 	return
 }
@@ -365,188 +398,117 @@ func (i *Init) GetTree() (*ssz.Node, error) {
 	return ssz.ProofTree(i)
 }
 
-// MarshalSSZ ssz marshals the Reshare object
-func (r *Reshare) MarshalSSZ() ([]byte, error) {
-	return ssz.MarshalSSZ(r)
+// MarshalSSZ ssz marshals the InitV1 object
+func (i *InitV1) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(i)
 }
 
-// MarshalSSZTo ssz marshals the Reshare object to a target array
-func (r *Reshare) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+// MarshalSSZTo ssz marshals the InitV1 object to a target array
+func (i *InitV1) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 	dst = buf
-	offset := int(108)
-
-	// Field (0) 'ValidatorPubKey'
-	if size := len(r.ValidatorPubKey); size != 48 {
-		err = ssz.ErrBytesLengthFn("Reshare.ValidatorPubKey", size, 48)
-		return
-	}
-	dst = append(dst, r.ValidatorPubKey...)
-
-	// Offset (1) 'OldOperators'
-	dst = ssz.WriteOffset(dst, offset)
-	for ii := 0; ii < len(r.OldOperators); ii++ {
-		offset += 4
-		offset += r.OldOperators[ii].SizeSSZ()
-	}
+	offset := int(48)
 
-	// Offset (2) 'NewOperators'
+	// Offset (0) 'Operators'
 	dst = ssz.WriteOffset(dst, offset)
-	for ii := 0; ii < len(r.NewOperators); ii++ {
+	for ii := 0; ii < len(i.Operators); ii++ {
 		offset += 4
-		offset += r.NewOperators[ii].SizeSSZ()
+		offset += i.Operators[ii].SizeSSZ()
 	}
 
-	// Field (3) 'OldT'
-	dst = ssz.MarshalUint64(dst, r.OldT)
-
-	// Field (4) 'NewT'
-	dst = ssz.MarshalUint64(dst, r.NewT)
-
-	// Field (5) 'Fork'
-	dst = append(dst, r.Fork[:]...)
+	// Field (1) 'T'
+	dst = ssz.MarshalUint64(dst, i.T)
 
-	// Offset (6) 'WithdrawalCredentials'
+	// Offset (2) 'WithdrawalCredentials'
 	dst = ssz.WriteOffset(dst, offset)
-	offset += len(r.WithdrawalCredentials)
+	offset += len(i.WithdrawalCredentials)
 
-	// Field (7) 'Owner'
-	dst = append(dst, r.Owner[:]...)
+	// Field (3) 'Fork'
+	dst = append(dst, i.Fork[:]...)
 
-	// Field (8) 'Nonce'
-	dst = ssz.MarshalUint64(dst, r.Nonce)
+	// Field (4) 'Owner'
+	dst = append(dst, i.Owner[:]...)
 
-	// Field (1) 'OldOperators'
-	if size := len(r.OldOperators); size > 13 {
-		err = ssz.ErrListTooBigFn("Reshare.OldOperators", size, 13)
-		return
-	}
-	{
-		offset = 4 * len(r.OldOperators)
-		for ii := 0; ii < len(r.OldOperators); ii++ {
-			dst = ssz.WriteOffset(dst, offset)
-			offset += r.OldOperators[ii].SizeSSZ()
-		}
-	}
-	for ii := 0; ii < len(r.OldOperators); ii++ {
-		if dst, err = r.OldOperators[ii].MarshalSSZTo(dst); err != nil {
-			return
-		}
-	}
+	// Field (5) 'Nonce'
+	dst = ssz.MarshalUint64(dst, i.Nonce)
 
-	// Field (2) 'NewOperators'
-	if size := len(r.NewOperators); size > 13 {
-		err = ssz.ErrListTooBigFn("Reshare.NewOperators", size, 13)
+	// Field (0) 'Operators'
+	if size := len(i.Operators); size > 13 {
+		err = ssz.ErrListTooBigFn("InitV1.Operators", size, 13)
 		return
 	}
 	{
-		offset = 4 * len(r.NewOperators)
-		for ii := 0; ii < len(r.NewOperators); ii++ {
+		offset = 4 * len(i.Operators)
+		for ii := 0; ii < len(i.Operators); ii++ {
 			dst = ssz.WriteOffset(dst, offset)
-			offset += r.NewOperators[ii].SizeSSZ()
+			offset += i.Operators[ii].SizeSSZ()
 		}
 	}
-	for ii := 0; ii < len(r.NewOperators); ii++ {
-		if dst, err = r.NewOperators[ii].MarshalSSZTo(dst); err != nil {
+	for ii := 0; ii < len(i.Operators); ii++ {
+		if dst, err = i.Operators[ii].MarshalSSZTo(dst); err != nil {
 			return
 		}
 	}
 
-	// Field (6) 'WithdrawalCredentials'
-	if size := len(r.WithdrawalCredentials); size > 32 {
-		err = ssz.ErrBytesLengthFn("Reshare.WithdrawalCredentials", size, 32)
+	// Field (2) 'WithdrawalCredentials'
+	if size := len(i.WithdrawalCredentials); size > 32 {
+		err = ssz.ErrBytesLengthFn("InitV1.WithdrawalCredentials", size, 32)
 		return
 	}
-	dst = append(dst, r.WithdrawalCredentials...)
+	dst = append(dst, i.WithdrawalCredentials...)
 
 	return
 }
 
-// UnmarshalSSZ ssz unmarshals the Reshare object
-func (r *Reshare) UnmarshalSSZ(buf []byte) error {
+// UnmarshalSSZ ssz unmarshals the InitV1 object
+func (i *InitV1) UnmarshalSSZ(buf []byte) error {
 	var err error
 	size := uint64(len(buf))
-	if size < 108 {
+	if size < 48 {
 		return ssz.ErrSize
 	}
 
 	tail := buf
-	var o1, o2, o6 uint64
-
-	// Field (0) 'ValidatorPubKey'
-	if cap(r.ValidatorPubKey) == 0 {
-		r.ValidatorPubKey = make([]byte, 0, len(buf[0:48]))
-	}
-	r.ValidatorPubKey = append(r.ValidatorPubKey, buf[0:48]...)
+	var o0, o2 uint64
 
-	// Offset (1) 'OldOperators'
-	if o1 = ssz.ReadOffset(buf[48:52]); o1 > size {
+	// Offset (0) 'Operators'
+	if o0 = ssz.ReadOffset(buf[0:4]); o0 > size {
 		return ssz.ErrOffset
 	}
 
-	if o1 < 108 {
+	if o0 < 48 {
 		return ssz.ErrInvalidVariableOffset
 	}
 
-	// Offset (2) 'NewOperators'
-	if o2 = ssz.ReadOffset(buf[52:56]); o2 > size || o1 > o2 {
-		return ssz.ErrOffset
-	}
-
-	// Field (3) 'OldT'
-	r.OldT = ssz.UnmarshallUint64(buf[56:64])
-
-	// Field (4) 'NewT'
-	r.NewT = ssz.UnmarshallUint64(buf[64:72])
-
-	// Field (5) 'Fork'
-	copy(r.Fork[:], buf[72:76])
+	// Field (1) 'T'
+	i.T = ssz.UnmarshallUint64(buf[4:12])
 
-	// Offset (6) 'WithdrawalCredentials'
-	if o6 = ssz.ReadOffset(buf[76:80]); o6 > size || o2 > o6 {
+	// Offset (2) 'WithdrawalCredentials'
+	if o2 = ssz.ReadOffset(buf[12:16]); o2 > size || o0 > o2 {
 		return ssz.ErrOffset
 	}
 
-	// Field (7) 'Owner'
-	copy(r.Owner[:], buf[80:100])
+	// Field (3) 'Fork'
+	copy(i.Fork[:], buf[16:20])
 
-	// Field (8) 'Nonce'
-	r.Nonce = ssz.UnmarshallUint64(buf[100:108])
+	// Field (4) 'Owner'
+	copy(i.Owner[:], buf[20:40])
 
-	// Field (1) 'OldOperators'
-	{
-		buf = tail[o1:o2]
-		num, err := ssz.DecodeDynamicLength(buf, 13)
-		if err != nil {
-			return err
-		}
-		r.OldOperators = make([]*Operator, num)
-		err = ssz.UnmarshalDynamic(buf, num, func(indx int, buf []byte) (err error) {
-			if r.OldOperators[indx] == nil {
-				r.OldOperators[indx] = new(Operator)
-			}
-			if err = r.OldOperators[indx].UnmarshalSSZ(buf); err != nil {
-				return err
-			}
-			return nil
-		})
-		if err != nil {
-			return err
-		}
-	}
+	// Field (5) 'Nonce'
+	i.Nonce = ssz.UnmarshallUint64(buf[40:48])
 
-	// Field (2) 'NewOperators'
+	// Field (0) 'Operators'
 	{
-		buf = tail[o2:o6]
+		buf = tail[o0:o2]
 		num, err := ssz.DecodeDynamicLength(buf, 13)
 		if err != nil {
 			return err
 		}
-		r.NewOperators = make([]*Operator, num)
+		i.Operators = make([]*Operator, num)
 		err = ssz.UnmarshalDynamic(buf, num, func(indx int, buf []byte) (err error) {
-			if r.NewOperators[indx] == nil {
-				r.NewOperators[indx] = new(Operator)
+			if i.Operators[indx] == nil {
+				i.Operators[indx] = new(Operator)
 			}
-			if err = r.NewOperators[indx].UnmarshalSSZ(buf); err != nil {
+			if err = i.Operators[indx].UnmarshalSSZ(buf); err != nil {
 				return err
 			}
 			return nil
@@ -556,67 +518,54 @@ func (r *Reshare) UnmarshalSSZ(buf []byte) error {
 		}
 	}
 
-	// Field (6) 'WithdrawalCredentials'
+	// Field (2) 'WithdrawalCredentials'
 	{
-		buf = tail[o6:]
+		buf = tail[o2:]
 		if len(buf) > 32 {
 			return ssz.ErrBytesLength
 		}
-		if cap(r.WithdrawalCredentials) == 0 {
-			r.WithdrawalCredentials = make([]byte, 0, len(buf))
+		if cap(i.WithdrawalCredentials) == 0 {
+			i.WithdrawalCredentials = make([]byte, 0, len(buf))
 		}
-		r.WithdrawalCredentials = append(r.WithdrawalCredentials, buf...)
+		i.WithdrawalCredentials = append(i.WithdrawalCredentials, buf...)
 	}
 	return err
 }
 
-// SizeSSZ returns the ssz encoded size in bytes for the Reshare object
-func (r *Reshare) SizeSSZ() (size int) {
-	size = 108
-
-	// Field (1) 'OldOperators'
-	for ii := 0; ii < len(r.OldOperators); ii++ {
-		size += 4
-		size += r.OldOperators[ii].SizeSSZ()
-	}
+// SizeSSZ returns the ssz encoded size in bytes for the InitV1 object
+func (i *InitV1) SizeSSZ() (size int) {
+	size = 48
 
-	// Field (2) 'NewOperators'
-	for ii := 0; ii < len(r.NewOperators); ii++ {
+	// Field (0) 'Operators'
+	for ii := 0; ii < len(i.Operators); ii++ {
 		size += 4
-		size += r.NewOperators[ii].SizeSSZ()
+		size += i.Operators[ii].SizeSSZ()
 	}
 
-	// Field (6) 'WithdrawalCredentials'
-	size += len(r.WithdrawalCredentials)
+	// Field (2) 'WithdrawalCredentials'
+	size += len(i.WithdrawalCredentials)
 
 	return
 }
 
-// HashTreeRoot ssz hashes the Reshare object
-func (r *Reshare) HashTreeRoot() ([32]byte, error) {
-	return ssz.HashWithDefaultHasher(r)
+// HashTreeRoot ssz hashes the InitV1 object
+func (i *InitV1) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(i)
 }
 
-// HashTreeRootWith ssz hashes the Reshare object with a hasher
-func (r *Reshare) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+// HashTreeRootWith ssz hashes the InitV1 object with a hasher
+func (i *InitV1) HashTreeRootWith(hh ssz.HashWalker) (err error) {
 	indx := hh.Index()
 
-	// Field (0) 'ValidatorPubKey'
-	if size := len(r.ValidatorPubKey); size != 48 {
-		err = ssz.ErrBytesLengthFn("Reshare.ValidatorPubKey", size, 48)
-		return
-	}
-	hh.PutBytes(r.ValidatorPubKey)
-
-	// Field (1) 'OldOperators'
+	// Field (0) 'Operators'
 	{
 		subIndx := hh.Index()
-		num := uint64(len(r.OldOperators))
+		num := uint64(len(i.Operators))
 		if num > 13 {
 			err = ssz.ErrIncorrectListSize
 			return
 		}
-		for _, elem := range r.OldOperators {
+		for _, elem := range i.Operators {
 			if err = elem.HashTreeRootWith(hh); err != nil {
 				return
 			}
@@ -624,783 +573,4898 @@ func (r *Reshare) HashTreeRootWith(hh ssz.HashWalker) (err error) {
 		hh.MerkleizeWithMixin(subIndx, num, 13)
 	}
 
-	// Field (2) 'NewOperators'
+	// Field (1) 'T'
+	hh.PutUint64(i.T)
+
+	// Field (2) 'WithdrawalCredentials'
 	{
-		subIndx := hh.Index()
-		num := uint64(len(r.NewOperators))
-		if num > 13 {
+		elemIndx := hh.Index()
+		byteLen := uint64(len(i.WithdrawalCredentials))
+		if byteLen > 32 {
 			err = ssz.ErrIncorrectListSize
 			return
 		}
-		for _, elem := range r.NewOperators {
-			if err = elem.HashTreeRootWith(hh); err != nil {
-				return
-			}
-		}
-		hh.MerkleizeWithMixin(subIndx, num, 13)
-	}
+		hh.Append(i.WithdrawalCredentials)
+		hh.MerkleizeWithMixin(elemIndx, byteLen, (32+31)/32)
+	}
 
-	// Field (3) 'OldT'
-	hh.PutUint64(r.OldT)
+	// Field (3) 'Fork'
+	hh.PutBytes(i.Fork[:])
 
-	// Field (4) 'NewT'
-	hh.PutUint64(r.NewT)
+	// Field (4) 'Owner'
+	hh.PutBytes(i.Owner[:])
 
-	// Field (5) 'Fork'
-	hh.PutBytes(r.Fork[:])
+	// Field (5) 'Nonce'
+	hh.PutUint64(i.Nonce)
 
-	// Field (6) 'WithdrawalCredentials'
-	{
-		elemIndx := hh.Index()
-		byteLen := uint64(len(r.WithdrawalCredentials))
-		if byteLen > 32 {
-			err = ssz.ErrIncorrectListSize
-			return
-		}
-		hh.Append(r.WithdrawalCredentials)
-		hh.MerkleizeWithMixin(elemIndx, byteLen, (32+31)/32)
+	hh.Merkleize(indx)
+	return
+}
+
+// GetTree ssz hashes the InitV1 object
+func (i *InitV1) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(i)
+}
+
+// MarshalSSZ ssz marshals the FeeTerms object
+func (f *FeeTerms) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(f)
+}
+
+// MarshalSSZTo ssz marshals the FeeTerms object to a target array
+func (f *FeeTerms) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+
+	// Field (0) 'Token'
+	dst = append(dst, f.Token[:]...)
+
+	// Field (1) 'Amount'
+	dst = append(dst, f.Amount[:]...)
+
+	// Field (2) 'PaymentReference'
+	dst = append(dst, f.PaymentReference[:]...)
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the FeeTerms object
+func (f *FeeTerms) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size != 84 {
+		return ssz.ErrSize
 	}
 
-	// Field (7) 'Owner'
-	hh.PutBytes(r.Owner[:])
+	// Field (0) 'Token'
+	copy(f.Token[:], buf[0:20])
 
-	// Field (8) 'Nonce'
-	hh.PutUint64(r.Nonce)
+	// Field (1) 'Amount'
+	copy(f.Amount[:], buf[20:52])
+
+	// Field (2) 'PaymentReference'
+	copy(f.PaymentReference[:], buf[52:84])
+
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the FeeTerms object
+func (f *FeeTerms) SizeSSZ() (size int) {
+	size = 84
+	return
+}
+
+// HashTreeRoot ssz hashes the FeeTerms object
+func (f *FeeTerms) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(f)
+}
+
+// HashTreeRootWith ssz hashes the FeeTerms object with a hasher
+func (f *FeeTerms) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'Token'
+	hh.PutBytes(f.Token[:])
+
+	// Field (1) 'Amount'
+	hh.PutBytes(f.Amount[:])
+
+	// Field (2) 'PaymentReference'
+	hh.PutBytes(f.PaymentReference[:])
 
 	hh.Merkleize(indx)
 	return
 }
 
-// GetTree ssz hashes the Reshare object
-func (r *Reshare) GetTree() (*ssz.Node, error) {
-	return ssz.ProofTree(r)
+// GetTree ssz hashes the FeeTerms object
+func (f *FeeTerms) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(f)
 }
 
-// MarshalSSZ ssz marshals the SignedReshare object
-func (s *SignedReshare) MarshalSSZ() ([]byte, error) {
+// MarshalSSZ ssz marshals the SignedInit object
+func (s *SignedInit) MarshalSSZ() ([]byte, error) {
 	return ssz.MarshalSSZ(s)
 }
 
-// MarshalSSZTo ssz marshals the SignedReshare object to a target array
-func (s *SignedReshare) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+// MarshalSSZTo ssz marshals the SignedInit object to a target array
+func (s *SignedInit) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 	dst = buf
-	offset := int(8)
+	offset := int(288)
 
-	// Offset (0) 'Reshare'
+	// Offset (0) 'Init'
 	dst = ssz.WriteOffset(dst, offset)
-	offset += s.Reshare.SizeSSZ()
+	if s.Init == nil {
+		s.Init = new(Init)
+	}
+	offset += s.Init.SizeSSZ()
 
-	// Offset (1) 'Signature'
+	// Field (1) 'RequestID'
+	dst = append(dst, s.RequestID[:]...)
+
+	// Offset (2) 'InitiatorPubKey'
 	dst = ssz.WriteOffset(dst, offset)
-	offset += len(s.Signature)
+	offset += len(s.InitiatorPubKey)
 
-	// Field (0) 'Reshare'
-	if dst, err = s.Reshare.MarshalSSZTo(dst); err != nil {
+	// Field (3) 'Signature'
+	if size := len(s.Signature); size != 256 {
+		err = ssz.ErrBytesLengthFn("SignedInit.Signature", size, 256)
 		return
 	}
+	dst = append(dst, s.Signature...)
 
-	// Field (1) 'Signature'
-	if size := len(s.Signature); size > 1536 {
-		err = ssz.ErrBytesLengthFn("SignedReshare.Signature", size, 1536)
+	// Field (0) 'Init'
+	if dst, err = s.Init.MarshalSSZTo(dst); err != nil {
 		return
 	}
-	dst = append(dst, s.Signature...)
+
+	// Field (2) 'InitiatorPubKey'
+	if size := len(s.InitiatorPubKey); size > 2048 {
+		err = ssz.ErrBytesLengthFn("SignedInit.InitiatorPubKey", size, 2048)
+		return
+	}
+	dst = append(dst, s.InitiatorPubKey...)
 
 	return
 }
 
-// UnmarshalSSZ ssz unmarshals the SignedReshare object
-func (s *SignedReshare) UnmarshalSSZ(buf []byte) error {
+// UnmarshalSSZ ssz unmarshals the SignedInit object
+func (s *SignedInit) UnmarshalSSZ(buf []byte) error {
 	var err error
 	size := uint64(len(buf))
-	if size < 8 {
+	if size < 288 {
 		return ssz.ErrSize
 	}
 
 	tail := buf
-	var o0, o1 uint64
+	var o0, o2 uint64
 
-	// Offset (0) 'Reshare'
+	// Offset (0) 'Init'
 	if o0 = ssz.ReadOffset(buf[0:4]); o0 > size {
 		return ssz.ErrOffset
 	}
 
-	if o0 < 8 {
+	if o0 < 288 {
 		return ssz.ErrInvalidVariableOffset
 	}
 
-	// Offset (1) 'Signature'
-	if o1 = ssz.ReadOffset(buf[4:8]); o1 > size || o0 > o1 {
+	// Field (1) 'RequestID'
+	copy(s.RequestID[:], buf[4:28])
+
+	// Offset (2) 'InitiatorPubKey'
+	if o2 = ssz.ReadOffset(buf[28:32]); o2 > size || o0 > o2 {
 		return ssz.ErrOffset
 	}
 
-	// Field (0) 'Reshare'
+	// Field (3) 'Signature'
+	if cap(s.Signature) == 0 {
+		s.Signature = make([]byte, 0, len(buf[32:288]))
+	}
+	s.Signature = append(s.Signature, buf[32:288]...)
+
+	// Field (0) 'Init'
 	{
-		buf = tail[o0:o1]
-		if err = s.Reshare.UnmarshalSSZ(buf); err != nil {
+		buf = tail[o0:o2]
+		if s.Init == nil {
+			s.Init = new(Init)
+		}
+		if err = s.Init.UnmarshalSSZ(buf); err != nil {
 			return err
 		}
 	}
 
-	// Field (1) 'Signature'
+	// Field (2) 'InitiatorPubKey'
 	{
-		buf = tail[o1:]
-		if len(buf) > 1536 {
+		buf = tail[o2:]
+		if len(buf) > 2048 {
 			return ssz.ErrBytesLength
 		}
-		if cap(s.Signature) == 0 {
-			s.Signature = make([]byte, 0, len(buf))
+		if cap(s.InitiatorPubKey) == 0 {
+			s.InitiatorPubKey = make([]byte, 0, len(buf))
 		}
-		s.Signature = append(s.Signature, buf...)
+		s.InitiatorPubKey = append(s.InitiatorPubKey, buf...)
 	}
 	return err
 }
 
-// SizeSSZ returns the ssz encoded size in bytes for the SignedReshare object
-func (s *SignedReshare) SizeSSZ() (size int) {
-	size = 8
+// SizeSSZ returns the ssz encoded size in bytes for the SignedInit object
+func (s *SignedInit) SizeSSZ() (size int) {
+	size = 288
 
-	// Field (0) 'Reshare'
-	size += s.Reshare.SizeSSZ()
+	// Field (0) 'Init'
+	if s.Init == nil {
+		s.Init = new(Init)
+	}
+	size += s.Init.SizeSSZ()
 
-	// Field (1) 'Signature'
-	size += len(s.Signature)
+	// Field (2) 'InitiatorPubKey'
+	size += len(s.InitiatorPubKey)
 
 	return
 }
 
-// HashTreeRoot ssz hashes the SignedReshare object
-func (s *SignedReshare) HashTreeRoot() ([32]byte, error) {
+// HashTreeRoot ssz hashes the SignedInit object
+func (s *SignedInit) HashTreeRoot() ([32]byte, error) {
 	return ssz.HashWithDefaultHasher(s)
 }
 
-// HashTreeRootWith ssz hashes the SignedReshare object with a hasher
-func (s *SignedReshare) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+// HashTreeRootWith ssz hashes the SignedInit object with a hasher
+func (s *SignedInit) HashTreeRootWith(hh ssz.HashWalker) (err error) {
 	indx := hh.Index()
 
-	// Field (0) 'Reshare'
-	if err = s.Reshare.HashTreeRootWith(hh); err != nil {
+	// Field (0) 'Init'
+	if err = s.Init.HashTreeRootWith(hh); err != nil {
 		return
 	}
 
-	// Field (1) 'Signature'
+	// Field (1) 'RequestID'
+	hh.PutBytes(s.RequestID[:])
+
+	// Field (2) 'InitiatorPubKey'
 	{
 		elemIndx := hh.Index()
-		byteLen := uint64(len(s.Signature))
-		if byteLen > 1536 {
+		byteLen := uint64(len(s.InitiatorPubKey))
+		if byteLen > 2048 {
 			err = ssz.ErrIncorrectListSize
 			return
 		}
-		hh.Append(s.Signature)
-		hh.MerkleizeWithMixin(elemIndx, byteLen, (1536+31)/32)
+		hh.Append(s.InitiatorPubKey)
+		hh.MerkleizeWithMixin(elemIndx, byteLen, (2048+31)/32)
+	}
+
+	// Field (3) 'Signature'
+	if size := len(s.Signature); size != 256 {
+		err = ssz.ErrBytesLengthFn("SignedInit.Signature", size, 256)
+		return
 	}
+	hh.PutBytes(s.Signature)
 
 	hh.Merkleize(indx)
 	return
 }
 
-// GetTree ssz hashes the SignedReshare object
-func (s *SignedReshare) GetTree() (*ssz.Node, error) {
+// GetTree ssz hashes the SignedInit object
+func (s *SignedInit) GetTree() (*ssz.Node, error) {
 	return ssz.ProofTree(s)
 }
 
-// MarshalSSZ ssz marshals the Resign object
-func (r *Resign) MarshalSSZ() ([]byte, error) {
-	return ssz.MarshalSSZ(r)
+// MarshalSSZ ssz marshals the InitiatorMessage object
+func (i *InitiatorMessage) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(i)
 }
 
-// MarshalSSZTo ssz marshals the Resign object to a target array
-func (r *Resign) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+// MarshalSSZTo ssz marshals the InitiatorMessage object to a target array
+func (i *InitiatorMessage) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 	dst = buf
-	offset := int(84)
+	offset := int(32)
 
-	// Field (0) 'ValidatorPubKey'
-	if size := len(r.ValidatorPubKey); size != 48 {
-		err = ssz.ErrBytesLengthFn("Resign.ValidatorPubKey", size, 48)
-		return
+	// Offset (0) 'Init'
+	dst = ssz.WriteOffset(dst, offset)
+	if i.Init == nil {
+		i.Init = new(Init)
 	}
-	dst = append(dst, r.ValidatorPubKey...)
+	offset += i.Init.SizeSSZ()
 
-	// Field (1) 'Fork'
-	dst = append(dst, r.Fork[:]...)
+	// Field (1) 'RequestID'
+	dst = append(dst, i.RequestID[:]...)
 
-	// Offset (2) 'WithdrawalCredentials'
+	// Offset (2) 'InitiatorPubKey'
 	dst = ssz.WriteOffset(dst, offset)
-	offset += len(r.WithdrawalCredentials)
-
-	// Field (3) 'Owner'
-	dst = append(dst, r.Owner[:]...)
+	offset += len(i.InitiatorPubKey)
 
-	// Field (4) 'Nonce'
-	dst = ssz.MarshalUint64(dst, r.Nonce)
+	// Field (0) 'Init'
+	if dst, err = i.Init.MarshalSSZTo(dst); err != nil {
+		return
+	}
 
-	// Field (2) 'WithdrawalCredentials'
-	if size := len(r.WithdrawalCredentials); size > 32 {
-		err = ssz.ErrBytesLengthFn("Resign.WithdrawalCredentials", size, 32)
+	// Field (2) 'InitiatorPubKey'
+	if size := len(i.InitiatorPubKey); size > 2048 {
+		err = ssz.ErrBytesLengthFn("InitiatorMessage.InitiatorPubKey", size, 2048)
 		return
 	}
-	dst = append(dst, r.WithdrawalCredentials...)
+	dst = append(dst, i.InitiatorPubKey...)
 
 	return
 }
 
-// UnmarshalSSZ ssz unmarshals the Resign object
-func (r *Resign) UnmarshalSSZ(buf []byte) error {
+// UnmarshalSSZ ssz unmarshals the InitiatorMessage object
+func (i *InitiatorMessage) UnmarshalSSZ(buf []byte) error {
 	var err error
 	size := uint64(len(buf))
-	if size < 84 {
+	if size < 32 {
 		return ssz.ErrSize
 	}
 
 	tail := buf
-	var o2 uint64
-
-	// Field (0) 'ValidatorPubKey'
-	if cap(r.ValidatorPubKey) == 0 {
-		r.ValidatorPubKey = make([]byte, 0, len(buf[0:48]))
-	}
-	r.ValidatorPubKey = append(r.ValidatorPubKey, buf[0:48]...)
-
-	// Field (1) 'Fork'
-	copy(r.Fork[:], buf[48:52])
+	var o0, o2 uint64
 
-	// Offset (2) 'WithdrawalCredentials'
-	if o2 = ssz.ReadOffset(buf[52:56]); o2 > size {
+	// Offset (0) 'Init'
+	if o0 = ssz.ReadOffset(buf[0:4]); o0 > size {
 		return ssz.ErrOffset
 	}
 
-	if o2 < 84 {
+	if o0 < 32 {
 		return ssz.ErrInvalidVariableOffset
 	}
 
-	// Field (3) 'Owner'
-	copy(r.Owner[:], buf[56:76])
+	// Field (1) 'RequestID'
+	copy(i.RequestID[:], buf[4:28])
 
-	// Field (4) 'Nonce'
-	r.Nonce = ssz.UnmarshallUint64(buf[76:84])
+	// Offset (2) 'InitiatorPubKey'
+	if o2 = ssz.ReadOffset(buf[28:32]); o2 > size || o0 > o2 {
+		return ssz.ErrOffset
+	}
 
-	// Field (2) 'WithdrawalCredentials'
+	// Field (0) 'Init'
+	{
+		buf = tail[o0:o2]
+		if i.Init == nil {
+			i.Init = new(Init)
+		}
+		if err = i.Init.UnmarshalSSZ(buf); err != nil {
+			return err
+		}
+	}
+
+	// Field (2) 'InitiatorPubKey'
 	{
 		buf = tail[o2:]
-		if len(buf) > 32 {
+		if len(buf) > 2048 {
 			return ssz.ErrBytesLength
 		}
-		if cap(r.WithdrawalCredentials) == 0 {
-			r.WithdrawalCredentials = make([]byte, 0, len(buf))
+		if cap(i.InitiatorPubKey) == 0 {
+			i.InitiatorPubKey = make([]byte, 0, len(buf))
 		}
-		r.WithdrawalCredentials = append(r.WithdrawalCredentials, buf...)
+		i.InitiatorPubKey = append(i.InitiatorPubKey, buf...)
 	}
 	return err
 }
 
-// SizeSSZ returns the ssz encoded size in bytes for the Resign object
-func (r *Resign) SizeSSZ() (size int) {
-	size = 84
+// SizeSSZ returns the ssz encoded size in bytes for the InitiatorMessage object
+func (i *InitiatorMessage) SizeSSZ() (size int) {
+	size = 32
 
-	// Field (2) 'WithdrawalCredentials'
-	size += len(r.WithdrawalCredentials)
+	// Field (0) 'Init'
+	if i.Init == nil {
+		i.Init = new(Init)
+	}
+	size += i.Init.SizeSSZ()
+
+	// Field (2) 'InitiatorPubKey'
+	size += len(i.InitiatorPubKey)
 
 	return
 }
 
-// HashTreeRoot ssz hashes the Resign object
-func (r *Resign) HashTreeRoot() ([32]byte, error) {
-	return ssz.HashWithDefaultHasher(r)
+// HashTreeRoot ssz hashes the InitiatorMessage object
+func (i *InitiatorMessage) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(i)
 }
 
-// HashTreeRootWith ssz hashes the Resign object with a hasher
-func (r *Resign) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+// HashTreeRootWith ssz hashes the InitiatorMessage object with a hasher
+func (i *InitiatorMessage) HashTreeRootWith(hh ssz.HashWalker) (err error) {
 	indx := hh.Index()
 
-	// Field (0) 'ValidatorPubKey'
-	if size := len(r.ValidatorPubKey); size != 48 {
-		err = ssz.ErrBytesLengthFn("Resign.ValidatorPubKey", size, 48)
+	// Field (0) 'Init'
+	if err = i.Init.HashTreeRootWith(hh); err != nil {
 		return
 	}
-	hh.PutBytes(r.ValidatorPubKey)
 
-	// Field (1) 'Fork'
-	hh.PutBytes(r.Fork[:])
+	// Field (1) 'RequestID'
+	hh.PutBytes(i.RequestID[:])
 
-	// Field (2) 'WithdrawalCredentials'
+	// Field (2) 'InitiatorPubKey'
 	{
 		elemIndx := hh.Index()
-		byteLen := uint64(len(r.WithdrawalCredentials))
-		if byteLen > 32 {
+		byteLen := uint64(len(i.InitiatorPubKey))
+		if byteLen > 2048 {
 			err = ssz.ErrIncorrectListSize
 			return
 		}
-		hh.Append(r.WithdrawalCredentials)
-		hh.MerkleizeWithMixin(elemIndx, byteLen, (32+31)/32)
+		hh.Append(i.InitiatorPubKey)
+		hh.MerkleizeWithMixin(elemIndx, byteLen, (2048+31)/32)
 	}
 
-	// Field (3) 'Owner'
-	hh.PutBytes(r.Owner[:])
-
-	// Field (4) 'Nonce'
-	hh.PutUint64(r.Nonce)
-
 	hh.Merkleize(indx)
 	return
 }
 
-// GetTree ssz hashes the Resign object
-func (r *Resign) GetTree() (*ssz.Node, error) {
-	return ssz.ProofTree(r)
-}
-
-// MarshalSSZ ssz marshals the SignedResign object
-func (s *SignedResign) MarshalSSZ() ([]byte, error) {
-	return ssz.MarshalSSZ(s)
+// GetTree ssz hashes the InitiatorMessage object
+func (i *InitiatorMessage) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(i)
+}
+
+// MarshalSSZ ssz marshals the Reshare object
+func (r *Reshare) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(r)
+}
+
+// MarshalSSZTo ssz marshals the Reshare object to a target array
+func (r *Reshare) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+	offset := int(192)
+
+	// Field (0) 'ValidatorPubKey'
+	if size := len(r.ValidatorPubKey); size != 48 {
+		err = ssz.ErrBytesLengthFn("Reshare.ValidatorPubKey", size, 48)
+		return
+	}
+	dst = append(dst, r.ValidatorPubKey...)
+
+	// Offset (1) 'OldOperators'
+	dst = ssz.WriteOffset(dst, offset)
+	for ii := 0; ii < len(r.OldOperators); ii++ {
+		offset += 4
+		offset += r.OldOperators[ii].SizeSSZ()
+	}
+
+	// Offset (2) 'NewOperators'
+	dst = ssz.WriteOffset(dst, offset)
+	for ii := 0; ii < len(r.NewOperators); ii++ {
+		offset += 4
+		offset += r.NewOperators[ii].SizeSSZ()
+	}
+
+	// Field (3) 'OldT'
+	dst = ssz.MarshalUint64(dst, r.OldT)
+
+	// Field (4) 'NewT'
+	dst = ssz.MarshalUint64(dst, r.NewT)
+
+	// Field (5) 'Fork'
+	dst = append(dst, r.Fork[:]...)
+
+	// Offset (6) 'WithdrawalCredentials'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(r.WithdrawalCredentials)
+
+	// Field (7) 'Owner'
+	dst = append(dst, r.Owner[:]...)
+
+	// Field (8) 'Nonce'
+	dst = ssz.MarshalUint64(dst, r.Nonce)
+
+	// Field (9) 'Fee'
+	if dst, err = r.Fee.MarshalSSZTo(dst); err != nil {
+		return
+	}
+
+	// Field (1) 'OldOperators'
+	if size := len(r.OldOperators); size > 13 {
+		err = ssz.ErrListTooBigFn("Reshare.OldOperators", size, 13)
+		return
+	}
+	{
+		offset = 4 * len(r.OldOperators)
+		for ii := 0; ii < len(r.OldOperators); ii++ {
+			dst = ssz.WriteOffset(dst, offset)
+			offset += r.OldOperators[ii].SizeSSZ()
+		}
+	}
+	for ii := 0; ii < len(r.OldOperators); ii++ {
+		if dst, err = r.OldOperators[ii].MarshalSSZTo(dst); err != nil {
+			return
+		}
+	}
+
+	// Field (2) 'NewOperators'
+	if size := len(r.NewOperators); size > 13 {
+		err = ssz.ErrListTooBigFn("Reshare.NewOperators", size, 13)
+		return
+	}
+	{
+		offset = 4 * len(r.NewOperators)
+		for ii := 0; ii < len(r.NewOperators); ii++ {
+			dst = ssz.WriteOffset(dst, offset)
+			offset += r.NewOperators[ii].SizeSSZ()
+		}
+	}
+	for ii := 0; ii < len(r.NewOperators); ii++ {
+		if dst, err = r.NewOperators[ii].MarshalSSZTo(dst); err != nil {
+			return
+		}
+	}
+
+	// Field (6) 'WithdrawalCredentials'
+	if size := len(r.WithdrawalCredentials); size > 32 {
+		err = ssz.ErrBytesLengthFn("Reshare.WithdrawalCredentials", size, 32)
+		return
+	}
+	dst = append(dst, r.WithdrawalCredentials...)
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the Reshare object
+func (r *Reshare) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size < 192 {
+		return ssz.ErrSize
+	}
+
+	tail := buf
+	var o1, o2, o6 uint64
+
+	// Field (0) 'ValidatorPubKey'
+	if cap(r.ValidatorPubKey) == 0 {
+		r.ValidatorPubKey = make([]byte, 0, len(buf[0:48]))
+	}
+	r.ValidatorPubKey = append(r.ValidatorPubKey, buf[0:48]...)
+
+	// Offset (1) 'OldOperators'
+	if o1 = ssz.ReadOffset(buf[48:52]); o1 > size {
+		return ssz.ErrOffset
+	}
+
+	if o1 < 192 {
+		return ssz.ErrInvalidVariableOffset
+	}
+
+	// Offset (2) 'NewOperators'
+	if o2 = ssz.ReadOffset(buf[52:56]); o2 > size || o1 > o2 {
+		return ssz.ErrOffset
+	}
+
+	// Field (3) 'OldT'
+	r.OldT = ssz.UnmarshallUint64(buf[56:64])
+
+	// Field (4) 'NewT'
+	r.NewT = ssz.UnmarshallUint64(buf[64:72])
+
+	// Field (5) 'Fork'
+	copy(r.Fork[:], buf[72:76])
+
+	// Offset (6) 'WithdrawalCredentials'
+	if o6 = ssz.ReadOffset(buf[76:80]); o6 > size || o2 > o6 {
+		return ssz.ErrOffset
+	}
+
+	// Field (7) 'Owner'
+	copy(r.Owner[:], buf[80:100])
+
+	// Field (8) 'Nonce'
+	r.Nonce = ssz.UnmarshallUint64(buf[100:108])
+
+	// Field (9) 'Fee'
+	if err = r.Fee.UnmarshalSSZ(buf[108:192]); err != nil {
+		return err
+	}
+
+	// Field (1) 'OldOperators'
+	{
+		buf = tail[o1:o2]
+		num, err := ssz.DecodeDynamicLength(buf, 13)
+		if err != nil {
+			return err
+		}
+		r.OldOperators = make([]*Operator, num)
+		err = ssz.UnmarshalDynamic(buf, num, func(indx int, buf []byte) (err error) {
+			if r.OldOperators[indx] == nil {
+				r.OldOperators[indx] = new(Operator)
+			}
+			if err = r.OldOperators[indx].UnmarshalSSZ(buf); err != nil {
+				return err
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	// Field (2) 'NewOperators'
+	{
+		buf = tail[o2:o6]
+		num, err := ssz.DecodeDynamicLength(buf, 13)
+		if err != nil {
+			return err
+		}
+		r.NewOperators = make([]*Operator, num)
+		err = ssz.UnmarshalDynamic(buf, num, func(indx int, buf []byte) (err error) {
+			if r.NewOperators[indx] == nil {
+				r.NewOperators[indx] = new(Operator)
+			}
+			if err = r.NewOperators[indx].UnmarshalSSZ(buf); err != nil {
+				return err
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	// Field (6) 'WithdrawalCredentials'
+	{
+		buf = tail[o6:]
+		if len(buf) > 32 {
+			return ssz.ErrBytesLength
+		}
+		if cap(r.WithdrawalCredentials) == 0 {
+			r.WithdrawalCredentials = make([]byte, 0, len(buf))
+		}
+		r.WithdrawalCredentials = append(r.WithdrawalCredentials, buf...)
+	}
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the Reshare object
+func (r *Reshare) SizeSSZ() (size int) {
+	size = 192
+
+	// Field (1) 'OldOperators'
+	for ii := 0; ii < len(r.OldOperators); ii++ {
+		size += 4
+		size += r.OldOperators[ii].SizeSSZ()
+	}
+
+	// Field (2) 'NewOperators'
+	for ii := 0; ii < len(r.NewOperators); ii++ {
+		size += 4
+		size += r.NewOperators[ii].SizeSSZ()
+	}
+
+	// Field (6) 'WithdrawalCredentials'
+	size += len(r.WithdrawalCredentials)
+
+	return
+}
+
+// HashTreeRoot ssz hashes the Reshare object
+func (r *Reshare) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(r)
+}
+
+// HashTreeRootWith ssz hashes the Reshare object with a hasher
+func (r *Reshare) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'ValidatorPubKey'
+	if size := len(r.ValidatorPubKey); size != 48 {
+		err = ssz.ErrBytesLengthFn("Reshare.ValidatorPubKey", size, 48)
+		return
+	}
+	hh.PutBytes(r.ValidatorPubKey)
+
+	// Field (1) 'OldOperators'
+	{
+		subIndx := hh.Index()
+		num := uint64(len(r.OldOperators))
+		if num > 13 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		for _, elem := range r.OldOperators {
+			if err = elem.HashTreeRootWith(hh); err != nil {
+				return
+			}
+		}
+		hh.MerkleizeWithMixin(subIndx, num, 13)
+	}
+
+	// Field (2) 'NewOperators'
+	{
+		subIndx := hh.Index()
+		num := uint64(len(r.NewOperators))
+		if num > 13 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		for _, elem := range r.NewOperators {
+			if err = elem.HashTreeRootWith(hh); err != nil {
+				return
+			}
+		}
+		hh.MerkleizeWithMixin(subIndx, num, 13)
+	}
+
+	// Field (3) 'OldT'
+	hh.PutUint64(r.OldT)
+
+	// Field (4) 'NewT'
+	hh.PutUint64(r.NewT)
+
+	// Field (5) 'Fork'
+	hh.PutBytes(r.Fork[:])
+
+	// Field (6) 'WithdrawalCredentials'
+	{
+		elemIndx := hh.Index()
+		byteLen := uint64(len(r.WithdrawalCredentials))
+		if byteLen > 32 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		hh.Append(r.WithdrawalCredentials)
+		hh.MerkleizeWithMixin(elemIndx, byteLen, (32+31)/32)
+	}
+
+	// Field (7) 'Owner'
+	hh.PutBytes(r.Owner[:])
+
+	// Field (8) 'Nonce'
+	hh.PutUint64(r.Nonce)
+
+	// Field (9) 'Fee'
+	if err = r.Fee.HashTreeRootWith(hh); err != nil {
+		return
+	}
+
+	hh.Merkleize(indx)
+	return
+}
+
+// GetTree ssz hashes the Reshare object
+func (r *Reshare) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(r)
+}
+
+// MarshalSSZ ssz marshals the ReshareV1 object
+func (r *ReshareV1) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(r)
+}
+
+// MarshalSSZTo ssz marshals the ReshareV1 object to a target array
+func (r *ReshareV1) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+	offset := int(108)
+
+	// Field (0) 'ValidatorPubKey'
+	if size := len(r.ValidatorPubKey); size != 48 {
+		err = ssz.ErrBytesLengthFn("ReshareV1.ValidatorPubKey", size, 48)
+		return
+	}
+	dst = append(dst, r.ValidatorPubKey...)
+
+	// Offset (1) 'OldOperators'
+	dst = ssz.WriteOffset(dst, offset)
+	for ii := 0; ii < len(r.OldOperators); ii++ {
+		offset += 4
+		offset += r.OldOperators[ii].SizeSSZ()
+	}
+
+	// Offset (2) 'NewOperators'
+	dst = ssz.WriteOffset(dst, offset)
+	for ii := 0; ii < len(r.NewOperators); ii++ {
+		offset += 4
+		offset += r.NewOperators[ii].SizeSSZ()
+	}
+
+	// Field (3) 'OldT'
+	dst = ssz.MarshalUint64(dst, r.OldT)
+
+	// Field (4) 'NewT'
+	dst = ssz.MarshalUint64(dst, r.NewT)
+
+	// Field (5) 'Fork'
+	dst = append(dst, r.Fork[:]...)
+
+	// Offset (6) 'WithdrawalCredentials'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(r.WithdrawalCredentials)
+
+	// Field (7) 'Owner'
+	dst = append(dst, r.Owner[:]...)
+
+	// Field (8) 'Nonce'
+	dst = ssz.MarshalUint64(dst, r.Nonce)
+
+	// Field (1) 'OldOperators'
+	if size := len(r.OldOperators); size > 13 {
+		err = ssz.ErrListTooBigFn("ReshareV1.OldOperators", size, 13)
+		return
+	}
+	{
+		offset = 4 * len(r.OldOperators)
+		for ii := 0; ii < len(r.OldOperators); ii++ {
+			dst = ssz.WriteOffset(dst, offset)
+			offset += r.OldOperators[ii].SizeSSZ()
+		}
+	}
+	for ii := 0; ii < len(r.OldOperators); ii++ {
+		if dst, err = r.OldOperators[ii].MarshalSSZTo(dst); err != nil {
+			return
+		}
+	}
+
+	// Field (2) 'NewOperators'
+	if size := len(r.NewOperators); size > 13 {
+		err = ssz.ErrListTooBigFn("ReshareV1.NewOperators", size, 13)
+		return
+	}
+	{
+		offset = 4 * len(r.NewOperators)
+		for ii := 0; ii < len(r.NewOperators); ii++ {
+			dst = ssz.WriteOffset(dst, offset)
+			offset += r.NewOperators[ii].SizeSSZ()
+		}
+	}
+	for ii := 0; ii < len(r.NewOperators); ii++ {
+		if dst, err = r.NewOperators[ii].MarshalSSZTo(dst); err != nil {
+			return
+		}
+	}
+
+	// Field (6) 'WithdrawalCredentials'
+	if size := len(r.WithdrawalCredentials); size > 32 {
+		err = ssz.ErrBytesLengthFn("ReshareV1.WithdrawalCredentials", size, 32)
+		return
+	}
+	dst = append(dst, r.WithdrawalCredentials...)
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the ReshareV1 object
+func (r *ReshareV1) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size < 108 {
+		return ssz.ErrSize
+	}
+
+	tail := buf
+	var o1, o2, o6 uint64
+
+	// Field (0) 'ValidatorPubKey'
+	if cap(r.ValidatorPubKey) == 0 {
+		r.ValidatorPubKey = make([]byte, 0, len(buf[0:48]))
+	}
+	r.ValidatorPubKey = append(r.ValidatorPubKey, buf[0:48]...)
+
+	// Offset (1) 'OldOperators'
+	if o1 = ssz.ReadOffset(buf[48:52]); o1 > size {
+		return ssz.ErrOffset
+	}
+
+	if o1 < 108 {
+		return ssz.ErrInvalidVariableOffset
+	}
+
+	// Offset (2) 'NewOperators'
+	if o2 = ssz.ReadOffset(buf[52:56]); o2 > size || o1 > o2 {
+		return ssz.ErrOffset
+	}
+
+	// Field (3) 'OldT'
+	r.OldT = ssz.UnmarshallUint64(buf[56:64])
+
+	// Field (4) 'NewT'
+	r.NewT = ssz.UnmarshallUint64(buf[64:72])
+
+	// Field (5) 'Fork'
+	copy(r.Fork[:], buf[72:76])
+
+	// Offset (6) 'WithdrawalCredentials'
+	if o6 = ssz.ReadOffset(buf[76:80]); o6 > size || o2 > o6 {
+		return ssz.ErrOffset
+	}
+
+	// Field (7) 'Owner'
+	copy(r.Owner[:], buf[80:100])
+
+	// Field (8) 'Nonce'
+	r.Nonce = ssz.UnmarshallUint64(buf[100:108])
+
+	// Field (1) 'OldOperators'
+	{
+		buf = tail[o1:o2]
+		num, err := ssz.DecodeDynamicLength(buf, 13)
+		if err != nil {
+			return err
+		}
+		r.OldOperators = make([]*Operator, num)
+		err = ssz.UnmarshalDynamic(buf, num, func(indx int, buf []byte) (err error) {
+			if r.OldOperators[indx] == nil {
+				r.OldOperators[indx] = new(Operator)
+			}
+			if err = r.OldOperators[indx].UnmarshalSSZ(buf); err != nil {
+				return err
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	// Field (2) 'NewOperators'
+	{
+		buf = tail[o2:o6]
+		num, err := ssz.DecodeDynamicLength(buf, 13)
+		if err != nil {
+			return err
+		}
+		r.NewOperators = make([]*Operator, num)
+		err = ssz.UnmarshalDynamic(buf, num, func(indx int, buf []byte) (err error) {
+			if r.NewOperators[indx] == nil {
+				r.NewOperators[indx] = new(Operator)
+			}
+			if err = r.NewOperators[indx].UnmarshalSSZ(buf); err != nil {
+				return err
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	// Field (6) 'WithdrawalCredentials'
+	{
+		buf = tail[o6:]
+		if len(buf) > 32 {
+			return ssz.ErrBytesLength
+		}
+		if cap(r.WithdrawalCredentials) == 0 {
+			r.WithdrawalCredentials = make([]byte, 0, len(buf))
+		}
+		r.WithdrawalCredentials = append(r.WithdrawalCredentials, buf...)
+	}
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the ReshareV1 object
+func (r *ReshareV1) SizeSSZ() (size int) {
+	size = 108
+
+	// Field (1) 'OldOperators'
+	for ii := 0; ii < len(r.OldOperators); ii++ {
+		size += 4
+		size += r.OldOperators[ii].SizeSSZ()
+	}
+
+	// Field (2) 'NewOperators'
+	for ii := 0; ii < len(r.NewOperators); ii++ {
+		size += 4
+		size += r.NewOperators[ii].SizeSSZ()
+	}
+
+	// Field (6) 'WithdrawalCredentials'
+	size += len(r.WithdrawalCredentials)
+
+	return
+}
+
+// HashTreeRoot ssz hashes the ReshareV1 object
+func (r *ReshareV1) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(r)
+}
+
+// HashTreeRootWith ssz hashes the ReshareV1 object with a hasher
+func (r *ReshareV1) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'ValidatorPubKey'
+	if size := len(r.ValidatorPubKey); size != 48 {
+		err = ssz.ErrBytesLengthFn("ReshareV1.ValidatorPubKey", size, 48)
+		return
+	}
+	hh.PutBytes(r.ValidatorPubKey)
+
+	// Field (1) 'OldOperators'
+	{
+		subIndx := hh.Index()
+		num := uint64(len(r.OldOperators))
+		if num > 13 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		for _, elem := range r.OldOperators {
+			if err = elem.HashTreeRootWith(hh); err != nil {
+				return
+			}
+		}
+		hh.MerkleizeWithMixin(subIndx, num, 13)
+	}
+
+	// Field (2) 'NewOperators'
+	{
+		subIndx := hh.Index()
+		num := uint64(len(r.NewOperators))
+		if num > 13 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		for _, elem := range r.NewOperators {
+			if err = elem.HashTreeRootWith(hh); err != nil {
+				return
+			}
+		}
+		hh.MerkleizeWithMixin(subIndx, num, 13)
+	}
+
+	// Field (3) 'OldT'
+	hh.PutUint64(r.OldT)
+
+	// Field (4) 'NewT'
+	hh.PutUint64(r.NewT)
+
+	// Field (5) 'Fork'
+	hh.PutBytes(r.Fork[:])
+
+	// Field (6) 'WithdrawalCredentials'
+	{
+		elemIndx := hh.Index()
+		byteLen := uint64(len(r.WithdrawalCredentials))
+		if byteLen > 32 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		hh.Append(r.WithdrawalCredentials)
+		hh.MerkleizeWithMixin(elemIndx, byteLen, (32+31)/32)
+	}
+
+	// Field (7) 'Owner'
+	hh.PutBytes(r.Owner[:])
+
+	// Field (8) 'Nonce'
+	hh.PutUint64(r.Nonce)
+
+	hh.Merkleize(indx)
+	return
+}
+
+// GetTree ssz hashes the ReshareV1 object
+func (r *ReshareV1) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(r)
+}
+
+// MarshalSSZ ssz marshals the SignedReshare object
+func (s *SignedReshare) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(s)
+}
+
+// MarshalSSZTo ssz marshals the SignedReshare object to a target array
+func (s *SignedReshare) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+	offset := int(8)
+
+	// Offset (0) 'Reshare'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += s.Reshare.SizeSSZ()
+
+	// Offset (1) 'Signature'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(s.Signature)
+
+	// Field (0) 'Reshare'
+	if dst, err = s.Reshare.MarshalSSZTo(dst); err != nil {
+		return
+	}
+
+	// Field (1) 'Signature'
+	if size := len(s.Signature); size > 1536 {
+		err = ssz.ErrBytesLengthFn("SignedReshare.Signature", size, 1536)
+		return
+	}
+	dst = append(dst, s.Signature...)
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the SignedReshare object
+func (s *SignedReshare) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size < 8 {
+		return ssz.ErrSize
+	}
+
+	tail := buf
+	var o0, o1 uint64
+
+	// Offset (0) 'Reshare'
+	if o0 = ssz.ReadOffset(buf[0:4]); o0 > size {
+		return ssz.ErrOffset
+	}
+
+	if o0 < 8 {
+		return ssz.ErrInvalidVariableOffset
+	}
+
+	// Offset (1) 'Signature'
+	if o1 = ssz.ReadOffset(buf[4:8]); o1 > size || o0 > o1 {
+		return ssz.ErrOffset
+	}
+
+	// Field (0) 'Reshare'
+	{
+		buf = tail[o0:o1]
+		if err = s.Reshare.UnmarshalSSZ(buf); err != nil {
+			return err
+		}
+	}
+
+	// Field (1) 'Signature'
+	{
+		buf = tail[o1:]
+		if len(buf) > 1536 {
+			return ssz.ErrBytesLength
+		}
+		if cap(s.Signature) == 0 {
+			s.Signature = make([]byte, 0, len(buf))
+		}
+		s.Signature = append(s.Signature, buf...)
+	}
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the SignedReshare object
+func (s *SignedReshare) SizeSSZ() (size int) {
+	size = 8
+
+	// Field (0) 'Reshare'
+	size += s.Reshare.SizeSSZ()
+
+	// Field (1) 'Signature'
+	size += len(s.Signature)
+
+	return
+}
+
+// HashTreeRoot ssz hashes the SignedReshare object
+func (s *SignedReshare) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(s)
+}
+
+// HashTreeRootWith ssz hashes the SignedReshare object with a hasher
+func (s *SignedReshare) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'Reshare'
+	if err = s.Reshare.HashTreeRootWith(hh); err != nil {
+		return
+	}
+
+	// Field (1) 'Signature'
+	{
+		elemIndx := hh.Index()
+		byteLen := uint64(len(s.Signature))
+		if byteLen > 1536 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		hh.Append(s.Signature)
+		hh.MerkleizeWithMixin(elemIndx, byteLen, (1536+31)/32)
+	}
+
+	hh.Merkleize(indx)
+	return
+}
+
+// GetTree ssz hashes the SignedReshare object
+func (s *SignedReshare) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(s)
+}
+
+// MarshalSSZ ssz marshals the Resign object
+func (r *Resign) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(r)
+}
+
+// MarshalSSZTo ssz marshals the Resign object to a target array
+func (r *Resign) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+	offset := int(92)
+
+	// Field (0) 'ValidatorPubKey'
+	if size := len(r.ValidatorPubKey); size != 48 {
+		err = ssz.ErrBytesLengthFn("Resign.ValidatorPubKey", size, 48)
+		return
+	}
+	dst = append(dst, r.ValidatorPubKey...)
+
+	// Field (1) 'Fork'
+	dst = append(dst, r.Fork[:]...)
+
+	// Offset (2) 'WithdrawalCredentials'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(r.WithdrawalCredentials)
+
+	// Field (3) 'Owner'
+	dst = append(dst, r.Owner[:]...)
+
+	// Field (4) 'Nonce'
+	dst = ssz.MarshalUint64(dst, r.Nonce)
+
+	// Field (5) 'Amount'
+	dst = ssz.MarshalUint64(dst, r.Amount)
+
+	// Field (2) 'WithdrawalCredentials'
+	if size := len(r.WithdrawalCredentials); size > 32 {
+		err = ssz.ErrBytesLengthFn("Resign.WithdrawalCredentials", size, 32)
+		return
+	}
+	dst = append(dst, r.WithdrawalCredentials...)
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the Resign object
+func (r *Resign) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size < 92 {
+		return ssz.ErrSize
+	}
+
+	tail := buf
+	var o2 uint64
+
+	// Field (0) 'ValidatorPubKey'
+	if cap(r.ValidatorPubKey) == 0 {
+		r.ValidatorPubKey = make([]byte, 0, len(buf[0:48]))
+	}
+	r.ValidatorPubKey = append(r.ValidatorPubKey, buf[0:48]...)
+
+	// Field (1) 'Fork'
+	copy(r.Fork[:], buf[48:52])
+
+	// Offset (2) 'WithdrawalCredentials'
+	if o2 = ssz.ReadOffset(buf[52:56]); o2 > size {
+		return ssz.ErrOffset
+	}
+
+	if o2 < 92 {
+		return ssz.ErrInvalidVariableOffset
+	}
+
+	// Field (3) 'Owner'
+	copy(r.Owner[:], buf[56:76])
+
+	// Field (4) 'Nonce'
+	r.Nonce = ssz.UnmarshallUint64(buf[76:84])
+
+	// Field (5) 'Amount'
+	r.Amount = ssz.UnmarshallUint64(buf[84:92])
+
+	// Field (2) 'WithdrawalCredentials'
+	{
+		buf = tail[o2:]
+		if len(buf) > 32 {
+			return ssz.ErrBytesLength
+		}
+		if cap(r.WithdrawalCredentials) == 0 {
+			r.WithdrawalCredentials = make([]byte, 0, len(buf))
+		}
+		r.WithdrawalCredentials = append(r.WithdrawalCredentials, buf...)
+	}
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the Resign object
+func (r *Resign) SizeSSZ() (size int) {
+	size = 92
+
+	// Field (2) 'WithdrawalCredentials'
+	size += len(r.WithdrawalCredentials)
+
+	return
+}
+
+// HashTreeRoot ssz hashes the Resign object
+func (r *Resign) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(r)
+}
+
+// HashTreeRootWith ssz hashes the Resign object with a hasher
+func (r *Resign) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'ValidatorPubKey'
+	if size := len(r.ValidatorPubKey); size != 48 {
+		err = ssz.ErrBytesLengthFn("Resign.ValidatorPubKey", size, 48)
+		return
+	}
+	hh.PutBytes(r.ValidatorPubKey)
+
+	// Field (1) 'Fork'
+	hh.PutBytes(r.Fork[:])
+
+	// Field (2) 'WithdrawalCredentials'
+	{
+		elemIndx := hh.Index()
+		byteLen := uint64(len(r.WithdrawalCredentials))
+		if byteLen > 32 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		hh.Append(r.WithdrawalCredentials)
+		hh.MerkleizeWithMixin(elemIndx, byteLen, (32+31)/32)
+	}
+
+	// Field (3) 'Owner'
+	hh.PutBytes(r.Owner[:])
+
+	// Field (4) 'Nonce'
+	hh.PutUint64(r.Nonce)
+
+	// Field (5) 'Amount'
+	hh.PutUint64(r.Amount)
+
+	hh.Merkleize(indx)
+	return
+}
+
+// GetTree ssz hashes the Resign object
+func (r *Resign) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(r)
+}
+
+// MarshalSSZ ssz marshals the ResignV1 object
+func (r *ResignV1) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(r)
+}
+
+// MarshalSSZTo ssz marshals the ResignV1 object to a target array
+func (r *ResignV1) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+	offset := int(84)
+
+	// Field (0) 'ValidatorPubKey'
+	if size := len(r.ValidatorPubKey); size != 48 {
+		err = ssz.ErrBytesLengthFn("ResignV1.ValidatorPubKey", size, 48)
+		return
+	}
+	dst = append(dst, r.ValidatorPubKey...)
+
+	// Field (1) 'Fork'
+	dst = append(dst, r.Fork[:]...)
+
+	// Offset (2) 'WithdrawalCredentials'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(r.WithdrawalCredentials)
+
+	// Field (3) 'Owner'
+	dst = append(dst, r.Owner[:]...)
+
+	// Field (4) 'Nonce'
+	dst = ssz.MarshalUint64(dst, r.Nonce)
+
+	// Field (2) 'WithdrawalCredentials'
+	if size := len(r.WithdrawalCredentials); size > 32 {
+		err = ssz.ErrBytesLengthFn("ResignV1.WithdrawalCredentials", size, 32)
+		return
+	}
+	dst = append(dst, r.WithdrawalCredentials...)
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the ResignV1 object
+func (r *ResignV1) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size < 84 {
+		return ssz.ErrSize
+	}
+
+	tail := buf
+	var o2 uint64
+
+	// Field (0) 'ValidatorPubKey'
+	if cap(r.ValidatorPubKey) == 0 {
+		r.ValidatorPubKey = make([]byte, 0, len(buf[0:48]))
+	}
+	r.ValidatorPubKey = append(r.ValidatorPubKey, buf[0:48]...)
+
+	// Field (1) 'Fork'
+	copy(r.Fork[:], buf[48:52])
+
+	// Offset (2) 'WithdrawalCredentials'
+	if o2 = ssz.ReadOffset(buf[52:56]); o2 > size {
+		return ssz.ErrOffset
+	}
+
+	if o2 < 84 {
+		return ssz.ErrInvalidVariableOffset
+	}
+
+	// Field (3) 'Owner'
+	copy(r.Owner[:], buf[56:76])
+
+	// Field (4) 'Nonce'
+	r.Nonce = ssz.UnmarshallUint64(buf[76:84])
+
+	// Field (2) 'WithdrawalCredentials'
+	{
+		buf = tail[o2:]
+		if len(buf) > 32 {
+			return ssz.ErrBytesLength
+		}
+		if cap(r.WithdrawalCredentials) == 0 {
+			r.WithdrawalCredentials = make([]byte, 0, len(buf))
+		}
+		r.WithdrawalCredentials = append(r.WithdrawalCredentials, buf...)
+	}
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the ResignV1 object
+func (r *ResignV1) SizeSSZ() (size int) {
+	size = 84
+
+	// Field (2) 'WithdrawalCredentials'
+	size += len(r.WithdrawalCredentials)
+
+	return
+}
+
+// HashTreeRoot ssz hashes the ResignV1 object
+func (r *ResignV1) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(r)
+}
+
+// HashTreeRootWith ssz hashes the ResignV1 object with a hasher
+func (r *ResignV1) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'ValidatorPubKey'
+	if size := len(r.ValidatorPubKey); size != 48 {
+		err = ssz.ErrBytesLengthFn("ResignV1.ValidatorPubKey", size, 48)
+		return
+	}
+	hh.PutBytes(r.ValidatorPubKey)
+
+	// Field (1) 'Fork'
+	hh.PutBytes(r.Fork[:])
+
+	// Field (2) 'WithdrawalCredentials'
+	{
+		elemIndx := hh.Index()
+		byteLen := uint64(len(r.WithdrawalCredentials))
+		if byteLen > 32 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		hh.Append(r.WithdrawalCredentials)
+		hh.MerkleizeWithMixin(elemIndx, byteLen, (32+31)/32)
+	}
+
+	// Field (3) 'Owner'
+	hh.PutBytes(r.Owner[:])
+
+	// Field (4) 'Nonce'
+	hh.PutUint64(r.Nonce)
+
+	hh.Merkleize(indx)
+	return
+}
+
+// GetTree ssz hashes the ResignV1 object
+func (r *ResignV1) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(r)
+}
+
+// MarshalSSZ ssz marshals the SignedResign object
+func (s *SignedResign) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(s)
 }
 
 // MarshalSSZTo ssz marshals the SignedResign object to a target array
 func (s *SignedResign) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 	dst = buf
-	offset := int(8)
+	offset := int(8)
+
+	// Offset (0) 'Resign'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += s.Resign.SizeSSZ()
+
+	// Offset (1) 'Signature'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(s.Signature)
+
+	// Field (0) 'Resign'
+	if dst, err = s.Resign.MarshalSSZTo(dst); err != nil {
+		return
+	}
+
+	// Field (1) 'Signature'
+	if size := len(s.Signature); size > 1536 {
+		err = ssz.ErrBytesLengthFn("SignedResign.Signature", size, 1536)
+		return
+	}
+	dst = append(dst, s.Signature...)
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the SignedResign object
+func (s *SignedResign) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size < 8 {
+		return ssz.ErrSize
+	}
+
+	tail := buf
+	var o0, o1 uint64
+
+	// Offset (0) 'Resign'
+	if o0 = ssz.ReadOffset(buf[0:4]); o0 > size {
+		return ssz.ErrOffset
+	}
+
+	if o0 < 8 {
+		return ssz.ErrInvalidVariableOffset
+	}
+
+	// Offset (1) 'Signature'
+	if o1 = ssz.ReadOffset(buf[4:8]); o1 > size || o0 > o1 {
+		return ssz.ErrOffset
+	}
+
+	// Field (0) 'Resign'
+	{
+		buf = tail[o0:o1]
+		if err = s.Resign.UnmarshalSSZ(buf); err != nil {
+			return err
+		}
+	}
+
+	// Field (1) 'Signature'
+	{
+		buf = tail[o1:]
+		if len(buf) > 1536 {
+			return ssz.ErrBytesLength
+		}
+		if cap(s.Signature) == 0 {
+			s.Signature = make([]byte, 0, len(buf))
+		}
+		s.Signature = append(s.Signature, buf...)
+	}
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the SignedResign object
+func (s *SignedResign) SizeSSZ() (size int) {
+	size = 8
+
+	// Field (0) 'Resign'
+	size += s.Resign.SizeSSZ()
+
+	// Field (1) 'Signature'
+	size += len(s.Signature)
+
+	return
+}
+
+// HashTreeRoot ssz hashes the SignedResign object
+func (s *SignedResign) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(s)
+}
+
+// HashTreeRootWith ssz hashes the SignedResign object with a hasher
+func (s *SignedResign) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'Resign'
+	if err = s.Resign.HashTreeRootWith(hh); err != nil {
+		return
+	}
+
+	// Field (1) 'Signature'
+	{
+		elemIndx := hh.Index()
+		byteLen := uint64(len(s.Signature))
+		if byteLen > 1536 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		hh.Append(s.Signature)
+		hh.MerkleizeWithMixin(elemIndx, byteLen, (1536+31)/32)
+	}
+
+	hh.Merkleize(indx)
+	return
+}
+
+// GetTree ssz hashes the SignedResign object
+func (s *SignedResign) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(s)
+}
+
+// MarshalSSZ ssz marshals the Result object
+func (r *Result) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(r)
+}
+
+// MarshalSSZTo ssz marshals the Result object to a target array
+func (r *Result) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+	offset := int(244)
+
+	// Field (0) 'OperatorID'
+	dst = ssz.MarshalUint64(dst, r.OperatorID)
+
+	// Field (1) 'RequestID'
+	dst = append(dst, r.RequestID[:]...)
+
+	// Field (2) 'DepositPartialSignature'
+	if size := len(r.DepositPartialSignature); size != 96 {
+		err = ssz.ErrBytesLengthFn("Result.DepositPartialSignature", size, 96)
+		return
+	}
+	dst = append(dst, r.DepositPartialSignature...)
+
+	// Field (3) 'OwnerNoncePartialSignature'
+	if size := len(r.OwnerNoncePartialSignature); size != 96 {
+		err = ssz.ErrBytesLengthFn("Result.OwnerNoncePartialSignature", size, 96)
+		return
+	}
+	dst = append(dst, r.OwnerNoncePartialSignature...)
+
+	// Offset (4) 'SignedProof'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += r.SignedProof.SizeSSZ()
+
+	// Field (5) 'ValidatorIndex'
+	dst = ssz.MarshalUint64(dst, r.ValidatorIndex)
+
+	// Offset (6) 'DealingCommitments'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(r.DealingCommitments) * 48
+
+	// Offset (7) 'DealingProof'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(r.DealingProof)
+
+	// Field (4) 'SignedProof'
+	if dst, err = r.SignedProof.MarshalSSZTo(dst); err != nil {
+		return
+	}
+
+	// Field (6) 'DealingCommitments'
+	if size := len(r.DealingCommitments); size > 13 {
+		err = ssz.ErrListTooBigFn("Result.DealingCommitments", size, 13)
+		return
+	}
+	for ii := 0; ii < len(r.DealingCommitments); ii++ {
+		if size := len(r.DealingCommitments[ii]); size != 48 {
+			err = ssz.ErrBytesLengthFn("Result.DealingCommitments[ii]", size, 48)
+			return
+		}
+		dst = append(dst, r.DealingCommitments[ii]...)
+	}
+
+	// Field (7) 'DealingProof'
+	if size := len(r.DealingProof); size > 96 {
+		err = ssz.ErrBytesLengthFn("Result.DealingProof", size, 96)
+		return
+	}
+	dst = append(dst, r.DealingProof...)
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the Result object
+func (r *Result) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size < 244 {
+		return ssz.ErrSize
+	}
+
+	tail := buf
+	var o4, o6, o7 uint64
+
+	// Field (0) 'OperatorID'
+	r.OperatorID = ssz.UnmarshallUint64(buf[0:8])
+
+	// Field (1) 'RequestID'
+	copy(r.RequestID[:], buf[8:32])
+
+	// Field (2) 'DepositPartialSignature'
+	if cap(r.DepositPartialSignature) == 0 {
+		r.DepositPartialSignature = make([]byte, 0, len(buf[32:128]))
+	}
+	r.DepositPartialSignature = append(r.DepositPartialSignature, buf[32:128]...)
+
+	// Field (3) 'OwnerNoncePartialSignature'
+	if cap(r.OwnerNoncePartialSignature) == 0 {
+		r.OwnerNoncePartialSignature = make([]byte, 0, len(buf[128:224]))
+	}
+	r.OwnerNoncePartialSignature = append(r.OwnerNoncePartialSignature, buf[128:224]...)
+
+	// Offset (4) 'SignedProof'
+	if o4 = ssz.ReadOffset(buf[224:228]); o4 > size {
+		return ssz.ErrOffset
+	}
+
+	if o4 < 244 {
+		return ssz.ErrInvalidVariableOffset
+	}
+
+	// Field (5) 'ValidatorIndex'
+	r.ValidatorIndex = ssz.UnmarshallUint64(buf[228:236])
+
+	// Offset (6) 'DealingCommitments'
+	if o6 = ssz.ReadOffset(buf[236:240]); o6 > size || o4 > o6 {
+		return ssz.ErrOffset
+	}
+
+	// Offset (7) 'DealingProof'
+	if o7 = ssz.ReadOffset(buf[240:244]); o7 > size || o6 > o7 {
+		return ssz.ErrOffset
+	}
+
+	// Field (4) 'SignedProof'
+	{
+		buf = tail[o4:o6]
+		if err = r.SignedProof.UnmarshalSSZ(buf); err != nil {
+			return err
+		}
+	}
+
+	// Field (6) 'DealingCommitments'
+	{
+		buf = tail[o6:o7]
+		num, err := ssz.DivideInt2(len(buf), 48, 13)
+		if err != nil {
+			return err
+		}
+		r.DealingCommitments = make([][]byte, num)
+		for ii := 0; ii < num; ii++ {
+			if cap(r.DealingCommitments[ii]) == 0 {
+				r.DealingCommitments[ii] = make([]byte, 0, len(buf[ii*48:(ii+1)*48]))
+			}
+			r.DealingCommitments[ii] = append(r.DealingCommitments[ii], buf[ii*48:(ii+1)*48]...)
+		}
+	}
+
+	// Field (7) 'DealingProof'
+	{
+		buf = tail[o7:]
+		if len(buf) > 96 {
+			return ssz.ErrBytesLength
+		}
+		if cap(r.DealingProof) == 0 {
+			r.DealingProof = make([]byte, 0, len(buf))
+		}
+		r.DealingProof = append(r.DealingProof, buf...)
+	}
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the Result object
+func (r *Result) SizeSSZ() (size int) {
+	size = 244
+
+	// Field (4) 'SignedProof'
+	size += r.SignedProof.SizeSSZ()
+
+	// Field (6) 'DealingCommitments'
+	size += len(r.DealingCommitments) * 48
+
+	// Field (7) 'DealingProof'
+	size += len(r.DealingProof)
+
+	return
+}
+
+// HashTreeRoot ssz hashes the Result object
+func (r *Result) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(r)
+}
+
+// HashTreeRootWith ssz hashes the Result object with a hasher
+func (r *Result) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'OperatorID'
+	hh.PutUint64(r.OperatorID)
+
+	// Field (1) 'RequestID'
+	hh.PutBytes(r.RequestID[:])
+
+	// Field (2) 'DepositPartialSignature'
+	if size := len(r.DepositPartialSignature); size != 96 {
+		err = ssz.ErrBytesLengthFn("Result.DepositPartialSignature", size, 96)
+		return
+	}
+	hh.PutBytes(r.DepositPartialSignature)
+
+	// Field (3) 'OwnerNoncePartialSignature'
+	if size := len(r.OwnerNoncePartialSignature); size != 96 {
+		err = ssz.ErrBytesLengthFn("Result.OwnerNoncePartialSignature", size, 96)
+		return
+	}
+	hh.PutBytes(r.OwnerNoncePartialSignature)
+
+	// Field (4) 'SignedProof'
+	if err = r.SignedProof.HashTreeRootWith(hh); err != nil {
+		return
+	}
+
+	// Field (5) 'ValidatorIndex'
+	hh.PutUint64(r.ValidatorIndex)
+
+	// Field (6) 'DealingCommitments'
+	{
+		if size := len(r.DealingCommitments); size > 13 {
+			err = ssz.ErrListTooBigFn("Result.DealingCommitments", size, 13)
+			return
+		}
+		subIndx := hh.Index()
+		for _, i := range r.DealingCommitments {
+			if len(i) != 48 {
+				err = ssz.ErrBytesLength
+				return
+			}
+			hh.PutBytes(i)
+		}
+		numItems := uint64(len(r.DealingCommitments))
+		hh.MerkleizeWithMixin(subIndx, numItems, 13)
+	}
+
+	// Field (7) 'DealingProof'
+	{
+		elemIndx := hh.Index()
+		byteLen := uint64(len(r.DealingProof))
+		if byteLen > 96 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		hh.Append(r.DealingProof)
+		hh.MerkleizeWithMixin(elemIndx, byteLen, (96+31)/32)
+	}
+
+	hh.Merkleize(indx)
+	return
+}
+
+// GetTree ssz hashes the Result object
+func (r *Result) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(r)
+}
+
+// MarshalSSZ ssz marshals the Dealing object
+func (d *Dealing) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(d)
+}
+
+// MarshalSSZTo ssz marshals the Dealing object to a target array
+func (d *Dealing) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+	offset := int(36)
+
+	// Field (0) 'RequestID'
+	dst = append(dst, d.RequestID[:]...)
+
+	// Field (1) 'OperatorID'
+	dst = ssz.MarshalUint64(dst, d.OperatorID)
+
+	// Offset (2) 'Commitments'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(d.Commitments) * 48
+
+	// Field (2) 'Commitments'
+	if size := len(d.Commitments); size > 13 {
+		err = ssz.ErrListTooBigFn("Dealing.Commitments", size, 13)
+		return
+	}
+	for ii := 0; ii < len(d.Commitments); ii++ {
+		if size := len(d.Commitments[ii]); size != 48 {
+			err = ssz.ErrBytesLengthFn("Dealing.Commitments[ii]", size, 48)
+			return
+		}
+		dst = append(dst, d.Commitments[ii]...)
+	}
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the Dealing object
+func (d *Dealing) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size < 36 {
+		return ssz.ErrSize
+	}
+
+	tail := buf
+	var o2 uint64
+
+	// Field (0) 'RequestID'
+	copy(d.RequestID[:], buf[0:24])
+
+	// Field (1) 'OperatorID'
+	d.OperatorID = ssz.UnmarshallUint64(buf[24:32])
+
+	// Offset (2) 'Commitments'
+	if o2 = ssz.ReadOffset(buf[32:36]); o2 > size {
+		return ssz.ErrOffset
+	}
+
+	if o2 < 36 {
+		return ssz.ErrInvalidVariableOffset
+	}
+
+	// Field (2) 'Commitments'
+	{
+		buf = tail[o2:]
+		num, err := ssz.DivideInt2(len(buf), 48, 13)
+		if err != nil {
+			return err
+		}
+		d.Commitments = make([][]byte, num)
+		for ii := 0; ii < num; ii++ {
+			if cap(d.Commitments[ii]) == 0 {
+				d.Commitments[ii] = make([]byte, 0, len(buf[ii*48:(ii+1)*48]))
+			}
+			d.Commitments[ii] = append(d.Commitments[ii], buf[ii*48:(ii+1)*48]...)
+		}
+	}
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the Dealing object
+func (d *Dealing) SizeSSZ() (size int) {
+	size = 36
+
+	// Field (2) 'Commitments'
+	size += len(d.Commitments) * 48
+
+	return
+}
+
+// HashTreeRoot ssz hashes the Dealing object
+func (d *Dealing) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(d)
+}
+
+// HashTreeRootWith ssz hashes the Dealing object with a hasher
+func (d *Dealing) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'RequestID'
+	hh.PutBytes(d.RequestID[:])
+
+	// Field (1) 'OperatorID'
+	hh.PutUint64(d.OperatorID)
+
+	// Field (2) 'Commitments'
+	{
+		if size := len(d.Commitments); size > 13 {
+			err = ssz.ErrListTooBigFn("Dealing.Commitments", size, 13)
+			return
+		}
+		subIndx := hh.Index()
+		for _, i := range d.Commitments {
+			if len(i) != 48 {
+				err = ssz.ErrBytesLength
+				return
+			}
+			hh.PutBytes(i)
+		}
+		numItems := uint64(len(d.Commitments))
+		hh.MerkleizeWithMixin(subIndx, numItems, 13)
+	}
+
+	hh.Merkleize(indx)
+	return
+}
+
+// GetTree ssz hashes the Dealing object
+func (d *Dealing) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(d)
+}
+
+// MarshalSSZ ssz marshals the Possession object
+func (p *Possession) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(p)
+}
+
+// MarshalSSZTo ssz marshals the Possession object to a target array
+func (p *Possession) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+
+	// Field (0) 'PubKey'
+	if size := len(p.PubKey); size != 48 {
+		err = ssz.ErrBytesLengthFn("Possession.PubKey", size, 48)
+		return
+	}
+	dst = append(dst, p.PubKey...)
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the Possession object
+func (p *Possession) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size != 48 {
+		return ssz.ErrSize
+	}
+
+	// Field (0) 'PubKey'
+	if cap(p.PubKey) == 0 {
+		p.PubKey = make([]byte, 0, len(buf[0:48]))
+	}
+	p.PubKey = append(p.PubKey, buf[0:48]...)
+
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the Possession object
+func (p *Possession) SizeSSZ() (size int) {
+	size = 48
+	return
+}
+
+// HashTreeRoot ssz hashes the Possession object
+func (p *Possession) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(p)
+}
+
+// HashTreeRootWith ssz hashes the Possession object with a hasher
+func (p *Possession) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'PubKey'
+	if size := len(p.PubKey); size != 48 {
+		err = ssz.ErrBytesLengthFn("Possession.PubKey", size, 48)
+		return
+	}
+	hh.PutBytes(p.PubKey)
+
+	hh.Merkleize(indx)
+	return
+}
+
+// GetTree ssz hashes the Possession object
+func (p *Possession) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(p)
+}
+
+// MarshalSSZ ssz marshals the Proof object
+func (p *Proof) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(p)
+}
+
+// MarshalSSZTo ssz marshals the Proof object to a target array
+func (p *Proof) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+	offset := int(180)
+
+	// Field (0) 'ValidatorPubKey'
+	if size := len(p.ValidatorPubKey); size != 48 {
+		err = ssz.ErrBytesLengthFn("Proof.ValidatorPubKey", size, 48)
+		return
+	}
+	dst = append(dst, p.ValidatorPubKey...)
+
+	// Offset (1) 'EncryptedShare'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(p.EncryptedShare)
+
+	// Field (2) 'SharePubKey'
+	if size := len(p.SharePubKey); size != 48 {
+		err = ssz.ErrBytesLengthFn("Proof.SharePubKey", size, 48)
+		return
+	}
+	dst = append(dst, p.SharePubKey...)
+
+	// Field (3) 'Owner'
+	dst = append(dst, p.Owner[:]...)
+
+	// Field (4) 'RequestID'
+	dst = append(dst, p.RequestID[:]...)
+
+	// Field (5) 'ParamsHash'
+	dst = append(dst, p.ParamsHash[:]...)
+
+	// Offset (6) 'Commitments'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(p.Commitments) * 48
+
+	// Field (1) 'EncryptedShare'
+	if size := len(p.EncryptedShare); size > 512 {
+		err = ssz.ErrBytesLengthFn("Proof.EncryptedShare", size, 512)
+		return
+	}
+	dst = append(dst, p.EncryptedShare...)
+
+	// Field (6) 'Commitments'
+	if size := len(p.Commitments); size > 13 {
+		err = ssz.ErrListTooBigFn("Proof.Commitments", size, 13)
+		return
+	}
+	for ii := 0; ii < len(p.Commitments); ii++ {
+		if size := len(p.Commitments[ii]); size != 48 {
+			err = ssz.ErrBytesLengthFn("Proof.Commitments[ii]", size, 48)
+			return
+		}
+		dst = append(dst, p.Commitments[ii]...)
+	}
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the Proof object
+func (p *Proof) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size < 180 {
+		return ssz.ErrSize
+	}
+
+	tail := buf
+	var o1, o6 uint64
+
+	// Field (0) 'ValidatorPubKey'
+	if cap(p.ValidatorPubKey) == 0 {
+		p.ValidatorPubKey = make([]byte, 0, len(buf[0:48]))
+	}
+	p.ValidatorPubKey = append(p.ValidatorPubKey, buf[0:48]...)
+
+	// Offset (1) 'EncryptedShare'
+	if o1 = ssz.ReadOffset(buf[48:52]); o1 > size {
+		return ssz.ErrOffset
+	}
+
+	if o1 < 180 {
+		return ssz.ErrInvalidVariableOffset
+	}
+
+	// Field (2) 'SharePubKey'
+	if cap(p.SharePubKey) == 0 {
+		p.SharePubKey = make([]byte, 0, len(buf[52:100]))
+	}
+	p.SharePubKey = append(p.SharePubKey, buf[52:100]...)
+
+	// Field (3) 'Owner'
+	copy(p.Owner[:], buf[100:120])
+
+	// Field (4) 'RequestID'
+	copy(p.RequestID[:], buf[120:144])
+
+	// Field (5) 'ParamsHash'
+	copy(p.ParamsHash[:], buf[144:176])
+
+	// Offset (6) 'Commitments'
+	if o6 = ssz.ReadOffset(buf[176:180]); o6 > size || o1 > o6 {
+		return ssz.ErrOffset
+	}
+
+	// Field (1) 'EncryptedShare'
+	{
+		buf = tail[o1:o6]
+		if len(buf) > 512 {
+			return ssz.ErrBytesLength
+		}
+		if cap(p.EncryptedShare) == 0 {
+			p.EncryptedShare = make([]byte, 0, len(buf))
+		}
+		p.EncryptedShare = append(p.EncryptedShare, buf...)
+	}
+
+	// Field (6) 'Commitments'
+	{
+		buf = tail[o6:]
+		num, err := ssz.DivideInt2(len(buf), 48, 13)
+		if err != nil {
+			return err
+		}
+		p.Commitments = make([][]byte, num)
+		for ii := 0; ii < num; ii++ {
+			if cap(p.Commitments[ii]) == 0 {
+				p.Commitments[ii] = make([]byte, 0, len(buf[ii*48:(ii+1)*48]))
+			}
+			p.Commitments[ii] = append(p.Commitments[ii], buf[ii*48:(ii+1)*48]...)
+		}
+	}
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the Proof object
+func (p *Proof) SizeSSZ() (size int) {
+	size = 180
+
+	// Field (1) 'EncryptedShare'
+	size += len(p.EncryptedShare)
+
+	// Field (6) 'Commitments'
+	size += len(p.Commitments) * 48
+
+	return
+}
+
+// HashTreeRoot ssz hashes the Proof object
+func (p *Proof) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(p)
+}
+
+// HashTreeRootWith ssz hashes the Proof object with a hasher
+func (p *Proof) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'ValidatorPubKey'
+	if size := len(p.ValidatorPubKey); size != 48 {
+		err = ssz.ErrBytesLengthFn("Proof.ValidatorPubKey", size, 48)
+		return
+	}
+	hh.PutBytes(p.ValidatorPubKey)
+
+	// Field (1) 'EncryptedShare'
+	{
+		elemIndx := hh.Index()
+		byteLen := uint64(len(p.EncryptedShare))
+		if byteLen > 512 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		hh.Append(p.EncryptedShare)
+		hh.MerkleizeWithMixin(elemIndx, byteLen, (512+31)/32)
+	}
+
+	// Field (2) 'SharePubKey'
+	if size := len(p.SharePubKey); size != 48 {
+		err = ssz.ErrBytesLengthFn("Proof.SharePubKey", size, 48)
+		return
+	}
+	hh.PutBytes(p.SharePubKey)
+
+	// Field (3) 'Owner'
+	hh.PutBytes(p.Owner[:])
+
+	// Field (4) 'RequestID'
+	hh.PutBytes(p.RequestID[:])
+
+	// Field (5) 'ParamsHash'
+	hh.PutBytes(p.ParamsHash[:])
+
+	// Field (6) 'Commitments'
+	{
+		if size := len(p.Commitments); size > 13 {
+			err = ssz.ErrListTooBigFn("Proof.Commitments", size, 13)
+			return
+		}
+		subIndx := hh.Index()
+		for _, i := range p.Commitments {
+			if len(i) != 48 {
+				err = ssz.ErrBytesLength
+				return
+			}
+			hh.PutBytes(i)
+		}
+		numItems := uint64(len(p.Commitments))
+		hh.MerkleizeWithMixin(subIndx, numItems, 13)
+	}
+
+	hh.Merkleize(indx)
+	return
+}
+
+// GetTree ssz hashes the Proof object
+func (p *Proof) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(p)
+}
+
+// MarshalSSZ ssz marshals the ProofV3 object
+func (p *ProofV3) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(p)
+}
+
+// MarshalSSZTo ssz marshals the ProofV3 object to a target array
+func (p *ProofV3) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+	offset := int(176)
+
+	// Field (0) 'ValidatorPubKey'
+	if size := len(p.ValidatorPubKey); size != 48 {
+		err = ssz.ErrBytesLengthFn("ProofV3.ValidatorPubKey", size, 48)
+		return
+	}
+	dst = append(dst, p.ValidatorPubKey...)
+
+	// Offset (1) 'EncryptedShare'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(p.EncryptedShare)
+
+	// Field (2) 'SharePubKey'
+	if size := len(p.SharePubKey); size != 48 {
+		err = ssz.ErrBytesLengthFn("ProofV3.SharePubKey", size, 48)
+		return
+	}
+	dst = append(dst, p.SharePubKey...)
+
+	// Field (3) 'Owner'
+	dst = append(dst, p.Owner[:]...)
+
+	// Field (4) 'RequestID'
+	dst = append(dst, p.RequestID[:]...)
+
+	// Field (5) 'ParamsHash'
+	dst = append(dst, p.ParamsHash[:]...)
+
+	// Field (1) 'EncryptedShare'
+	if size := len(p.EncryptedShare); size > 512 {
+		err = ssz.ErrBytesLengthFn("ProofV3.EncryptedShare", size, 512)
+		return
+	}
+	dst = append(dst, p.EncryptedShare...)
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the ProofV3 object
+func (p *ProofV3) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size < 176 {
+		return ssz.ErrSize
+	}
+
+	tail := buf
+	var o1 uint64
+
+	// Field (0) 'ValidatorPubKey'
+	if cap(p.ValidatorPubKey) == 0 {
+		p.ValidatorPubKey = make([]byte, 0, len(buf[0:48]))
+	}
+	p.ValidatorPubKey = append(p.ValidatorPubKey, buf[0:48]...)
+
+	// Offset (1) 'EncryptedShare'
+	if o1 = ssz.ReadOffset(buf[48:52]); o1 > size {
+		return ssz.ErrOffset
+	}
+
+	if o1 < 176 {
+		return ssz.ErrInvalidVariableOffset
+	}
+
+	// Field (2) 'SharePubKey'
+	if cap(p.SharePubKey) == 0 {
+		p.SharePubKey = make([]byte, 0, len(buf[52:100]))
+	}
+	p.SharePubKey = append(p.SharePubKey, buf[52:100]...)
+
+	// Field (3) 'Owner'
+	copy(p.Owner[:], buf[100:120])
+
+	// Field (4) 'RequestID'
+	copy(p.RequestID[:], buf[120:144])
+
+	// Field (5) 'ParamsHash'
+	copy(p.ParamsHash[:], buf[144:176])
+
+	// Field (1) 'EncryptedShare'
+	{
+		buf = tail[o1:]
+		if len(buf) > 512 {
+			return ssz.ErrBytesLength
+		}
+		if cap(p.EncryptedShare) == 0 {
+			p.EncryptedShare = make([]byte, 0, len(buf))
+		}
+		p.EncryptedShare = append(p.EncryptedShare, buf...)
+	}
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the ProofV3 object
+func (p *ProofV3) SizeSSZ() (size int) {
+	size = 176
+
+	// Field (1) 'EncryptedShare'
+	size += len(p.EncryptedShare)
+
+	return
+}
+
+// HashTreeRoot ssz hashes the ProofV3 object
+func (p *ProofV3) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(p)
+}
+
+// HashTreeRootWith ssz hashes the ProofV3 object with a hasher
+func (p *ProofV3) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'ValidatorPubKey'
+	if size := len(p.ValidatorPubKey); size != 48 {
+		err = ssz.ErrBytesLengthFn("ProofV3.ValidatorPubKey", size, 48)
+		return
+	}
+	hh.PutBytes(p.ValidatorPubKey)
+
+	// Field (1) 'EncryptedShare'
+	{
+		elemIndx := hh.Index()
+		byteLen := uint64(len(p.EncryptedShare))
+		if byteLen > 512 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		hh.Append(p.EncryptedShare)
+		hh.MerkleizeWithMixin(elemIndx, byteLen, (512+31)/32)
+	}
+
+	// Field (2) 'SharePubKey'
+	if size := len(p.SharePubKey); size != 48 {
+		err = ssz.ErrBytesLengthFn("ProofV3.SharePubKey", size, 48)
+		return
+	}
+	hh.PutBytes(p.SharePubKey)
+
+	// Field (3) 'Owner'
+	hh.PutBytes(p.Owner[:])
+
+	// Field (4) 'RequestID'
+	hh.PutBytes(p.RequestID[:])
+
+	// Field (5) 'ParamsHash'
+	hh.PutBytes(p.ParamsHash[:])
+
+	hh.Merkleize(indx)
+	return
+}
+
+// GetTree ssz hashes the ProofV3 object
+func (p *ProofV3) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(p)
+}
+
+// MarshalSSZ ssz marshals the ProofV2 object
+func (p *ProofV2) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(p)
+}
+
+// MarshalSSZTo ssz marshals the ProofV2 object to a target array
+func (p *ProofV2) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+	offset := int(144)
+
+	// Field (0) 'ValidatorPubKey'
+	if size := len(p.ValidatorPubKey); size != 48 {
+		err = ssz.ErrBytesLengthFn("ProofV2.ValidatorPubKey", size, 48)
+		return
+	}
+	dst = append(dst, p.ValidatorPubKey...)
+
+	// Offset (1) 'EncryptedShare'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(p.EncryptedShare)
+
+	// Field (2) 'SharePubKey'
+	if size := len(p.SharePubKey); size != 48 {
+		err = ssz.ErrBytesLengthFn("ProofV2.SharePubKey", size, 48)
+		return
+	}
+	dst = append(dst, p.SharePubKey...)
+
+	// Field (3) 'Owner'
+	dst = append(dst, p.Owner[:]...)
+
+	// Field (4) 'RequestID'
+	dst = append(dst, p.RequestID[:]...)
+
+	// Field (1) 'EncryptedShare'
+	if size := len(p.EncryptedShare); size > 512 {
+		err = ssz.ErrBytesLengthFn("ProofV2.EncryptedShare", size, 512)
+		return
+	}
+	dst = append(dst, p.EncryptedShare...)
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the ProofV2 object
+func (p *ProofV2) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size < 144 {
+		return ssz.ErrSize
+	}
+
+	tail := buf
+	var o1 uint64
+
+	// Field (0) 'ValidatorPubKey'
+	if cap(p.ValidatorPubKey) == 0 {
+		p.ValidatorPubKey = make([]byte, 0, len(buf[0:48]))
+	}
+	p.ValidatorPubKey = append(p.ValidatorPubKey, buf[0:48]...)
+
+	// Offset (1) 'EncryptedShare'
+	if o1 = ssz.ReadOffset(buf[48:52]); o1 > size {
+		return ssz.ErrOffset
+	}
+
+	if o1 < 144 {
+		return ssz.ErrInvalidVariableOffset
+	}
+
+	// Field (2) 'SharePubKey'
+	if cap(p.SharePubKey) == 0 {
+		p.SharePubKey = make([]byte, 0, len(buf[52:100]))
+	}
+	p.SharePubKey = append(p.SharePubKey, buf[52:100]...)
+
+	// Field (3) 'Owner'
+	copy(p.Owner[:], buf[100:120])
+
+	// Field (4) 'RequestID'
+	copy(p.RequestID[:], buf[120:144])
+
+	// Field (1) 'EncryptedShare'
+	{
+		buf = tail[o1:]
+		if len(buf) > 512 {
+			return ssz.ErrBytesLength
+		}
+		if cap(p.EncryptedShare) == 0 {
+			p.EncryptedShare = make([]byte, 0, len(buf))
+		}
+		p.EncryptedShare = append(p.EncryptedShare, buf...)
+	}
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the ProofV2 object
+func (p *ProofV2) SizeSSZ() (size int) {
+	size = 144
+
+	// Field (1) 'EncryptedShare'
+	size += len(p.EncryptedShare)
+
+	return
+}
+
+// HashTreeRoot ssz hashes the ProofV2 object
+func (p *ProofV2) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(p)
+}
+
+// HashTreeRootWith ssz hashes the ProofV2 object with a hasher
+func (p *ProofV2) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'ValidatorPubKey'
+	if size := len(p.ValidatorPubKey); size != 48 {
+		err = ssz.ErrBytesLengthFn("ProofV2.ValidatorPubKey", size, 48)
+		return
+	}
+	hh.PutBytes(p.ValidatorPubKey)
+
+	// Field (1) 'EncryptedShare'
+	{
+		elemIndx := hh.Index()
+		byteLen := uint64(len(p.EncryptedShare))
+		if byteLen > 512 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		hh.Append(p.EncryptedShare)
+		hh.MerkleizeWithMixin(elemIndx, byteLen, (512+31)/32)
+	}
+
+	// Field (2) 'SharePubKey'
+	if size := len(p.SharePubKey); size != 48 {
+		err = ssz.ErrBytesLengthFn("ProofV2.SharePubKey", size, 48)
+		return
+	}
+	hh.PutBytes(p.SharePubKey)
+
+	// Field (3) 'Owner'
+	hh.PutBytes(p.Owner[:])
+
+	// Field (4) 'RequestID'
+	hh.PutBytes(p.RequestID[:])
+
+	hh.Merkleize(indx)
+	return
+}
+
+// GetTree ssz hashes the ProofV2 object
+func (p *ProofV2) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(p)
+}
+
+// MarshalSSZ ssz marshals the ProofV1 object
+func (p *ProofV1) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(p)
+}
+
+// MarshalSSZTo ssz marshals the ProofV1 object to a target array
+func (p *ProofV1) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+	offset := int(120)
+
+	// Field (0) 'ValidatorPubKey'
+	if size := len(p.ValidatorPubKey); size != 48 {
+		err = ssz.ErrBytesLengthFn("ProofV1.ValidatorPubKey", size, 48)
+		return
+	}
+	dst = append(dst, p.ValidatorPubKey...)
+
+	// Offset (1) 'EncryptedShare'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(p.EncryptedShare)
+
+	// Field (2) 'SharePubKey'
+	if size := len(p.SharePubKey); size != 48 {
+		err = ssz.ErrBytesLengthFn("ProofV1.SharePubKey", size, 48)
+		return
+	}
+	dst = append(dst, p.SharePubKey...)
+
+	// Field (3) 'Owner'
+	dst = append(dst, p.Owner[:]...)
+
+	// Field (1) 'EncryptedShare'
+	if size := len(p.EncryptedShare); size > 512 {
+		err = ssz.ErrBytesLengthFn("ProofV1.EncryptedShare", size, 512)
+		return
+	}
+	dst = append(dst, p.EncryptedShare...)
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the ProofV1 object
+func (p *ProofV1) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size < 120 {
+		return ssz.ErrSize
+	}
+
+	tail := buf
+	var o1 uint64
+
+	// Field (0) 'ValidatorPubKey'
+	if cap(p.ValidatorPubKey) == 0 {
+		p.ValidatorPubKey = make([]byte, 0, len(buf[0:48]))
+	}
+	p.ValidatorPubKey = append(p.ValidatorPubKey, buf[0:48]...)
+
+	// Offset (1) 'EncryptedShare'
+	if o1 = ssz.ReadOffset(buf[48:52]); o1 > size {
+		return ssz.ErrOffset
+	}
+
+	if o1 < 120 {
+		return ssz.ErrInvalidVariableOffset
+	}
+
+	// Field (2) 'SharePubKey'
+	if cap(p.SharePubKey) == 0 {
+		p.SharePubKey = make([]byte, 0, len(buf[52:100]))
+	}
+	p.SharePubKey = append(p.SharePubKey, buf[52:100]...)
+
+	// Field (3) 'Owner'
+	copy(p.Owner[:], buf[100:120])
+
+	// Field (1) 'EncryptedShare'
+	{
+		buf = tail[o1:]
+		if len(buf) > 512 {
+			return ssz.ErrBytesLength
+		}
+		if cap(p.EncryptedShare) == 0 {
+			p.EncryptedShare = make([]byte, 0, len(buf))
+		}
+		p.EncryptedShare = append(p.EncryptedShare, buf...)
+	}
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the ProofV1 object
+func (p *ProofV1) SizeSSZ() (size int) {
+	size = 120
+
+	// Field (1) 'EncryptedShare'
+	size += len(p.EncryptedShare)
+
+	return
+}
+
+// HashTreeRoot ssz hashes the ProofV1 object
+func (p *ProofV1) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(p)
+}
+
+// HashTreeRootWith ssz hashes the ProofV1 object with a hasher
+func (p *ProofV1) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'ValidatorPubKey'
+	if size := len(p.ValidatorPubKey); size != 48 {
+		err = ssz.ErrBytesLengthFn("ProofV1.ValidatorPubKey", size, 48)
+		return
+	}
+	hh.PutBytes(p.ValidatorPubKey)
+
+	// Field (1) 'EncryptedShare'
+	{
+		elemIndx := hh.Index()
+		byteLen := uint64(len(p.EncryptedShare))
+		if byteLen > 512 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		hh.Append(p.EncryptedShare)
+		hh.MerkleizeWithMixin(elemIndx, byteLen, (512+31)/32)
+	}
+
+	// Field (2) 'SharePubKey'
+	if size := len(p.SharePubKey); size != 48 {
+		err = ssz.ErrBytesLengthFn("ProofV1.SharePubKey", size, 48)
+		return
+	}
+	hh.PutBytes(p.SharePubKey)
+
+	// Field (3) 'Owner'
+	hh.PutBytes(p.Owner[:])
+
+	hh.Merkleize(indx)
+	return
+}
+
+// GetTree ssz hashes the ProofV1 object
+func (p *ProofV1) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(p)
+}
+
+// MarshalSSZ ssz marshals the Lineage object
+func (l *Lineage) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(l)
+}
+
+// MarshalSSZTo ssz marshals the Lineage object to a target array
+func (l *Lineage) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+
+	// Field (0) 'ValidatorPubKey'
+	if size := len(l.ValidatorPubKey); size != 48 {
+		err = ssz.ErrBytesLengthFn("Lineage.ValidatorPubKey", size, 48)
+		return
+	}
+	dst = append(dst, l.ValidatorPubKey...)
+
+	// Field (1) 'RequestID'
+	dst = append(dst, l.RequestID[:]...)
+
+	// Field (2) 'PriorProofsRoot'
+	dst = append(dst, l.PriorProofsRoot[:]...)
+
+	// Field (3) 'SignedReshareRoot'
+	dst = append(dst, l.SignedReshareRoot[:]...)
+
+	// Field (4) 'ProofsRoot'
+	dst = append(dst, l.ProofsRoot[:]...)
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the Lineage object
+func (l *Lineage) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size != 168 {
+		return ssz.ErrSize
+	}
+
+	// Field (0) 'ValidatorPubKey'
+	if cap(l.ValidatorPubKey) == 0 {
+		l.ValidatorPubKey = make([]byte, 0, len(buf[0:48]))
+	}
+	l.ValidatorPubKey = append(l.ValidatorPubKey, buf[0:48]...)
+
+	// Field (1) 'RequestID'
+	copy(l.RequestID[:], buf[48:72])
+
+	// Field (2) 'PriorProofsRoot'
+	copy(l.PriorProofsRoot[:], buf[72:104])
+
+	// Field (3) 'SignedReshareRoot'
+	copy(l.SignedReshareRoot[:], buf[104:136])
+
+	// Field (4) 'ProofsRoot'
+	copy(l.ProofsRoot[:], buf[136:168])
+
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the Lineage object
+func (l *Lineage) SizeSSZ() (size int) {
+	size = 168
+	return
+}
+
+// HashTreeRoot ssz hashes the Lineage object
+func (l *Lineage) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(l)
+}
+
+// HashTreeRootWith ssz hashes the Lineage object with a hasher
+func (l *Lineage) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'ValidatorPubKey'
+	if size := len(l.ValidatorPubKey); size != 48 {
+		err = ssz.ErrBytesLengthFn("Lineage.ValidatorPubKey", size, 48)
+		return
+	}
+	hh.PutBytes(l.ValidatorPubKey)
+
+	// Field (1) 'RequestID'
+	hh.PutBytes(l.RequestID[:])
+
+	// Field (2) 'PriorProofsRoot'
+	hh.PutBytes(l.PriorProofsRoot[:])
+
+	// Field (3) 'SignedReshareRoot'
+	hh.PutBytes(l.SignedReshareRoot[:])
+
+	// Field (4) 'ProofsRoot'
+	hh.PutBytes(l.ProofsRoot[:])
+
+	hh.Merkleize(indx)
+	return
+}
+
+// GetTree ssz hashes the Lineage object
+func (l *Lineage) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(l)
+}
+
+// MarshalSSZ ssz marshals the OperatorState object
+func (o *OperatorState) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(o)
+}
+
+// MarshalSSZTo ssz marshals the OperatorState object to a target array
+func (o *OperatorState) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+	offset := int(20)
+
+	// Field (0) 'Version'
+	dst = ssz.MarshalUint64(dst, o.Version)
+
+	// Offset (1) 'Operator'
+	dst = ssz.WriteOffset(dst, offset)
+	if o.Operator == nil {
+		o.Operator = new(Operator)
+	}
+	offset += o.Operator.SizeSSZ()
+
+	// Offset (2) 'ProcessedRequestIDs'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(o.ProcessedRequestIDs) * 24
+
+	// Offset (3) 'Shares'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(o.Shares) * 152
+
+	// Field (1) 'Operator'
+	if dst, err = o.Operator.MarshalSSZTo(dst); err != nil {
+		return
+	}
+
+	// Field (2) 'ProcessedRequestIDs'
+	if size := len(o.ProcessedRequestIDs); size > 1048576 {
+		err = ssz.ErrListTooBigFn("OperatorState.ProcessedRequestIDs", size, 1048576)
+		return
+	}
+	for ii := 0; ii < len(o.ProcessedRequestIDs); ii++ {
+		if size := len(o.ProcessedRequestIDs[ii]); size != 24 {
+			err = ssz.ErrBytesLengthFn("OperatorState.ProcessedRequestIDs[ii]", size, 24)
+			return
+		}
+		dst = append(dst, o.ProcessedRequestIDs[ii]...)
+	}
+
+	// Field (3) 'Shares'
+	if size := len(o.Shares); size > 1048576 {
+		err = ssz.ErrListTooBigFn("OperatorState.Shares", size, 1048576)
+		return
+	}
+	for ii := 0; ii < len(o.Shares); ii++ {
+		if dst, err = o.Shares[ii].MarshalSSZTo(dst); err != nil {
+			return
+		}
+	}
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the OperatorState object
+func (o *OperatorState) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size < 20 {
+		return ssz.ErrSize
+	}
+
+	tail := buf
+	var o1, o2, o3 uint64
+
+	// Field (0) 'Version'
+	o.Version = ssz.UnmarshallUint64(buf[0:8])
+
+	// Offset (1) 'Operator'
+	if o1 = ssz.ReadOffset(buf[8:12]); o1 > size {
+		return ssz.ErrOffset
+	}
+
+	if o1 < 20 {
+		return ssz.ErrInvalidVariableOffset
+	}
+
+	// Offset (2) 'ProcessedRequestIDs'
+	if o2 = ssz.ReadOffset(buf[12:16]); o2 > size || o1 > o2 {
+		return ssz.ErrOffset
+	}
+
+	// Offset (3) 'Shares'
+	if o3 = ssz.ReadOffset(buf[16:20]); o3 > size || o2 > o3 {
+		return ssz.ErrOffset
+	}
+
+	// Field (1) 'Operator'
+	{
+		buf = tail[o1:o2]
+		if o.Operator == nil {
+			o.Operator = new(Operator)
+		}
+		if err = o.Operator.UnmarshalSSZ(buf); err != nil {
+			return err
+		}
+	}
+
+	// Field (2) 'ProcessedRequestIDs'
+	{
+		buf = tail[o2:o3]
+		num, err := ssz.DivideInt2(len(buf), 24, 1048576)
+		if err != nil {
+			return err
+		}
+		o.ProcessedRequestIDs = make([][]byte, num)
+		for ii := 0; ii < num; ii++ {
+			if cap(o.ProcessedRequestIDs[ii]) == 0 {
+				o.ProcessedRequestIDs[ii] = make([]byte, 0, len(buf[ii*24:(ii+1)*24]))
+			}
+			o.ProcessedRequestIDs[ii] = append(o.ProcessedRequestIDs[ii], buf[ii*24:(ii+1)*24]...)
+		}
+	}
+
+	// Field (3) 'Shares'
+	{
+		buf = tail[o3:]
+		num, err := ssz.DivideInt2(len(buf), 152, 1048576)
+		if err != nil {
+			return err
+		}
+		o.Shares = make([]*StoredShare, num)
+		for ii := 0; ii < num; ii++ {
+			if o.Shares[ii] == nil {
+				o.Shares[ii] = new(StoredShare)
+			}
+			if err = o.Shares[ii].UnmarshalSSZ(buf[ii*152 : (ii+1)*152]); err != nil {
+				return err
+			}
+		}
+	}
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the OperatorState object
+func (o *OperatorState) SizeSSZ() (size int) {
+	size = 20
+
+	// Field (1) 'Operator'
+	if o.Operator == nil {
+		o.Operator = new(Operator)
+	}
+	size += o.Operator.SizeSSZ()
+
+	// Field (2) 'ProcessedRequestIDs'
+	size += len(o.ProcessedRequestIDs) * 24
+
+	// Field (3) 'Shares'
+	size += len(o.Shares) * 152
+
+	return
+}
+
+// HashTreeRoot ssz hashes the OperatorState object
+func (o *OperatorState) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(o)
+}
+
+// HashTreeRootWith ssz hashes the OperatorState object with a hasher
+func (o *OperatorState) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'Version'
+	hh.PutUint64(o.Version)
+
+	// Field (1) 'Operator'
+	if err = o.Operator.HashTreeRootWith(hh); err != nil {
+		return
+	}
+
+	// Field (2) 'ProcessedRequestIDs'
+	{
+		if size := len(o.ProcessedRequestIDs); size > 1048576 {
+			err = ssz.ErrListTooBigFn("OperatorState.ProcessedRequestIDs", size, 1048576)
+			return
+		}
+		subIndx := hh.Index()
+		for _, i := range o.ProcessedRequestIDs {
+			if len(i) != 24 {
+				err = ssz.ErrBytesLength
+				return
+			}
+			hh.PutBytes(i)
+		}
+		numItems := uint64(len(o.ProcessedRequestIDs))
+		hh.MerkleizeWithMixin(subIndx, numItems, 1048576)
+	}
+
+	// Field (3) 'Shares'
+	{
+		subIndx := hh.Index()
+		num := uint64(len(o.Shares))
+		if num > 1048576 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		for _, elem := range o.Shares {
+			if err = elem.HashTreeRootWith(hh); err != nil {
+				return
+			}
+		}
+		hh.MerkleizeWithMixin(subIndx, num, 1048576)
+	}
+
+	hh.Merkleize(indx)
+	return
+}
+
+// GetTree ssz hashes the OperatorState object
+func (o *OperatorState) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(o)
+}
+
+// MarshalSSZ ssz marshals the EncryptedOperatorState object
+func (e *EncryptedOperatorState) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(e)
+}
+
+// MarshalSSZTo ssz marshals the EncryptedOperatorState object to a target array
+func (e *EncryptedOperatorState) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+	offset := int(8)
+
+	// Offset (0) 'KDF'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(e.KDF)
+
+	// Offset (1) 'Ciphertext'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(e.Ciphertext)
+
+	// Field (0) 'KDF'
+	if size := len(e.KDF); size > 64 {
+		err = ssz.ErrBytesLengthFn("EncryptedOperatorState.KDF", size, 64)
+		return
+	}
+	dst = append(dst, e.KDF...)
+
+	// Field (1) 'Ciphertext'
+	if size := len(e.Ciphertext); size > 268435456 {
+		err = ssz.ErrBytesLengthFn("EncryptedOperatorState.Ciphertext", size, 268435456)
+		return
+	}
+	dst = append(dst, e.Ciphertext...)
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the EncryptedOperatorState object
+func (e *EncryptedOperatorState) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size < 8 {
+		return ssz.ErrSize
+	}
+
+	tail := buf
+	var o0, o1 uint64
+
+	// Offset (0) 'KDF'
+	if o0 = ssz.ReadOffset(buf[0:4]); o0 > size {
+		return ssz.ErrOffset
+	}
+
+	if o0 < 8 {
+		return ssz.ErrInvalidVariableOffset
+	}
+
+	// Offset (1) 'Ciphertext'
+	if o1 = ssz.ReadOffset(buf[4:8]); o1 > size || o0 > o1 {
+		return ssz.ErrOffset
+	}
+
+	// Field (0) 'KDF'
+	{
+		buf = tail[o0:o1]
+		if len(buf) > 64 {
+			return ssz.ErrBytesLength
+		}
+		if cap(e.KDF) == 0 {
+			e.KDF = make([]byte, 0, len(buf))
+		}
+		e.KDF = append(e.KDF, buf...)
+	}
+
+	// Field (1) 'Ciphertext'
+	{
+		buf = tail[o1:]
+		if len(buf) > 268435456 {
+			return ssz.ErrBytesLength
+		}
+		if cap(e.Ciphertext) == 0 {
+			e.Ciphertext = make([]byte, 0, len(buf))
+		}
+		e.Ciphertext = append(e.Ciphertext, buf...)
+	}
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the EncryptedOperatorState object
+func (e *EncryptedOperatorState) SizeSSZ() (size int) {
+	size = 8
+
+	// Field (0) 'KDF'
+	size += len(e.KDF)
+
+	// Field (1) 'Ciphertext'
+	size += len(e.Ciphertext)
+
+	return
+}
+
+// HashTreeRoot ssz hashes the EncryptedOperatorState object
+func (e *EncryptedOperatorState) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(e)
+}
+
+// HashTreeRootWith ssz hashes the EncryptedOperatorState object with a hasher
+func (e *EncryptedOperatorState) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'KDF'
+	{
+		elemIndx := hh.Index()
+		byteLen := uint64(len(e.KDF))
+		if byteLen > 64 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		hh.Append(e.KDF)
+		hh.MerkleizeWithMixin(elemIndx, byteLen, (64+31)/32)
+	}
+
+	// Field (1) 'Ciphertext'
+	{
+		elemIndx := hh.Index()
+		byteLen := uint64(len(e.Ciphertext))
+		if byteLen > 268435456 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		hh.Append(e.Ciphertext)
+		hh.MerkleizeWithMixin(elemIndx, byteLen, (268435456+31)/32)
+	}
+
+	hh.Merkleize(indx)
+	return
+}
+
+// GetTree ssz hashes the EncryptedOperatorState object
+func (e *EncryptedOperatorState) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(e)
+}
+
+// MarshalSSZ ssz marshals the StoredShare object
+func (s *StoredShare) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(s)
+}
+
+// MarshalSSZTo ssz marshals the StoredShare object to a target array
+func (s *StoredShare) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+
+	// Field (0) 'ValidatorPubKey'
+	if size := len(s.ValidatorPubKey); size != 48 {
+		err = ssz.ErrBytesLengthFn("StoredShare.ValidatorPubKey", size, 48)
+		return
+	}
+	dst = append(dst, s.ValidatorPubKey...)
+
+	// Field (1) 'SharePubKey'
+	if size := len(s.SharePubKey); size != 48 {
+		err = ssz.ErrBytesLengthFn("StoredShare.SharePubKey", size, 48)
+		return
+	}
+	dst = append(dst, s.SharePubKey...)
+
+	// Field (2) 'RequestID'
+	dst = append(dst, s.RequestID[:]...)
+
+	// Field (3) 'ResultRoot'
+	dst = append(dst, s.ResultRoot[:]...)
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the StoredShare object
+func (s *StoredShare) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size != 152 {
+		return ssz.ErrSize
+	}
+
+	// Field (0) 'ValidatorPubKey'
+	if cap(s.ValidatorPubKey) == 0 {
+		s.ValidatorPubKey = make([]byte, 0, len(buf[0:48]))
+	}
+	s.ValidatorPubKey = append(s.ValidatorPubKey, buf[0:48]...)
+
+	// Field (1) 'SharePubKey'
+	if cap(s.SharePubKey) == 0 {
+		s.SharePubKey = make([]byte, 0, len(buf[48:96]))
+	}
+	s.SharePubKey = append(s.SharePubKey, buf[48:96]...)
+
+	// Field (2) 'RequestID'
+	copy(s.RequestID[:], buf[96:120])
+
+	// Field (3) 'ResultRoot'
+	copy(s.ResultRoot[:], buf[120:152])
+
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the StoredShare object
+func (s *StoredShare) SizeSSZ() (size int) {
+	size = 152
+	return
+}
+
+// HashTreeRoot ssz hashes the StoredShare object
+func (s *StoredShare) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(s)
+}
+
+// HashTreeRootWith ssz hashes the StoredShare object with a hasher
+func (s *StoredShare) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'ValidatorPubKey'
+	if size := len(s.ValidatorPubKey); size != 48 {
+		err = ssz.ErrBytesLengthFn("StoredShare.ValidatorPubKey", size, 48)
+		return
+	}
+	hh.PutBytes(s.ValidatorPubKey)
+
+	// Field (1) 'SharePubKey'
+	if size := len(s.SharePubKey); size != 48 {
+		err = ssz.ErrBytesLengthFn("StoredShare.SharePubKey", size, 48)
+		return
+	}
+	hh.PutBytes(s.SharePubKey)
+
+	// Field (2) 'RequestID'
+	hh.PutBytes(s.RequestID[:])
+
+	// Field (3) 'ResultRoot'
+	hh.PutBytes(s.ResultRoot[:])
+
+	hh.Merkleize(indx)
+	return
+}
+
+// GetTree ssz hashes the StoredShare object
+func (s *StoredShare) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(s)
+}
+
+// MarshalSSZ ssz marshals the CeremonyParams object
+func (c *CeremonyParams) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(c)
+}
+
+// MarshalSSZTo ssz marshals the CeremonyParams object to a target array
+func (c *CeremonyParams) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+	offset := int(24)
+
+	// Offset (0) 'OperatorIDs'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(c.OperatorIDs) * 8
+
+	// Field (1) 'T'
+	dst = ssz.MarshalUint64(dst, c.T)
+
+	// Field (2) 'Fork'
+	dst = append(dst, c.Fork[:]...)
+
+	// Field (3) 'Amount'
+	dst = ssz.MarshalUint64(dst, c.Amount)
+
+	// Field (0) 'OperatorIDs'
+	if size := len(c.OperatorIDs); size > 13 {
+		err = ssz.ErrListTooBigFn("CeremonyParams.OperatorIDs", size, 13)
+		return
+	}
+	for ii := 0; ii < len(c.OperatorIDs); ii++ {
+		dst = ssz.MarshalUint64(dst, c.OperatorIDs[ii])
+	}
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the CeremonyParams object
+func (c *CeremonyParams) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size < 24 {
+		return ssz.ErrSize
+	}
+
+	tail := buf
+	var o0 uint64
+
+	// Offset (0) 'OperatorIDs'
+	if o0 = ssz.ReadOffset(buf[0:4]); o0 > size {
+		return ssz.ErrOffset
+	}
+
+	if o0 < 24 {
+		return ssz.ErrInvalidVariableOffset
+	}
+
+	// Field (1) 'T'
+	c.T = ssz.UnmarshallUint64(buf[4:12])
+
+	// Field (2) 'Fork'
+	copy(c.Fork[:], buf[12:16])
+
+	// Field (3) 'Amount'
+	c.Amount = ssz.UnmarshallUint64(buf[16:24])
+
+	// Field (0) 'OperatorIDs'
+	{
+		buf = tail[o0:]
+		num, err := ssz.DivideInt2(len(buf), 8, 13)
+		if err != nil {
+			return err
+		}
+		c.OperatorIDs = ssz.ExtendUint64(c.OperatorIDs, num)
+		for ii := 0; ii < num; ii++ {
+			c.OperatorIDs[ii] = ssz.UnmarshallUint64(buf[ii*8 : (ii+1)*8])
+		}
+	}
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the CeremonyParams object
+func (c *CeremonyParams) SizeSSZ() (size int) {
+	size = 24
+
+	// Field (0) 'OperatorIDs'
+	size += len(c.OperatorIDs) * 8
+
+	return
+}
+
+// HashTreeRoot ssz hashes the CeremonyParams object
+func (c *CeremonyParams) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(c)
+}
+
+// HashTreeRootWith ssz hashes the CeremonyParams object with a hasher
+func (c *CeremonyParams) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'OperatorIDs'
+	{
+		if size := len(c.OperatorIDs); size > 13 {
+			err = ssz.ErrListTooBigFn("CeremonyParams.OperatorIDs", size, 13)
+			return
+		}
+		subIndx := hh.Index()
+		for _, i := range c.OperatorIDs {
+			hh.AppendUint64(i)
+		}
+		hh.FillUpTo32()
+		numItems := uint64(len(c.OperatorIDs))
+		hh.MerkleizeWithMixin(subIndx, numItems, ssz.CalculateLimit(13, numItems, 8))
+	}
+
+	// Field (1) 'T'
+	hh.PutUint64(c.T)
+
+	// Field (2) 'Fork'
+	hh.PutBytes(c.Fork[:])
+
+	// Field (3) 'Amount'
+	hh.PutUint64(c.Amount)
+
+	hh.Merkleize(indx)
+	return
+}
+
+// GetTree ssz hashes the CeremonyParams object
+func (c *CeremonyParams) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(c)
+}
+
+// MarshalSSZ ssz marshals the OwnerNonce object
+func (o *OwnerNonce) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(o)
+}
+
+// MarshalSSZTo ssz marshals the OwnerNonce object to a target array
+func (o *OwnerNonce) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+
+	// Field (0) 'Domain'
+	dst = append(dst, o.Domain[:]...)
+
+	// Field (1) 'Owner'
+	dst = append(dst, o.Owner[:]...)
+
+	// Field (2) 'Nonce'
+	dst = ssz.MarshalUint64(dst, o.Nonce)
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the OwnerNonce object
+func (o *OwnerNonce) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size != 32 {
+		return ssz.ErrSize
+	}
+
+	// Field (0) 'Domain'
+	copy(o.Domain[:], buf[0:4])
+
+	// Field (1) 'Owner'
+	copy(o.Owner[:], buf[4:24])
+
+	// Field (2) 'Nonce'
+	o.Nonce = ssz.UnmarshallUint64(buf[24:32])
+
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the OwnerNonce object
+func (o *OwnerNonce) SizeSSZ() (size int) {
+	size = 32
+	return
+}
+
+// HashTreeRoot ssz hashes the OwnerNonce object
+func (o *OwnerNonce) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(o)
+}
+
+// HashTreeRootWith ssz hashes the OwnerNonce object with a hasher
+func (o *OwnerNonce) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'Domain'
+	hh.PutBytes(o.Domain[:])
+
+	// Field (1) 'Owner'
+	hh.PutBytes(o.Owner[:])
+
+	// Field (2) 'Nonce'
+	hh.PutUint64(o.Nonce)
+
+	hh.Merkleize(indx)
+	return
+}
+
+// GetTree ssz hashes the OwnerNonce object
+func (o *OwnerNonce) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(o)
+}
+
+// MarshalSSZ ssz marshals the SigningData object
+func (s *SigningData) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(s)
+}
+
+// MarshalSSZTo ssz marshals the SigningData object to a target array
+func (s *SigningData) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+
+	// Field (0) 'ObjectRoot'
+	dst = append(dst, s.ObjectRoot[:]...)
+
+	// Field (1) 'Domain'
+	dst = append(dst, s.Domain[:]...)
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the SigningData object
+func (s *SigningData) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size != 36 {
+		return ssz.ErrSize
+	}
+
+	// Field (0) 'ObjectRoot'
+	copy(s.ObjectRoot[:], buf[0:32])
+
+	// Field (1) 'Domain'
+	copy(s.Domain[:], buf[32:36])
+
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the SigningData object
+func (s *SigningData) SizeSSZ() (size int) {
+	size = 36
+	return
+}
+
+// HashTreeRoot ssz hashes the SigningData object
+func (s *SigningData) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(s)
+}
+
+// HashTreeRootWith ssz hashes the SigningData object with a hasher
+func (s *SigningData) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'ObjectRoot'
+	hh.PutBytes(s.ObjectRoot[:])
+
+	// Field (1) 'Domain'
+	hh.PutBytes(s.Domain[:])
+
+	hh.Merkleize(indx)
+	return
+}
+
+// GetTree ssz hashes the SigningData object
+func (s *SigningData) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(s)
+}
+
+// MarshalSSZ ssz marshals the SignedProof object
+func (s *SignedProof) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(s)
+}
+
+// MarshalSSZTo ssz marshals the SignedProof object to a target array
+func (s *SignedProof) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+	offset := int(260)
+
+	// Offset (0) 'Proof'
+	dst = ssz.WriteOffset(dst, offset)
+	if s.Proof == nil {
+		s.Proof = new(Proof)
+	}
+	offset += s.Proof.SizeSSZ()
+
+	// Field (1) 'Signature'
+	if size := len(s.Signature); size != 256 {
+		err = ssz.ErrBytesLengthFn("SignedProof.Signature", size, 256)
+		return
+	}
+	dst = append(dst, s.Signature...)
+
+	// Field (0) 'Proof'
+	if dst, err = s.Proof.MarshalSSZTo(dst); err != nil {
+		return
+	}
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the SignedProof object
+func (s *SignedProof) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size < 260 {
+		return ssz.ErrSize
+	}
+
+	tail := buf
+	var o0 uint64
+
+	// Offset (0) 'Proof'
+	if o0 = ssz.ReadOffset(buf[0:4]); o0 > size {
+		return ssz.ErrOffset
+	}
+
+	if o0 < 260 {
+		return ssz.ErrInvalidVariableOffset
+	}
+
+	// Field (1) 'Signature'
+	if cap(s.Signature) == 0 {
+		s.Signature = make([]byte, 0, len(buf[4:260]))
+	}
+	s.Signature = append(s.Signature, buf[4:260]...)
+
+	// Field (0) 'Proof'
+	{
+		buf = tail[o0:]
+		if s.Proof == nil {
+			s.Proof = new(Proof)
+		}
+		if err = s.Proof.UnmarshalSSZ(buf); err != nil {
+			return err
+		}
+	}
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the SignedProof object
+func (s *SignedProof) SizeSSZ() (size int) {
+	size = 260
+
+	// Field (0) 'Proof'
+	if s.Proof == nil {
+		s.Proof = new(Proof)
+	}
+	size += s.Proof.SizeSSZ()
+
+	return
+}
+
+// HashTreeRoot ssz hashes the SignedProof object
+func (s *SignedProof) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(s)
+}
+
+// HashTreeRootWith ssz hashes the SignedProof object with a hasher
+func (s *SignedProof) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'Proof'
+	if err = s.Proof.HashTreeRootWith(hh); err != nil {
+		return
+	}
+
+	// Field (1) 'Signature'
+	if size := len(s.Signature); size != 256 {
+		err = ssz.ErrBytesLengthFn("SignedProof.Signature", size, 256)
+		return
+	}
+	hh.PutBytes(s.Signature)
+
+	hh.Merkleize(indx)
+	return
+}
+
+// GetTree ssz hashes the SignedProof object
+func (s *SignedProof) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(s)
+}
+
+// MarshalSSZ ssz marshals the VersionedProof object
+func (v *VersionedProof) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(v)
+}
+
+// MarshalSSZTo ssz marshals the VersionedProof object to a target array
+func (v *VersionedProof) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+	offset := int(5)
+
+	// Field (0) 'Version'
+	dst = ssz.MarshalUint8(dst, v.Version)
+
+	// Offset (1) 'Proof'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(v.Proof)
+
+	// Field (1) 'Proof'
+	if size := len(v.Proof); size > 1316 {
+		err = ssz.ErrBytesLengthFn("VersionedProof.Proof", size, 1316)
+		return
+	}
+	dst = append(dst, v.Proof...)
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the VersionedProof object
+func (v *VersionedProof) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size < 5 {
+		return ssz.ErrSize
+	}
+
+	tail := buf
+	var o1 uint64
+
+	// Field (0) 'Version'
+	v.Version = ssz.UnmarshallUint8(buf[0:1])
+
+	// Offset (1) 'Proof'
+	if o1 = ssz.ReadOffset(buf[1:5]); o1 > size {
+		return ssz.ErrOffset
+	}
+
+	if o1 < 5 {
+		return ssz.ErrInvalidVariableOffset
+	}
+
+	// Field (1) 'Proof'
+	{
+		buf = tail[o1:]
+		if len(buf) > 1316 {
+			return ssz.ErrBytesLength
+		}
+		if cap(v.Proof) == 0 {
+			v.Proof = make([]byte, 0, len(buf))
+		}
+		v.Proof = append(v.Proof, buf...)
+	}
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the VersionedProof object
+func (v *VersionedProof) SizeSSZ() (size int) {
+	size = 5
+
+	// Field (1) 'Proof'
+	size += len(v.Proof)
+
+	return
+}
+
+// HashTreeRoot ssz hashes the VersionedProof object
+func (v *VersionedProof) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(v)
+}
+
+// HashTreeRootWith ssz hashes the VersionedProof object with a hasher
+func (v *VersionedProof) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'Version'
+	hh.PutUint8(v.Version)
+
+	// Field (1) 'Proof'
+	{
+		elemIndx := hh.Index()
+		byteLen := uint64(len(v.Proof))
+		if byteLen > 1316 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		hh.Append(v.Proof)
+		hh.MerkleizeWithMixin(elemIndx, byteLen, (1316+31)/32)
+	}
+
+	hh.Merkleize(indx)
+	return
+}
+
+// GetTree ssz hashes the VersionedProof object
+func (v *VersionedProof) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(v)
+}
+
+// MarshalSSZ ssz marshals the VersionedInit object
+func (v *VersionedInit) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(v)
+}
+
+// MarshalSSZTo ssz marshals the VersionedInit object to a target array
+func (v *VersionedInit) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+	offset := int(5)
+
+	// Field (0) 'Version'
+	dst = ssz.MarshalUint8(dst, v.Version)
+
+	// Offset (1) 'Init'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(v.Init)
+
+	// Field (1) 'Init'
+	if size := len(v.Init); size > 131072 {
+		err = ssz.ErrBytesLengthFn("VersionedInit.Init", size, 131072)
+		return
+	}
+	dst = append(dst, v.Init...)
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the VersionedInit object
+func (v *VersionedInit) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size < 5 {
+		return ssz.ErrSize
+	}
+
+	tail := buf
+	var o1 uint64
+
+	// Field (0) 'Version'
+	v.Version = ssz.UnmarshallUint8(buf[0:1])
+
+	// Offset (1) 'Init'
+	if o1 = ssz.ReadOffset(buf[1:5]); o1 > size {
+		return ssz.ErrOffset
+	}
+
+	if o1 < 5 {
+		return ssz.ErrInvalidVariableOffset
+	}
+
+	// Field (1) 'Init'
+	{
+		buf = tail[o1:]
+		if len(buf) > 131072 {
+			return ssz.ErrBytesLength
+		}
+		if cap(v.Init) == 0 {
+			v.Init = make([]byte, 0, len(buf))
+		}
+		v.Init = append(v.Init, buf...)
+	}
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the VersionedInit object
+func (v *VersionedInit) SizeSSZ() (size int) {
+	size = 5
+
+	// Field (1) 'Init'
+	size += len(v.Init)
+
+	return
+}
+
+// HashTreeRoot ssz hashes the VersionedInit object
+func (v *VersionedInit) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(v)
+}
+
+// HashTreeRootWith ssz hashes the VersionedInit object with a hasher
+func (v *VersionedInit) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'Version'
+	hh.PutUint8(v.Version)
+
+	// Field (1) 'Init'
+	{
+		elemIndx := hh.Index()
+		byteLen := uint64(len(v.Init))
+		if byteLen > 131072 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		hh.Append(v.Init)
+		hh.MerkleizeWithMixin(elemIndx, byteLen, (131072+31)/32)
+	}
+
+	hh.Merkleize(indx)
+	return
+}
+
+// GetTree ssz hashes the VersionedInit object
+func (v *VersionedInit) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(v)
+}
+
+// MarshalSSZ ssz marshals the VersionedReshare object
+func (v *VersionedReshare) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(v)
+}
+
+// MarshalSSZTo ssz marshals the VersionedReshare object to a target array
+func (v *VersionedReshare) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+	offset := int(5)
+
+	// Field (0) 'Version'
+	dst = ssz.MarshalUint8(dst, v.Version)
+
+	// Offset (1) 'Reshare'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(v.Reshare)
+
+	// Field (1) 'Reshare'
+	if size := len(v.Reshare); size > 262144 {
+		err = ssz.ErrBytesLengthFn("VersionedReshare.Reshare", size, 262144)
+		return
+	}
+	dst = append(dst, v.Reshare...)
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the VersionedReshare object
+func (v *VersionedReshare) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size < 5 {
+		return ssz.ErrSize
+	}
+
+	tail := buf
+	var o1 uint64
+
+	// Field (0) 'Version'
+	v.Version = ssz.UnmarshallUint8(buf[0:1])
+
+	// Offset (1) 'Reshare'
+	if o1 = ssz.ReadOffset(buf[1:5]); o1 > size {
+		return ssz.ErrOffset
+	}
+
+	if o1 < 5 {
+		return ssz.ErrInvalidVariableOffset
+	}
+
+	// Field (1) 'Reshare'
+	{
+		buf = tail[o1:]
+		if len(buf) > 262144 {
+			return ssz.ErrBytesLength
+		}
+		if cap(v.Reshare) == 0 {
+			v.Reshare = make([]byte, 0, len(buf))
+		}
+		v.Reshare = append(v.Reshare, buf...)
+	}
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the VersionedReshare object
+func (v *VersionedReshare) SizeSSZ() (size int) {
+	size = 5
+
+	// Field (1) 'Reshare'
+	size += len(v.Reshare)
+
+	return
+}
+
+// HashTreeRoot ssz hashes the VersionedReshare object
+func (v *VersionedReshare) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(v)
+}
+
+// HashTreeRootWith ssz hashes the VersionedReshare object with a hasher
+func (v *VersionedReshare) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'Version'
+	hh.PutUint8(v.Version)
+
+	// Field (1) 'Reshare'
+	{
+		elemIndx := hh.Index()
+		byteLen := uint64(len(v.Reshare))
+		if byteLen > 262144 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		hh.Append(v.Reshare)
+		hh.MerkleizeWithMixin(elemIndx, byteLen, (262144+31)/32)
+	}
+
+	hh.Merkleize(indx)
+	return
+}
+
+// GetTree ssz hashes the VersionedReshare object
+func (v *VersionedReshare) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(v)
+}
+
+// MarshalSSZ ssz marshals the VersionedResign object
+func (v *VersionedResign) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(v)
+}
+
+// MarshalSSZTo ssz marshals the VersionedResign object to a target array
+func (v *VersionedResign) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+	offset := int(5)
+
+	// Field (0) 'Version'
+	dst = ssz.MarshalUint8(dst, v.Version)
+
+	// Offset (1) 'Resign'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(v.Resign)
+
+	// Field (1) 'Resign'
+	if size := len(v.Resign); size > 256 {
+		err = ssz.ErrBytesLengthFn("VersionedResign.Resign", size, 256)
+		return
+	}
+	dst = append(dst, v.Resign...)
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the VersionedResign object
+func (v *VersionedResign) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size < 5 {
+		return ssz.ErrSize
+	}
+
+	tail := buf
+	var o1 uint64
+
+	// Field (0) 'Version'
+	v.Version = ssz.UnmarshallUint8(buf[0:1])
+
+	// Offset (1) 'Resign'
+	if o1 = ssz.ReadOffset(buf[1:5]); o1 > size {
+		return ssz.ErrOffset
+	}
+
+	if o1 < 5 {
+		return ssz.ErrInvalidVariableOffset
+	}
+
+	// Field (1) 'Resign'
+	{
+		buf = tail[o1:]
+		if len(buf) > 256 {
+			return ssz.ErrBytesLength
+		}
+		if cap(v.Resign) == 0 {
+			v.Resign = make([]byte, 0, len(buf))
+		}
+		v.Resign = append(v.Resign, buf...)
+	}
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the VersionedResign object
+func (v *VersionedResign) SizeSSZ() (size int) {
+	size = 5
+
+	// Field (1) 'Resign'
+	size += len(v.Resign)
+
+	return
+}
+
+// HashTreeRoot ssz hashes the VersionedResign object
+func (v *VersionedResign) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(v)
+}
+
+// HashTreeRootWith ssz hashes the VersionedResign object with a hasher
+func (v *VersionedResign) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'Version'
+	hh.PutUint8(v.Version)
+
+	// Field (1) 'Resign'
+	{
+		elemIndx := hh.Index()
+		byteLen := uint64(len(v.Resign))
+		if byteLen > 256 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		hh.Append(v.Resign)
+		hh.MerkleizeWithMixin(elemIndx, byteLen, (256+31)/32)
+	}
+
+	hh.Merkleize(indx)
+	return
+}
+
+// GetTree ssz hashes the VersionedResign object
+func (v *VersionedResign) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(v)
+}
+
+// MarshalSSZ ssz marshals the ResponseEnvelope object
+func (r *ResponseEnvelope) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(r)
+}
+
+// MarshalSSZTo ssz marshals the ResponseEnvelope object to a target array
+func (r *ResponseEnvelope) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+
+	// Field (0) 'OperatorID'
+	dst = ssz.MarshalUint64(dst, r.OperatorID)
+
+	// Field (1) 'Timestamp'
+	dst = ssz.MarshalUint64(dst, r.Timestamp)
+
+	// Field (2) 'PayloadHash'
+	dst = append(dst, r.PayloadHash[:]...)
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the ResponseEnvelope object
+func (r *ResponseEnvelope) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size != 48 {
+		return ssz.ErrSize
+	}
+
+	// Field (0) 'OperatorID'
+	r.OperatorID = ssz.UnmarshallUint64(buf[0:8])
+
+	// Field (1) 'Timestamp'
+	r.Timestamp = ssz.UnmarshallUint64(buf[8:16])
+
+	// Field (2) 'PayloadHash'
+	copy(r.PayloadHash[:], buf[16:48])
+
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the ResponseEnvelope object
+func (r *ResponseEnvelope) SizeSSZ() (size int) {
+	size = 48
+	return
+}
+
+// HashTreeRoot ssz hashes the ResponseEnvelope object
+func (r *ResponseEnvelope) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(r)
+}
+
+// HashTreeRootWith ssz hashes the ResponseEnvelope object with a hasher
+func (r *ResponseEnvelope) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'OperatorID'
+	hh.PutUint64(r.OperatorID)
+
+	// Field (1) 'Timestamp'
+	hh.PutUint64(r.Timestamp)
+
+	// Field (2) 'PayloadHash'
+	hh.PutBytes(r.PayloadHash[:])
+
+	hh.Merkleize(indx)
+	return
+}
+
+// GetTree ssz hashes the ResponseEnvelope object
+func (r *ResponseEnvelope) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(r)
+}
+
+// MarshalSSZ ssz marshals the SignedResponseEnvelope object
+func (s *SignedResponseEnvelope) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(s)
+}
+
+// MarshalSSZTo ssz marshals the SignedResponseEnvelope object to a target array
+func (s *SignedResponseEnvelope) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+
+	// Field (0) 'Envelope'
+	if s.Envelope == nil {
+		s.Envelope = new(ResponseEnvelope)
+	}
+	if dst, err = s.Envelope.MarshalSSZTo(dst); err != nil {
+		return
+	}
+
+	// Field (1) 'Signature'
+	if size := len(s.Signature); size != 256 {
+		err = ssz.ErrBytesLengthFn("SignedResponseEnvelope.Signature", size, 256)
+		return
+	}
+	dst = append(dst, s.Signature...)
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the SignedResponseEnvelope object
+func (s *SignedResponseEnvelope) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size != 304 {
+		return ssz.ErrSize
+	}
 
-	// Offset (0) 'Resign'
-	dst = ssz.WriteOffset(dst, offset)
-	offset += s.Resign.SizeSSZ()
+	// Field (0) 'Envelope'
+	if s.Envelope == nil {
+		s.Envelope = new(ResponseEnvelope)
+	}
+	if err = s.Envelope.UnmarshalSSZ(buf[0:48]); err != nil {
+		return err
+	}
 
-	// Offset (1) 'Signature'
-	dst = ssz.WriteOffset(dst, offset)
-	offset += len(s.Signature)
+	// Field (1) 'Signature'
+	if cap(s.Signature) == 0 {
+		s.Signature = make([]byte, 0, len(buf[48:304]))
+	}
+	s.Signature = append(s.Signature, buf[48:304]...)
 
-	// Field (0) 'Resign'
-	if dst, err = s.Resign.MarshalSSZTo(dst); err != nil {
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the SignedResponseEnvelope object
+func (s *SignedResponseEnvelope) SizeSSZ() (size int) {
+	size = 304
+	return
+}
+
+// HashTreeRoot ssz hashes the SignedResponseEnvelope object
+func (s *SignedResponseEnvelope) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(s)
+}
+
+// HashTreeRootWith ssz hashes the SignedResponseEnvelope object with a hasher
+func (s *SignedResponseEnvelope) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'Envelope'
+	if s.Envelope == nil {
+		s.Envelope = new(ResponseEnvelope)
+	}
+	if err = s.Envelope.HashTreeRootWith(hh); err != nil {
 		return
 	}
 
 	// Field (1) 'Signature'
-	if size := len(s.Signature); size > 1536 {
-		err = ssz.ErrBytesLengthFn("SignedResign.Signature", size, 1536)
+	if size := len(s.Signature); size != 256 {
+		err = ssz.ErrBytesLengthFn("SignedResponseEnvelope.Signature", size, 256)
 		return
 	}
-	dst = append(dst, s.Signature...)
+	hh.PutBytes(s.Signature)
 
+	hh.Merkleize(indx)
 	return
 }
 
-// UnmarshalSSZ ssz unmarshals the SignedResign object
-func (s *SignedResign) UnmarshalSSZ(buf []byte) error {
+// GetTree ssz hashes the SignedResponseEnvelope object
+func (s *SignedResponseEnvelope) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(s)
+}
+
+// MarshalSSZ ssz marshals the CeremonySummary object
+func (c *CeremonySummary) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(c)
+}
+
+// MarshalSSZTo ssz marshals the CeremonySummary object to a target array
+func (c *CeremonySummary) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+	offset := int(104)
+
+	// Field (0) 'RequestID'
+	dst = append(dst, c.RequestID[:]...)
+
+	// Field (1) 'ValidatorPubKey'
+	if size := len(c.ValidatorPubKey); size != 48 {
+		err = ssz.ErrBytesLengthFn("CeremonySummary.ValidatorPubKey", size, 48)
+		return
+	}
+	dst = append(dst, c.ValidatorPubKey...)
+
+	// Field (2) 'Owner'
+	dst = append(dst, c.Owner[:]...)
+
+	// Field (3) 'Nonce'
+	dst = ssz.MarshalUint64(dst, c.Nonce)
+
+	// Offset (4) 'Results'
+	dst = ssz.WriteOffset(dst, offset)
+	for ii := 0; ii < len(c.Results); ii++ {
+		offset += 4
+		offset += c.Results[ii].SizeSSZ()
+	}
+
+	// Field (4) 'Results'
+	if size := len(c.Results); size > 13 {
+		err = ssz.ErrListTooBigFn("CeremonySummary.Results", size, 13)
+		return
+	}
+	{
+		offset = 4 * len(c.Results)
+		for ii := 0; ii < len(c.Results); ii++ {
+			dst = ssz.WriteOffset(dst, offset)
+			offset += c.Results[ii].SizeSSZ()
+		}
+	}
+	for ii := 0; ii < len(c.Results); ii++ {
+		if dst, err = c.Results[ii].MarshalSSZTo(dst); err != nil {
+			return
+		}
+	}
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the CeremonySummary object
+func (c *CeremonySummary) UnmarshalSSZ(buf []byte) error {
 	var err error
 	size := uint64(len(buf))
-	if size < 8 {
+	if size < 104 {
 		return ssz.ErrSize
 	}
 
 	tail := buf
-	var o0, o1 uint64
+	var o4 uint64
 
-	// Offset (0) 'Resign'
-	if o0 = ssz.ReadOffset(buf[0:4]); o0 > size {
-		return ssz.ErrOffset
-	}
+	// Field (0) 'RequestID'
+	copy(c.RequestID[:], buf[0:24])
 
-	if o0 < 8 {
-		return ssz.ErrInvalidVariableOffset
+	// Field (1) 'ValidatorPubKey'
+	if cap(c.ValidatorPubKey) == 0 {
+		c.ValidatorPubKey = make([]byte, 0, len(buf[24:72]))
 	}
+	c.ValidatorPubKey = append(c.ValidatorPubKey, buf[24:72]...)
 
-	// Offset (1) 'Signature'
-	if o1 = ssz.ReadOffset(buf[4:8]); o1 > size || o0 > o1 {
+	// Field (2) 'Owner'
+	copy(c.Owner[:], buf[72:92])
+
+	// Field (3) 'Nonce'
+	c.Nonce = ssz.UnmarshallUint64(buf[92:100])
+
+	// Offset (4) 'Results'
+	if o4 = ssz.ReadOffset(buf[100:104]); o4 > size {
 		return ssz.ErrOffset
 	}
 
-	// Field (0) 'Resign'
-	{
-		buf = tail[o0:o1]
-		if err = s.Resign.UnmarshalSSZ(buf); err != nil {
-			return err
-		}
+	if o4 < 104 {
+		return ssz.ErrInvalidVariableOffset
 	}
 
-	// Field (1) 'Signature'
+	// Field (4) 'Results'
 	{
-		buf = tail[o1:]
-		if len(buf) > 1536 {
-			return ssz.ErrBytesLength
+		buf = tail[o4:]
+		num, err := ssz.DecodeDynamicLength(buf, 13)
+		if err != nil {
+			return err
 		}
-		if cap(s.Signature) == 0 {
-			s.Signature = make([]byte, 0, len(buf))
+		c.Results = make([]*Result, num)
+		err = ssz.UnmarshalDynamic(buf, num, func(indx int, buf []byte) (err error) {
+			if c.Results[indx] == nil {
+				c.Results[indx] = new(Result)
+			}
+			if err = c.Results[indx].UnmarshalSSZ(buf); err != nil {
+				return err
+			}
+			return nil
+		})
+		if err != nil {
+			return err
 		}
-		s.Signature = append(s.Signature, buf...)
 	}
 	return err
 }
 
-// SizeSSZ returns the ssz encoded size in bytes for the SignedResign object
-func (s *SignedResign) SizeSSZ() (size int) {
-	size = 8
-
-	// Field (0) 'Resign'
-	size += s.Resign.SizeSSZ()
+// SizeSSZ returns the ssz encoded size in bytes for the CeremonySummary object
+func (c *CeremonySummary) SizeSSZ() (size int) {
+	size = 104
 
-	// Field (1) 'Signature'
-	size += len(s.Signature)
+	// Field (4) 'Results'
+	for ii := 0; ii < len(c.Results); ii++ {
+		size += 4
+		size += c.Results[ii].SizeSSZ()
+	}
 
 	return
 }
 
-// HashTreeRoot ssz hashes the SignedResign object
-func (s *SignedResign) HashTreeRoot() ([32]byte, error) {
-	return ssz.HashWithDefaultHasher(s)
+// HashTreeRoot ssz hashes the CeremonySummary object
+func (c *CeremonySummary) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(c)
 }
 
-// HashTreeRootWith ssz hashes the SignedResign object with a hasher
-func (s *SignedResign) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+// HashTreeRootWith ssz hashes the CeremonySummary object with a hasher
+func (c *CeremonySummary) HashTreeRootWith(hh ssz.HashWalker) (err error) {
 	indx := hh.Index()
 
-	// Field (0) 'Resign'
-	if err = s.Resign.HashTreeRootWith(hh); err != nil {
+	// Field (0) 'RequestID'
+	hh.PutBytes(c.RequestID[:])
+
+	// Field (1) 'ValidatorPubKey'
+	if size := len(c.ValidatorPubKey); size != 48 {
+		err = ssz.ErrBytesLengthFn("CeremonySummary.ValidatorPubKey", size, 48)
 		return
 	}
+	hh.PutBytes(c.ValidatorPubKey)
 
-	// Field (1) 'Signature'
+	// Field (2) 'Owner'
+	hh.PutBytes(c.Owner[:])
+
+	// Field (3) 'Nonce'
+	hh.PutUint64(c.Nonce)
+
+	// Field (4) 'Results'
 	{
-		elemIndx := hh.Index()
-		byteLen := uint64(len(s.Signature))
-		if byteLen > 1536 {
+		subIndx := hh.Index()
+		num := uint64(len(c.Results))
+		if num > 13 {
 			err = ssz.ErrIncorrectListSize
 			return
 		}
-		hh.Append(s.Signature)
-		hh.MerkleizeWithMixin(elemIndx, byteLen, (1536+31)/32)
+		for _, elem := range c.Results {
+			if err = elem.HashTreeRootWith(hh); err != nil {
+				return
+			}
+		}
+		hh.MerkleizeWithMixin(subIndx, num, 13)
 	}
 
 	hh.Merkleize(indx)
 	return
 }
 
-// GetTree ssz hashes the SignedResign object
-func (s *SignedResign) GetTree() (*ssz.Node, error) {
-	return ssz.ProofTree(s)
+// GetTree ssz hashes the CeremonySummary object
+func (c *CeremonySummary) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(c)
 }
 
-// MarshalSSZ ssz marshals the Result object
-func (r *Result) MarshalSSZ() ([]byte, error) {
-	return ssz.MarshalSSZ(r)
+// MarshalSSZ ssz marshals the SignedCeremonySummary object
+func (s *SignedCeremonySummary) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(s)
 }
 
-// MarshalSSZTo ssz marshals the Result object to a target array
-func (r *Result) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+// MarshalSSZTo ssz marshals the SignedCeremonySummary object to a target array
+func (s *SignedCeremonySummary) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 	dst = buf
-	offset := int(228)
-
-	// Field (0) 'OperatorID'
-	dst = ssz.MarshalUint64(dst, r.OperatorID)
-
-	// Field (1) 'RequestID'
-	dst = append(dst, r.RequestID[:]...)
+	offset := int(260)
 
-	// Field (2) 'DepositPartialSignature'
-	if size := len(r.DepositPartialSignature); size != 96 {
-		err = ssz.ErrBytesLengthFn("Result.DepositPartialSignature", size, 96)
-		return
+	// Offset (0) 'Summary'
+	dst = ssz.WriteOffset(dst, offset)
+	if s.Summary == nil {
+		s.Summary = new(CeremonySummary)
 	}
-	dst = append(dst, r.DepositPartialSignature...)
+	offset += s.Summary.SizeSSZ()
 
-	// Field (3) 'OwnerNoncePartialSignature'
-	if size := len(r.OwnerNoncePartialSignature); size != 96 {
-		err = ssz.ErrBytesLengthFn("Result.OwnerNoncePartialSignature", size, 96)
+	// Field (1) 'Signature'
+	if size := len(s.Signature); size != 256 {
+		err = ssz.ErrBytesLengthFn("SignedCeremonySummary.Signature", size, 256)
 		return
 	}
-	dst = append(dst, r.OwnerNoncePartialSignature...)
-
-	// Offset (4) 'SignedProof'
-	dst = ssz.WriteOffset(dst, offset)
-	offset += r.SignedProof.SizeSSZ()
+	dst = append(dst, s.Signature...)
 
-	// Field (4) 'SignedProof'
-	if dst, err = r.SignedProof.MarshalSSZTo(dst); err != nil {
+	// Field (0) 'Summary'
+	if dst, err = s.Summary.MarshalSSZTo(dst); err != nil {
 		return
 	}
 
 	return
 }
 
-// UnmarshalSSZ ssz unmarshals the Result object
-func (r *Result) UnmarshalSSZ(buf []byte) error {
+// UnmarshalSSZ ssz unmarshals the SignedCeremonySummary object
+func (s *SignedCeremonySummary) UnmarshalSSZ(buf []byte) error {
 	var err error
 	size := uint64(len(buf))
-	if size < 228 {
+	if size < 260 {
 		return ssz.ErrSize
 	}
 
 	tail := buf
-	var o4 uint64
-
-	// Field (0) 'OperatorID'
-	r.OperatorID = ssz.UnmarshallUint64(buf[0:8])
-
-	// Field (1) 'RequestID'
-	copy(r.RequestID[:], buf[8:32])
-
-	// Field (2) 'DepositPartialSignature'
-	if cap(r.DepositPartialSignature) == 0 {
-		r.DepositPartialSignature = make([]byte, 0, len(buf[32:128]))
-	}
-	r.DepositPartialSignature = append(r.DepositPartialSignature, buf[32:128]...)
-
-	// Field (3) 'OwnerNoncePartialSignature'
-	if cap(r.OwnerNoncePartialSignature) == 0 {
-		r.OwnerNoncePartialSignature = make([]byte, 0, len(buf[128:224]))
-	}
-	r.OwnerNoncePartialSignature = append(r.OwnerNoncePartialSignature, buf[128:224]...)
+	var o0 uint64
 
-	// Offset (4) 'SignedProof'
-	if o4 = ssz.ReadOffset(buf[224:228]); o4 > size {
+	// Offset (0) 'Summary'
+	if o0 = ssz.ReadOffset(buf[0:4]); o0 > size {
 		return ssz.ErrOffset
 	}
 
-	if o4 < 228 {
+	if o0 < 260 {
 		return ssz.ErrInvalidVariableOffset
 	}
 
-	// Field (4) 'SignedProof'
+	// Field (1) 'Signature'
+	if cap(s.Signature) == 0 {
+		s.Signature = make([]byte, 0, len(buf[4:260]))
+	}
+	s.Signature = append(s.Signature, buf[4:260]...)
+
+	// Field (0) 'Summary'
 	{
-		buf = tail[o4:]
-		if err = r.SignedProof.UnmarshalSSZ(buf); err != nil {
+		buf = tail[o0:]
+		if s.Summary == nil {
+			s.Summary = new(CeremonySummary)
+		}
+		if err = s.Summary.UnmarshalSSZ(buf); err != nil {
 			return err
 		}
 	}
 	return err
 }
 
-// SizeSSZ returns the ssz encoded size in bytes for the Result object
-func (r *Result) SizeSSZ() (size int) {
-	size = 228
+// SizeSSZ returns the ssz encoded size in bytes for the SignedCeremonySummary object
+func (s *SignedCeremonySummary) SizeSSZ() (size int) {
+	size = 260
+
+	// Field (0) 'Summary'
+	if s.Summary == nil {
+		s.Summary = new(CeremonySummary)
+	}
+	size += s.Summary.SizeSSZ()
+
+	return
+}
+
+// HashTreeRoot ssz hashes the SignedCeremonySummary object
+func (s *SignedCeremonySummary) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(s)
+}
+
+// HashTreeRootWith ssz hashes the SignedCeremonySummary object with a hasher
+func (s *SignedCeremonySummary) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'Summary'
+	if err = s.Summary.HashTreeRootWith(hh); err != nil {
+		return
+	}
+
+	// Field (1) 'Signature'
+	if size := len(s.Signature); size != 256 {
+		err = ssz.ErrBytesLengthFn("SignedCeremonySummary.Signature", size, 256)
+		return
+	}
+	hh.PutBytes(s.Signature)
+
+	hh.Merkleize(indx)
+	return
+}
+
+// GetTree ssz hashes the SignedCeremonySummary object
+func (s *SignedCeremonySummary) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(s)
+}
+
+// MarshalSSZ ssz marshals the Ping object
+func (p *Ping) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(p)
+}
+
+// MarshalSSZTo ssz marshals the Ping object to a target array
+func (p *Ping) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+
+	// Field (0) 'Nonce'
+	dst = append(dst, p.Nonce[:]...)
+
+	// Field (1) 'Timestamp'
+	dst = ssz.MarshalUint64(dst, p.Timestamp)
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the Ping object
+func (p *Ping) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size != 40 {
+		return ssz.ErrSize
+	}
+
+	// Field (0) 'Nonce'
+	copy(p.Nonce[:], buf[0:32])
+
+	// Field (1) 'Timestamp'
+	p.Timestamp = ssz.UnmarshallUint64(buf[32:40])
 
-	// Field (4) 'SignedProof'
-	size += r.SignedProof.SizeSSZ()
+	return err
+}
 
+// SizeSSZ returns the ssz encoded size in bytes for the Ping object
+func (p *Ping) SizeSSZ() (size int) {
+	size = 40
 	return
 }
 
-// HashTreeRoot ssz hashes the Result object
-func (r *Result) HashTreeRoot() ([32]byte, error) {
-	return ssz.HashWithDefaultHasher(r)
+// HashTreeRoot ssz hashes the Ping object
+func (p *Ping) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(p)
 }
 
-// HashTreeRootWith ssz hashes the Result object with a hasher
-func (r *Result) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+// HashTreeRootWith ssz hashes the Ping object with a hasher
+func (p *Ping) HashTreeRootWith(hh ssz.HashWalker) (err error) {
 	indx := hh.Index()
 
-	// Field (0) 'OperatorID'
-	hh.PutUint64(r.OperatorID)
-
-	// Field (1) 'RequestID'
-	hh.PutBytes(r.RequestID[:])
-
-	// Field (2) 'DepositPartialSignature'
-	if size := len(r.DepositPartialSignature); size != 96 {
-		err = ssz.ErrBytesLengthFn("Result.DepositPartialSignature", size, 96)
-		return
-	}
-	hh.PutBytes(r.DepositPartialSignature)
-
-	// Field (3) 'OwnerNoncePartialSignature'
-	if size := len(r.OwnerNoncePartialSignature); size != 96 {
-		err = ssz.ErrBytesLengthFn("Result.OwnerNoncePartialSignature", size, 96)
-		return
-	}
-	hh.PutBytes(r.OwnerNoncePartialSignature)
+	// Field (0) 'Nonce'
+	hh.PutBytes(p.Nonce[:])
 
-	// Field (4) 'SignedProof'
-	if err = r.SignedProof.HashTreeRootWith(hh); err != nil {
-		return
-	}
+	// Field (1) 'Timestamp'
+	hh.PutUint64(p.Timestamp)
 
 	hh.Merkleize(indx)
 	return
 }
 
-// GetTree ssz hashes the Result object
-func (r *Result) GetTree() (*ssz.Node, error) {
-	return ssz.ProofTree(r)
+// GetTree ssz hashes the Ping object
+func (p *Ping) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(p)
 }
 
-// MarshalSSZ ssz marshals the Proof object
-func (p *Proof) MarshalSSZ() ([]byte, error) {
+// MarshalSSZ ssz marshals the Pong object
+func (p *Pong) MarshalSSZ() ([]byte, error) {
 	return ssz.MarshalSSZ(p)
 }
 
-// MarshalSSZTo ssz marshals the Proof object to a target array
-func (p *Proof) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+// MarshalSSZTo ssz marshals the Pong object to a target array
+func (p *Pong) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 	dst = buf
-	offset := int(120)
+	offset := int(72)
 
-	// Field (0) 'ValidatorPubKey'
-	if size := len(p.ValidatorPubKey); size != 48 {
-		err = ssz.ErrBytesLengthFn("Proof.ValidatorPubKey", size, 48)
-		return
-	}
-	dst = append(dst, p.ValidatorPubKey...)
+	// Field (0) 'OperatorID'
+	dst = ssz.MarshalUint64(dst, p.OperatorID)
 
-	// Offset (1) 'EncryptedShare'
+	// Field (1) 'PingNonce'
+	dst = append(dst, p.PingNonce[:]...)
+
+	// Field (2) 'Timestamp'
+	dst = ssz.MarshalUint64(dst, p.Timestamp)
+
+	// Offset (3) 'SpecVersion'
 	dst = ssz.WriteOffset(dst, offset)
-	offset += len(p.EncryptedShare)
+	offset += len(p.SpecVersion)
 
-	// Field (2) 'SharePubKey'
-	if size := len(p.SharePubKey); size != 48 {
-		err = ssz.ErrBytesLengthFn("Proof.SharePubKey", size, 48)
+	// Offset (4) 'Forks'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(p.Forks) * 4
+
+	// Field (5) 'ActiveCeremonies'
+	dst = ssz.MarshalUint64(dst, p.ActiveCeremonies)
+
+	// Field (6) 'MaxCeremonies'
+	dst = ssz.MarshalUint64(dst, p.MaxCeremonies)
+
+	// Field (3) 'SpecVersion'
+	if size := len(p.SpecVersion); size > 32 {
+		err = ssz.ErrBytesLengthFn("Pong.SpecVersion", size, 32)
 		return
 	}
-	dst = append(dst, p.SharePubKey...)
-
-	// Field (3) 'Owner'
-	dst = append(dst, p.Owner[:]...)
+	dst = append(dst, p.SpecVersion...)
 
-	// Field (1) 'EncryptedShare'
-	if size := len(p.EncryptedShare); size > 512 {
-		err = ssz.ErrBytesLengthFn("Proof.EncryptedShare", size, 512)
+	// Field (4) 'Forks'
+	if size := len(p.Forks); size > 64 {
+		err = ssz.ErrListTooBigFn("Pong.Forks", size, 64)
 		return
 	}
-	dst = append(dst, p.EncryptedShare...)
+	for ii := 0; ii < len(p.Forks); ii++ {
+		if size := len(p.Forks[ii]); size != 4 {
+			err = ssz.ErrBytesLengthFn("Pong.Forks[ii]", size, 4)
+			return
+		}
+		dst = append(dst, p.Forks[ii]...)
+	}
 
 	return
 }
 
-// UnmarshalSSZ ssz unmarshals the Proof object
-func (p *Proof) UnmarshalSSZ(buf []byte) error {
+// UnmarshalSSZ ssz unmarshals the Pong object
+func (p *Pong) UnmarshalSSZ(buf []byte) error {
 	var err error
 	size := uint64(len(buf))
-	if size < 120 {
+	if size < 72 {
 		return ssz.ErrSize
 	}
 
 	tail := buf
-	var o1 uint64
+	var o3, o4 uint64
 
-	// Field (0) 'ValidatorPubKey'
-	if cap(p.ValidatorPubKey) == 0 {
-		p.ValidatorPubKey = make([]byte, 0, len(buf[0:48]))
-	}
-	p.ValidatorPubKey = append(p.ValidatorPubKey, buf[0:48]...)
+	// Field (0) 'OperatorID'
+	p.OperatorID = ssz.UnmarshallUint64(buf[0:8])
 
-	// Offset (1) 'EncryptedShare'
-	if o1 = ssz.ReadOffset(buf[48:52]); o1 > size {
+	// Field (1) 'PingNonce'
+	copy(p.PingNonce[:], buf[8:40])
+
+	// Field (2) 'Timestamp'
+	p.Timestamp = ssz.UnmarshallUint64(buf[40:48])
+
+	// Offset (3) 'SpecVersion'
+	if o3 = ssz.ReadOffset(buf[48:52]); o3 > size {
 		return ssz.ErrOffset
 	}
 
-	if o1 < 120 {
+	if o3 < 72 {
 		return ssz.ErrInvalidVariableOffset
 	}
 
-	// Field (2) 'SharePubKey'
-	if cap(p.SharePubKey) == 0 {
-		p.SharePubKey = make([]byte, 0, len(buf[52:100]))
+	// Offset (4) 'Forks'
+	if o4 = ssz.ReadOffset(buf[52:56]); o4 > size || o3 > o4 {
+		return ssz.ErrOffset
 	}
-	p.SharePubKey = append(p.SharePubKey, buf[52:100]...)
 
-	// Field (3) 'Owner'
-	copy(p.Owner[:], buf[100:120])
+	// Field (5) 'ActiveCeremonies'
+	p.ActiveCeremonies = ssz.UnmarshallUint64(buf[56:64])
 
-	// Field (1) 'EncryptedShare'
+	// Field (6) 'MaxCeremonies'
+	p.MaxCeremonies = ssz.UnmarshallUint64(buf[64:72])
+
+	// Field (3) 'SpecVersion'
 	{
-		buf = tail[o1:]
-		if len(buf) > 512 {
+		buf = tail[o3:o4]
+		if len(buf) > 32 {
 			return ssz.ErrBytesLength
 		}
-		if cap(p.EncryptedShare) == 0 {
-			p.EncryptedShare = make([]byte, 0, len(buf))
+		if cap(p.SpecVersion) == 0 {
+			p.SpecVersion = make([]byte, 0, len(buf))
+		}
+		p.SpecVersion = append(p.SpecVersion, buf...)
+	}
+
+	// Field (4) 'Forks'
+	{
+		buf = tail[o4:]
+		num, err := ssz.DivideInt2(len(buf), 4, 64)
+		if err != nil {
+			return err
+		}
+		p.Forks = make([][]byte, num)
+		for ii := 0; ii < num; ii++ {
+			if cap(p.Forks[ii]) == 0 {
+				p.Forks[ii] = make([]byte, 0, len(buf[ii*4:(ii+1)*4]))
+			}
+			p.Forks[ii] = append(p.Forks[ii], buf[ii*4:(ii+1)*4]...)
 		}
-		p.EncryptedShare = append(p.EncryptedShare, buf...)
 	}
 	return err
 }
 
-// SizeSSZ returns the ssz encoded size in bytes for the Proof object
-func (p *Proof) SizeSSZ() (size int) {
-	size = 120
+// SizeSSZ returns the ssz encoded size in bytes for the Pong object
+func (p *Pong) SizeSSZ() (size int) {
+	size = 72
 
-	// Field (1) 'EncryptedShare'
-	size += len(p.EncryptedShare)
+	// Field (3) 'SpecVersion'
+	size += len(p.SpecVersion)
+
+	// Field (4) 'Forks'
+	size += len(p.Forks) * 4
 
 	return
 }
 
-// HashTreeRoot ssz hashes the Proof object
-func (p *Proof) HashTreeRoot() ([32]byte, error) {
+// HashTreeRoot ssz hashes the Pong object
+func (p *Pong) HashTreeRoot() ([32]byte, error) {
 	return ssz.HashWithDefaultHasher(p)
 }
 
-// HashTreeRootWith ssz hashes the Proof object with a hasher
-func (p *Proof) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+// HashTreeRootWith ssz hashes the Pong object with a hasher
+func (p *Pong) HashTreeRootWith(hh ssz.HashWalker) (err error) {
 	indx := hh.Index()
 
-	// Field (0) 'ValidatorPubKey'
-	if size := len(p.ValidatorPubKey); size != 48 {
-		err = ssz.ErrBytesLengthFn("Proof.ValidatorPubKey", size, 48)
-		return
-	}
-	hh.PutBytes(p.ValidatorPubKey)
+	// Field (0) 'OperatorID'
+	hh.PutUint64(p.OperatorID)
 
-	// Field (1) 'EncryptedShare'
+	// Field (1) 'PingNonce'
+	hh.PutBytes(p.PingNonce[:])
+
+	// Field (2) 'Timestamp'
+	hh.PutUint64(p.Timestamp)
+
+	// Field (3) 'SpecVersion'
 	{
 		elemIndx := hh.Index()
-		byteLen := uint64(len(p.EncryptedShare))
-		if byteLen > 512 {
+		byteLen := uint64(len(p.SpecVersion))
+		if byteLen > 32 {
 			err = ssz.ErrIncorrectListSize
 			return
 		}
-		hh.Append(p.EncryptedShare)
-		hh.MerkleizeWithMixin(elemIndx, byteLen, (512+31)/32)
+		hh.Append(p.SpecVersion)
+		hh.MerkleizeWithMixin(elemIndx, byteLen, (32+31)/32)
 	}
 
-	// Field (2) 'SharePubKey'
-	if size := len(p.SharePubKey); size != 48 {
-		err = ssz.ErrBytesLengthFn("Proof.SharePubKey", size, 48)
-		return
+	// Field (4) 'Forks'
+	{
+		if size := len(p.Forks); size > 64 {
+			err = ssz.ErrListTooBigFn("Pong.Forks", size, 64)
+			return
+		}
+		subIndx := hh.Index()
+		for _, i := range p.Forks {
+			if len(i) != 4 {
+				err = ssz.ErrBytesLength
+				return
+			}
+			hh.PutBytes(i)
+		}
+		numItems := uint64(len(p.Forks))
+		hh.MerkleizeWithMixin(subIndx, numItems, 64)
 	}
-	hh.PutBytes(p.SharePubKey)
 
-	// Field (3) 'Owner'
-	hh.PutBytes(p.Owner[:])
+	// Field (5) 'ActiveCeremonies'
+	hh.PutUint64(p.ActiveCeremonies)
+
+	// Field (6) 'MaxCeremonies'
+	hh.PutUint64(p.MaxCeremonies)
 
 	hh.Merkleize(indx)
 	return
 }
 
-// GetTree ssz hashes the Proof object
-func (p *Proof) GetTree() (*ssz.Node, error) {
+// GetTree ssz hashes the Pong object
+func (p *Pong) GetTree() (*ssz.Node, error) {
 	return ssz.ProofTree(p)
 }
 
-// MarshalSSZ ssz marshals the SignedProof object
-func (s *SignedProof) MarshalSSZ() ([]byte, error) {
+// MarshalSSZ ssz marshals the SignedPong object
+func (s *SignedPong) MarshalSSZ() ([]byte, error) {
 	return ssz.MarshalSSZ(s)
 }
 
-// MarshalSSZTo ssz marshals the SignedProof object to a target array
-func (s *SignedProof) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+// MarshalSSZTo ssz marshals the SignedPong object to a target array
+func (s *SignedPong) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 	dst = buf
 	offset := int(260)
 
-	// Offset (0) 'Proof'
+	// Offset (0) 'Pong'
 	dst = ssz.WriteOffset(dst, offset)
-	if s.Proof == nil {
-		s.Proof = new(Proof)
+	if s.Pong == nil {
+		s.Pong = new(Pong)
 	}
-	offset += s.Proof.SizeSSZ()
+	offset += s.Pong.SizeSSZ()
 
 	// Field (1) 'Signature'
 	if size := len(s.Signature); size != 256 {
-		err = ssz.ErrBytesLengthFn("SignedProof.Signature", size, 256)
+		err = ssz.ErrBytesLengthFn("SignedPong.Signature", size, 256)
 		return
 	}
 	dst = append(dst, s.Signature...)
 
-	// Field (0) 'Proof'
-	if dst, err = s.Proof.MarshalSSZTo(dst); err != nil {
+	// Field (0) 'Pong'
+	if dst, err = s.Pong.MarshalSSZTo(dst); err != nil {
 		return
 	}
 
 	return
 }
 
-// UnmarshalSSZ ssz unmarshals the SignedProof object
-func (s *SignedProof) UnmarshalSSZ(buf []byte) error {
+// UnmarshalSSZ ssz unmarshals the SignedPong object
+func (s *SignedPong) UnmarshalSSZ(buf []byte) error {
 	var err error
 	size := uint64(len(buf))
 	if size < 260 {
@@ -1410,7 +5474,7 @@ func (s *SignedProof) UnmarshalSSZ(buf []byte) error {
 	tail := buf
 	var o0 uint64
 
-	// Offset (0) 'Proof'
+	// Offset (0) 'Pong'
 	if o0 = ssz.ReadOffset(buf[0:4]); o0 > size {
 		return ssz.ErrOffset
 	}
@@ -1425,49 +5489,49 @@ func (s *SignedProof) UnmarshalSSZ(buf []byte) error {
 	}
 	s.Signature = append(s.Signature, buf[4:260]...)
 
-	// Field (0) 'Proof'
+	// Field (0) 'Pong'
 	{
 		buf = tail[o0:]
-		if s.Proof == nil {
-			s.Proof = new(Proof)
+		if s.Pong == nil {
+			s.Pong = new(Pong)
 		}
-		if err = s.Proof.UnmarshalSSZ(buf); err != nil {
+		if err = s.Pong.UnmarshalSSZ(buf); err != nil {
 			return err
 		}
 	}
 	return err
 }
 
-// SizeSSZ returns the ssz encoded size in bytes for the SignedProof object
-func (s *SignedProof) SizeSSZ() (size int) {
+// SizeSSZ returns the ssz encoded size in bytes for the SignedPong object
+func (s *SignedPong) SizeSSZ() (size int) {
 	size = 260
 
-	// Field (0) 'Proof'
-	if s.Proof == nil {
-		s.Proof = new(Proof)
+	// Field (0) 'Pong'
+	if s.Pong == nil {
+		s.Pong = new(Pong)
 	}
-	size += s.Proof.SizeSSZ()
+	size += s.Pong.SizeSSZ()
 
 	return
 }
 
-// HashTreeRoot ssz hashes the SignedProof object
-func (s *SignedProof) HashTreeRoot() ([32]byte, error) {
+// HashTreeRoot ssz hashes the SignedPong object
+func (s *SignedPong) HashTreeRoot() ([32]byte, error) {
 	return ssz.HashWithDefaultHasher(s)
 }
 
-// HashTreeRootWith ssz hashes the SignedProof object with a hasher
-func (s *SignedProof) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+// HashTreeRootWith ssz hashes the SignedPong object with a hasher
+func (s *SignedPong) HashTreeRootWith(hh ssz.HashWalker) (err error) {
 	indx := hh.Index()
 
-	// Field (0) 'Proof'
-	if err = s.Proof.HashTreeRootWith(hh); err != nil {
+	// Field (0) 'Pong'
+	if err = s.Pong.HashTreeRootWith(hh); err != nil {
 		return
 	}
 
 	// Field (1) 'Signature'
 	if size := len(s.Signature); size != 256 {
-		err = ssz.ErrBytesLengthFn("SignedProof.Signature", size, 256)
+		err = ssz.ErrBytesLengthFn("SignedPong.Signature", size, 256)
 		return
 	}
 	hh.PutBytes(s.Signature)
@@ -1476,7 +5540,132 @@ func (s *SignedProof) HashTreeRootWith(hh ssz.HashWalker) (err error) {
 	return
 }
 
-// GetTree ssz hashes the SignedProof object
-func (s *SignedProof) GetTree() (*ssz.Node, error) {
+// GetTree ssz hashes the SignedPong object
+func (s *SignedPong) GetTree() (*ssz.Node, error) {
 	return ssz.ProofTree(s)
 }
+
+// MarshalSSZ ssz marshals the EncryptedPayload object
+func (e *EncryptedPayload) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(e)
+}
+
+// MarshalSSZTo ssz marshals the EncryptedPayload object to a target array
+func (e *EncryptedPayload) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+	offset := int(37)
+
+	// Field (0) 'OperatorID'
+	dst = ssz.MarshalUint64(dst, e.OperatorID)
+
+	// Field (1) 'RequestID'
+	dst = append(dst, e.RequestID[:]...)
+
+	// Field (2) 'Type'
+	dst = ssz.MarshalUint8(dst, e.Type)
+
+	// Offset (3) 'Ciphertext'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(e.Ciphertext)
+
+	// Field (3) 'Ciphertext'
+	if size := len(e.Ciphertext); size > 524288 {
+		err = ssz.ErrBytesLengthFn("EncryptedPayload.Ciphertext", size, 524288)
+		return
+	}
+	dst = append(dst, e.Ciphertext...)
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the EncryptedPayload object
+func (e *EncryptedPayload) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size < 37 {
+		return ssz.ErrSize
+	}
+
+	tail := buf
+	var o3 uint64
+
+	// Field (0) 'OperatorID'
+	e.OperatorID = ssz.UnmarshallUint64(buf[0:8])
+
+	// Field (1) 'RequestID'
+	copy(e.RequestID[:], buf[8:32])
+
+	// Field (2) 'Type'
+	e.Type = ssz.UnmarshallUint8(buf[32:33])
+
+	// Offset (3) 'Ciphertext'
+	if o3 = ssz.ReadOffset(buf[33:37]); o3 > size {
+		return ssz.ErrOffset
+	}
+
+	if o3 < 37 {
+		return ssz.ErrInvalidVariableOffset
+	}
+
+	// Field (3) 'Ciphertext'
+	{
+		buf = tail[o3:]
+		if len(buf) > 524288 {
+			return ssz.ErrBytesLength
+		}
+		if cap(e.Ciphertext) == 0 {
+			e.Ciphertext = make([]byte, 0, len(buf))
+		}
+		e.Ciphertext = append(e.Ciphertext, buf...)
+	}
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the EncryptedPayload object
+func (e *EncryptedPayload) SizeSSZ() (size int) {
+	size = 37
+
+	// Field (3) 'Ciphertext'
+	size += len(e.Ciphertext)
+
+	return
+}
+
+// HashTreeRoot ssz hashes the EncryptedPayload object
+func (e *EncryptedPayload) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(e)
+}
+
+// HashTreeRootWith ssz hashes the EncryptedPayload object with a hasher
+func (e *EncryptedPayload) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'OperatorID'
+	hh.PutUint64(e.OperatorID)
+
+	// Field (1) 'RequestID'
+	hh.PutBytes(e.RequestID[:])
+
+	// Field (2) 'Type'
+	hh.PutUint8(e.Type)
+
+	// Field (3) 'Ciphertext'
+	{
+		elemIndx := hh.Index()
+		byteLen := uint64(len(e.Ciphertext))
+		if byteLen > 524288 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		hh.Append(e.Ciphertext)
+		hh.MerkleizeWithMixin(elemIndx, byteLen, (524288+31)/32)
+	}
+
+	hh.Merkleize(indx)
+	return
+}
+
+// GetTree ssz hashes the EncryptedPayload object
+func (e *EncryptedPayload) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(e)
+}
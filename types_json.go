@@ -7,25 +7,97 @@ import (
 	"strings"
 )
 
+// hexBytes is a JSON hex string, decoded straight from the JSON input without intermediate strings
+type hexBytes []byte
+
+func (h *hexBytes) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	if len(data) < 2 || data[0] != '"' || data[len(data)-1] != '"' {
+		return fmt.Errorf("invalid hex string")
+	}
+	data = data[1 : len(data)-1]
+	// tolerated by lenient validation, strict validation rejects it as non-canonical
+	if len(data) >= 2 && data[0] == '0' && (data[1] == 'x' || data[1] == 'X') {
+		data = data[2:]
+	}
+	ret := make([]byte, hex.DecodedLen(len(data)))
+	if _, err := hex.Decode(ret, data); err != nil {
+		return err
+	}
+	*h = ret
+	return nil
+}
+
+// appendHexString appends src to dst as a JSON hex string
+func appendHexString(dst []byte, src []byte) []byte {
+	dst = append(dst, '"')
+	n := len(dst)
+	dst = append(dst, make([]byte, hex.EncodedLen(len(src)))...)
+	hex.Encode(dst[n:], src)
+	return append(dst, '"')
+}
+
 // Proof for a DKG ceremony
 type proofJSON struct {
 	// ValidatorPubKey the resulting public key corresponding to the shared private key
-	ValidatorPubKey string `json:"validator"`
-	// EncryptedShare standard SSV encrypted shares
-	EncryptedShare string `json:"encrypted_share"`
+	ValidatorPubKey hexBytes `json:"validator"`
+	// EncryptedShare is the share encrypted to the operator, see DecodeShareEnvelope
+	EncryptedShare hexBytes `json:"encrypted_share"`
 	// SharePubKey is the share's BLS pubkey
-	SharePubKey string `json:"share_pub"`
+	SharePubKey hexBytes `json:"share_pub"`
 	// Owner address
-	Owner string `json:"owner"`
+	Owner hexBytes `json:"owner"`
+	// RequestID of the ceremony, empty for proofs issued before proofs were bound to it
+	RequestID hexBytes `json:"request_id"`
+	// ParamsHash of the ceremony, empty for proofs issued before proofs committed to it
+	ParamsHash hexBytes `json:"params_hash"`
+	// Commitments to the ceremony's public polynomial, omitted if the proof carries none
+	Commitments []hexBytes `json:"commitments,omitempty"`
+}
+
+const proofJSONOverhead = len(`{"validator":"","encrypted_share":"","share_pub":"","owner":"","request_id":"","params_hash":""}`)
+
+func (p *Proof) appendJSON(dst []byte) []byte {
+	dst = append(dst, `{"validator":`...)
+	dst = appendHexString(dst, p.ValidatorPubKey)
+	dst = append(dst, `,"encrypted_share":`...)
+	dst = appendHexString(dst, p.EncryptedShare)
+	dst = append(dst, `,"share_pub":`...)
+	dst = appendHexString(dst, p.SharePubKey)
+	dst = append(dst, `,"owner":`...)
+	dst = appendHexString(dst, p.Owner[:])
+	dst = append(dst, `,"request_id":`...)
+	dst = appendHexString(dst, p.RequestID[:])
+	dst = append(dst, `,"params_hash":`...)
+	dst = appendHexString(dst, p.ParamsHash[:])
+	if len(p.Commitments) > 0 {
+		dst = append(dst, `,"commitments":[`...)
+		for i, commitment := range p.Commitments {
+			if i > 0 {
+				dst = append(dst, ',')
+			}
+			dst = appendHexString(dst, commitment)
+		}
+		dst = append(dst, ']')
+	}
+	return append(dst, '}')
+}
+
+func (p *Proof) jsonSize() int {
+	size := proofJSONOverhead + hex.EncodedLen(len(p.ValidatorPubKey)+len(p.EncryptedShare)+len(p.SharePubKey)+len(p.Owner)+len(p.RequestID)+len(p.ParamsHash))
+	if len(p.Commitments) > 0 {
+		size += len(`,"commitments":[]`)
+		for _, commitment := range p.Commitments {
+			size += len(`"",`) + hex.EncodedLen(len(commitment))
+		}
+	}
+	return size
 }
 
 func (p *Proof) MarshalJSON() ([]byte, error) {
-	return json.Marshal(proofJSON{
-		ValidatorPubKey: hex.EncodeToString(p.ValidatorPubKey),
-		EncryptedShare:  hex.EncodeToString(p.EncryptedShare),
-		SharePubKey:     hex.EncodeToString(p.SharePubKey),
-		Owner:           hex.EncodeToString(p.Owner[:]),
-	})
+	return p.appendJSON(make([]byte, 0, p.jsonSize())), nil
 }
 
 func (p *Proof) UnmarshalJSON(data []byte) error {
@@ -33,41 +105,59 @@ func (p *Proof) UnmarshalJSON(data []byte) error {
 	if err := json.Unmarshal(data, &proof); err != nil {
 		return err
 	}
-	var err error
-	p.ValidatorPubKey, err = hex.DecodeString(proof.ValidatorPubKey)
-	if err != nil {
-		return err
+	if len(proof.Owner) != 20 {
+		return fmt.Errorf("invalid owner length")
 	}
-	p.EncryptedShare, err = hex.DecodeString(proof.EncryptedShare)
-	if err != nil {
-		return err
+	if len(proof.RequestID) != 0 && len(proof.RequestID) != 24 {
+		return fmt.Errorf("invalid request ID length")
 	}
-	p.SharePubKey, err = hex.DecodeString(proof.SharePubKey)
-	if err != nil {
-		return err
+	if len(proof.ParamsHash) != 0 && len(proof.ParamsHash) != 32 {
+		return fmt.Errorf("invalid params hash length")
 	}
-	owner, err := hex.DecodeString(proof.Owner)
-	if err != nil {
-		return err
+	if len(proof.Commitments) > 13 {
+		return fmt.Errorf("too many commitments")
 	}
-	if len(owner) != 20 {
-		return fmt.Errorf("invalid owner length")
+	p.ValidatorPubKey = proof.ValidatorPubKey
+	p.EncryptedShare = proof.EncryptedShare
+	p.SharePubKey = proof.SharePubKey
+	copy(p.Owner[:], proof.Owner)
+	copy(p.RequestID[:], proof.RequestID)
+	copy(p.ParamsHash[:], proof.ParamsHash)
+	p.Commitments = nil
+	for _, commitment := range proof.Commitments {
+		if len(commitment) != 48 {
+			return fmt.Errorf("invalid commitment length")
+		}
+		p.Commitments = append(p.Commitments, commitment)
 	}
-	copy(p.Owner[:], owner)
 	return nil
 }
 
 type signedProofJSON struct {
 	Proof *Proof `json:"proof"`
 	// Signature is an RSA signature over proof
-	Signature string `json:"signature"`
+	Signature hexBytes `json:"signature"`
 }
 
+const signedProofJSONOverhead = len(`{"proof":,"signature":""}`)
+
 func (sp *SignedProof) MarshalJSON() ([]byte, error) {
-	return json.Marshal(signedProofJSON{
-		Proof:     sp.Proof,
-		Signature: hex.EncodeToString(sp.Signature),
-	})
+	size := signedProofJSONOverhead + hex.EncodedLen(len(sp.Signature))
+	if sp.Proof != nil {
+		size += sp.Proof.jsonSize()
+	} else {
+		size += len("null")
+	}
+	ret := make([]byte, 0, size)
+	ret = append(ret, `{"proof":`...)
+	if sp.Proof != nil {
+		ret = sp.Proof.appendJSON(ret)
+	} else {
+		ret = append(ret, "null"...)
+	}
+	ret = append(ret, `,"signature":`...)
+	ret = appendHexString(ret, sp.Signature)
+	return append(ret, '}'), nil
 }
 
 func (sp *SignedProof) UnmarshalJSON(data []byte) error {
@@ -75,10 +165,9 @@ func (sp *SignedProof) UnmarshalJSON(data []byte) error {
 	if err := json.Unmarshal(data, &signedProof); err != nil {
 		return err
 	}
-	var err error
 	sp.Proof = signedProof.Proof
-	sp.Signature, err = hex.DecodeString(signedProof.Signature)
-	return err
+	sp.Signature = signedProof.Signature
+	return nil
 }
 
 type operatorJSON struct {
@@ -0,0 +1,82 @@
+package spec
+
+import (
+	"bytes"
+	"fmt"
+
+	ssz "github.com/ferranbt/fastssz"
+)
+
+// MaxOperators is the SSZ limit of operator lists (see Init.Operators, Reshare.OldOperators and Reshare.NewOperators)
+const MaxOperators = 13
+
+// Operators is an SSZ list of operators, encoded and hashed exactly as operator lists embedded within Init and Reshare.
+// It lets operator lists be committed to (and hashed) on their own.
+type Operators []*Operator
+
+// MarshalSSZ ssz marshals the Operators list
+func (o Operators) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(o)
+}
+
+// MarshalSSZTo ssz marshals the Operators list to a target array
+func (o Operators) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	return MarshalSSZListTo(buf, o, MaxOperators)
+}
+
+// UnmarshalSSZ ssz unmarshals the Operators list
+func (o *Operators) UnmarshalSSZ(buf []byte) error {
+	num, err := ssz.DecodeDynamicLength(buf, MaxOperators)
+	if err != nil {
+		return err
+	}
+	ret := make(Operators, num)
+	err = ssz.UnmarshalDynamic(buf, num, func(indx int, buf []byte) (err error) {
+		ret[indx] = new(Operator)
+		return ret[indx].UnmarshalSSZ(buf)
+	})
+	if err != nil {
+		return err
+	}
+	*o = ret
+	return nil
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the Operators list
+func (o Operators) SizeSSZ() (size int) {
+	return SizeSSZList(o)
+}
+
+// HashTreeRoot ssz hashes the Operators list
+func (o Operators) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(o)
+}
+
+// HashTreeRootWith ssz hashes the Operators list with a hasher
+func (o Operators) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	return HashTreeRootListWith(hh, o, MaxOperators)
+}
+
+// GetTree ssz hashes the Operators list
+func (o Operators) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(o)
+}
+
+// GetOperator returns operator by ID or nil if not found
+func GetOperator(operators []*Operator, id uint64) *Operator {
+	for _, operator := range operators {
+		if operator.ID == id {
+			return operator
+		}
+	}
+	return nil
+}
+
+func OperatorIDByPubKey(operators []*Operator, pkBytes []byte) (uint64, error) {
+	for _, op := range operators {
+		if bytes.Equal(op.PubKey, pkBytes) {
+			return op.ID, nil
+		}
+	}
+	return 0, fmt.Errorf("wrong operator")
+}
@@ -0,0 +1,192 @@
+package spec
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+)
+
+// ValidationMode sets how forgiving decoding of untrusted messages is (see DecodeJSON)
+type ValidationMode int
+
+const (
+	// ValidationLenient normalizes messages: unsorted operators are sorted, 0x prefixed and upper case hex and unknown JSON fields are accepted
+	ValidationLenient ValidationMode = iota
+	// ValidationStrict rejects anything but the canonical encoding: unknown JSON fields, unsorted operators and non-canonical values
+	ValidationStrict
+)
+
+func (m ValidationMode) String() string {
+	switch m {
+	case ValidationLenient:
+		return "lenient"
+	case ValidationStrict:
+		return "strict"
+	default:
+		return fmt.Sprintf("unknown(%d)", int(m))
+	}
+}
+
+// DecodeJSON decodes data into v under mode, operators of Init and Reshare messages are sorted (lenient) or required to be
+// sorted (strict). Messages embedded in v (e.g. SignedReshare or API requests) are normalized too.
+func DecodeJSON(data []byte, v interface{}, mode ValidationMode) error {
+	if err := json.Unmarshal(data, v); err != nil {
+		return err
+	}
+	if mode == ValidationStrict {
+		if err := checkCanonicalJSON(data, v); err != nil {
+			return err
+		}
+	}
+	return normalizeOperators(reflect.ValueOf(v), mode)
+}
+
+// checkCanonicalJSON returns nil if data holds no field nor value that differs from v's encoding
+func checkCanonicalJSON(data []byte, v interface{}) error {
+	encoded, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	got, err := decodeJSONValue(data)
+	if err != nil {
+		return err
+	}
+	want, err := decodeJSONValue(encoded)
+	if err != nil {
+		return err
+	}
+	return compareJSONValues("", got, want)
+}
+
+func decodeJSONValue(data []byte) (interface{}, error) {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	var ret interface{}
+	if err := dec.Decode(&ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// compareJSONValues returns an error naming the first path at which got has an unknown field or a value other than want's.
+// Fields missing from got are left to message validation.
+func compareJSONValues(path string, got, want interface{}) error {
+	switch got := got.(type) {
+	case map[string]interface{}:
+		want, ok := want.(map[string]interface{})
+		if !ok {
+			return codedError(CodeNonCanonicalEncoding, "non-canonical value at %s", jsonPath(path))
+		}
+		keys := make([]string, 0, len(got))
+		for key := range got {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			field := key
+			if path != "" {
+				field = path + "." + key
+			}
+			wantValue, found := want[key]
+			if !found {
+				return codedError(CodeUnknownField, "unknown field %s", field)
+			}
+			if err := compareJSONValues(field, got[key], wantValue); err != nil {
+				return err
+			}
+		}
+		return nil
+	case []interface{}:
+		want, ok := want.([]interface{})
+		if !ok || len(want) != len(got) {
+			return codedError(CodeNonCanonicalEncoding, "non-canonical value at %s", jsonPath(path))
+		}
+		for i := range got {
+			if err := compareJSONValues(fmt.Sprintf("%s[%d]", path, i), got[i], want[i]); err != nil {
+				return err
+			}
+		}
+		return nil
+	default:
+		if !reflect.DeepEqual(got, want) {
+			return codedError(CodeNonCanonicalEncoding, "non-canonical value at %s", jsonPath(path))
+		}
+		return nil
+	}
+}
+
+func jsonPath(path string) string {
+	if path == "" {
+		return "root"
+	}
+	return path
+}
+
+var (
+	initType    = reflect.TypeOf(Init{})
+	reshareType = reflect.TypeOf(Reshare{})
+)
+
+// normalizeOperators sorts (lenient) or checks the order of (strict) operators of every Init and Reshare reachable from v
+func normalizeOperators(v reflect.Value, mode ValidationMode) error {
+	switch v.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if v.IsNil() {
+			return nil
+		}
+		return normalizeOperators(v.Elem(), mode)
+	case reflect.Slice, reflect.Array:
+		switch v.Type().Elem().Kind() {
+		case reflect.Ptr, reflect.Interface, reflect.Struct, reflect.Slice:
+		default:
+			return nil
+		}
+		for i := 0; i < v.Len(); i++ {
+			if err := normalizeOperators(v.Index(i), mode); err != nil {
+				return err
+			}
+		}
+		return nil
+	case reflect.Struct:
+	default:
+		return nil
+	}
+
+	switch v.Type() {
+	case initType:
+		return orderOperators(v.Addr().Interface().(*Init).Operators, "operators", mode)
+	case reshareType:
+		reshare := v.Addr().Interface().(*Reshare)
+		if err := orderOperators(reshare.OldOperators, "old operators", mode); err != nil {
+			return err
+		}
+		return orderOperators(reshare.NewOperators, "new operators", mode)
+	}
+	for i := 0; i < v.NumField(); i++ {
+		if !v.Type().Field(i).IsExported() {
+			continue
+		}
+		if err := normalizeOperators(v.Field(i), mode); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func orderOperators(operators []*Operator, name string, mode ValidationMode) error {
+	for _, op := range operators {
+		if op == nil {
+			return fmt.Errorf("%s: missing operator", name)
+		}
+	}
+	if mode == ValidationStrict {
+		if !UniqueAndOrderedOperators(operators) {
+			return codedError(CodeOperatorsNotOrdered, "%s are not unique and ordered", name)
+		}
+		return nil
+	}
+	OrderOperators(operators)
+	return nil
+}
@@ -0,0 +1,123 @@
+// Package verify is the verification-only surface of the spec: RSA proof verification, hash roots and SSZ/JSON decoding.
+// It doesn't depend on BLS (cgo) code and builds with the verifyonly tag, e.g. for browser based verification UIs:
+//
+//	GOOS=js GOARCH=wasm go build -tags verifyonly -o dkg-verify.wasm ./verify/wasm
+package verify
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	spec "github.com/bloxapp/dkg-spec"
+)
+
+// Message is an SSZ message of the spec
+type Message interface {
+	MarshalSSZ() ([]byte, error)
+	UnmarshalSSZ(buf []byte) error
+	HashTreeRoot() ([32]byte, error)
+}
+
+var messages = map[string]func() Message{
+	"Operator":               func() Message { return &spec.Operator{} },
+	"Init":                   func() Message { return &spec.Init{} },
+	"Reshare":                func() Message { return &spec.Reshare{} },
+	"SignedReshare":          func() Message { return &spec.SignedReshare{} },
+	"Resign":                 func() Message { return &spec.Resign{} },
+	"SignedResign":           func() Message { return &spec.SignedResign{} },
+	"Result":                 func() Message { return &spec.Result{} },
+	"Proof":                  func() Message { return &spec.Proof{} },
+	"SignedProof":            func() Message { return &spec.SignedProof{} },
+	"VersionedProof":         func() Message { return &spec.VersionedProof{} },
+	"VersionedInit":          func() Message { return &spec.VersionedInit{} },
+	"VersionedReshare":       func() Message { return &spec.VersionedReshare{} },
+	"VersionedResign":        func() Message { return &spec.VersionedResign{} },
+	"ResponseEnvelope":       func() Message { return &spec.ResponseEnvelope{} },
+	"SignedResponseEnvelope": func() Message { return &spec.SignedResponseEnvelope{} },
+	"CeremonySummary":        func() Message { return &spec.CeremonySummary{} },
+	"SignedCeremonySummary":  func() Message { return &spec.SignedCeremonySummary{} },
+}
+
+// MessageTypes returns the (sorted) message type names accepted by NewMessage
+func MessageTypes() []string {
+	ret := make([]string, 0, len(messages))
+	for name := range messages {
+		ret = append(ret, name)
+	}
+	sort.Strings(ret)
+	return ret
+}
+
+// NewMessage returns an empty message of type name
+func NewMessage(name string) (Message, error) {
+	newF, found := messages[name]
+	if !found {
+		return nil, fmt.Errorf("unknown message type %s", name)
+	}
+	return newF(), nil
+}
+
+// VerifyCeremonyProof returns nil if signedProofJSON is a valid proof signed by operatorPubKey (base64 encoded PEM RSA public key)
+func VerifyCeremonyProof(operatorPubKey []byte, signedProofJSON []byte) error {
+	proof := &spec.SignedProof{}
+	if err := json.Unmarshal(signedProofJSON, proof); err != nil {
+		return err
+	}
+	return spec.VerifyCeremonyProof(operatorPubKey, *proof)
+}
+
+// ValidateCeremonyProofs returns nil if every ceremony in proofsJSON (proofs.json, single or bulk) is valid for operatorsJSON
+func ValidateCeremonyProofs(operatorsJSON []byte, proofsJSON []byte) error {
+	operators := []*spec.Operator{}
+	if err := json.Unmarshal(operatorsJSON, &operators); err != nil {
+		return err
+	}
+	ceremonies, err := spec.ReadProofs(bytes.NewReader(proofsJSON))
+	if err != nil {
+		return err
+	}
+	for i, proofs := range ceremonies {
+		if err := spec.ValidateCeremonyProofs(operators, proofs); err != nil {
+			return fmt.Errorf("ceremony %d: %v", i, err)
+		}
+	}
+	return nil
+}
+
+// HashTreeRoot returns the hash tree root of the JSON encoded message of type name
+func HashTreeRoot(name string, messageJSON []byte) ([32]byte, error) {
+	msg, err := NewMessage(name)
+	if err != nil {
+		return [32]byte{}, err
+	}
+	if err := json.Unmarshal(messageJSON, msg); err != nil {
+		return [32]byte{}, err
+	}
+	return msg.HashTreeRoot()
+}
+
+// DecodeSSZ decodes an SSZ encoded message of type name and returns it JSON encoded
+func DecodeSSZ(name string, data []byte) ([]byte, error) {
+	msg, err := NewMessage(name)
+	if err != nil {
+		return nil, err
+	}
+	if err := msg.UnmarshalSSZ(data); err != nil {
+		return nil, err
+	}
+	return json.Marshal(msg)
+}
+
+// EncodeSSZ encodes the JSON encoded message of type name to SSZ
+func EncodeSSZ(name string, messageJSON []byte) ([]byte, error) {
+	msg, err := NewMessage(name)
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(messageJSON, msg); err != nil {
+		return nil, err
+	}
+	return msg.MarshalSSZ()
+}
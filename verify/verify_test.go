@@ -0,0 +1,92 @@
+package verify
+
+import (
+	"encoding/json"
+	"testing"
+
+	spec "github.com/bloxapp/dkg-spec"
+	"github.com/bloxapp/dkg-spec/testing/fixtures"
+
+	"github.com/stretchr/testify/require"
+)
+
+func mustJSON(t *testing.T, v interface{}) []byte {
+	byts, err := json.Marshal(v)
+	require.NoError(t, err)
+	return byts
+}
+
+func TestVerifyCeremonyProof(t *testing.T) {
+	t.Run("valid", func(t *testing.T) {
+		require.NoError(t, VerifyCeremonyProof(
+			fixtures.EncodedOperatorPK(fixtures.TestOperator1SK),
+			mustJSON(t, &fixtures.TestOperator1Proof4Operators),
+		))
+	})
+
+	t.Run("wrong operator", func(t *testing.T) {
+		require.Error(t, VerifyCeremonyProof(
+			fixtures.EncodedOperatorPK(fixtures.TestOperator2SK),
+			mustJSON(t, &fixtures.TestOperator1Proof4Operators),
+		))
+	})
+}
+
+func TestValidateCeremonyProofs(t *testing.T) {
+	proofs := spec.CeremonyProofs{}
+	for _, result := range fixtures.Results4Operators() {
+		proof := result.SignedProof
+		proofs = append(proofs, &proof)
+	}
+
+	t.Run("bulk", func(t *testing.T) {
+		require.NoError(t, ValidateCeremonyProofs(
+			mustJSON(t, fixtures.GenerateOperators(4)),
+			mustJSON(t, []spec.CeremonyProofs{proofs, proofs}),
+		))
+	})
+
+	t.Run("operators mismatch", func(t *testing.T) {
+		require.EqualError(t, ValidateCeremonyProofs(
+			mustJSON(t, fixtures.GenerateOperators(7)),
+			mustJSON(t, proofs),
+		), "ceremony 0: mismatch proofs count")
+	})
+}
+
+func TestHashTreeRoot(t *testing.T) {
+	t.Run("proof", func(t *testing.T) {
+		expected, err := fixtures.TestOperator1Proof4Operators.Proof.HashTreeRoot()
+		require.NoError(t, err)
+
+		root, err := HashTreeRoot("Proof", mustJSON(t, fixtures.TestOperator1Proof4Operators.Proof))
+		require.NoError(t, err)
+		require.EqualValues(t, expected, root)
+	})
+
+	t.Run("unknown type", func(t *testing.T) {
+		_, err := HashTreeRoot("Deposit", []byte("{}"))
+		require.EqualError(t, err, "unknown message type Deposit")
+	})
+}
+
+func TestSSZ(t *testing.T) {
+	for _, name := range MessageTypes() {
+		_, err := NewMessage(name)
+		require.NoError(t, err)
+	}
+
+	data, err := fixtures.TestOperator1Proof4Operators.MarshalSSZ()
+	require.NoError(t, err)
+
+	proofJSON, err := DecodeSSZ("SignedProof", data)
+	require.NoError(t, err)
+	require.NoError(t, VerifyCeremonyProof(fixtures.EncodedOperatorPK(fixtures.TestOperator1SK), proofJSON))
+
+	encoded, err := EncodeSSZ("SignedProof", proofJSON)
+	require.NoError(t, err)
+	require.EqualValues(t, data, encoded)
+
+	_, err = DecodeSSZ("SignedProof", data[:10])
+	require.Error(t, err)
+}
@@ -0,0 +1,66 @@
+//go:build js && wasm
+
+// Command wasm exposes package verify to javascript as the global dkgVerify object.
+// Binary arguments and results are hex encoded, every function returns {result, error}.
+package main
+
+import (
+	"encoding/hex"
+	"strings"
+	"syscall/js"
+
+	"github.com/bloxapp/dkg-spec/verify"
+)
+
+func decodeHex(s string) ([]byte, error) {
+	return hex.DecodeString(strings.TrimPrefix(s, "0x"))
+}
+
+func ret(result interface{}, err error) interface{} {
+	if err != nil {
+		return map[string]interface{}{"result": nil, "error": err.Error()}
+	}
+	return map[string]interface{}{"result": result, "error": nil}
+}
+
+func wrap(f func(args []js.Value) (interface{}, error)) js.Func {
+	return js.FuncOf(func(this js.Value, args []js.Value) interface{} {
+		for len(args) < 2 {
+			args = append(args, js.Undefined())
+		}
+		return ret(f(args))
+	})
+}
+
+func main() {
+	js.Global().Set("dkgVerify", map[string]interface{}{
+		// verifyCeremonyProof(operatorPubKey, signedProofJSON)
+		"verifyCeremonyProof": wrap(func(args []js.Value) (interface{}, error) {
+			return true, verify.VerifyCeremonyProof([]byte(args[0].String()), []byte(args[1].String()))
+		}),
+		// validateCeremonyProofs(operatorsJSON, proofsJSON)
+		"validateCeremonyProofs": wrap(func(args []js.Value) (interface{}, error) {
+			return true, verify.ValidateCeremonyProofs([]byte(args[0].String()), []byte(args[1].String()))
+		}),
+		// hashTreeRoot(messageType, messageJSON)
+		"hashTreeRoot": wrap(func(args []js.Value) (interface{}, error) {
+			root, err := verify.HashTreeRoot(args[0].String(), []byte(args[1].String()))
+			return hex.EncodeToString(root[:]), err
+		}),
+		// decodeSSZ(messageType, sszHex)
+		"decodeSSZ": wrap(func(args []js.Value) (interface{}, error) {
+			data, err := decodeHex(args[1].String())
+			if err != nil {
+				return nil, err
+			}
+			messageJSON, err := verify.DecodeSSZ(args[0].String(), data)
+			return string(messageJSON), err
+		}),
+		// encodeSSZ(messageType, messageJSON)
+		"encodeSSZ": wrap(func(args []js.Value) (interface{}, error) {
+			data, err := verify.EncodeSSZ(args[0].String(), []byte(args[1].String()))
+			return hex.EncodeToString(data), err
+		}),
+	})
+	select {}
+}
@@ -0,0 +1,348 @@
+package spec
+
+import "fmt"
+
+const (
+	// SpecVersion is the version of this spec, see Compatibility for what it supports
+	SpecVersion = "v1.0.0"
+	// ProofVersion is the current Proof version, earlier versions (see ProofV1, ProofV2 and ProofV3) are still decoded.
+	// Version 3 proofs are encoded as version 4, but signed over their hash tree root without DomainProof.
+	ProofVersion = uint8(5)
+	// InitVersion is the current Init version, version 1 (see InitV1) is still decoded
+	InitVersion = uint8(2)
+	// ReshareVersion is the current Reshare version, version 1 (see ReshareV1) is still decoded
+	ReshareVersion = uint8(2)
+	// ResignVersion is the current Resign version, version 1 (see ResignV1) is still decoded
+	ResignVersion = uint8(2)
+)
+
+// NewVersionedProof wraps proof with the current Proof version
+func NewVersionedProof(proof *Proof) (*VersionedProof, error) {
+	byts, err := proof.MarshalSSZ()
+	if err != nil {
+		return nil, err
+	}
+	return &VersionedProof{
+		Version: ProofVersion,
+		Proof:   byts,
+	}, nil
+}
+
+// Decode returns the wrapped Proof, or error if its version is unsupported
+func (v *VersionedProof) Decode() (*Proof, error) {
+	switch v.Version {
+	case 1:
+		proof := &ProofV1{}
+		if err := proof.UnmarshalSSZ(v.Proof); err != nil {
+			return nil, err
+		}
+		return &Proof{
+			ValidatorPubKey: proof.ValidatorPubKey,
+			EncryptedShare:  proof.EncryptedShare,
+			SharePubKey:     proof.SharePubKey,
+			Owner:           proof.Owner,
+		}, nil
+	case 2:
+		proof := &ProofV2{}
+		if err := proof.UnmarshalSSZ(v.Proof); err != nil {
+			return nil, err
+		}
+		return &Proof{
+			ValidatorPubKey: proof.ValidatorPubKey,
+			EncryptedShare:  proof.EncryptedShare,
+			SharePubKey:     proof.SharePubKey,
+			Owner:           proof.Owner,
+			RequestID:       proof.RequestID,
+		}, nil
+	case 3, 4:
+		proof := &ProofV3{}
+		if err := proof.UnmarshalSSZ(v.Proof); err != nil {
+			return nil, err
+		}
+		return &Proof{
+			ValidatorPubKey: proof.ValidatorPubKey,
+			EncryptedShare:  proof.EncryptedShare,
+			SharePubKey:     proof.SharePubKey,
+			Owner:           proof.Owner,
+			RequestID:       proof.RequestID,
+			ParamsHash:      proof.ParamsHash,
+		}, nil
+	case ProofVersion:
+		ret := &Proof{}
+		if err := ret.UnmarshalSSZ(v.Proof); err != nil {
+			return nil, err
+		}
+		return ret, nil
+	default:
+		return nil, fmt.Errorf("unsupported proof version %d", v.Version)
+	}
+}
+
+// SigningRoot returns the signing root of the wrapped Proof, unaffected by the wrapper itself.
+// Earlier versions were signed over the hash tree root of their own type (e.g. ProofV1).
+func (v *VersionedProof) SigningRoot() ([32]byte, error) {
+	var proof interface {
+		UnmarshalSSZ([]byte) error
+		HashTreeRoot() ([32]byte, error)
+	}
+	switch v.Version {
+	case 1:
+		proof = &ProofV1{}
+	case 2:
+		proof = &ProofV2{}
+	case 3:
+		proof = &ProofV3{}
+	case 4:
+		proof := &ProofV3{}
+		if err := proof.UnmarshalSSZ(v.Proof); err != nil {
+			return [32]byte{}, err
+		}
+		return ComputeSigningRoot(proof, DomainProof)
+	case ProofVersion:
+		proof := &Proof{}
+		if err := proof.UnmarshalSSZ(v.Proof); err != nil {
+			return [32]byte{}, err
+		}
+		return proof.SigningRoot()
+	default:
+		return [32]byte{}, fmt.Errorf("unsupported proof version %d", v.Version)
+	}
+	if err := proof.UnmarshalSSZ(v.Proof); err != nil {
+		return [32]byte{}, err
+	}
+	return proof.HashTreeRoot()
+}
+
+// proofVersions returns the Proof versions proof can be encoded as, newest first.
+// Earlier versions lack the fields added since, so only proofs leaving them empty can be encoded as them.
+func proofVersions(proof *Proof) []uint8 {
+	ret := []uint8{ProofVersion}
+	if len(proof.Commitments) > 0 {
+		return ret
+	}
+	ret = append(ret, 4, 3)
+	if proof.ParamsHash != [32]byte{} {
+		return ret
+	}
+	ret = append(ret, 2)
+	if proof.RequestID != [24]byte{} {
+		return ret
+	}
+	return append(ret, 1)
+}
+
+// encodeProof wraps proof with version, one of proofVersions(proof)
+func encodeProof(proof *Proof, version uint8) (*VersionedProof, error) {
+	var encoded interface{ MarshalSSZ() ([]byte, error) }
+	switch version {
+	case 1:
+		encoded = &ProofV1{
+			ValidatorPubKey: proof.ValidatorPubKey,
+			EncryptedShare:  proof.EncryptedShare,
+			SharePubKey:     proof.SharePubKey,
+			Owner:           proof.Owner,
+		}
+	case 2:
+		encoded = &ProofV2{
+			ValidatorPubKey: proof.ValidatorPubKey,
+			EncryptedShare:  proof.EncryptedShare,
+			SharePubKey:     proof.SharePubKey,
+			Owner:           proof.Owner,
+			RequestID:       proof.RequestID,
+		}
+	case 3, 4:
+		encoded = &ProofV3{
+			ValidatorPubKey: proof.ValidatorPubKey,
+			EncryptedShare:  proof.EncryptedShare,
+			SharePubKey:     proof.SharePubKey,
+			Owner:           proof.Owner,
+			RequestID:       proof.RequestID,
+			ParamsHash:      proof.ParamsHash,
+		}
+	case ProofVersion:
+		encoded = proof
+	default:
+		return nil, fmt.Errorf("unsupported proof version %d", version)
+	}
+	byts, err := encoded.MarshalSSZ()
+	if err != nil {
+		return nil, err
+	}
+	return &VersionedProof{
+		Version: version,
+		Proof:   byts,
+	}, nil
+}
+
+// NewVersionedInit wraps init with the current Init version
+func NewVersionedInit(init *Init) (*VersionedInit, error) {
+	byts, err := init.MarshalSSZ()
+	if err != nil {
+		return nil, err
+	}
+	return &VersionedInit{
+		Version: InitVersion,
+		Init:    byts,
+	}, nil
+}
+
+// Decode returns the wrapped Init, or error if its version is unsupported
+func (v *VersionedInit) Decode() (*Init, error) {
+	switch v.Version {
+	case 1:
+		init := &InitV1{}
+		if err := init.UnmarshalSSZ(v.Init); err != nil {
+			return nil, err
+		}
+		// version 1 inits are free and accepted at any time
+		return &Init{
+			Operators:             init.Operators,
+			T:                     init.T,
+			WithdrawalCredentials: init.WithdrawalCredentials,
+			Fork:                  init.Fork,
+			Owner:                 init.Owner,
+			Nonce:                 init.Nonce,
+		}, nil
+	case InitVersion:
+		ret := &Init{}
+		if err := ret.UnmarshalSSZ(v.Init); err != nil {
+			return nil, err
+		}
+		return ret, nil
+	default:
+		return nil, fmt.Errorf("unsupported init version %d", v.Version)
+	}
+}
+
+// SigningRoot returns the signing root of the wrapped Init, unaffected by the wrapper itself.
+// Version 1 inits were signed over the hash tree root of InitV1.
+func (v *VersionedInit) SigningRoot() ([32]byte, error) {
+	if v.Version == 1 {
+		init := &InitV1{}
+		if err := init.UnmarshalSSZ(v.Init); err != nil {
+			return [32]byte{}, err
+		}
+		return init.HashTreeRoot()
+	}
+	init, err := v.Decode()
+	if err != nil {
+		return [32]byte{}, err
+	}
+	return init.SigningRoot()
+}
+
+// NewVersionedReshare wraps reshare with the current Reshare version
+func NewVersionedReshare(reshare *Reshare) (*VersionedReshare, error) {
+	byts, err := reshare.MarshalSSZ()
+	if err != nil {
+		return nil, err
+	}
+	return &VersionedReshare{
+		Version: ReshareVersion,
+		Reshare: byts,
+	}, nil
+}
+
+// Decode returns the wrapped Reshare, or error if its version is unsupported
+func (v *VersionedReshare) Decode() (*Reshare, error) {
+	switch v.Version {
+	case 1:
+		reshare := &ReshareV1{}
+		if err := reshare.UnmarshalSSZ(v.Reshare); err != nil {
+			return nil, err
+		}
+		return &Reshare{
+			ValidatorPubKey:       reshare.ValidatorPubKey,
+			OldOperators:          reshare.OldOperators,
+			NewOperators:          reshare.NewOperators,
+			OldT:                  reshare.OldT,
+			NewT:                  reshare.NewT,
+			Fork:                  reshare.Fork,
+			WithdrawalCredentials: reshare.WithdrawalCredentials,
+			Owner:                 reshare.Owner,
+			Nonce:                 reshare.Nonce,
+		}, nil
+	case ReshareVersion:
+		ret := &Reshare{}
+		if err := ret.UnmarshalSSZ(v.Reshare); err != nil {
+			return nil, err
+		}
+		return ret, nil
+	default:
+		return nil, fmt.Errorf("unsupported reshare version %d", v.Version)
+	}
+}
+
+// SigningRoot returns the signing root of the wrapped Reshare, unaffected by the wrapper itself.
+// Version 1 reshares were signed over the hash tree root of ReshareV1.
+func (v *VersionedReshare) SigningRoot() ([32]byte, error) {
+	if v.Version == 1 {
+		reshare := &ReshareV1{}
+		if err := reshare.UnmarshalSSZ(v.Reshare); err != nil {
+			return [32]byte{}, err
+		}
+		return reshare.HashTreeRoot()
+	}
+	reshare, err := v.Decode()
+	if err != nil {
+		return [32]byte{}, err
+	}
+	return reshare.SigningRoot()
+}
+
+// NewVersionedResign wraps resign with the current Resign version
+func NewVersionedResign(resign *Resign) (*VersionedResign, error) {
+	byts, err := resign.MarshalSSZ()
+	if err != nil {
+		return nil, err
+	}
+	return &VersionedResign{
+		Version: ResignVersion,
+		Resign:  byts,
+	}, nil
+}
+
+// Decode returns the wrapped Resign, or error if its version is unsupported
+func (v *VersionedResign) Decode() (*Resign, error) {
+	switch v.Version {
+	case 1:
+		resign := &ResignV1{}
+		if err := resign.UnmarshalSSZ(v.Resign); err != nil {
+			return nil, err
+		}
+		// version 1 resigns signed deposit data for DepositAmount
+		return &Resign{
+			ValidatorPubKey:       resign.ValidatorPubKey,
+			Fork:                  resign.Fork,
+			WithdrawalCredentials: resign.WithdrawalCredentials,
+			Owner:                 resign.Owner,
+			Nonce:                 resign.Nonce,
+			Amount:                DepositAmount,
+		}, nil
+	case ResignVersion:
+		ret := &Resign{}
+		if err := ret.UnmarshalSSZ(v.Resign); err != nil {
+			return nil, err
+		}
+		return ret, nil
+	default:
+		return nil, fmt.Errorf("unsupported resign version %d", v.Version)
+	}
+}
+
+// SigningRoot returns the signing root of the wrapped Resign, unaffected by the wrapper itself.
+// Version 1 resigns were signed over the hash tree root of ResignV1.
+func (v *VersionedResign) SigningRoot() ([32]byte, error) {
+	if v.Version == 1 {
+		resign := &ResignV1{}
+		if err := resign.UnmarshalSSZ(v.Resign); err != nil {
+			return [32]byte{}, err
+		}
+		return resign.HashTreeRoot()
+	}
+	resign, err := v.Decode()
+	if err != nil {
+		return [32]byte{}, err
+	}
+	return resign.SigningRoot()
+}
@@ -0,0 +1,204 @@
+// Package wal implements an append-only write-ahead log of ceremony state transitions and outbound messages.
+//
+// Every record is written and synced before the corresponding message is sent, so an operator restarting
+// mid-ceremony can re-send the exact logged message instead of dealing a new share or signing again.
+//
+// File layout, repeated per record:
+//
+//	length uint32 (big endian, of the record body)
+//	crc32  uint32 (IEEE, of the record body)
+//	body   request ID [24]byte | type uint8 | data
+//
+// A torn (partially written) last record is truncated when the log is opened, any other corrupted record fails Open.
+package wal
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"os"
+	"sync"
+)
+
+const (
+	headerLen = 8
+	// MaxDataLen bounds a single record's data
+	MaxDataLen = 1 << 20
+)
+
+// RecordType is the type of a WAL record
+type RecordType uint8
+
+const (
+	// RecordState records a ceremony state transition, data is the new state
+	RecordState RecordType = iota + 1
+	// RecordOutbound records a message about to be sent, data is the encoded message
+	RecordOutbound
+)
+
+type Record struct {
+	RequestID [24]byte
+	Type      RecordType
+	Data      []byte
+}
+
+func (r *Record) encode() []byte {
+	body := make([]byte, 0, 25+len(r.Data))
+	body = append(body, r.RequestID[:]...)
+	body = append(body, byte(r.Type))
+	body = append(body, r.Data...)
+
+	ret := make([]byte, headerLen, headerLen+len(body))
+	binary.BigEndian.PutUint32(ret[0:4], uint32(len(body)))
+	binary.BigEndian.PutUint32(ret[4:8], crc32.ChecksumIEEE(body))
+	return append(ret, body...)
+}
+
+// WAL is a write-ahead log backed by a single file
+type WAL struct {
+	mtx     sync.Mutex
+	file    *os.File
+	records []*Record
+}
+
+// Open opens (or creates) the log at path and replays its records
+func Open(path string) (*WAL, error) {
+	file, err := os.OpenFile(path, os.O_RDWR|os.O_CREATE, 0600)
+	if err != nil {
+		return nil, err
+	}
+	info, err := file.Stat()
+	if err != nil {
+		file.Close()
+		return nil, err
+	}
+	records, valid, err := readRecords(file, info.Size())
+	if err != nil {
+		file.Close()
+		return nil, err
+	}
+	// drop a torn tail so new records are appended after the last valid one
+	if err := file.Truncate(valid); err != nil {
+		file.Close()
+		return nil, err
+	}
+	if _, err := file.Seek(valid, io.SeekStart); err != nil {
+		file.Close()
+		return nil, err
+	}
+	return &WAL{
+		file:    file,
+		records: records,
+	}, nil
+}
+
+// readRecords returns all records of r, of size bytes, and the offset following the last one.
+// Only the last record may be torn: a record failing its length or CRC check which doesn't end the log is corrupted.
+func readRecords(r io.Reader, size int64) ([]*Record, int64, error) {
+	var ret []*Record
+	var offset int64
+	reader := bufio.NewReader(r)
+	header := make([]byte, headerLen)
+	for {
+		if _, err := io.ReadFull(reader, header); err != nil {
+			if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+				return ret, offset, nil
+			}
+			return nil, 0, err
+		}
+		length := binary.BigEndian.Uint32(header[0:4])
+		end := offset + headerLen + int64(length)
+		if length < 25 || length > MaxDataLen+25 {
+			if end >= size {
+				return ret, offset, nil
+			}
+			return nil, 0, fmt.Errorf("corrupted record at offset %d: invalid length", offset)
+		}
+		body := make([]byte, length)
+		if _, err := io.ReadFull(reader, body); err != nil {
+			if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+				return ret, offset, nil
+			}
+			return nil, 0, err
+		}
+		if crc32.ChecksumIEEE(body) != binary.BigEndian.Uint32(header[4:8]) {
+			if end == size {
+				return ret, offset, nil
+			}
+			return nil, 0, fmt.Errorf("corrupted record at offset %d: checksum mismatch", offset)
+		}
+
+		record := &Record{Type: RecordType(body[24]), Data: body[25:]}
+		copy(record.RequestID[:], body[:24])
+		ret = append(ret, record)
+		offset = end
+	}
+}
+
+// Append durably writes record, it returns only once the record is synced to disk
+func (w *WAL) Append(record *Record) error {
+	if len(record.Data) > MaxDataLen {
+		return fmt.Errorf("record data too large")
+	}
+	w.mtx.Lock()
+	defer w.mtx.Unlock()
+
+	if _, err := w.file.Write(record.encode()); err != nil {
+		return err
+	}
+	if err := w.file.Sync(); err != nil {
+		return err
+	}
+	w.records = append(w.records, record)
+	return nil
+}
+
+// Records returns all records in the order they were appended
+func (w *WAL) Records() []*Record {
+	w.mtx.Lock()
+	defer w.mtx.Unlock()
+	return append([]*Record{}, w.records...)
+}
+
+// State returns the last state recorded for requestID
+func (w *WAL) State(requestID [24]byte) ([]byte, bool) {
+	return w.last(requestID, RecordState)
+}
+
+// States returns all states recorded for requestID, in the order they were appended
+func (w *WAL) States(requestID [24]byte) [][]byte {
+	w.mtx.Lock()
+	defer w.mtx.Unlock()
+	var ret [][]byte
+	for _, record := range w.records {
+		if record.RequestID == requestID && record.Type == RecordState {
+			ret = append(ret, record.Data)
+		}
+	}
+	return ret
+}
+
+// Outbound returns the last outbound message recorded for requestID
+func (w *WAL) Outbound(requestID [24]byte) ([]byte, bool) {
+	return w.last(requestID, RecordOutbound)
+}
+
+func (w *WAL) last(requestID [24]byte, recordType RecordType) ([]byte, bool) {
+	w.mtx.Lock()
+	defer w.mtx.Unlock()
+	for i := len(w.records) - 1; i >= 0; i-- {
+		if w.records[i].RequestID == requestID && w.records[i].Type == recordType {
+			return w.records[i].Data, true
+		}
+	}
+	return nil, false
+}
+
+func (w *WAL) Close() error {
+	w.mtx.Lock()
+	defer w.mtx.Unlock()
+	return w.file.Close()
+}
@@ -0,0 +1,104 @@
+package wal
+
+import (
+	"encoding/binary"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestWAL(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "ceremonies.wal")
+	requestID := [24]byte{1, 2, 3}
+
+	w, err := Open(path)
+	require.NoError(t, err)
+	require.Empty(t, w.Records())
+	require.NoError(t, w.Append(&Record{RequestID: requestID, Type: RecordState, Data: []byte("init")}))
+	require.NoError(t, w.Append(&Record{RequestID: requestID, Type: RecordOutbound, Data: []byte("result")}))
+	require.NoError(t, w.Append(&Record{RequestID: [24]byte{4}, Type: RecordState, Data: []byte("failed")}))
+	require.NoError(t, w.Close())
+
+	t.Run("replay", func(t *testing.T) {
+		w, err := Open(path)
+		require.NoError(t, err)
+		defer w.Close()
+		require.Len(t, w.Records(), 3)
+
+		state, found := w.State(requestID)
+		require.True(t, found)
+		require.EqualValues(t, "init", state)
+		require.EqualValues(t, [][]byte{[]byte("init")}, w.States(requestID))
+		outbound, found := w.Outbound(requestID)
+		require.True(t, found)
+		require.EqualValues(t, "result", outbound)
+		_, found = w.Outbound([24]byte{4})
+		require.False(t, found)
+	})
+
+	t.Run("torn tail", func(t *testing.T) {
+		info, err := os.Stat(path)
+		require.NoError(t, err)
+		f, err := os.OpenFile(path, os.O_WRONLY|os.O_APPEND, 0600)
+		require.NoError(t, err)
+		_, err = f.Write((&Record{RequestID: requestID, Type: RecordOutbound, Data: []byte("partial")}).encode()[:20])
+		require.NoError(t, err)
+		require.NoError(t, f.Close())
+
+		w, err := Open(path)
+		require.NoError(t, err)
+		require.Len(t, w.Records(), 3)
+		require.NoError(t, w.Append(&Record{RequestID: requestID, Type: RecordState, Data: []byte("done")}))
+		require.NoError(t, w.Close())
+
+		truncated, err := os.Stat(path)
+		require.NoError(t, err)
+		require.EqualValues(t, info.Size()+int64(headerLen+25+len("done")), truncated.Size())
+
+		w, err = Open(path)
+		require.NoError(t, err)
+		defer w.Close()
+		require.Len(t, w.Records(), 4)
+		state, _ := w.State(requestID)
+		require.EqualValues(t, "done", state)
+	})
+
+	t.Run("corrupted record", func(t *testing.T) {
+		byts, err := os.ReadFile(path)
+		require.NoError(t, err)
+		byts[headerLen] ^= 1
+		corrupted := filepath.Join(t.TempDir(), "corrupted.wal")
+		require.NoError(t, os.WriteFile(corrupted, byts, 0600))
+
+		_, err = Open(corrupted)
+		require.EqualError(t, err, "corrupted record at offset 0: checksum mismatch")
+
+		// the log isn't truncated
+		after, err := os.ReadFile(corrupted)
+		require.NoError(t, err)
+		require.EqualValues(t, byts, after)
+
+		byts, err = os.ReadFile(path)
+		require.NoError(t, err)
+		binary.BigEndian.PutUint32(byts[0:4], 1)
+		require.NoError(t, os.WriteFile(corrupted, byts, 0600))
+		_, err = Open(corrupted)
+		require.EqualError(t, err, "corrupted record at offset 0: invalid length")
+	})
+
+	t.Run("corrupted last record", func(t *testing.T) {
+		byts, err := os.ReadFile(path)
+		require.NoError(t, err)
+		byts[len(byts)-1] ^= 1
+		corrupted := filepath.Join(t.TempDir(), "corrupted.wal")
+		require.NoError(t, os.WriteFile(corrupted, byts, 0600))
+
+		// a torn last record, its body partially written
+		w, err := Open(corrupted)
+		require.NoError(t, err)
+		defer w.Close()
+		require.Len(t, w.Records(), 3)
+	})
+}